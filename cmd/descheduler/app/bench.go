@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/features"
+)
+
+// NewBenchCommand creates a command benchmarking descheduling cycles against a synthetic cluster
+func NewBenchCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+	opts := descheduler.NewBenchOptions()
+	output := forecastOutputTable
+	nodeCPU, nodeMemory := "4", "16Gi"
+	podCPU, podMemory := "100m", "128Mi"
+
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Benchmark descheduling cycles against a synthetic cluster",
+		Long: `Creates a synthetic cluster of the given number of nodes and pods and measures the duration,
+the memory and the API calls of descheduling cycles of the given policy.
+The cluster is created in a kwok cluster when a kubeconfig is given, otherwise an in-memory fake apiserver is used.
+Pods are evicted for real unless --dry-run is set.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != forecastOutputTable && output != forecastOutputJSON {
+				return fmt.Errorf("unsupported output format %q, expected one of %q, %q", output, forecastOutputTable, forecastOutputJSON)
+			}
+			for _, q := range []struct {
+				flag, value string
+				milli       bool
+				into        *int64
+			}{
+				{"node-cpu", nodeCPU, true, &opts.NodeMilliCPU},
+				{"node-memory", nodeMemory, false, &opts.NodeMemory},
+				{"pod-cpu", podCPU, true, &opts.PodMilliCPU},
+				{"pod-memory", podMemory, false, &opts.PodMemory},
+			} {
+				quantity, err := resource.ParseQuantity(q.value)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %v", q.flag, err)
+				}
+				if q.milli {
+					*q.into = quantity.MilliValue()
+				} else {
+					*q.into = quantity.Value()
+				}
+			}
+			s.DefaultFeatureGates = features.DefaultMutableFeatureGate
+			descheduler.SetupPlugins()
+
+			result, err := descheduler.Bench(cmd.Context(), s, opts)
+			if err != nil {
+				return err
+			}
+			return printBenchResult(cmd.OutOrStdout(), result, output)
+		},
+	}
+	cmd.SetOut(out)
+
+	flags := cmd.Flags()
+	flags.StringVar(&s.ClientConnection.Kubeconfig, "kubeconfig", s.ClientConnection.Kubeconfig, "File with kube configuration of a kwok cluster to create the synthetic cluster in. An in-memory fake apiserver is used when empty.")
+	flags.StringVar(&s.PolicyConfigFile, "policy-config-file", s.PolicyConfigFile, "File with descheduler policy configuration.")
+	flags.BoolVar(&s.DryRun, "dry-run", s.DryRun, "Execute descheduler in dry run mode.")
+	flags.IntVar(&opts.Nodes, "nodes", opts.Nodes, "Number of synthetic nodes.")
+	flags.IntVar(&opts.Pods, "pods", opts.Pods, "Number of synthetic pods.")
+	flags.IntVar(&opts.PodsPerWorkload, "pods-per-workload", opts.PodsPerWorkload, "Number of pods owned by a single replica set.")
+	flags.IntVar(&opts.HotNodes, "hot-nodes", opts.HotNodes, "Number of nodes receiving half of the pods. The pods are spread evenly when zero.")
+	flags.StringVar(&nodeCPU, "node-cpu", nodeCPU, "Allocatable cpu of every node.")
+	flags.StringVar(&nodeMemory, "node-memory", nodeMemory, "Allocatable memory of every node.")
+	flags.StringVar(&podCPU, "pod-cpu", podCPU, "Cpu request of every pod.")
+	flags.StringVar(&podMemory, "pod-memory", podMemory, "Memory request of every pod.")
+	flags.StringVar(&opts.Namespace, "namespace", opts.Namespace, "Namespace of the synthetic pods.")
+	flags.IntVar(&opts.Cycles, "cycles", opts.Cycles, "Number of measured descheduling cycles.")
+	flags.BoolVar(&opts.Cleanup, "cleanup", opts.Cleanup, "Delete the synthetic nodes and pods from the cluster once finished.")
+	flags.StringVarP(&output, "output", "o", output, "Output format. One of: table, json.")
+
+	return cmd
+}
+
+func printBenchResult(out io.Writer, result *descheduler.BenchResult, output string) error {
+	if output == forecastOutputJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+
+	fmt.Fprintf(out, "Nodes: %d, pods: %d, informers synced in %v, heap in use: %s\n", result.Nodes, result.Pods, result.SyncDuration, formatBytes(result.HeapInUseBytes))
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CYCLE\tDURATION\tEVICTED\tALLOCATED\tAPI CALLS")
+	for idx, cycle := range result.Cycles {
+		var total uint
+		calls := make([]string, 0, len(cycle.APICalls))
+		for call, count := range cycle.APICalls {
+			total += count
+			calls = append(calls, fmt.Sprintf("%s=%d", call, count))
+		}
+		sort.Strings(calls)
+		fmt.Fprintf(w, "%d\t%v\t%d\t%s\t%d (%s)\n", idx, cycle.Duration, cycle.Evicted, formatBytes(cycle.AllocatedBytes), total, strings.Join(calls, ", "))
+	}
+	return w.Flush()
+}
+
+func formatBytes(bytes uint64) string {
+	return fmt.Sprintf("%.1fMiB", float64(bytes)/(1024*1024))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/descheduler/source"
+	"sigs.k8s.io/descheduler/pkg/features"
+)
+
+// NewDiffCommand creates a command comparing the would-be evictions of two policies
+func NewDiffCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+	output := forecastOutputTable
+	var snapshotFile string
+	var randomSeed int64
+	var comparedPolicyFile string
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare would-be evictions of two policies",
+		Long: `Simulates a single descheduling cycle of two policies against the same snapshot of the cluster
+and reports the pods evicted under only one of them. The cycles run in the dry run mode with the eviction limits
+of the policies respected. No pod is evicted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != forecastOutputTable && output != forecastOutputJSON {
+				return fmt.Errorf("unsupported output format %q, expected one of %q, %q", output, forecastOutputTable, forecastOutputJSON)
+			}
+			if s.PolicyConfigFile == "" || comparedPolicyFile == "" {
+				return fmt.Errorf("both --policy-config-file and --compared-policy-config-file are required")
+			}
+			s.DefaultFeatureGates = features.DefaultMutableFeatureGate
+			descheduler.SetupPlugins()
+			if snapshotFile != "" {
+				objectSource, err := source.NewFileSource(snapshotFile)
+				if err != nil {
+					return err
+				}
+				s.ObjectSource = objectSource
+			}
+
+			if cmd.Flags().Changed("random-seed") {
+				s.RandomSeed = &randomSeed
+			}
+			diff, err := descheduler.DiffSimulations(cmd.Context(), s, comparedPolicyFile)
+			if err != nil {
+				return err
+			}
+			return printSimulationDiff(cmd.OutOrStdout(), diff, output)
+		},
+	}
+	cmd.SetOut(out)
+
+	flags := cmd.Flags()
+	flags.StringVar(&s.ClientConnection.Kubeconfig, "kubeconfig", s.ClientConnection.Kubeconfig, "File with kube configuration.")
+	flags.StringVar(&s.PolicyConfigFile, "policy-config-file", s.PolicyConfigFile, "File with the base descheduler policy configuration.")
+	flags.StringVar(&comparedPolicyFile, "compared-policy-config-file", comparedPolicyFile, "File with the descheduler policy configuration compared to the base one.")
+	flags.StringVar(&snapshotFile, "snapshot", snapshotFile, "File with a snapshot of the cluster state (see the snapshot export subcommand) used instead of the cluster.")
+	flags.Int64Var(&randomSeed, "random-seed", randomSeed, "Seed of the randomized choices of the plugins overriding the randomSeed of the policy, e.g. the seed logged by a descheduling cycle to reproduce.")
+	flags.StringVarP(&output, "output", "o", output, "Output format. One of: table, json.")
+
+	return cmd
+}
+
+func printSimulationDiff(out io.Writer, diff *descheduler.SimulationDiff, output string) error {
+	if output == forecastOutputJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diff)
+	}
+
+	fmt.Fprintf(out, "Base policy evicted: %d, compared policy evicted: %d, evicted under both: %d\n", diff.BaseEvicted, diff.ComparedEvicted, diff.Unchanged)
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CHANGE\tNAMESPACE\tPOD\tNODE\tPLUGIN")
+	for _, eviction := range diff.Removed {
+		fmt.Fprintf(w, "-\t%s\t%s\t%s\t%s/%s\n", eviction.Namespace, eviction.Pod, eviction.Node, eviction.Profile, eviction.Plugin)
+	}
+	for _, eviction := range diff.Added {
+		fmt.Fprintf(w, "+\t%s\t%s\t%s\t%s/%s\n", eviction.Namespace, eviction.Pod, eviction.Node, eviction.Profile, eviction.Plugin)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/descheduler/source"
+	"sigs.k8s.io/descheduler/pkg/features"
+)
+
+const (
+	forecastOutputTable = "table"
+	forecastOutputJSON  = "json"
+)
+
+// NewForecastCommand creates a command reporting the disruption exposure of workloads
+func NewForecastCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+	output := forecastOutputTable
+	var snapshotFile string
+	var randomSeed int64
+
+	cmd := &cobra.Command{
+		Use:   "forecast",
+		Short: "Report disruption exposure of workloads",
+		Long: `Reports per workload which plugins of the given policy would evict its pods in the next descheduling cycle.
+A single cycle is simulated in the dry run mode with the eviction limits ignored. No pod is evicted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != forecastOutputTable && output != forecastOutputJSON {
+				return fmt.Errorf("unsupported output format %q, expected one of %q, %q", output, forecastOutputTable, forecastOutputJSON)
+			}
+			s.DefaultFeatureGates = features.DefaultMutableFeatureGate
+			descheduler.SetupPlugins()
+			if snapshotFile != "" {
+				objectSource, err := source.NewFileSource(snapshotFile)
+				if err != nil {
+					return err
+				}
+				s.ObjectSource = objectSource
+			}
+
+			if cmd.Flags().Changed("random-seed") {
+				s.RandomSeed = &randomSeed
+			}
+			exposures, err := descheduler.Forecast(cmd.Context(), s)
+			if err != nil {
+				return err
+			}
+			return printExposures(cmd.OutOrStdout(), exposures, output)
+		},
+	}
+	cmd.SetOut(out)
+
+	flags := cmd.Flags()
+	flags.StringVar(&s.ClientConnection.Kubeconfig, "kubeconfig", s.ClientConnection.Kubeconfig, "File with kube configuration.")
+	flags.StringVar(&s.PolicyConfigFile, "policy-config-file", s.PolicyConfigFile, "File with descheduler policy configuration.")
+	flags.StringVar(&snapshotFile, "snapshot", snapshotFile, "File with a snapshot of the cluster state (see the snapshot export subcommand) used instead of the cluster.")
+	flags.Int64Var(&randomSeed, "random-seed", randomSeed, "Seed of the randomized choices of the plugins overriding the randomSeed of the policy, e.g. the seed logged by a descheduling cycle to reproduce.")
+	flags.StringVarP(&output, "output", "o", output, "Output format. One of: table, json.")
+
+	return cmd
+}
+
+func printExposures(out io.Writer, exposures []descheduler.WorkloadExposure, output string) error {
+	if output == forecastOutputJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(exposures)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tKIND\tNAME\tPLUGINS")
+	for _, exposure := range exposures {
+		var plugins []string
+		for _, plugin := range exposure.Plugins {
+			plugins = append(plugins, fmt.Sprintf("%s/%s(%d)", plugin.Profile, plugin.Plugin, plugin.Pods))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", exposure.Namespace, exposure.Kind, exposure.Name, strings.Join(plugins, ","))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+// NewLintCommand creates a command flagging risky configurations of a policy
+func NewLintCommand(out io.Writer) *cobra.Command {
+	output := forecastOutputTable
+	var policyConfigFile string
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Flag risky configurations of a policy",
+		Long: `Validates the policy the same way the descheduler does on start and warns about valid but risky
+configurations, e.g. no eviction limits set or PodLifeTime evicting pods of all the namespaces.
+The command exits with a non-zero status when the policy is invalid or any warning is reported,
+so it can gate policy changes in CI. No cluster is accessed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != forecastOutputTable && output != forecastOutputJSON {
+				return fmt.Errorf("unsupported output format %q, expected one of %q, %q", output, forecastOutputTable, forecastOutputJSON)
+			}
+			if policyConfigFile == "" {
+				return fmt.Errorf("--policy-config-file is required")
+			}
+			descheduler.SetupPlugins()
+
+			warnings, err := descheduler.LintPolicyConfig(policyConfigFile, pluginregistry.PluginRegistry)
+			if err != nil {
+				return err
+			}
+			if err := printLintWarnings(cmd.OutOrStdout(), warnings, output); err != nil {
+				return err
+			}
+			if len(warnings) > 0 {
+				return fmt.Errorf("policy %q has %d warning(s)", policyConfigFile, len(warnings))
+			}
+			return nil
+		},
+	}
+	cmd.SetOut(out)
+
+	flags := cmd.Flags()
+	flags.StringVar(&policyConfigFile, "policy-config-file", policyConfigFile, "File with the descheduler policy configuration.")
+	flags.StringVarP(&output, "output", "o", output, "Output format. One of: table, json.")
+
+	return cmd
+}
+
+func printLintWarnings(out io.Writer, warnings []descheduler.LintWarning, output string) error {
+	if output == forecastOutputJSON {
+		if warnings == nil {
+			warnings = []descheduler.LintWarning{}
+		}
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(warnings)
+	}
+
+	if len(warnings) == 0 {
+		fmt.Fprintln(out, "No warnings")
+		return nil
+	}
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tPROFILE\tPLUGIN\tMESSAGE")
+	for _, warning := range warnings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", warning.Rule, warning.Profile, warning.Plugin, warning.Message)
+	}
+	return w.Flush()
+}
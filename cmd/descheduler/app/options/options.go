@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiserver "k8s.io/apiserver/pkg/server"
 	apiserveroptions "k8s.io/apiserver/pkg/server/options"
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 
 	restclient "k8s.io/client-go/rest"
@@ -40,6 +41,7 @@ import (
 	"sigs.k8s.io/descheduler/pkg/apis/componentconfig"
 	"sigs.k8s.io/descheduler/pkg/apis/componentconfig/v1alpha1"
 	deschedulerscheme "sigs.k8s.io/descheduler/pkg/descheduler/scheme"
+	"sigs.k8s.io/descheduler/pkg/descheduler/source"
 	"sigs.k8s.io/descheduler/pkg/features"
 	"sigs.k8s.io/descheduler/pkg/tracing"
 )
@@ -54,6 +56,7 @@ type DeschedulerServer struct {
 
 	Client            clientset.Interface
 	EventClient       clientset.Interface
+	DynamicClient     dynamic.Interface
 	MetricsClient     metricsclient.Interface
 	PrometheusClient  promapi.Client
 	SecureServing     *apiserveroptions.SecureServingOptionsWithLoopback
@@ -64,6 +67,12 @@ type DeschedulerServer struct {
 	FeatureGates map[string]bool
 	// DefaultFeatureGates for internal accessing so unit tests can enable/disable specific features
 	DefaultFeatureGates featuregate.FeatureGate
+	// ObjectSource the clients are created from, the cluster of the client connection when nil
+	ObjectSource source.ObjectSource
+	// ProfileClients are the clients of the profiles configuring their own client identity, keyed by the profile name
+	ProfileClients map[string]clientset.Interface
+	// RandomSeed overrides the random seed of the policy, e.g. to reproduce a descheduling cycle in a simulation
+	RandomSeed *int64
 }
 
 // NewDeschedulerServer creates a new DeschedulerServer with default parameters
@@ -112,7 +121,14 @@ func (rs *DeschedulerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.Float32Var(&rs.ClientConnection.QPS, "client-connection-qps", rs.ClientConnection.QPS, "QPS to use for interacting with kubernetes apiserver.")
 	fs.Int32Var(&rs.ClientConnection.Burst, "client-connection-burst", rs.ClientConnection.Burst, "Burst to use for interacting with kubernetes apiserver.")
 	fs.StringVar(&rs.PolicyConfigFile, "policy-config-file", rs.PolicyConfigFile, "File with descheduler policy configuration.")
+	fs.StringVar(&rs.PolicyURL, "policy-url", rs.PolicyURL, "HTTPS URL the descheduler policy configuration is fetched from instead of the policy config file.")
+	fs.DurationVar(&rs.PolicyURLPollInterval, "policy-url-poll-interval", rs.PolicyURLPollInterval, "Time interval between two consecutive polls of the policy URL, the descheduler restarts with every changed policy. Zero disables the polling.")
+	fs.StringVar(&rs.PolicyURLCAFile, "policy-url-ca-file", rs.PolicyURLCAFile, "File with the CA bundle the server of the policy URL is verified with. The system roots are used when empty.")
+	fs.StringVar(&rs.PolicySignatureKeyFile, "policy-signature-key-file", rs.PolicySignatureKeyFile, "File with the PEM encoded public key verifying the signature of the policy, unsigned or tampered policies are refused. The signature of the policy fetched from the policy URL is sent base64 encoded in the X-Descheduler-Policy-Signature header.")
+	fs.StringVar(&rs.PolicySignatureFile, "policy-signature-file", rs.PolicySignatureFile, "File with the base64 encoded signature of the policy config file, e.g. from cosign sign-blob. Defaults to the policy config file with the .sig suffix.")
 	fs.BoolVar(&rs.DryRun, "dry-run", rs.DryRun, "Execute descheduler in dry run mode.")
+	fs.BoolVar(&rs.ReadOnly, "read-only", rs.ReadOnly, "Reject every mutating request sent to the apiserver, regardless of the dry run settings. Implies --dry-run.")
+	fs.BoolVar(&rs.DryRunValidation, "dry-run-validation", rs.DryRunValidation, "Validate every eviction of the dry run mode through the Eviction API with DryRun=All, so the PodDisruptionBudgets and the admission webhooks are checked by the apiserver. Implies --dry-run.")
 	fs.BoolVar(&rs.DisableMetrics, "disable-metrics", rs.DisableMetrics, "Disables metrics. The metrics are by default served through https://localhost:10258/metrics. Secure address, resp. port can be changed through --bind-address, resp. --secure-port flags.")
 	fs.StringVar(&rs.Tracing.CollectorEndpoint, "otel-collector-endpoint", "", "Set this flag to the OpenTelemetry Collector Service Address")
 	fs.StringVar(&rs.Tracing.TransportCert, "otel-transport-ca-cert", "", "Path of the CA Cert that can be used to generate the client Certificate for establishing secure connection to the OTEL in gRPC mode")
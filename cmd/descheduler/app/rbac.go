@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+// NewRBACCommand creates a command generating the minimal RBAC of a policy
+func NewRBACCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+	var policyConfigFile string
+	manifestOptions := descheduler.RBACManifestOptions{
+		Name:           "descheduler",
+		ServiceAccount: "descheduler-sa",
+		Namespace:      "kube-system",
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rbac",
+		Short: "Generate the minimal RBAC of a policy",
+		Long: `Prints the ClusterRole, the Roles and their bindings granting the descheduler service account
+the minimal permissions the policy needs: the permissions of the descheduler itself, of the enabled policy
+features and the permissions the enabled plugins declare. No cluster is accessed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if policyConfigFile == "" {
+				return fmt.Errorf("--policy-config-file is required")
+			}
+			descheduler.SetupPlugins()
+
+			rules, err := descheduler.PolicyRulesForPolicyConfig(policyConfigFile, pluginregistry.PluginRegistry, descheduler.PolicyRulesOptions{
+				DryRun:           s.DryRun,
+				DryRunValidation: s.DryRunValidation,
+				LeaderElection:   &s.LeaderElection,
+			})
+			if err != nil {
+				return err
+			}
+			return descheduler.WriteRBACManifests(cmd.OutOrStdout(), rules, manifestOptions)
+		},
+	}
+	cmd.SetOut(out)
+
+	flags := cmd.Flags()
+	flags.StringVar(&policyConfigFile, "policy-config-file", policyConfigFile, "File with the descheduler policy configuration.")
+	flags.StringVar(&manifestOptions.Name, "name", manifestOptions.Name, "Name of the ClusterRole, the Roles and their bindings.")
+	flags.StringVar(&manifestOptions.ServiceAccount, "service-account", manifestOptions.ServiceAccount, "Service account the descheduler runs as.")
+	flags.StringVar(&manifestOptions.Namespace, "namespace", manifestOptions.Namespace, "Namespace of the service account.")
+	flags.BoolVar(&s.DryRun, "dry-run", s.DryRun, "Generate the RBAC of a descheduler running in the dry run mode, no evictions are granted.")
+	flags.BoolVar(&s.DryRunValidation, "dry-run-validation", s.DryRunValidation, "Grant the evictions validated in the server dry run mode to a descheduler running in the dry run mode.")
+	flags.BoolVar(&s.LeaderElection.LeaderElect, "leader-elect", s.LeaderElection.LeaderElect, "Grant the access to the lease of the leader election.")
+	flags.StringVar(&s.LeaderElection.ResourceName, "leader-elect-resource-name", s.LeaderElection.ResourceName, "The name of the lease of the leader election.")
+	flags.StringVar(&s.LeaderElection.ResourceNamespace, "leader-elect-resource-namespace", s.LeaderElection.ResourceNamespace, "The namespace of the lease of the leader election.")
+
+	return cmd
+}
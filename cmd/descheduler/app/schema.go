@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+// NewSchemaCommand creates a command printing the JSON Schemas of the policy and the plugin args
+func NewSchemaCommand(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [plugin]",
+		Short: "Print the JSON Schema of the policy or the args of a plugin",
+		Long: `Prints the JSON Schema of the descheduler/v1alpha2 policy with the args of each pluginConfig entry
+checked against the schema of the named plugin. When a plugin name is given only the schema of its args is printed.
+The schemas let editors and CI validate and auto-complete policies without running the descheduler against a cluster.
+The same schemas are bundled in the docs/schemas directory of the repository.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			descheduler.SetupPlugins()
+
+			schema := descheduler.PolicySchema(pluginregistry.PluginRegistry)
+			if len(args) == 1 {
+				var err error
+				if schema, err = descheduler.PluginArgsSchema(args[0], pluginregistry.PluginRegistry); err != nil {
+					return err
+				}
+			}
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(schema)
+		},
+	}
+	cmd.SetOut(out)
+	return cmd
+}
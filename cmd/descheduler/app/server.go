@@ -26,7 +26,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/metrics"
 	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
 	"sigs.k8s.io/descheduler/pkg/tracing"
 
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -37,7 +39,6 @@ import (
 	"k8s.io/component-base/logs"
 	logsapi "k8s.io/component-base/logs/api/v1"
 	_ "k8s.io/component-base/logs/json/register"
-	"k8s.io/component-base/metrics/legacyregistry"
 	"k8s.io/klog/v2"
 )
 
@@ -92,9 +93,11 @@ func Run(rootCtx context.Context, rs *options.DeschedulerServer) error {
 
 	pathRecorderMux := mux.NewPathRecorderMux("descheduler")
 	if !rs.DisableMetrics {
-		pathRecorderMux.Handle("/metrics", legacyregistry.HandlerWithReset())
+		pathRecorderMux.Handle("/metrics", metrics.HandlerWithReset())
 	}
 
+	pathRecorderMux.Handle("/nodeutilization/classifications", nodeutilization.ClassificationsHandler())
+
 	healthz.InstallHandler(pathRecorderMux, healthz.NamedCheck("Descheduler", healthz.PingHealthz.Check))
 
 	stoppedCh, _, err := rs.SecureServingInfo.Serve(pathRecorderMux, 0, ctx.Done())
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+)
+
+// NewSnapshotCommand creates a command managing snapshots of the cluster state
+func NewSnapshotCommand(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage snapshots of the cluster state",
+		Long: `Snapshots of the cluster state let the forecast and diff subcommands run offline through --snapshot,
+e.g. to debug descheduling decisions taken in production or to attach the cluster state to a bug report.`,
+	}
+	cmd.AddCommand(newSnapshotExportCommand(out))
+	return cmd
+}
+
+func newSnapshotExportCommand(out io.Writer) *cobra.Command {
+	s, err := options.NewDeschedulerServer()
+	if err != nil {
+		klog.ErrorS(err, "unable to initialize server")
+	}
+	var outputFile string
+	includeMetrics := true
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a snapshot of the cluster state",
+		Long: `Exports the nodes, pods, namespaces, priority classes, pod disruption budgets and the node and pod metrics
+of the Kubernetes Metrics server as a YAML list. Secrets are never exported.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := cmd.OutOrStdout()
+			if outputFile != "" {
+				f, err := os.Create(outputFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			return descheduler.ExportSnapshot(cmd.Context(), s, w, includeMetrics)
+		},
+	}
+	cmd.SetOut(out)
+
+	flags := cmd.Flags()
+	flags.StringVar(&s.ClientConnection.Kubeconfig, "kubeconfig", s.ClientConnection.Kubeconfig, "File with kube configuration.")
+	flags.StringVar(&outputFile, "output-file", outputFile, "File the snapshot is written to. Standard output when empty.")
+	flags.BoolVar(&includeMetrics, "include-metrics", includeMetrics, "Include the node and pod metrics of the Kubernetes Metrics server.")
+
+	return cmd
+}
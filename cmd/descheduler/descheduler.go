@@ -27,6 +27,13 @@ func main() {
 	out := os.Stdout
 	cmd := app.NewDeschedulerCommand(out)
 	cmd.AddCommand(app.NewVersionCommand())
+	cmd.AddCommand(app.NewForecastCommand(out))
+	cmd.AddCommand(app.NewBenchCommand(out))
+	cmd.AddCommand(app.NewDiffCommand(out))
+	cmd.AddCommand(app.NewSnapshotCommand(out))
+	cmd.AddCommand(app.NewLintCommand(out))
+	cmd.AddCommand(app.NewSchemaCommand(out))
+	cmd.AddCommand(app.NewRBACCommand(out))
 
 	code := cli.Run(cmd)
 	os.Exit(code)
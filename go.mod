@@ -4,7 +4,9 @@ go 1.23.3
 
 require (
 	github.com/client9/misspell v0.3.4
+	github.com/google/cel-go v0.22.0
 	github.com/google/go-cmp v0.6.0
+	github.com/google/gofuzz v1.2.0
 	github.com/openshift/build-machinery-go v0.0.0-20250211133638-a00a772ae1a2
 	github.com/prometheus/client_golang v1.19.1
 	github.com/prometheus/common v0.55.0
@@ -30,6 +32,7 @@ require (
 	kubevirt.io/client-go v1.3.0
 	kubevirt.io/containerized-data-importer-api v1.60.1 // indirect; drops dependency on o/api
 	sigs.k8s.io/controller-tools v0.16.5
+	sigs.k8s.io/descheduler/pkg/framework/types v0.0.0
 	sigs.k8s.io/mdtoc v1.1.0
 	sigs.k8s.io/yaml v1.4.0
 )
@@ -70,9 +73,7 @@ require (
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/gomarkdown/markdown v0.0.0-20210514010506-3b9f47219fe7 // indirect
 	github.com/google/btree v1.0.1 // indirect
-	github.com/google/cel-go v0.22.0 // indirect
 	github.com/google/gnostic-models v0.6.8 // indirect
-	github.com/google/gofuzz v1.2.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/gorilla/websocket v1.5.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0 // indirect
@@ -138,3 +139,5 @@ replace k8s.io/kube-openapi => k8s.io/kube-openapi v0.0.0-20240430033511-f0e62f9
 replace golang.org/x/net => golang.org/x/net v0.33.0
 
 replace golang.org/x/crypto => golang.org/x/crypto v0.31.0
+
+replace sigs.k8s.io/descheduler/pkg/framework/types => ./pkg/framework/types
@@ -8,36 +8,15 @@ host_platform="$(os::build::host_platform)"
 
 # by default, build for these platforms
 platforms=(
-  linux/amd64
+  "${OS_RELEASE_PLATFORMS[@]}"
   darwin/amd64
   windows/amd64
 )
-image_platforms=( )
+image_platforms=( "${OS_RELEASE_PLATFORMS[@]}" )
 test_platforms=( "${host_platform}" )
 
 targets=( "${OS_CROSS_COMPILE_TARGETS[@]}" )
 
-# Special case ppc64le
-if [[ "${host_platform}" == "linux/ppc64le" ]]; then
-  platforms+=( "linux/ppc64le" )
-fi
-
-# Special case arm64
-if [[ "${host_platform}" == "linux/arm64" ]]; then
-  platforms+=( "linux/arm64" )
-fi
-
-# Special case s390x
-if [[ "${host_platform}" == "linux/s390x" ]]; then
-  platforms+=( "linux/s390x" )
-fi
-
-# On linux platforms, build images
-if [[ "${host_platform}" == linux/* ]]; then
-  image_platforms+=( "${host_platform}" )
-fi
-
-# filter platform list
 if [[ -n "${OS_ONLY_BUILD_PLATFORMS-}" ]]; then
   filtered=( )
   for platform in ${platforms[@]}; do
@@ -72,7 +51,20 @@ os::build::build_static_binaries "${OS_SCRATCH_IMAGE_COMPILE_TARGETS_LINUX[@]-}"
 os::build::build_binaries "${OS_IMAGE_COMPILE_TARGETS_LINUX[@]-}"
 
 # Build the primary client/server for all platforms
-OS_BUILD_PLATFORMS=("${platforms[@]+"${platforms[@]}"}")
+# the linux binaries are released static so they run on any distribution and in scratch images
+static_platforms=( )
+dynamic_platforms=( )
+for platform in ${platforms[@]+"${platforms[@]}"}; do
+  if [[ "${platform}" == linux/* ]]; then
+    static_platforms+=("${platform}")
+  else
+    dynamic_platforms+=("${platform}")
+  fi
+done
+
+OS_BUILD_PLATFORMS=("${static_platforms[@]+"${static_platforms[@]}"}")
+os::build::build_static_binaries "${OS_CROSS_COMPILE_TARGETS[@]-}"
+OS_BUILD_PLATFORMS=("${dynamic_platforms[@]+"${dynamic_platforms[@]}"}")
 os::build::build_binaries "${OS_CROSS_COMPILE_TARGETS[@]-}"
 
 # Build the test binaries for the host platform
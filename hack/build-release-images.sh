@@ -0,0 +1,56 @@
+#!/bin/bash
+
+# This script builds the multi-arch descheduler image of the static release binaries
+# built by hack/build-cross.sh through docker buildx. The image is tagged OS_IMAGE
+# (descheduler:<version> by default) and pushed when OS_PUSH_IMAGE is set, in which
+# case the image of every platform the host can execute is run as a smoke test.
+source "$(dirname "${BASH_SOURCE}")/lib/init.sh"
+
+function cleanup() {
+    return_code=$?
+    os::util::describe_return_code "${return_code}"
+    exit "${return_code}"
+}
+trap "cleanup" EXIT
+
+os::util::ensure::system_binary_exists docker
+os::build::version::get_vars
+
+image="${OS_IMAGE:-descheduler:${OS_GIT_VERSION}}"
+
+platforms=( )
+for platform in "${OS_RELEASE_PLATFORMS[@]}"; do
+  if [[ -n "${OS_ONLY_BUILD_PLATFORMS-}" && ! "${platform}" =~ "${OS_ONLY_BUILD_PLATFORMS}" ]]; then
+    continue
+  fi
+  if [[ ! -f "${OS_OUTPUT_BINPATH}/${platform}/descheduler" ]]; then
+    os::log::fatal "${OS_OUTPUT_BINPATH}/${platform}/descheduler does not exist, run hack/build-cross.sh first"
+  fi
+  platforms+=("${platform}")
+done
+if [[ ${#platforms[@]} -eq 0 ]]; then
+  os::log::fatal "no release platform matches OS_ONLY_BUILD_PLATFORMS=${OS_ONLY_BUILD_PLATFORMS-}"
+fi
+
+output="--output=type=image,push=false"
+if [[ -n "${OS_PUSH_IMAGE-}" ]]; then
+  output="--output=type=image,push=true"
+fi
+
+docker buildx build \
+  --platform "$(IFS=,; echo "${platforms[*]}")" \
+  --build-arg "BINPATH=${OS_OUTPUT_SUBPATH}/bin" \
+  -f "${OS_ROOT}/images/descheduler/Dockerfile.static" \
+  -t "${image}" \
+  "${output}" \
+  "${OS_ROOT}"
+os::log::info "Built ${image} for ${platforms[*]}"
+
+if [[ -n "${OS_PUSH_IMAGE-}" ]]; then
+  for platform in "${platforms[@]}"; do
+    if os::build::can_run_platform "${platform}"; then
+      docker run --rm --platform "${platform}" "${image}" version > /dev/null || os::log::fatal "${image} failed to run on ${platform}"
+      os::log::info "${image} runs on ${platform}"
+    fi
+  done
+fi
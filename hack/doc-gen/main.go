@@ -13,6 +13,13 @@ var docGenPath = "docs/cli"
 func main() {
 	cmd := app.NewDeschedulerCommand(os.Stdout)
 	cmd.AddCommand(app.NewVersionCommand())
+	cmd.AddCommand(app.NewForecastCommand(os.Stdout))
+	cmd.AddCommand(app.NewBenchCommand(os.Stdout))
+	cmd.AddCommand(app.NewDiffCommand(os.Stdout))
+	cmd.AddCommand(app.NewSnapshotCommand(os.Stdout))
+	cmd.AddCommand(app.NewLintCommand(os.Stdout))
+	cmd.AddCommand(app.NewSchemaCommand(os.Stdout))
+	cmd.AddCommand(app.NewRBACCommand(os.Stdout))
 	cmd.DisableAutoGenTag = true // Disable this so that the diff wont track it
 	if err := doc.GenMarkdownTree(cmd, docGenPath); err != nil {
 		log.Fatal(err)
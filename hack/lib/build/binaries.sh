@@ -61,6 +61,26 @@ function os::build::platform_arch() {
 }
 readonly -f os::build::platform_arch
 
+# os::build::can_run_platform returns success when the host can execute binaries of
+# the platform, either natively or through a registered qemu binfmt handler
+function os::build::can_run_platform() {
+  local platform=$1
+  if [[ "${platform}" == "$(os::build::host_platform)" ]]; then
+    return 0
+  fi
+  if [[ "${platform%/*}" != "linux" || "$(go env GOHOSTOS)" != "linux" ]]; then
+    return 1
+  fi
+
+  local qemu_arch="${platform##*/}"
+  case "${qemu_arch}" in
+    amd64) qemu_arch="x86_64" ;;
+    arm64) qemu_arch="aarch64" ;;
+  esac
+  [[ -f "/proc/sys/fs/binfmt_misc/qemu-${qemu_arch}" ]]
+}
+readonly -f os::build::can_run_platform
+
 # os::build::setup_env will check that the `go` commands is available in
 # ${PATH}. If not running on Travis, it will also check that the Go version is
 # good enough for the Kubernetes build.
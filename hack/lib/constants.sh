@@ -29,6 +29,15 @@ readonly OS_CROSS_COMPILE_TARGETS=(
 )
 readonly OS_CROSS_COMPILE_BINARIES=("${OS_CROSS_COMPILE_TARGETS[@]##*/}")
 
+# OS_RELEASE_PLATFORMS are the platforms the static release binaries and the
+# multi-arch images are built for, regardless of the host platform
+readonly OS_RELEASE_PLATFORMS=(
+  linux/amd64
+  linux/arm64
+  linux/ppc64le
+  linux/s390x
+)
+
 readonly OS_TEST_TARGETS=( )
 
 # os::build::get_product_vars exports variables that we expect to change
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+var schemaGenPath = "docs/schemas"
+
+func main() {
+	descheduler.SetupPlugins()
+
+	schemas := []*descheduler.JSONSchema{descheduler.PolicySchema(pluginregistry.PluginRegistry)}
+	for name := range pluginregistry.PluginRegistry {
+		schema, err := descheduler.PluginArgsSchema(name, pluginregistry.PluginRegistry)
+		if err != nil {
+			log.Fatal(err)
+		}
+		schemas = append(schemas, schema)
+	}
+
+	for _, schema := range schemas {
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		file := filepath.Join(schemaGenPath, schema.ID)
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(file, append(data, '\n'), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
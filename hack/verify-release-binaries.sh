@@ -0,0 +1,39 @@
+#!/bin/bash
+
+# This script checks the release binaries built by hack/build-cross.sh target
+# the expected platform and are static. Binaries the host can execute, either
+# natively or through a registered qemu binfmt handler, are run as a smoke test.
+source "$(dirname "${BASH_SOURCE}")/lib/init.sh"
+
+function cleanup() {
+    return_code=$?
+    os::util::describe_return_code "${return_code}"
+    exit "${return_code}"
+}
+trap "cleanup" EXIT
+
+for platform in "${OS_RELEASE_PLATFORMS[@]}"; do
+  if [[ -n "${OS_ONLY_BUILD_PLATFORMS-}" && ! "${platform}" =~ "${OS_ONLY_BUILD_PLATFORMS}" ]]; then
+    continue
+  fi
+  for binary in "${OS_CROSS_COMPILE_BINARIES[@]}"; do
+    path="${OS_OUTPUT_BINPATH}/${platform}/${binary}"
+    if [[ ! -f "${path}" ]]; then
+      os::log::fatal "${path} does not exist, run hack/build-cross.sh first"
+    fi
+
+    settings="$(go version -m "${path}")"
+    for setting in "GOOS=${platform%/*}" "GOARCH=${platform##*/}" "CGO_ENABLED=0"; do
+      if ! grep -q -P "\tbuild\t${setting}$" <<< "${settings}"; then
+        os::log::fatal "${path} was not built with ${setting}"
+      fi
+    done
+
+    if os::build::can_run_platform "${platform}"; then
+      "${path}" version > /dev/null || os::log::fatal "${path} failed to run"
+      os::log::info "${path} is static and runs"
+    else
+      os::log::info "${path} is static, running it is skipped as no qemu binfmt handler is registered for ${platform}"
+    fi
+  done
+done
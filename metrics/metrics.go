@@ -17,8 +17,15 @@ limitations under the License.
 package metrics
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"k8s.io/component-base/metrics"
 	"k8s.io/component-base/metrics/legacyregistry"
 	"sigs.k8s.io/descheduler/pkg/version"
@@ -27,6 +34,8 @@ import (
 const (
 	// DeschedulerSubsystem - subsystem name used by descheduler
 	DeschedulerSubsystem = "descheduler"
+	// PluginSubsystem - subsystem name the metrics registered by the plugins are namespaced under
+	PluginSubsystem = DeschedulerSubsystem + "_plugin"
 )
 
 var (
@@ -38,6 +47,14 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		}, []string{"result", "strategy", "profile", "namespace", "node"})
 
+	StrategyErrors = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "strategy_errors",
+			Help:           "Number of errors returned by the strategies, by the reason, by the strategy, by the profile. 'fatal_config' reason means a configuration bug, 'throttled' and 'transient_api' reasons mean a flaky cluster",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"reason", "strategy", "profile"})
+
 	buildInfo = metrics.NewGauge(
 		&metrics.GaugeOpts{
 			Subsystem:      DeschedulerSubsystem,
@@ -66,11 +83,112 @@ var (
 			Buckets:        []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 25, 50, 100},
 		}, []string{"strategy", "profile"})
 
+	PodEvictionDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "pod_eviction_duration_seconds",
+			Help:           "Latency of the pod eviction API calls, by the result, by the strategy, by the profile. Observations carry trace exemplars when tracing is enabled",
+			StabilityLevel: metrics.ALPHA,
+			Buckets:        []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{"result", "strategy", "profile"})
+
+	ScopedNodes = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "scoped_nodes",
+			Help:           "Number of nodes matching the policy node selector, by the readiness",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"ready"})
+
+	RecommendedEvictions = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "recommended_evictions",
+			Help:           "Number of pods evicted, or evicted in the dry run mode, in the last descheduling cycle, by the namespace, by the owner kind, by the owner name, by the strategy",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"namespace", "owner_kind", "owner_name", "strategy"})
+
+	ZoneOutage = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "zone_outage",
+			Help:           "Zones in an outage in the last descheduling cycle, the balance plugins are suspended while any zone is in an outage",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"zone"})
+
+	ClusterHeadroom = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "cluster_headroom_percentage",
+			Help:           "Share of the allocatable resources of the schedulable nodes left free by the pod requests at the start of the last descheduling cycle",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"resource"})
+
+	NonConvergingPlugins = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "non_converging_plugins",
+			Help:           "Plugins evicting the pods of the same workloads from the same nodes cycle after cycle, 1 while reported non-converging",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"profile", "plugin"})
+
+	PodsSkipped = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "pods_skipped",
+			Help:           "Number of the pods of the workloads selected by the skip explanations skipped by the evictor plugins or failing the eviction, counted when explained",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"namespace", "owner_kind", "owner_name", "reason"})
+
+	UnscheduledReplacements = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "unscheduled_replacements",
+			Help:           "Number of the evictions failing the verification, the replacements of the evicted pod not scheduled within the timeout",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"namespace", "owner_kind", "owner_name", "strategy", "profile"})
+
+	ReplacementPlacements = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "replacement_placements",
+			Help:           "Number of the replacements of the evicted pods scheduled, by the placement, by the strategy, by the profile. 'same_node' placement means the replacement landed back on the node the pod was evicted from",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"placement", "strategy", "profile"})
+
+	ReturnedReplacementsRatio = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "returned_replacements_ratio",
+			Help:           "Share of the replacements of the evicted pods scheduled back on the node the pod was evicted from, by the strategy, by the profile. A high ratio means the strategy fights the scheduler",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"strategy", "profile"})
+
+	EvictionCircuitBreakerTrips = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      DeschedulerSubsystem,
+			Name:           "eviction_circuit_breaker_trips",
+			Help:           "Number of the descheduling cycles aborted as more evictions failed than allowed by maxEvictionFailuresPerCycle",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{})
+
 	metricsList = []metrics.Registerable{
 		PodsEvicted,
+		StrategyErrors,
 		buildInfo,
 		DeschedulerLoopDuration,
 		DeschedulerStrategyDuration,
+		PodEvictionDuration,
+		ScopedNodes,
+		RecommendedEvictions,
+		ZoneOutage,
+		ClusterHeadroom,
+		NonConvergingPlugins,
+		PodsSkipped,
+		UnscheduledReplacements,
+		ReplacementPlacements,
+		ReturnedReplacementsRatio,
+		EvictionCircuitBreakerTrips,
 	}
 )
 
@@ -90,3 +208,51 @@ func RegisterMetrics(extraMetrics ...metrics.Registerable) {
 		legacyregistry.MustRegister(metric)
 	}
 }
+
+var (
+	pluginMetricsLock sync.Mutex
+	pluginMetrics     = map[string]metrics.Registerable{}
+)
+
+// RegisterPluginMetric registers a metric of a plugin. The fully-qualified name of the metric
+// must start with the PluginSubsystem, e.g. descheduler_plugin_topology_spread_max_skew.
+// The plugins are built every descheduling cycle, registering the same metric again is a no-op.
+func RegisterPluginMetric(metric metrics.Registerable) error {
+	name := metric.FQName()
+	if !strings.HasPrefix(name, PluginSubsystem+"_") {
+		return fmt.Errorf("plugin metric %q is not namespaced under %s_", name, PluginSubsystem)
+	}
+
+	pluginMetricsLock.Lock()
+	defer pluginMetricsLock.Unlock()
+	if registered, ok := pluginMetrics[name]; ok {
+		if registered != metric {
+			return fmt.Errorf("another plugin metric %q is already registered", name)
+		}
+		return nil
+	}
+	if err := legacyregistry.Register(metric); err != nil {
+		return fmt.Errorf("unable to register plugin metric %q: %w", name, err)
+	}
+	pluginMetrics[name] = metric
+	return nil
+}
+
+// HandlerWithReset returns an HTTP handler for the global registry that invokes
+// registry reset if the http method is DELETE. Unlike the legacyregistry handler
+// the OpenMetrics format is negotiated so exemplars are exposed to the scrapers
+// that request it.
+func HandlerWithReset() http.Handler {
+	defaultHandler := metrics.HandlerFor(legacyregistry.DefaultGatherer, metrics.HandlerOpts{EnableOpenMetrics: true})
+	return promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer,
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				legacyregistry.Reset()
+				io.WriteString(w, "metrics reset\n")
+				return
+			}
+			defaultHandler.ServeHTTP(w, r)
+		}),
+	)
+}
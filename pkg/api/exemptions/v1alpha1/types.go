@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeschedulingExemption exempts the pods of a workload from the descheduling until the expiry.
+// The exemptions are namespaced, the teams create them for their own workloads.
+type DeschedulingExemption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DeschedulingExemptionSpec `json:"spec"`
+}
+
+// DeschedulingExemptionSpec selects the exempted pods
+type DeschedulingExemptionSpec struct {
+	// Selector of the exempted pods of the namespace, an empty selector exempts all the pods of the namespace
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// Expiry of the exemption, the pods are descheduled as any other pods afterwards
+	Expiry metav1.Time `json:"expiry"`
+
+	// Reason the workload is exempted for, e.g. a link to the change request
+	Reason string `json:"reason,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeschedulingExemptionList is a list of DeschedulingExemption objects
+type DeschedulingExemptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DeschedulingExemption `json:"items"`
+}
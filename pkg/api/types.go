@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -43,10 +44,26 @@ type DeschedulerPolicy struct {
 	// MaxNoOfPodsToTotal restricts maximum of pods to be evicted total.
 	MaxNoOfPodsToEvictTotal *uint
 
+	// MaxNoOfPodsToEvictPerOwner restricts maximum of pods sharing the same owner, e.g. a ReplicaSet,
+	// a StatefulSet or a Job, to be evicted per descheduling cycle.
+	MaxNoOfPodsToEvictPerOwner *uint
+
+	// MaxNoOfPodsToEvictPerTopologyDomain restricts maximum of pods to be evicted from the nodes of every
+	// topology domain, e.g. of every zone, per descheduling cycle.
+	MaxNoOfPodsToEvictPerTopologyDomain *uint
+
+	// TopologyDomainKey is the node label identifying the topology domains of MaxNoOfPodsToEvictPerTopologyDomain.
+	// Default is topology.kubernetes.io/zone.
+	TopologyDomainKey string
+
 	// EvictionFailureEventNotification should be set to true to enable eviction failure event notification.
 	// Default is false.
 	EvictionFailureEventNotification *bool
 
+	// NodeEvictionAnnotations should be set to true to annotate nodes with the timestamp and count
+	// of the last descheduler-driven evictions from them. Default is false.
+	NodeEvictionAnnotations *bool
+
 	// MetricsCollector configures collection of metrics about actual resource utilization
 	// Deprecated. Use MetricsProviders field instead.
 	MetricsCollector *MetricsCollector
@@ -54,11 +71,408 @@ type DeschedulerPolicy struct {
 	// MetricsProviders configure collection of metrics about actual resource utilization from various sources
 	MetricsProviders []MetricsProvider
 
+	// MaxEvictionFailuresPerCycle aborts the descheduling cycle once more evictions failed than the count or the
+	// percentage of the eviction requests of the cycle, e.g. while the API server is unavailable. The following
+	// cycles are skipped, twice as many after every consecutive aborted cycle, 8 at most.
+	MaxEvictionFailuresPerCycle *intstr.IntOrString
+
+	// EvictionBackend selects the API the pods are evicted through, Eviction (default) or Evacuation
+	EvictionBackend EvictionBackend
+
+	// EvictionOrder orders the pods of the same workload the plugins pick for eviction, e.g. OlderRevisionsFirst
+	// evicts the pods of the older revisions of a Deployment before the pods of its newer revisions
+	EvictionOrder EvictionOrder
+
 	// GracePeriodSeconds The duration in seconds before the object should be deleted. Value must be non-negative integer.
 	// The value zero indicates delete immediately. If this value is nil, the default grace period for the
 	// specified type will be used.
 	// Defaults to a per object value if not specified. zero means delete immediately.
 	GracePeriodSeconds *int64
+
+	// Notifications configures sinks notified about the outcome of descheduling cycles
+	Notifications *Notifications
+
+	// EvictionSpreading spreads the MaxNoOfPodsToEvictTotal budget across topology domains
+	EvictionSpreading *EvictionSpreading
+
+	// NodeCooldownCycles sets the number of descheduling cycles no pods are evicted from a node
+	// after pods got evicted from it. Default is 0 (no cool-down).
+	NodeCooldownCycles *uint
+
+	// DefaultEvictorArgs are shared by the DefaultEvictor plugin of every profile. The DefaultEvictor
+	// args of a profile override the shared args field by field.
+	DefaultEvictorArgs runtime.Object
+
+	// PodWatch scopes the pods the descheduler watches. Pods outside of the scope are invisible
+	// to every plugin, including the node utilization and node fit computations.
+	PodWatch *PodWatch
+
+	// EvictionFairness shares the MaxNoOfPodsToEvictTotal budget fairly across namespaces or owners
+	EvictionFairness *EvictionFairness
+
+	// NamespaceDisruptionQuotas limit the evictions per namespace over a sliding period across all the plugins
+	NamespaceDisruptionQuotas []NamespaceDisruptionQuota
+
+	// AggregatedEvictionEvents should be set to true to emit one event per owner per descheduling cycle
+	// instead of one event per evicted pod
+	AggregatedEvictionEvents *bool
+
+	// EvictionVeto is evaluated against every eviction as the final step before the pod is evicted
+	EvictionVeto *EvictionVeto
+
+	// ConcurrentDrains detects the nodes drained by other actors, e.g. kubectl drain or a cluster upgrade.
+	// No pods are evicted from the drained nodes and the plugins do not get them as targets either.
+	ConcurrentDrains *ConcurrentDrains
+
+	// NodeLeases makes the evictions mutually exclusive per node with the other disruption controllers
+	// holding the leases named after the nodes
+	NodeLeases *NodeLeases
+
+	// DeschedulingExemptions exempts the pods selected by the DeschedulingExemption objects
+	// from the evictions until the exemptions expire
+	DeschedulingExemptions *DeschedulingExemptions
+
+	// ZoneOutageBrake suspends the balance plugins while a zone appears to be down,
+	// rebalancing during an outage amplifies the load on the surviving zones
+	ZoneOutageBrake *ZoneOutageBrake
+
+	// MinClusterHeadroom is the least share of the allocatable resources of the schedulable nodes left free
+	// by the pod requests, no pods are evicted in a cycle starting with less headroom for any listed resource
+	MinClusterHeadroom ResourceThresholds
+
+	// ConvergenceDetection reports the plugins evicting the pods of the same workloads from the same nodes
+	// cycle after cycle, a sign of a conflict between the policy and the scheduler
+	ConvergenceDetection *ConvergenceDetection
+
+	// SkipExplanations periodically explains why the evictor plugins skip the pods of the workloads
+	SkipExplanations *SkipExplanations
+
+	// PreferredNodeHints annotates the owners of the evicted pods with the nodes the plugins consider
+	// good targets for the replacement pods, for a scheduler plugin to prefer them
+	PreferredNodeHints *PreferredNodeHints
+
+	// GangScheduling makes the evictions aware of the gangs, i.e. the pods co-scheduled as a PodGroup
+	// by a batch scheduler. Evicting a single member of a gang leaves the rest of the gang deadlocked.
+	GangScheduling *GangScheduling
+
+	// EvictionVerification verifies the replacements of the evicted pods get scheduled and stops evicting
+	// the pods of the owners whose replacements do not, closing the loop on the evictions
+	EvictionVerification *EvictionVerification
+
+	// RandomSeed seeds the randomized choices of the plugins, e.g. the tie-breaking, of the first descheduling
+	// cycle, the seed of every next cycle is derived from the seed of the previous one. A random seed is drawn
+	// when not set. The seed of every cycle is logged so a cycle can be reproduced in a simulation.
+	RandomSeed *int64
+
+	// EvictionRateLimits smooth the evictions per node and per namespace over time across the descheduling
+	// cycles, unlike the MaxNoOfPodsToEvictPerNode and MaxNoOfPodsToEvictPerNamespace limits of a single cycle
+	EvictionRateLimits *EvictionRateLimits
+
+	// NamespaceEvictionIntervals lets the namespaces declare the least interval between the descheduling cycles
+	// evicting their pods with the descheduler.alpha.kubernetes.io/min-eviction-interval annotation, e.g. 24h
+	NamespaceEvictionIntervals *NamespaceEvictionIntervals
+
+	// TerminationPacing keeps the number of the evicted pods terminating at once under limits, evicting many pods
+	// with long termination grace periods at once spikes the resource usage of the nodes running their shutdown
+	TerminationPacing *TerminationPacing
+
+	// Schedule limits the descheduling cycles to maintenance windows, e.g. outside of the business hours.
+	// The cycles run at any time when not set.
+	Schedule *Schedule
+}
+
+// EvictionSpreading spreads the evictions of a descheduling cycle across topology domains.
+// Every domain gets a share of the MaxNoOfPodsToEvictTotal budget proportional to its number of nodes.
+type EvictionSpreading struct {
+	// TopologyKey is the node label identifying the topology domains, e.g. topology.kubernetes.io/zone
+	TopologyKey string
+}
+
+// EvictionFairness shares the evictions of a descheduling cycle across namespaces or pod owners
+// so a single large workload does not consume the whole MaxNoOfPodsToEvictTotal budget.
+// Every unit with pods on the nodes gets a share of the budget proportional to its weight,
+// the weight of a namespace is read from the descheduler.alpha.kubernetes.io/eviction-weight
+// annotation and defaults to 1.
+type EvictionFairness struct {
+	// By is the unit the budget is shared across, Namespace (default) or Owner
+	By FairnessUnit
+}
+
+type FairnessUnit string
+
+const (
+	// FairnessByNamespace shares the budget across namespaces
+	FairnessByNamespace FairnessUnit = "Namespace"
+
+	// FairnessByOwner shares the budget across the pod owners, e.g. ReplicaSets or StatefulSets.
+	// Pods without an owner are units of their own.
+	FairnessByOwner FairnessUnit = "Owner"
+)
+
+type EvictionBackend string
+
+const (
+	// EvictionAPIBackend evicts the pods through the Eviction API
+	EvictionAPIBackend EvictionBackend = "Eviction"
+
+	// EvacuationAPIBackend requests the evacuation of the pods through the Evacuation API (KEP-4563).
+	// The evacuation controllers of the workloads migrate the pods before their deletion.
+	EvacuationAPIBackend EvictionBackend = "Evacuation"
+)
+
+type EvictionOrder string
+
+const (
+	// OlderRevisionsFirstEvictionOrder evicts the pods of the older revisions of a Deployment first, the revisions
+	// are read from the deployment.kubernetes.io/revision annotation of the ReplicaSets of the pods
+	OlderRevisionsFirstEvictionOrder EvictionOrder = "OlderRevisionsFirst"
+)
+
+// NamespaceDisruptionQuota limits the number of the pods evicted from every matching namespace
+// over a sliding period. The first quota matching a namespace applies, namespaces matching
+// no quota are not limited.
+type NamespaceDisruptionQuota struct {
+	// Namespaces the quota applies to, names, glob patterns (e.g. prod-*) or regular expressions enclosed in slashes
+	Namespaces []string
+
+	// MaxEvictions is the maximum number of the pods evicted from every matching namespace in the period
+	MaxEvictions uint
+
+	// Period is the sliding window the evictions are counted in, e.g. 1h
+	Period metav1.Duration
+}
+
+// EvictionRateLimits limit the rate of the evictions with token buckets. Every node, respectively namespace,
+// gets a bucket of Burst tokens refilled at EvictionsPerMinute, every eviction takes a token and no pod is
+// evicted while the bucket is empty.
+type EvictionRateLimits struct {
+	// PerNode limits the rate of the evictions from every node
+	PerNode *EvictionRateLimit
+
+	// PerNamespace limits the rate of the evictions from every namespace
+	PerNamespace *EvictionRateLimit
+}
+
+// EvictionRateLimit is a token bucket
+type EvictionRateLimit struct {
+	// EvictionsPerMinute is the rate the bucket is refilled at
+	EvictionsPerMinute uint
+
+	// Burst is the size of the bucket, i.e. the most evictions at once. Defaults to 1.
+	Burst *uint
+}
+
+// NamespaceEvictionIntervals enforces the intervals declared by the namespaces. Once pods got evicted from
+// a namespace in a descheduling cycle, no pods are evicted from the namespace in the next cycles until the
+// interval elapses.
+type NamespaceEvictionIntervals struct {
+	// Enabled enforces the intervals declared by the namespaces
+	Enabled bool
+
+	// MaxInterval caps the intervals declared by the namespaces. Not capped when not set.
+	MaxInterval *metav1.Duration
+}
+
+// TerminationPacing paces the evictions by the pods still terminating. An evicted pod is terminating until
+// its termination grace period elapses or it is gone, no pod is evicted while a limit is reached.
+type TerminationPacing struct {
+	// MaxTerminatingPodsPerNode is the most evicted pods terminating at once on every node
+	MaxTerminatingPodsPerNode *uint
+
+	// MaxTerminatingPods is the most evicted pods terminating at once in the cluster
+	MaxTerminatingPods *uint
+}
+
+// Schedule lists the maintenance windows the descheduling cycles run in. A cycle runs while any window is open.
+type Schedule struct {
+	// Windows the descheduling cycles run in
+	Windows []MaintenanceWindow
+
+	// TimeZone the cron expressions of the windows are evaluated in, e.g. Europe/Berlin. Defaults to UTC.
+	TimeZone string
+}
+
+// MaintenanceWindow opens at the times matching a cron expression and stays open for a duration
+type MaintenanceWindow struct {
+	// Cron is a standard five-field cron expression of the times the window opens at, e.g. "0 22 * * 1-5"
+	Cron string
+
+	// Duration the window stays open for, e.g. 6h
+	Duration metav1.Duration
+}
+
+// EvictionVeto lets the cluster security teams veto evictions independently of the profiles,
+// in the fashion of the validations of a ValidatingAdmissionPolicy
+type EvictionVeto struct {
+	// Validations every eviction must satisfy, an eviction failing any of them is vetoed
+	Validations []VetoValidation
+}
+
+// VetoValidation is a CEL expression evaluated against an eviction
+type VetoValidation struct {
+	// Expression evaluates to true when the eviction is allowed. The evicted pod is available
+	// as object, the names of the strategy and the profile evicting it as strategy and profile,
+	// e.g. "object.metadata.namespace != 'kube-system' || strategy == 'RemoveFailedPods'"
+	Expression string
+
+	// Message is reported when the eviction is vetoed, the expression is reported when empty
+	Message string
+}
+
+// ConcurrentDrains lists the signals the drainers leave on the nodes they drain.
+// A node showing any of the signals is drained.
+type ConcurrentDrains struct {
+	// Cordoned treats the cordoned nodes as drained, e.g. by kubectl drain
+	Cordoned bool
+
+	// NodeAnnotations marking the drained nodes, either a key or a key=value,
+	// e.g. "machineconfiguration.openshift.io/state=Working"
+	NodeAnnotations []string
+
+	// NodeTaints lists the keys of the taints marking the drained nodes, e.g. ToBeDeletedByClusterAutoscaler
+	NodeTaints []string
+
+	// LeaseNamespace is the namespace of the leases named after the nodes the drainers hold while draining them.
+	// A node is drained while its lease has a holder and is not expired.
+	LeaseNamespace string
+}
+
+// NodeLeases are coordination leases named after the nodes. The descheduler acquires the lease of a node
+// before evicting the first pod from the node and releases it at the end of the descheduling cycle.
+// No pods are evicted from a node whose lease is held by another controller.
+type NodeLeases struct {
+	// Namespace of the leases
+	Namespace string
+
+	// LeaseDuration of the acquired leases, renewed while the pods are evicted from the node. Defaults to 2m.
+	LeaseDuration metav1.Duration
+
+	// HolderIdentity the descheduler holds the leases as. Defaults to "descheduler".
+	HolderIdentity string
+}
+
+// ClientIdentity separates the apiserver requests of a profile from the requests of the descheduler,
+// e.g. to tell the evictions of the profiles apart in the audit logs or to grant each profile its own
+// eviction permissions. The identity is used for the requests issued by the plugins and the evictions,
+// the pods and nodes are still listed and watched as the descheduler.
+type ClientIdentity struct {
+	// TokenFile is the path of the ServiceAccount token the requests are authenticated with,
+	// e.g. mounted through a projected volume. The token is reloaded as it gets rotated.
+	TokenFile string
+}
+
+// DeschedulingExemptions lets the teams exempt their workloads from the descheduling for a limited time
+// through the namespaced DeschedulingExemption objects. The DeschedulingExemption CRD has to be installed.
+type DeschedulingExemptions struct {
+	// Enabled lists the exemptions in force every descheduling cycle
+	Enabled bool
+
+	// MaxDuration caps the time between the creation and the expiry of the exemptions,
+	// the exemptions expiring later are ignored. Not capped when not set.
+	MaxDuration *metav1.Duration
+}
+
+// ZoneOutageBrake detects the zone outages from the share of the unavailable nodes of the zones.
+// A node is unavailable when it is NotReady or unschedulable.
+type ZoneOutageBrake struct {
+	// TopologyKey is the node label identifying the zones. Defaults to "topology.kubernetes.io/zone".
+	TopologyKey string
+
+	// MaxUnavailablePercentage of the nodes of a zone, a zone with more unavailable nodes is in an outage
+	MaxUnavailablePercentage Percentage
+}
+
+// ConvergenceDetection compares the evictions of every plugin with its evictions in the previous cycle.
+// Two cycles are similar when enough of the evicted workload and node pairs repeat.
+type ConvergenceDetection struct {
+	// Cycles is the number of consecutive similar cycles a plugin is reported non-converging after. Defaults to 3.
+	Cycles *uint
+
+	// MinSimilarityPercentage of the evicted workload and node pairs of two cycles in common for the cycles
+	// to be similar. Defaults to 50.
+	MinSimilarityPercentage *Percentage
+
+	// PauseCycles is the number of cycles the non-converging plugins are paused for, the plugins are only
+	// reported when not set or 0
+	PauseCycles *uint
+}
+
+// SkipExplanations aggregates the reasons the evictor plugins filter out the pods of a workload and
+// the evictions of its pods fail, and reports the top reasons in an event on the workload
+type SkipExplanations struct {
+	// LabelSelector selects the pods the skips are explained for, all the pods when not set
+	LabelSelector *metav1.LabelSelector
+
+	// Interval between two explanations of a workload. Defaults to 1h.
+	Interval *metav1.Duration
+}
+
+// PreferredNodeHints annotates the owner of every evicted pod, e.g. its replica set, with the nodes
+// the plugin evicting the pod considered good targets. The hints are there for a scheduler plugin
+// to keep the replacement pods from going right back to the nodes they were evicted from.
+type PreferredNodeHints struct {
+	// Enabled annotates the owners of the evicted pods at the end of every descheduling cycle
+	Enabled bool
+
+	// MaxNodes listed in a hint. Defaults to 10.
+	MaxNodes *uint
+}
+
+// GangScheduling detects the members of the gangs from the PodGroup label of the scheduler-plugins
+// coscheduling (scheduling.x-k8s.io/pod-group or pod-group.scheduling.sigs.k8s.io) and from the
+// group name annotation of Volcano (scheduling.k8s.io/group-name).
+type GangScheduling struct {
+	// Members is the policy for the members of the gangs, Skip or EvictTogether
+	Members GangMembersPolicy
+
+	// Pacing between the evictions of the members of a gang evicted together. Not paced when not set.
+	Pacing *metav1.Duration
+}
+
+// EvictionVerification tracks the replacements of the pods evicted from their owners, e.g. replica sets.
+// An eviction fails the verification when the replacements of the pod are still not scheduled once the
+// timeout elapses. No further pods of the owner are evicted in the cycle the failure is detected in and
+// in the next cycle.
+type EvictionVerification struct {
+	// Enabled verifies the evictions at the start of every descheduling cycle
+	Enabled bool
+
+	// Timeout for the replacements of an evicted pod to get scheduled. Defaults to 5m.
+	Timeout *metav1.Duration
+
+	// Wait waits, after every eviction, for a replacement of the evicted pod to get ready before evicting
+	// further pods. Applies independently of Enabled.
+	Wait *EvictionWait
+}
+
+// EvictionWait holds the further evictions until the replacement of the evicted pod, i.e. a pod of the same
+// owner created after the eviction, gets scheduled, or running. No further pods are evicted in the descheduling
+// cycle once a replacement created is still not ready when the timeout elapses.
+type EvictionWait struct {
+	// Timeout for the replacement of an evicted pod to get ready. Defaults to 1m.
+	Timeout *metav1.Duration
+
+	// Running waits for the replacement to be running rather than only scheduled
+	Running bool
+}
+
+type GangMembersPolicy string
+
+const (
+	// GangMembersSkip refuses the eviction of the members of the gangs
+	GangMembersSkip GangMembersPolicy = "Skip"
+
+	// GangMembersEvictTogether evicts all the members of a gang, or none, whenever one of them is evicted
+	GangMembersEvictTogether GangMembersPolicy = "EvictTogether"
+)
+
+// PodWatch scopes the pod watch of the descheduler at the informer level
+type PodWatch struct {
+	// LabelSelector the watched pods match, e.g. "tier notin (control-plane)"
+	LabelSelector string
+
+	// FieldSelector the watched pods match, e.g. "metadata.namespace!=kube-system"
+	FieldSelector string
 }
 
 // Namespaces carries a list of included/excluded namespaces
@@ -82,17 +496,36 @@ type (
 type PriorityThreshold struct {
 	Value *int32 `json:"value"`
 	Name  string `json:"name"`
+	// LabelSelector selects the priority classes the lowest value of is the threshold
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
 type DeschedulerProfile struct {
 	Name          string
 	PluginConfigs []PluginConfig
 	Plugins       Plugins
+	// Namespaces are inherited by all the plugins of the profile not configuring their own namespaces
+	Namespaces *Namespaces
+	// ClientIdentity the profile talks to the apiserver as, the identity of the descheduler when not set
+	ClientIdentity *ClientIdentity
+
+	// Schedule of the profile overrides the schedule of the policy
+	Schedule *Schedule
+
+	// MetricsSource selects the metrics provider of the policy the plugins of the profile consume the
+	// utilization from, KubernetesMetrics, Prometheus or None. All the providers when not set.
+	MetricsSource MetricsSource
 }
 
 type PluginConfig struct {
 	Name string
 	Args runtime.Object
+
+	// MaxPodsToEvictPerNode limits the pods the plugin evicts from every node in a descheduling cycle
+	MaxPodsToEvictPerNode *uint
+
+	// MaxPodsToEvictPerCycle limits the pods the plugin evicts in a descheduling cycle
+	MaxPodsToEvictPerCycle *uint
 }
 
 type Plugins struct {
@@ -118,6 +551,9 @@ const (
 
 	// KubernetesMetrics enables metrics from a Prometheus metrics server.
 	PrometheusMetrics MetricsSource = "Prometheus"
+
+	// NoMetrics selects no metrics provider, the utilization is computed from the pod requests.
+	NoMetrics MetricsSource = "None"
 )
 
 // MetricsCollector configures collection of metrics about actual resource utilization
@@ -160,3 +596,30 @@ type SecretReference struct {
 	// name is the name of the secret.
 	Name string
 }
+
+// Notifications configures sinks notified about the outcome of descheduling cycles
+type Notifications struct {
+	// Webhooks receive a summary of each descheduling cycle that evicted more than minEvictions pods
+	Webhooks []Webhook
+}
+
+type WebhookFormat string
+
+const (
+	// GenericWebhookFormat posts the cycle summary as a JSON document
+	GenericWebhookFormat WebhookFormat = "Generic"
+
+	// SlackWebhookFormat posts the cycle summary as a Slack incoming webhook message
+	SlackWebhookFormat WebhookFormat = "Slack"
+)
+
+// Webhook configures an HTTP(S) endpoint a cycle summary is POSTed to
+type Webhook struct {
+	// URL of the webhook endpoint
+	URL string
+	// Format of the payload. Defaults to Generic when not set.
+	Format WebhookFormat
+	// MinEvictions is the number of evictions a cycle needs to exceed
+	// for the summary to be sent. Defaults to 0, i.e. any eviction.
+	MinEvictions uint
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"encoding/json"
 	"fmt"
 	"sync"
 
@@ -27,6 +28,7 @@ import (
 	api "sigs.k8s.io/descheduler/pkg/api"
 
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 )
 
 var (
@@ -54,9 +56,101 @@ func Convert_v1alpha2_DeschedulerPolicy_To_api_DeschedulerPolicy(in *Descheduler
 	if err := autoConvert_v1alpha2_DeschedulerPolicy_To_api_DeschedulerPolicy(in, out, s); err != nil {
 		return err
 	}
+	if err := convertSharedDefaultEvictorArgs(in, out); err != nil {
+		return err
+	}
 	return convertToInternalPluginConfigArgs(out)
 }
 
+// convertSharedDefaultEvictorArgs decodes the DefaultEvictor args shared across the profiles
+// and sets them to the DefaultEvictor plugin of every profile. The fields set in the DefaultEvictor
+// args of a profile override the shared ones.
+func convertSharedDefaultEvictorArgs(in *DeschedulerPolicy, out *api.DeschedulerPolicy) error {
+	if in.DefaultEvictorArgs == nil {
+		return nil
+	}
+	shared, err := rawArgs(*in.DefaultEvictorArgs)
+	if err != nil {
+		return fmt.Errorf("encoding .DefaultEvictorArgs: %w", err)
+	}
+	if out.DefaultEvictorArgs, err = decodeDefaultEvictorArgs(shared); err != nil {
+		return fmt.Errorf("decoding .DefaultEvictorArgs: %w", err)
+	}
+
+	for i := range in.Profiles {
+		merged := shared
+		idx := -1
+		for j := range in.Profiles[i].PluginConfigs {
+			if in.Profiles[i].PluginConfigs[j].Name != defaultevictor.PluginName {
+				continue
+			}
+			idx = j
+			override, err := rawArgs(in.Profiles[i].PluginConfigs[j].Args)
+			if err != nil {
+				return fmt.Errorf("encoding .Profiles[%d].PluginConfigs[%d].Args: %w", i, j, err)
+			}
+			if merged, err = mergeArgs(shared, override); err != nil {
+				return fmt.Errorf("merging .Profiles[%d].PluginConfigs[%d].Args into .DefaultEvictorArgs: %w", i, j, err)
+			}
+			break
+		}
+		args, err := decodeDefaultEvictorArgs(merged)
+		if err != nil {
+			return fmt.Errorf("decoding DefaultEvictor args of .Profiles[%d]: %w", i, err)
+		}
+		if idx < 0 {
+			out.Profiles[i].PluginConfigs = append([]api.PluginConfig{{Name: defaultevictor.PluginName, Args: args}}, out.Profiles[i].PluginConfigs...)
+			continue
+		}
+		out.Profiles[i].PluginConfigs[idx].Args = args
+	}
+	return nil
+}
+
+func decodeDefaultEvictorArgs(raw []byte) (runtime.Object, error) {
+	args := pluginregistry.PluginRegistry[defaultevictor.PluginName].PluginArgInstance.DeepCopyObject()
+	if raw == nil {
+		return args, nil
+	}
+	if _, _, err := Codecs.UniversalDecoder().Decode(raw, nil, args); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// rawArgs returns the JSON encoding of the args
+func rawArgs(args runtime.RawExtension) ([]byte, error) {
+	if args.Raw != nil {
+		return args.Raw, nil
+	}
+	if args.Object != nil {
+		return json.Marshal(args.Object)
+	}
+	return nil, nil
+}
+
+// mergeArgs overrides the top level fields of the base args with the fields set in override
+func mergeArgs(base, override []byte) ([]byte, error) {
+	if base == nil {
+		return override, nil
+	}
+	if override == nil {
+		return base, nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(base, &fields); err != nil {
+		return nil, err
+	}
+	overrideFields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(override, &overrideFields); err != nil {
+		return nil, err
+	}
+	for name, value := range overrideFields {
+		fields[name] = value
+	}
+	return json.Marshal(fields)
+}
+
 // convertToInternalPluginConfigArgs converts PluginConfig#Args into internal
 // types using a scheme, after applying defaults.
 func convertToInternalPluginConfigArgs(out *api.DeschedulerPolicy) error {
@@ -87,6 +181,8 @@ func convertToInternalPluginConfigArgs(out *api.DeschedulerPolicy) error {
 
 func Convert_v1alpha2_PluginConfig_To_api_PluginConfig(in *PluginConfig, out *api.PluginConfig, s conversion.Scope) error {
 	out.Name = in.Name
+	out.MaxPodsToEvictPerNode = in.MaxPodsToEvictPerNode
+	out.MaxPodsToEvictPerCycle = in.MaxPodsToEvictPerCycle
 	if _, ok := pluginregistry.PluginRegistry[in.Name]; ok {
 		out.Args = pluginregistry.PluginRegistry[in.Name].PluginArgInstance.DeepCopyObject()
 		if in.Args.Raw != nil {
@@ -109,6 +205,13 @@ func Convert_api_DeschedulerPolicy_To_v1alpha2_DeschedulerPolicy(in *api.Desched
 	if err := autoConvert_api_DeschedulerPolicy_To_v1alpha2_DeschedulerPolicy(in, out, s); err != nil {
 		return err
 	}
+	if in.DefaultEvictorArgs != nil {
+		externalArgs, err := GetPluginArgConversionScheme().ConvertToVersion(in.DefaultEvictorArgs, SchemeGroupVersion)
+		if err != nil {
+			return err
+		}
+		out.DefaultEvictorArgs = &runtime.RawExtension{Object: externalArgs}
+	}
 	return convertToExternalPluginConfigArgs(out)
 }
 
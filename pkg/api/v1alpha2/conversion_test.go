@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/dump"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+)
+
+const roundTripIterations = 200
+
+// newPolicyFuzzer fuzzes the policies but the plugin args, the conversion of the args is covered by
+// the decoding tests of the policies. The DefaultEvictor args shared across the profiles are fuzzed
+// as merged into the DefaultEvictor args of every profile, as the conversion to the internal policy does.
+func newPolicyFuzzer(seed int64) *fuzz.Fuzzer {
+	return fuzz.New().NilChance(0.3).NumElements(0, 2).RandSource(rand.NewSource(seed)).Funcs(
+		// The kind and version are set by the serializers, not by the conversions
+		func(in *metav1.TypeMeta, c fuzz.Continue) {
+			*in = metav1.TypeMeta{}
+		},
+		func(in *runtime.Object, c fuzz.Continue) {
+			*in = nil
+		},
+		func(in *runtime.RawExtension, c fuzz.Continue) {
+			*in = runtime.RawExtension{}
+		},
+		// The times and the quantities are encoded in their canonical form
+		func(in *metav1.Time, c fuzz.Continue) {
+			*in = metav1.Unix(c.Int63n(1<<32), 0)
+		},
+		func(in *resource.Quantity, c fuzz.Continue) {
+			*in = *resource.NewQuantity(c.Int63n(1<<32), resource.BinarySI)
+		},
+		func(in *api.DeschedulerPolicy, c fuzz.Continue) {
+			c.FuzzNoCustom(in)
+			args := fuzzDefaultEvictorArgs(c)
+			if args == nil {
+				return
+			}
+			in.DefaultEvictorArgs = args
+			for i := range in.Profiles {
+				in.Profiles[i].PluginConfigs = append([]api.PluginConfig{{Name: defaultevictor.PluginName, Args: args.DeepCopy()}}, in.Profiles[i].PluginConfigs...)
+			}
+		},
+		func(in *DeschedulerPolicy, c fuzz.Continue) {
+			c.FuzzNoCustom(in)
+			in.DefaultEvictorArgs = nil
+			args := fuzzDefaultEvictorArgs(c)
+			if args == nil {
+				return
+			}
+			raw, err := json.Marshal(args)
+			if err != nil {
+				panic(err)
+			}
+			in.DefaultEvictorArgs = &runtime.RawExtension{Raw: raw}
+			for i := range in.Profiles {
+				in.Profiles[i].PluginConfigs = append([]PluginConfig{{Name: defaultevictor.PluginName, Args: runtime.RawExtension{Raw: raw}}}, in.Profiles[i].PluginConfigs...)
+			}
+		},
+	)
+}
+
+// fuzzDefaultEvictorArgs fuzzes the DefaultEvictor args, nil in some of the policies
+func fuzzDefaultEvictorArgs(c fuzz.Continue) *defaultevictor.DefaultEvictorArgs {
+	if c.Float64() < 0.3 {
+		return nil
+	}
+	args := &defaultevictor.DefaultEvictorArgs{}
+	c.Fuzz(args)
+	// The args decoded from a policy keep their kind and version
+	args.TypeMeta = metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "DefaultEvictorArgs"}
+	return args
+}
+
+// encodeArgs encodes the plugin args the conversions set as objects, the decoded policies hold the encoded args
+func encodeArgs(t *testing.T, policy *DeschedulerPolicy) {
+	encode := func(args *runtime.RawExtension) {
+		if args.Object == nil {
+			return
+		}
+		raw, err := json.Marshal(args.Object)
+		if err != nil {
+			t.Fatalf("Unexpected error when encoding the args: %v", err)
+		}
+		*args = runtime.RawExtension{Raw: raw}
+	}
+	if policy.DefaultEvictorArgs != nil {
+		encode(policy.DefaultEvictorArgs)
+	}
+	for i := range policy.Profiles {
+		for j := range policy.Profiles[i].PluginConfigs {
+			encode(&policy.Profiles[i].PluginConfigs[j].Args)
+		}
+	}
+}
+
+func newConversionScheme() *runtime.Scheme {
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	pluginregistry.Register(defaultevictor.PluginName, defaultevictor.New, &defaultevictor.DefaultEvictor{}, &defaultevictor.DefaultEvictorArgs{}, defaultevictor.ValidateDefaultEvictorArgs, defaultevictor.SetDefaults_DefaultEvictorArgs, pluginregistry.PluginRegistry)
+
+	// The args are registered as the plugins register them in the scheme of the descheduler
+	GetPluginArgConversionScheme().AddKnownTypes(SchemeGroupVersion, &defaultevictor.DefaultEvictorArgs{})
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(AddToScheme(scheme))
+	utilruntime.Must(api.AddToScheme(scheme))
+	return scheme
+}
+
+// TestRoundTripInternal checks no field of the internal policy is lost when converted to v1alpha2 and back,
+// i.e. every field is converted by the generated and the manual conversions.
+func TestRoundTripInternal(t *testing.T) {
+	scheme := newConversionScheme()
+	fuzzer := newPolicyFuzzer(1)
+	for i := 0; i < roundTripIterations; i++ {
+		original := &api.DeschedulerPolicy{}
+		fuzzer.Fuzz(original)
+
+		versioned := &DeschedulerPolicy{}
+		if err := scheme.Convert(original.DeepCopy(), versioned, nil); err != nil {
+			t.Fatalf("Unexpected error when converting to v1alpha2: %v", err)
+		}
+		roundTripped := &api.DeschedulerPolicy{}
+		if err := scheme.Convert(versioned, roundTripped, nil); err != nil {
+			t.Fatalf("Unexpected error when converting from v1alpha2: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("Policy changed by the round trip through v1alpha2:\n%s\n%s", dump.Pretty(original), dump.Pretty(roundTripped))
+		}
+	}
+}
+
+// TestRoundTripV1alpha2 checks a stored v1alpha2 policy is encoded and converted to the internal policy
+// and back without a loss, so it keeps working across the upgrades of the descheduler.
+func TestRoundTripV1alpha2(t *testing.T) {
+	scheme := newConversionScheme()
+	fuzzer := newPolicyFuzzer(2)
+	for i := 0; i < roundTripIterations; i++ {
+		original := &DeschedulerPolicy{}
+		fuzzer.Fuzz(original)
+
+		data, err := json.Marshal(original)
+		if err != nil {
+			t.Fatalf("Unexpected error when encoding the policy: %v", err)
+		}
+		decoded := &DeschedulerPolicy{}
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("Unexpected error when decoding the policy: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, decoded) {
+			t.Fatalf("Policy changed by the JSON round trip:\n%s\n%s", dump.Pretty(original), dump.Pretty(decoded))
+		}
+
+		internal := &api.DeschedulerPolicy{}
+		if err := scheme.Convert(decoded, internal, nil); err != nil {
+			t.Fatalf("Unexpected error when converting from v1alpha2: %v", err)
+		}
+		roundTripped := &DeschedulerPolicy{}
+		if err := scheme.Convert(internal, roundTripped, nil); err != nil {
+			t.Fatalf("Unexpected error when converting to v1alpha2: %v", err)
+		}
+		encodeArgs(t, roundTripped)
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("Policy changed by the round trip through the internal policy:\n%s\n%s", dump.Pretty(original), dump.Pretty(roundTripped))
+		}
+	}
+}
@@ -24,8 +24,10 @@ package v1alpha2
 import (
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	api "sigs.k8s.io/descheduler/pkg/api"
 )
 
@@ -46,6 +48,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClientIdentity)(nil), (*api.ClientIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ClientIdentity_To_api_ClientIdentity(a.(*ClientIdentity), b.(*api.ClientIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.ClientIdentity)(nil), (*ClientIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_ClientIdentity_To_v1alpha2_ClientIdentity(a.(*api.ClientIdentity), b.(*ClientIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConcurrentDrains)(nil), (*api.ConcurrentDrains)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ConcurrentDrains_To_api_ConcurrentDrains(a.(*ConcurrentDrains), b.(*api.ConcurrentDrains), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.ConcurrentDrains)(nil), (*ConcurrentDrains)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_ConcurrentDrains_To_v1alpha2_ConcurrentDrains(a.(*api.ConcurrentDrains), b.(*ConcurrentDrains), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConvergenceDetection)(nil), (*api.ConvergenceDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ConvergenceDetection_To_api_ConvergenceDetection(a.(*ConvergenceDetection), b.(*api.ConvergenceDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.ConvergenceDetection)(nil), (*ConvergenceDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_ConvergenceDetection_To_v1alpha2_ConvergenceDetection(a.(*api.ConvergenceDetection), b.(*ConvergenceDetection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeschedulerProfile)(nil), (*api.DeschedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_DeschedulerProfile_To_api_DeschedulerProfile(a.(*DeschedulerProfile), b.(*api.DeschedulerProfile), scope)
 	}); err != nil {
@@ -56,6 +88,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeschedulingExemptions)(nil), (*api.DeschedulingExemptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_DeschedulingExemptions_To_api_DeschedulingExemptions(a.(*DeschedulingExemptions), b.(*api.DeschedulingExemptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.DeschedulingExemptions)(nil), (*DeschedulingExemptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_DeschedulingExemptions_To_v1alpha2_DeschedulingExemptions(a.(*api.DeschedulingExemptions), b.(*DeschedulingExemptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionFairness)(nil), (*api.EvictionFairness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionFairness_To_api_EvictionFairness(a.(*EvictionFairness), b.(*api.EvictionFairness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionFairness)(nil), (*EvictionFairness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionFairness_To_v1alpha2_EvictionFairness(a.(*api.EvictionFairness), b.(*EvictionFairness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionRateLimit)(nil), (*api.EvictionRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(a.(*EvictionRateLimit), b.(*api.EvictionRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionRateLimit)(nil), (*EvictionRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(a.(*api.EvictionRateLimit), b.(*EvictionRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionRateLimits)(nil), (*api.EvictionRateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(a.(*EvictionRateLimits), b.(*api.EvictionRateLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionRateLimits)(nil), (*EvictionRateLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(a.(*api.EvictionRateLimits), b.(*EvictionRateLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionSpreading)(nil), (*api.EvictionSpreading)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionSpreading_To_api_EvictionSpreading(a.(*EvictionSpreading), b.(*api.EvictionSpreading), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionSpreading)(nil), (*EvictionSpreading)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionSpreading_To_v1alpha2_EvictionSpreading(a.(*api.EvictionSpreading), b.(*EvictionSpreading), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionVerification)(nil), (*api.EvictionVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionVerification_To_api_EvictionVerification(a.(*EvictionVerification), b.(*api.EvictionVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionVerification)(nil), (*EvictionVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionVerification_To_v1alpha2_EvictionVerification(a.(*api.EvictionVerification), b.(*EvictionVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionVeto)(nil), (*api.EvictionVeto)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionVeto_To_api_EvictionVeto(a.(*EvictionVeto), b.(*api.EvictionVeto), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionVeto)(nil), (*EvictionVeto)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionVeto_To_v1alpha2_EvictionVeto(a.(*api.EvictionVeto), b.(*EvictionVeto), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EvictionWait)(nil), (*api.EvictionWait)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EvictionWait_To_api_EvictionWait(a.(*EvictionWait), b.(*api.EvictionWait), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.EvictionWait)(nil), (*EvictionWait)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_EvictionWait_To_v1alpha2_EvictionWait(a.(*api.EvictionWait), b.(*EvictionWait), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GangScheduling)(nil), (*api.GangScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_GangScheduling_To_api_GangScheduling(a.(*GangScheduling), b.(*api.GangScheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.GangScheduling)(nil), (*GangScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_GangScheduling_To_v1alpha2_GangScheduling(a.(*api.GangScheduling), b.(*GangScheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*api.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MaintenanceWindow_To_api_MaintenanceWindow(a.(*MaintenanceWindow), b.(*api.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_MaintenanceWindow_To_v1alpha2_MaintenanceWindow(a.(*api.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MetricsCollector)(nil), (*api.MetricsCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_MetricsCollector_To_api_MetricsCollector(a.(*MetricsCollector), b.(*api.MetricsCollector), scope)
 	}); err != nil {
@@ -76,6 +208,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDisruptionQuota)(nil), (*api.NamespaceDisruptionQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceDisruptionQuota_To_api_NamespaceDisruptionQuota(a.(*NamespaceDisruptionQuota), b.(*api.NamespaceDisruptionQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.NamespaceDisruptionQuota)(nil), (*NamespaceDisruptionQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_NamespaceDisruptionQuota_To_v1alpha2_NamespaceDisruptionQuota(a.(*api.NamespaceDisruptionQuota), b.(*NamespaceDisruptionQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceEvictionIntervals)(nil), (*api.NamespaceEvictionIntervals)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceEvictionIntervals_To_api_NamespaceEvictionIntervals(a.(*NamespaceEvictionIntervals), b.(*api.NamespaceEvictionIntervals), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.NamespaceEvictionIntervals)(nil), (*NamespaceEvictionIntervals)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_NamespaceEvictionIntervals_To_v1alpha2_NamespaceEvictionIntervals(a.(*api.NamespaceEvictionIntervals), b.(*NamespaceEvictionIntervals), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeLeases)(nil), (*api.NodeLeases)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NodeLeases_To_api_NodeLeases(a.(*NodeLeases), b.(*api.NodeLeases), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.NodeLeases)(nil), (*NodeLeases)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_NodeLeases_To_v1alpha2_NodeLeases(a.(*api.NodeLeases), b.(*NodeLeases), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Notifications)(nil), (*api.Notifications)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Notifications_To_api_Notifications(a.(*Notifications), b.(*api.Notifications), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.Notifications)(nil), (*Notifications)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_Notifications_To_v1alpha2_Notifications(a.(*api.Notifications), b.(*Notifications), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*api.PluginConfig)(nil), (*PluginConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_api_PluginConfig_To_v1alpha2_PluginConfig(a.(*api.PluginConfig), b.(*PluginConfig), scope)
 	}); err != nil {
@@ -101,6 +273,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodWatch)(nil), (*api.PodWatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PodWatch_To_api_PodWatch(a.(*PodWatch), b.(*api.PodWatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.PodWatch)(nil), (*PodWatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_PodWatch_To_v1alpha2_PodWatch(a.(*api.PodWatch), b.(*PodWatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PreferredNodeHints)(nil), (*api.PreferredNodeHints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PreferredNodeHints_To_api_PreferredNodeHints(a.(*PreferredNodeHints), b.(*api.PreferredNodeHints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.PreferredNodeHints)(nil), (*PreferredNodeHints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_PreferredNodeHints_To_v1alpha2_PreferredNodeHints(a.(*api.PreferredNodeHints), b.(*PreferredNodeHints), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Prometheus)(nil), (*api.Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Prometheus_To_api_Prometheus(a.(*Prometheus), b.(*api.Prometheus), scope)
 	}); err != nil {
@@ -111,6 +303,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Schedule)(nil), (*api.Schedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Schedule_To_api_Schedule(a.(*Schedule), b.(*api.Schedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.Schedule)(nil), (*Schedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_Schedule_To_v1alpha2_Schedule(a.(*api.Schedule), b.(*Schedule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretReference)(nil), (*api.SecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_SecretReference_To_api_SecretReference(a.(*SecretReference), b.(*api.SecretReference), scope)
 	}); err != nil {
@@ -121,6 +323,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SkipExplanations)(nil), (*api.SkipExplanations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SkipExplanations_To_api_SkipExplanations(a.(*SkipExplanations), b.(*api.SkipExplanations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.SkipExplanations)(nil), (*SkipExplanations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_SkipExplanations_To_v1alpha2_SkipExplanations(a.(*api.SkipExplanations), b.(*SkipExplanations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerminationPacing)(nil), (*api.TerminationPacing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TerminationPacing_To_api_TerminationPacing(a.(*TerminationPacing), b.(*api.TerminationPacing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.TerminationPacing)(nil), (*TerminationPacing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_TerminationPacing_To_v1alpha2_TerminationPacing(a.(*api.TerminationPacing), b.(*TerminationPacing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VetoValidation)(nil), (*api.VetoValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VetoValidation_To_api_VetoValidation(a.(*VetoValidation), b.(*api.VetoValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.VetoValidation)(nil), (*VetoValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_VetoValidation_To_v1alpha2_VetoValidation(a.(*api.VetoValidation), b.(*VetoValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Webhook)(nil), (*api.Webhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Webhook_To_api_Webhook(a.(*Webhook), b.(*api.Webhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.Webhook)(nil), (*Webhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_Webhook_To_v1alpha2_Webhook(a.(*api.Webhook), b.(*Webhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneOutageBrake)(nil), (*api.ZoneOutageBrake)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ZoneOutageBrake_To_api_ZoneOutageBrake(a.(*ZoneOutageBrake), b.(*api.ZoneOutageBrake), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*api.ZoneOutageBrake)(nil), (*ZoneOutageBrake)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_api_ZoneOutageBrake_To_v1alpha2_ZoneOutageBrake(a.(*api.ZoneOutageBrake), b.(*ZoneOutageBrake), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*api.DeschedulerPolicy)(nil), (*DeschedulerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_api_DeschedulerPolicy_To_v1alpha2_DeschedulerPolicy(a.(*api.DeschedulerPolicy), b.(*DeschedulerPolicy), scope)
 	}); err != nil {
@@ -159,6 +411,76 @@ func Convert_api_AuthToken_To_v1alpha2_AuthToken(in *api.AuthToken, out *AuthTok
 	return autoConvert_api_AuthToken_To_v1alpha2_AuthToken(in, out, s)
 }
 
+func autoConvert_v1alpha2_ClientIdentity_To_api_ClientIdentity(in *ClientIdentity, out *api.ClientIdentity, s conversion.Scope) error {
+	out.TokenFile = in.TokenFile
+	return nil
+}
+
+// Convert_v1alpha2_ClientIdentity_To_api_ClientIdentity is an autogenerated conversion function.
+func Convert_v1alpha2_ClientIdentity_To_api_ClientIdentity(in *ClientIdentity, out *api.ClientIdentity, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ClientIdentity_To_api_ClientIdentity(in, out, s)
+}
+
+func autoConvert_api_ClientIdentity_To_v1alpha2_ClientIdentity(in *api.ClientIdentity, out *ClientIdentity, s conversion.Scope) error {
+	out.TokenFile = in.TokenFile
+	return nil
+}
+
+// Convert_api_ClientIdentity_To_v1alpha2_ClientIdentity is an autogenerated conversion function.
+func Convert_api_ClientIdentity_To_v1alpha2_ClientIdentity(in *api.ClientIdentity, out *ClientIdentity, s conversion.Scope) error {
+	return autoConvert_api_ClientIdentity_To_v1alpha2_ClientIdentity(in, out, s)
+}
+
+func autoConvert_v1alpha2_ConcurrentDrains_To_api_ConcurrentDrains(in *ConcurrentDrains, out *api.ConcurrentDrains, s conversion.Scope) error {
+	out.Cordoned = in.Cordoned
+	out.NodeAnnotations = *(*[]string)(unsafe.Pointer(&in.NodeAnnotations))
+	out.NodeTaints = *(*[]string)(unsafe.Pointer(&in.NodeTaints))
+	out.LeaseNamespace = in.LeaseNamespace
+	return nil
+}
+
+// Convert_v1alpha2_ConcurrentDrains_To_api_ConcurrentDrains is an autogenerated conversion function.
+func Convert_v1alpha2_ConcurrentDrains_To_api_ConcurrentDrains(in *ConcurrentDrains, out *api.ConcurrentDrains, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ConcurrentDrains_To_api_ConcurrentDrains(in, out, s)
+}
+
+func autoConvert_api_ConcurrentDrains_To_v1alpha2_ConcurrentDrains(in *api.ConcurrentDrains, out *ConcurrentDrains, s conversion.Scope) error {
+	out.Cordoned = in.Cordoned
+	out.NodeAnnotations = *(*[]string)(unsafe.Pointer(&in.NodeAnnotations))
+	out.NodeTaints = *(*[]string)(unsafe.Pointer(&in.NodeTaints))
+	out.LeaseNamespace = in.LeaseNamespace
+	return nil
+}
+
+// Convert_api_ConcurrentDrains_To_v1alpha2_ConcurrentDrains is an autogenerated conversion function.
+func Convert_api_ConcurrentDrains_To_v1alpha2_ConcurrentDrains(in *api.ConcurrentDrains, out *ConcurrentDrains, s conversion.Scope) error {
+	return autoConvert_api_ConcurrentDrains_To_v1alpha2_ConcurrentDrains(in, out, s)
+}
+
+func autoConvert_v1alpha2_ConvergenceDetection_To_api_ConvergenceDetection(in *ConvergenceDetection, out *api.ConvergenceDetection, s conversion.Scope) error {
+	out.Cycles = (*uint)(unsafe.Pointer(in.Cycles))
+	out.MinSimilarityPercentage = (*api.Percentage)(unsafe.Pointer(in.MinSimilarityPercentage))
+	out.PauseCycles = (*uint)(unsafe.Pointer(in.PauseCycles))
+	return nil
+}
+
+// Convert_v1alpha2_ConvergenceDetection_To_api_ConvergenceDetection is an autogenerated conversion function.
+func Convert_v1alpha2_ConvergenceDetection_To_api_ConvergenceDetection(in *ConvergenceDetection, out *api.ConvergenceDetection, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ConvergenceDetection_To_api_ConvergenceDetection(in, out, s)
+}
+
+func autoConvert_api_ConvergenceDetection_To_v1alpha2_ConvergenceDetection(in *api.ConvergenceDetection, out *ConvergenceDetection, s conversion.Scope) error {
+	out.Cycles = (*uint)(unsafe.Pointer(in.Cycles))
+	out.MinSimilarityPercentage = (*api.Percentage)(unsafe.Pointer(in.MinSimilarityPercentage))
+	out.PauseCycles = (*uint)(unsafe.Pointer(in.PauseCycles))
+	return nil
+}
+
+// Convert_api_ConvergenceDetection_To_v1alpha2_ConvergenceDetection is an autogenerated conversion function.
+func Convert_api_ConvergenceDetection_To_v1alpha2_ConvergenceDetection(in *api.ConvergenceDetection, out *ConvergenceDetection, s conversion.Scope) error {
+	return autoConvert_api_ConvergenceDetection_To_v1alpha2_ConvergenceDetection(in, out, s)
+}
+
 func autoConvert_v1alpha2_DeschedulerPolicy_To_api_DeschedulerPolicy(in *DeschedulerPolicy, out *api.DeschedulerPolicy, s conversion.Scope) error {
 	if in.Profiles != nil {
 		in, out := &in.Profiles, &out.Profiles
@@ -175,10 +497,41 @@ func autoConvert_v1alpha2_DeschedulerPolicy_To_api_DeschedulerPolicy(in *Desched
 	out.MaxNoOfPodsToEvictPerNode = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNode))
 	out.MaxNoOfPodsToEvictPerNamespace = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNamespace))
 	out.MaxNoOfPodsToEvictTotal = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictTotal))
+	out.MaxNoOfPodsToEvictPerOwner = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerOwner))
+	out.MaxNoOfPodsToEvictPerTopologyDomain = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerTopologyDomain))
+	out.TopologyDomainKey = in.TopologyDomainKey
 	out.EvictionFailureEventNotification = (*bool)(unsafe.Pointer(in.EvictionFailureEventNotification))
+	out.NodeEvictionAnnotations = (*bool)(unsafe.Pointer(in.NodeEvictionAnnotations))
 	out.MetricsCollector = (*api.MetricsCollector)(unsafe.Pointer(in.MetricsCollector))
 	out.MetricsProviders = *(*[]api.MetricsProvider)(unsafe.Pointer(&in.MetricsProviders))
+	out.MaxEvictionFailuresPerCycle = (*intstr.IntOrString)(unsafe.Pointer(in.MaxEvictionFailuresPerCycle))
+	out.EvictionBackend = api.EvictionBackend(in.EvictionBackend)
+	out.EvictionOrder = api.EvictionOrder(in.EvictionOrder)
 	out.GracePeriodSeconds = (*int64)(unsafe.Pointer(in.GracePeriodSeconds))
+	out.Notifications = (*api.Notifications)(unsafe.Pointer(in.Notifications))
+	out.EvictionSpreading = (*api.EvictionSpreading)(unsafe.Pointer(in.EvictionSpreading))
+	out.NodeCooldownCycles = (*uint)(unsafe.Pointer(in.NodeCooldownCycles))
+	// WARNING: in.DefaultEvictorArgs requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/runtime.RawExtension vs k8s.io/apimachinery/pkg/runtime.Object)
+	out.PodWatch = (*api.PodWatch)(unsafe.Pointer(in.PodWatch))
+	out.EvictionFairness = (*api.EvictionFairness)(unsafe.Pointer(in.EvictionFairness))
+	out.NamespaceDisruptionQuotas = *(*[]api.NamespaceDisruptionQuota)(unsafe.Pointer(&in.NamespaceDisruptionQuotas))
+	out.AggregatedEvictionEvents = (*bool)(unsafe.Pointer(in.AggregatedEvictionEvents))
+	out.EvictionVeto = (*api.EvictionVeto)(unsafe.Pointer(in.EvictionVeto))
+	out.ConcurrentDrains = (*api.ConcurrentDrains)(unsafe.Pointer(in.ConcurrentDrains))
+	out.NodeLeases = (*api.NodeLeases)(unsafe.Pointer(in.NodeLeases))
+	out.DeschedulingExemptions = (*api.DeschedulingExemptions)(unsafe.Pointer(in.DeschedulingExemptions))
+	out.ZoneOutageBrake = (*api.ZoneOutageBrake)(unsafe.Pointer(in.ZoneOutageBrake))
+	out.MinClusterHeadroom = *(*api.ResourceThresholds)(unsafe.Pointer(&in.MinClusterHeadroom))
+	out.ConvergenceDetection = (*api.ConvergenceDetection)(unsafe.Pointer(in.ConvergenceDetection))
+	out.SkipExplanations = (*api.SkipExplanations)(unsafe.Pointer(in.SkipExplanations))
+	out.PreferredNodeHints = (*api.PreferredNodeHints)(unsafe.Pointer(in.PreferredNodeHints))
+	out.GangScheduling = (*api.GangScheduling)(unsafe.Pointer(in.GangScheduling))
+	out.EvictionVerification = (*api.EvictionVerification)(unsafe.Pointer(in.EvictionVerification))
+	out.RandomSeed = (*int64)(unsafe.Pointer(in.RandomSeed))
+	out.EvictionRateLimits = (*api.EvictionRateLimits)(unsafe.Pointer(in.EvictionRateLimits))
+	out.NamespaceEvictionIntervals = (*api.NamespaceEvictionIntervals)(unsafe.Pointer(in.NamespaceEvictionIntervals))
+	out.TerminationPacing = (*api.TerminationPacing)(unsafe.Pointer(in.TerminationPacing))
+	out.Schedule = (*api.Schedule)(unsafe.Pointer(in.Schedule))
 	return nil
 }
 
@@ -198,10 +551,41 @@ func autoConvert_api_DeschedulerPolicy_To_v1alpha2_DeschedulerPolicy(in *api.Des
 	out.MaxNoOfPodsToEvictPerNode = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNode))
 	out.MaxNoOfPodsToEvictPerNamespace = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerNamespace))
 	out.MaxNoOfPodsToEvictTotal = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictTotal))
+	out.MaxNoOfPodsToEvictPerOwner = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerOwner))
+	out.MaxNoOfPodsToEvictPerTopologyDomain = (*uint)(unsafe.Pointer(in.MaxNoOfPodsToEvictPerTopologyDomain))
+	out.TopologyDomainKey = in.TopologyDomainKey
 	out.EvictionFailureEventNotification = (*bool)(unsafe.Pointer(in.EvictionFailureEventNotification))
+	out.NodeEvictionAnnotations = (*bool)(unsafe.Pointer(in.NodeEvictionAnnotations))
 	out.MetricsCollector = (*MetricsCollector)(unsafe.Pointer(in.MetricsCollector))
 	out.MetricsProviders = *(*[]MetricsProvider)(unsafe.Pointer(&in.MetricsProviders))
+	out.MaxEvictionFailuresPerCycle = (*intstr.IntOrString)(unsafe.Pointer(in.MaxEvictionFailuresPerCycle))
+	out.EvictionBackend = EvictionBackend(in.EvictionBackend)
+	out.EvictionOrder = EvictionOrder(in.EvictionOrder)
 	out.GracePeriodSeconds = (*int64)(unsafe.Pointer(in.GracePeriodSeconds))
+	out.Notifications = (*Notifications)(unsafe.Pointer(in.Notifications))
+	out.EvictionSpreading = (*EvictionSpreading)(unsafe.Pointer(in.EvictionSpreading))
+	out.NodeCooldownCycles = (*uint)(unsafe.Pointer(in.NodeCooldownCycles))
+	// WARNING: in.DefaultEvictorArgs requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/runtime.Object vs *k8s.io/apimachinery/pkg/runtime.RawExtension)
+	out.PodWatch = (*PodWatch)(unsafe.Pointer(in.PodWatch))
+	out.EvictionFairness = (*EvictionFairness)(unsafe.Pointer(in.EvictionFairness))
+	out.NamespaceDisruptionQuotas = *(*[]NamespaceDisruptionQuota)(unsafe.Pointer(&in.NamespaceDisruptionQuotas))
+	out.AggregatedEvictionEvents = (*bool)(unsafe.Pointer(in.AggregatedEvictionEvents))
+	out.EvictionVeto = (*EvictionVeto)(unsafe.Pointer(in.EvictionVeto))
+	out.ConcurrentDrains = (*ConcurrentDrains)(unsafe.Pointer(in.ConcurrentDrains))
+	out.NodeLeases = (*NodeLeases)(unsafe.Pointer(in.NodeLeases))
+	out.DeschedulingExemptions = (*DeschedulingExemptions)(unsafe.Pointer(in.DeschedulingExemptions))
+	out.ZoneOutageBrake = (*ZoneOutageBrake)(unsafe.Pointer(in.ZoneOutageBrake))
+	out.MinClusterHeadroom = *(*api.ResourceThresholds)(unsafe.Pointer(&in.MinClusterHeadroom))
+	out.ConvergenceDetection = (*ConvergenceDetection)(unsafe.Pointer(in.ConvergenceDetection))
+	out.SkipExplanations = (*SkipExplanations)(unsafe.Pointer(in.SkipExplanations))
+	out.PreferredNodeHints = (*PreferredNodeHints)(unsafe.Pointer(in.PreferredNodeHints))
+	out.GangScheduling = (*GangScheduling)(unsafe.Pointer(in.GangScheduling))
+	out.EvictionVerification = (*EvictionVerification)(unsafe.Pointer(in.EvictionVerification))
+	out.RandomSeed = (*int64)(unsafe.Pointer(in.RandomSeed))
+	out.EvictionRateLimits = (*EvictionRateLimits)(unsafe.Pointer(in.EvictionRateLimits))
+	out.NamespaceEvictionIntervals = (*NamespaceEvictionIntervals)(unsafe.Pointer(in.NamespaceEvictionIntervals))
+	out.TerminationPacing = (*TerminationPacing)(unsafe.Pointer(in.TerminationPacing))
+	out.Schedule = (*Schedule)(unsafe.Pointer(in.Schedule))
 	return nil
 }
 
@@ -221,6 +605,10 @@ func autoConvert_v1alpha2_DeschedulerProfile_To_api_DeschedulerProfile(in *Desch
 	if err := Convert_v1alpha2_Plugins_To_api_Plugins(&in.Plugins, &out.Plugins, s); err != nil {
 		return err
 	}
+	out.Namespaces = (*api.Namespaces)(unsafe.Pointer(in.Namespaces))
+	out.ClientIdentity = (*api.ClientIdentity)(unsafe.Pointer(in.ClientIdentity))
+	out.Schedule = (*api.Schedule)(unsafe.Pointer(in.Schedule))
+	out.MetricsSource = api.MetricsSource(in.MetricsSource)
 	return nil
 }
 
@@ -245,6 +633,10 @@ func autoConvert_api_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in *api.D
 	if err := Convert_api_Plugins_To_v1alpha2_Plugins(&in.Plugins, &out.Plugins, s); err != nil {
 		return err
 	}
+	out.Namespaces = (*api.Namespaces)(unsafe.Pointer(in.Namespaces))
+	out.ClientIdentity = (*ClientIdentity)(unsafe.Pointer(in.ClientIdentity))
+	out.Schedule = (*Schedule)(unsafe.Pointer(in.Schedule))
+	out.MetricsSource = MetricsSource(in.MetricsSource)
 	return nil
 }
 
@@ -253,6 +645,222 @@ func Convert_api_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in *api.Desch
 	return autoConvert_api_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in, out, s)
 }
 
+func autoConvert_v1alpha2_DeschedulingExemptions_To_api_DeschedulingExemptions(in *DeschedulingExemptions, out *api.DeschedulingExemptions, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxDuration = (*v1.Duration)(unsafe.Pointer(in.MaxDuration))
+	return nil
+}
+
+// Convert_v1alpha2_DeschedulingExemptions_To_api_DeschedulingExemptions is an autogenerated conversion function.
+func Convert_v1alpha2_DeschedulingExemptions_To_api_DeschedulingExemptions(in *DeschedulingExemptions, out *api.DeschedulingExemptions, s conversion.Scope) error {
+	return autoConvert_v1alpha2_DeschedulingExemptions_To_api_DeschedulingExemptions(in, out, s)
+}
+
+func autoConvert_api_DeschedulingExemptions_To_v1alpha2_DeschedulingExemptions(in *api.DeschedulingExemptions, out *DeschedulingExemptions, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxDuration = (*v1.Duration)(unsafe.Pointer(in.MaxDuration))
+	return nil
+}
+
+// Convert_api_DeschedulingExemptions_To_v1alpha2_DeschedulingExemptions is an autogenerated conversion function.
+func Convert_api_DeschedulingExemptions_To_v1alpha2_DeschedulingExemptions(in *api.DeschedulingExemptions, out *DeschedulingExemptions, s conversion.Scope) error {
+	return autoConvert_api_DeschedulingExemptions_To_v1alpha2_DeschedulingExemptions(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionFairness_To_api_EvictionFairness(in *EvictionFairness, out *api.EvictionFairness, s conversion.Scope) error {
+	out.By = api.FairnessUnit(in.By)
+	return nil
+}
+
+// Convert_v1alpha2_EvictionFairness_To_api_EvictionFairness is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionFairness_To_api_EvictionFairness(in *EvictionFairness, out *api.EvictionFairness, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionFairness_To_api_EvictionFairness(in, out, s)
+}
+
+func autoConvert_api_EvictionFairness_To_v1alpha2_EvictionFairness(in *api.EvictionFairness, out *EvictionFairness, s conversion.Scope) error {
+	out.By = FairnessUnit(in.By)
+	return nil
+}
+
+// Convert_api_EvictionFairness_To_v1alpha2_EvictionFairness is an autogenerated conversion function.
+func Convert_api_EvictionFairness_To_v1alpha2_EvictionFairness(in *api.EvictionFairness, out *EvictionFairness, s conversion.Scope) error {
+	return autoConvert_api_EvictionFairness_To_v1alpha2_EvictionFairness(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(in *EvictionRateLimit, out *api.EvictionRateLimit, s conversion.Scope) error {
+	out.EvictionsPerMinute = in.EvictionsPerMinute
+	out.Burst = (*uint)(unsafe.Pointer(in.Burst))
+	return nil
+}
+
+// Convert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(in *EvictionRateLimit, out *api.EvictionRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionRateLimit_To_api_EvictionRateLimit(in, out, s)
+}
+
+func autoConvert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(in *api.EvictionRateLimit, out *EvictionRateLimit, s conversion.Scope) error {
+	out.EvictionsPerMinute = in.EvictionsPerMinute
+	out.Burst = (*uint)(unsafe.Pointer(in.Burst))
+	return nil
+}
+
+// Convert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit is an autogenerated conversion function.
+func Convert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(in *api.EvictionRateLimit, out *EvictionRateLimit, s conversion.Scope) error {
+	return autoConvert_api_EvictionRateLimit_To_v1alpha2_EvictionRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(in *EvictionRateLimits, out *api.EvictionRateLimits, s conversion.Scope) error {
+	out.PerNode = (*api.EvictionRateLimit)(unsafe.Pointer(in.PerNode))
+	out.PerNamespace = (*api.EvictionRateLimit)(unsafe.Pointer(in.PerNamespace))
+	return nil
+}
+
+// Convert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(in *EvictionRateLimits, out *api.EvictionRateLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionRateLimits_To_api_EvictionRateLimits(in, out, s)
+}
+
+func autoConvert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(in *api.EvictionRateLimits, out *EvictionRateLimits, s conversion.Scope) error {
+	out.PerNode = (*EvictionRateLimit)(unsafe.Pointer(in.PerNode))
+	out.PerNamespace = (*EvictionRateLimit)(unsafe.Pointer(in.PerNamespace))
+	return nil
+}
+
+// Convert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits is an autogenerated conversion function.
+func Convert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(in *api.EvictionRateLimits, out *EvictionRateLimits, s conversion.Scope) error {
+	return autoConvert_api_EvictionRateLimits_To_v1alpha2_EvictionRateLimits(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionSpreading_To_api_EvictionSpreading(in *EvictionSpreading, out *api.EvictionSpreading, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+// Convert_v1alpha2_EvictionSpreading_To_api_EvictionSpreading is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionSpreading_To_api_EvictionSpreading(in *EvictionSpreading, out *api.EvictionSpreading, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionSpreading_To_api_EvictionSpreading(in, out, s)
+}
+
+func autoConvert_api_EvictionSpreading_To_v1alpha2_EvictionSpreading(in *api.EvictionSpreading, out *EvictionSpreading, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+// Convert_api_EvictionSpreading_To_v1alpha2_EvictionSpreading is an autogenerated conversion function.
+func Convert_api_EvictionSpreading_To_v1alpha2_EvictionSpreading(in *api.EvictionSpreading, out *EvictionSpreading, s conversion.Scope) error {
+	return autoConvert_api_EvictionSpreading_To_v1alpha2_EvictionSpreading(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionVerification_To_api_EvictionVerification(in *EvictionVerification, out *api.EvictionVerification, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Wait = (*api.EvictionWait)(unsafe.Pointer(in.Wait))
+	return nil
+}
+
+// Convert_v1alpha2_EvictionVerification_To_api_EvictionVerification is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionVerification_To_api_EvictionVerification(in *EvictionVerification, out *api.EvictionVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionVerification_To_api_EvictionVerification(in, out, s)
+}
+
+func autoConvert_api_EvictionVerification_To_v1alpha2_EvictionVerification(in *api.EvictionVerification, out *EvictionVerification, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Wait = (*EvictionWait)(unsafe.Pointer(in.Wait))
+	return nil
+}
+
+// Convert_api_EvictionVerification_To_v1alpha2_EvictionVerification is an autogenerated conversion function.
+func Convert_api_EvictionVerification_To_v1alpha2_EvictionVerification(in *api.EvictionVerification, out *EvictionVerification, s conversion.Scope) error {
+	return autoConvert_api_EvictionVerification_To_v1alpha2_EvictionVerification(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionVeto_To_api_EvictionVeto(in *EvictionVeto, out *api.EvictionVeto, s conversion.Scope) error {
+	out.Validations = *(*[]api.VetoValidation)(unsafe.Pointer(&in.Validations))
+	return nil
+}
+
+// Convert_v1alpha2_EvictionVeto_To_api_EvictionVeto is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionVeto_To_api_EvictionVeto(in *EvictionVeto, out *api.EvictionVeto, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionVeto_To_api_EvictionVeto(in, out, s)
+}
+
+func autoConvert_api_EvictionVeto_To_v1alpha2_EvictionVeto(in *api.EvictionVeto, out *EvictionVeto, s conversion.Scope) error {
+	out.Validations = *(*[]VetoValidation)(unsafe.Pointer(&in.Validations))
+	return nil
+}
+
+// Convert_api_EvictionVeto_To_v1alpha2_EvictionVeto is an autogenerated conversion function.
+func Convert_api_EvictionVeto_To_v1alpha2_EvictionVeto(in *api.EvictionVeto, out *EvictionVeto, s conversion.Scope) error {
+	return autoConvert_api_EvictionVeto_To_v1alpha2_EvictionVeto(in, out, s)
+}
+
+func autoConvert_v1alpha2_EvictionWait_To_api_EvictionWait(in *EvictionWait, out *api.EvictionWait, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Running = in.Running
+	return nil
+}
+
+// Convert_v1alpha2_EvictionWait_To_api_EvictionWait is an autogenerated conversion function.
+func Convert_v1alpha2_EvictionWait_To_api_EvictionWait(in *EvictionWait, out *api.EvictionWait, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EvictionWait_To_api_EvictionWait(in, out, s)
+}
+
+func autoConvert_api_EvictionWait_To_v1alpha2_EvictionWait(in *api.EvictionWait, out *EvictionWait, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Running = in.Running
+	return nil
+}
+
+// Convert_api_EvictionWait_To_v1alpha2_EvictionWait is an autogenerated conversion function.
+func Convert_api_EvictionWait_To_v1alpha2_EvictionWait(in *api.EvictionWait, out *EvictionWait, s conversion.Scope) error {
+	return autoConvert_api_EvictionWait_To_v1alpha2_EvictionWait(in, out, s)
+}
+
+func autoConvert_v1alpha2_GangScheduling_To_api_GangScheduling(in *GangScheduling, out *api.GangScheduling, s conversion.Scope) error {
+	out.Members = api.GangMembersPolicy(in.Members)
+	out.Pacing = (*v1.Duration)(unsafe.Pointer(in.Pacing))
+	return nil
+}
+
+// Convert_v1alpha2_GangScheduling_To_api_GangScheduling is an autogenerated conversion function.
+func Convert_v1alpha2_GangScheduling_To_api_GangScheduling(in *GangScheduling, out *api.GangScheduling, s conversion.Scope) error {
+	return autoConvert_v1alpha2_GangScheduling_To_api_GangScheduling(in, out, s)
+}
+
+func autoConvert_api_GangScheduling_To_v1alpha2_GangScheduling(in *api.GangScheduling, out *GangScheduling, s conversion.Scope) error {
+	out.Members = GangMembersPolicy(in.Members)
+	out.Pacing = (*v1.Duration)(unsafe.Pointer(in.Pacing))
+	return nil
+}
+
+// Convert_api_GangScheduling_To_v1alpha2_GangScheduling is an autogenerated conversion function.
+func Convert_api_GangScheduling_To_v1alpha2_GangScheduling(in *api.GangScheduling, out *GangScheduling, s conversion.Scope) error {
+	return autoConvert_api_GangScheduling_To_v1alpha2_GangScheduling(in, out, s)
+}
+
+func autoConvert_v1alpha2_MaintenanceWindow_To_api_MaintenanceWindow(in *MaintenanceWindow, out *api.MaintenanceWindow, s conversion.Scope) error {
+	out.Cron = in.Cron
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1alpha2_MaintenanceWindow_To_api_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha2_MaintenanceWindow_To_api_MaintenanceWindow(in *MaintenanceWindow, out *api.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MaintenanceWindow_To_api_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_api_MaintenanceWindow_To_v1alpha2_MaintenanceWindow(in *api.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Cron = in.Cron
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_api_MaintenanceWindow_To_v1alpha2_MaintenanceWindow is an autogenerated conversion function.
+func Convert_api_MaintenanceWindow_To_v1alpha2_MaintenanceWindow(in *api.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_api_MaintenanceWindow_To_v1alpha2_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha2_MetricsCollector_To_api_MetricsCollector(in *MetricsCollector, out *api.MetricsCollector, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -295,11 +903,103 @@ func Convert_api_MetricsProvider_To_v1alpha2_MetricsProvider(in *api.MetricsProv
 	return autoConvert_api_MetricsProvider_To_v1alpha2_MetricsProvider(in, out, s)
 }
 
+func autoConvert_v1alpha2_NamespaceDisruptionQuota_To_api_NamespaceDisruptionQuota(in *NamespaceDisruptionQuota, out *api.NamespaceDisruptionQuota, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.MaxEvictions = in.MaxEvictions
+	out.Period = in.Period
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceDisruptionQuota_To_api_NamespaceDisruptionQuota is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceDisruptionQuota_To_api_NamespaceDisruptionQuota(in *NamespaceDisruptionQuota, out *api.NamespaceDisruptionQuota, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceDisruptionQuota_To_api_NamespaceDisruptionQuota(in, out, s)
+}
+
+func autoConvert_api_NamespaceDisruptionQuota_To_v1alpha2_NamespaceDisruptionQuota(in *api.NamespaceDisruptionQuota, out *NamespaceDisruptionQuota, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.MaxEvictions = in.MaxEvictions
+	out.Period = in.Period
+	return nil
+}
+
+// Convert_api_NamespaceDisruptionQuota_To_v1alpha2_NamespaceDisruptionQuota is an autogenerated conversion function.
+func Convert_api_NamespaceDisruptionQuota_To_v1alpha2_NamespaceDisruptionQuota(in *api.NamespaceDisruptionQuota, out *NamespaceDisruptionQuota, s conversion.Scope) error {
+	return autoConvert_api_NamespaceDisruptionQuota_To_v1alpha2_NamespaceDisruptionQuota(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceEvictionIntervals_To_api_NamespaceEvictionIntervals(in *NamespaceEvictionIntervals, out *api.NamespaceEvictionIntervals, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceEvictionIntervals_To_api_NamespaceEvictionIntervals is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceEvictionIntervals_To_api_NamespaceEvictionIntervals(in *NamespaceEvictionIntervals, out *api.NamespaceEvictionIntervals, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceEvictionIntervals_To_api_NamespaceEvictionIntervals(in, out, s)
+}
+
+func autoConvert_api_NamespaceEvictionIntervals_To_v1alpha2_NamespaceEvictionIntervals(in *api.NamespaceEvictionIntervals, out *NamespaceEvictionIntervals, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	return nil
+}
+
+// Convert_api_NamespaceEvictionIntervals_To_v1alpha2_NamespaceEvictionIntervals is an autogenerated conversion function.
+func Convert_api_NamespaceEvictionIntervals_To_v1alpha2_NamespaceEvictionIntervals(in *api.NamespaceEvictionIntervals, out *NamespaceEvictionIntervals, s conversion.Scope) error {
+	return autoConvert_api_NamespaceEvictionIntervals_To_v1alpha2_NamespaceEvictionIntervals(in, out, s)
+}
+
+func autoConvert_v1alpha2_NodeLeases_To_api_NodeLeases(in *NodeLeases, out *api.NodeLeases, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.LeaseDuration = in.LeaseDuration
+	out.HolderIdentity = in.HolderIdentity
+	return nil
+}
+
+// Convert_v1alpha2_NodeLeases_To_api_NodeLeases is an autogenerated conversion function.
+func Convert_v1alpha2_NodeLeases_To_api_NodeLeases(in *NodeLeases, out *api.NodeLeases, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NodeLeases_To_api_NodeLeases(in, out, s)
+}
+
+func autoConvert_api_NodeLeases_To_v1alpha2_NodeLeases(in *api.NodeLeases, out *NodeLeases, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.LeaseDuration = in.LeaseDuration
+	out.HolderIdentity = in.HolderIdentity
+	return nil
+}
+
+// Convert_api_NodeLeases_To_v1alpha2_NodeLeases is an autogenerated conversion function.
+func Convert_api_NodeLeases_To_v1alpha2_NodeLeases(in *api.NodeLeases, out *NodeLeases, s conversion.Scope) error {
+	return autoConvert_api_NodeLeases_To_v1alpha2_NodeLeases(in, out, s)
+}
+
+func autoConvert_v1alpha2_Notifications_To_api_Notifications(in *Notifications, out *api.Notifications, s conversion.Scope) error {
+	out.Webhooks = *(*[]api.Webhook)(unsafe.Pointer(&in.Webhooks))
+	return nil
+}
+
+// Convert_v1alpha2_Notifications_To_api_Notifications is an autogenerated conversion function.
+func Convert_v1alpha2_Notifications_To_api_Notifications(in *Notifications, out *api.Notifications, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Notifications_To_api_Notifications(in, out, s)
+}
+
+func autoConvert_api_Notifications_To_v1alpha2_Notifications(in *api.Notifications, out *Notifications, s conversion.Scope) error {
+	out.Webhooks = *(*[]Webhook)(unsafe.Pointer(&in.Webhooks))
+	return nil
+}
+
+// Convert_api_Notifications_To_v1alpha2_Notifications is an autogenerated conversion function.
+func Convert_api_Notifications_To_v1alpha2_Notifications(in *api.Notifications, out *Notifications, s conversion.Scope) error {
+	return autoConvert_api_Notifications_To_v1alpha2_Notifications(in, out, s)
+}
+
 func autoConvert_v1alpha2_PluginConfig_To_api_PluginConfig(in *PluginConfig, out *api.PluginConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Args, &out.Args, s); err != nil {
 		return err
 	}
+	out.MaxPodsToEvictPerNode = (*uint)(unsafe.Pointer(in.MaxPodsToEvictPerNode))
+	out.MaxPodsToEvictPerCycle = (*uint)(unsafe.Pointer(in.MaxPodsToEvictPerCycle))
 	return nil
 }
 
@@ -308,6 +1008,8 @@ func autoConvert_api_PluginConfig_To_v1alpha2_PluginConfig(in *api.PluginConfig,
 	if err := runtime.Convert_runtime_Object_To_runtime_RawExtension(&in.Args, &out.Args, s); err != nil {
 		return err
 	}
+	out.MaxPodsToEvictPerNode = (*uint)(unsafe.Pointer(in.MaxPodsToEvictPerNode))
+	out.MaxPodsToEvictPerCycle = (*uint)(unsafe.Pointer(in.MaxPodsToEvictPerCycle))
 	return nil
 }
 
@@ -392,6 +1094,50 @@ func Convert_api_Plugins_To_v1alpha2_Plugins(in *api.Plugins, out *Plugins, s co
 	return autoConvert_api_Plugins_To_v1alpha2_Plugins(in, out, s)
 }
 
+func autoConvert_v1alpha2_PodWatch_To_api_PodWatch(in *PodWatch, out *api.PodWatch, s conversion.Scope) error {
+	out.LabelSelector = in.LabelSelector
+	out.FieldSelector = in.FieldSelector
+	return nil
+}
+
+// Convert_v1alpha2_PodWatch_To_api_PodWatch is an autogenerated conversion function.
+func Convert_v1alpha2_PodWatch_To_api_PodWatch(in *PodWatch, out *api.PodWatch, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PodWatch_To_api_PodWatch(in, out, s)
+}
+
+func autoConvert_api_PodWatch_To_v1alpha2_PodWatch(in *api.PodWatch, out *PodWatch, s conversion.Scope) error {
+	out.LabelSelector = in.LabelSelector
+	out.FieldSelector = in.FieldSelector
+	return nil
+}
+
+// Convert_api_PodWatch_To_v1alpha2_PodWatch is an autogenerated conversion function.
+func Convert_api_PodWatch_To_v1alpha2_PodWatch(in *api.PodWatch, out *PodWatch, s conversion.Scope) error {
+	return autoConvert_api_PodWatch_To_v1alpha2_PodWatch(in, out, s)
+}
+
+func autoConvert_v1alpha2_PreferredNodeHints_To_api_PreferredNodeHints(in *PreferredNodeHints, out *api.PreferredNodeHints, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxNodes = (*uint)(unsafe.Pointer(in.MaxNodes))
+	return nil
+}
+
+// Convert_v1alpha2_PreferredNodeHints_To_api_PreferredNodeHints is an autogenerated conversion function.
+func Convert_v1alpha2_PreferredNodeHints_To_api_PreferredNodeHints(in *PreferredNodeHints, out *api.PreferredNodeHints, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PreferredNodeHints_To_api_PreferredNodeHints(in, out, s)
+}
+
+func autoConvert_api_PreferredNodeHints_To_v1alpha2_PreferredNodeHints(in *api.PreferredNodeHints, out *PreferredNodeHints, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxNodes = (*uint)(unsafe.Pointer(in.MaxNodes))
+	return nil
+}
+
+// Convert_api_PreferredNodeHints_To_v1alpha2_PreferredNodeHints is an autogenerated conversion function.
+func Convert_api_PreferredNodeHints_To_v1alpha2_PreferredNodeHints(in *api.PreferredNodeHints, out *PreferredNodeHints, s conversion.Scope) error {
+	return autoConvert_api_PreferredNodeHints_To_v1alpha2_PreferredNodeHints(in, out, s)
+}
+
 func autoConvert_v1alpha2_Prometheus_To_api_Prometheus(in *Prometheus, out *api.Prometheus, s conversion.Scope) error {
 	out.URL = in.URL
 	out.AuthToken = (*api.AuthToken)(unsafe.Pointer(in.AuthToken))
@@ -414,6 +1160,28 @@ func Convert_api_Prometheus_To_v1alpha2_Prometheus(in *api.Prometheus, out *Prom
 	return autoConvert_api_Prometheus_To_v1alpha2_Prometheus(in, out, s)
 }
 
+func autoConvert_v1alpha2_Schedule_To_api_Schedule(in *Schedule, out *api.Schedule, s conversion.Scope) error {
+	out.Windows = *(*[]api.MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha2_Schedule_To_api_Schedule is an autogenerated conversion function.
+func Convert_v1alpha2_Schedule_To_api_Schedule(in *Schedule, out *api.Schedule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Schedule_To_api_Schedule(in, out, s)
+}
+
+func autoConvert_api_Schedule_To_v1alpha2_Schedule(in *api.Schedule, out *Schedule, s conversion.Scope) error {
+	out.Windows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_api_Schedule_To_v1alpha2_Schedule is an autogenerated conversion function.
+func Convert_api_Schedule_To_v1alpha2_Schedule(in *api.Schedule, out *Schedule, s conversion.Scope) error {
+	return autoConvert_api_Schedule_To_v1alpha2_Schedule(in, out, s)
+}
+
 func autoConvert_v1alpha2_SecretReference_To_api_SecretReference(in *SecretReference, out *api.SecretReference, s conversion.Scope) error {
 	out.Namespace = in.Namespace
 	out.Name = in.Name
@@ -435,3 +1203,115 @@ func autoConvert_api_SecretReference_To_v1alpha2_SecretReference(in *api.SecretR
 func Convert_api_SecretReference_To_v1alpha2_SecretReference(in *api.SecretReference, out *SecretReference, s conversion.Scope) error {
 	return autoConvert_api_SecretReference_To_v1alpha2_SecretReference(in, out, s)
 }
+
+func autoConvert_v1alpha2_SkipExplanations_To_api_SkipExplanations(in *SkipExplanations, out *api.SkipExplanations, s conversion.Scope) error {
+	out.LabelSelector = (*v1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_v1alpha2_SkipExplanations_To_api_SkipExplanations is an autogenerated conversion function.
+func Convert_v1alpha2_SkipExplanations_To_api_SkipExplanations(in *SkipExplanations, out *api.SkipExplanations, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SkipExplanations_To_api_SkipExplanations(in, out, s)
+}
+
+func autoConvert_api_SkipExplanations_To_v1alpha2_SkipExplanations(in *api.SkipExplanations, out *SkipExplanations, s conversion.Scope) error {
+	out.LabelSelector = (*v1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_api_SkipExplanations_To_v1alpha2_SkipExplanations is an autogenerated conversion function.
+func Convert_api_SkipExplanations_To_v1alpha2_SkipExplanations(in *api.SkipExplanations, out *SkipExplanations, s conversion.Scope) error {
+	return autoConvert_api_SkipExplanations_To_v1alpha2_SkipExplanations(in, out, s)
+}
+
+func autoConvert_v1alpha2_TerminationPacing_To_api_TerminationPacing(in *TerminationPacing, out *api.TerminationPacing, s conversion.Scope) error {
+	out.MaxTerminatingPodsPerNode = (*uint)(unsafe.Pointer(in.MaxTerminatingPodsPerNode))
+	out.MaxTerminatingPods = (*uint)(unsafe.Pointer(in.MaxTerminatingPods))
+	return nil
+}
+
+// Convert_v1alpha2_TerminationPacing_To_api_TerminationPacing is an autogenerated conversion function.
+func Convert_v1alpha2_TerminationPacing_To_api_TerminationPacing(in *TerminationPacing, out *api.TerminationPacing, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TerminationPacing_To_api_TerminationPacing(in, out, s)
+}
+
+func autoConvert_api_TerminationPacing_To_v1alpha2_TerminationPacing(in *api.TerminationPacing, out *TerminationPacing, s conversion.Scope) error {
+	out.MaxTerminatingPodsPerNode = (*uint)(unsafe.Pointer(in.MaxTerminatingPodsPerNode))
+	out.MaxTerminatingPods = (*uint)(unsafe.Pointer(in.MaxTerminatingPods))
+	return nil
+}
+
+// Convert_api_TerminationPacing_To_v1alpha2_TerminationPacing is an autogenerated conversion function.
+func Convert_api_TerminationPacing_To_v1alpha2_TerminationPacing(in *api.TerminationPacing, out *TerminationPacing, s conversion.Scope) error {
+	return autoConvert_api_TerminationPacing_To_v1alpha2_TerminationPacing(in, out, s)
+}
+
+func autoConvert_v1alpha2_VetoValidation_To_api_VetoValidation(in *VetoValidation, out *api.VetoValidation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha2_VetoValidation_To_api_VetoValidation is an autogenerated conversion function.
+func Convert_v1alpha2_VetoValidation_To_api_VetoValidation(in *VetoValidation, out *api.VetoValidation, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VetoValidation_To_api_VetoValidation(in, out, s)
+}
+
+func autoConvert_api_VetoValidation_To_v1alpha2_VetoValidation(in *api.VetoValidation, out *VetoValidation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_api_VetoValidation_To_v1alpha2_VetoValidation is an autogenerated conversion function.
+func Convert_api_VetoValidation_To_v1alpha2_VetoValidation(in *api.VetoValidation, out *VetoValidation, s conversion.Scope) error {
+	return autoConvert_api_VetoValidation_To_v1alpha2_VetoValidation(in, out, s)
+}
+
+func autoConvert_v1alpha2_Webhook_To_api_Webhook(in *Webhook, out *api.Webhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Format = api.WebhookFormat(in.Format)
+	out.MinEvictions = in.MinEvictions
+	return nil
+}
+
+// Convert_v1alpha2_Webhook_To_api_Webhook is an autogenerated conversion function.
+func Convert_v1alpha2_Webhook_To_api_Webhook(in *Webhook, out *api.Webhook, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Webhook_To_api_Webhook(in, out, s)
+}
+
+func autoConvert_api_Webhook_To_v1alpha2_Webhook(in *api.Webhook, out *Webhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Format = WebhookFormat(in.Format)
+	out.MinEvictions = in.MinEvictions
+	return nil
+}
+
+// Convert_api_Webhook_To_v1alpha2_Webhook is an autogenerated conversion function.
+func Convert_api_Webhook_To_v1alpha2_Webhook(in *api.Webhook, out *Webhook, s conversion.Scope) error {
+	return autoConvert_api_Webhook_To_v1alpha2_Webhook(in, out, s)
+}
+
+func autoConvert_v1alpha2_ZoneOutageBrake_To_api_ZoneOutageBrake(in *ZoneOutageBrake, out *api.ZoneOutageBrake, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	out.MaxUnavailablePercentage = api.Percentage(in.MaxUnavailablePercentage)
+	return nil
+}
+
+// Convert_v1alpha2_ZoneOutageBrake_To_api_ZoneOutageBrake is an autogenerated conversion function.
+func Convert_v1alpha2_ZoneOutageBrake_To_api_ZoneOutageBrake(in *ZoneOutageBrake, out *api.ZoneOutageBrake, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ZoneOutageBrake_To_api_ZoneOutageBrake(in, out, s)
+}
+
+func autoConvert_api_ZoneOutageBrake_To_v1alpha2_ZoneOutageBrake(in *api.ZoneOutageBrake, out *ZoneOutageBrake, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	out.MaxUnavailablePercentage = api.Percentage(in.MaxUnavailablePercentage)
+	return nil
+}
+
+// Convert_api_ZoneOutageBrake_To_v1alpha2_ZoneOutageBrake is an autogenerated conversion function.
+func Convert_api_ZoneOutageBrake_To_v1alpha2_ZoneOutageBrake(in *api.ZoneOutageBrake, out *ZoneOutageBrake, s conversion.Scope) error {
+	return autoConvert_api_ZoneOutageBrake_To_v1alpha2_ZoneOutageBrake(in, out, s)
+}
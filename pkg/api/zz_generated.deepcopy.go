@@ -22,7 +22,9 @@ limitations under the License.
 package api
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -46,6 +48,79 @@ func (in *AuthToken) DeepCopy() *AuthToken {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientIdentity) DeepCopyInto(out *ClientIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientIdentity.
+func (in *ClientIdentity) DeepCopy() *ClientIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConcurrentDrains) DeepCopyInto(out *ConcurrentDrains) {
+	*out = *in
+	if in.NodeAnnotations != nil {
+		in, out := &in.NodeAnnotations, &out.NodeAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrentDrains.
+func (in *ConcurrentDrains) DeepCopy() *ConcurrentDrains {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrentDrains)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConvergenceDetection) DeepCopyInto(out *ConvergenceDetection) {
+	*out = *in
+	if in.Cycles != nil {
+		in, out := &in.Cycles, &out.Cycles
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MinSimilarityPercentage != nil {
+		in, out := &in.MinSimilarityPercentage, &out.MinSimilarityPercentage
+		*out = new(Percentage)
+		**out = **in
+	}
+	if in.PauseCycles != nil {
+		in, out := &in.PauseCycles, &out.PauseCycles
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConvergenceDetection.
+func (in *ConvergenceDetection) DeepCopy() *ConvergenceDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(ConvergenceDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeschedulerPolicy) DeepCopyInto(out *DeschedulerPolicy) {
 	*out = *in
@@ -77,11 +152,26 @@ func (in *DeschedulerPolicy) DeepCopyInto(out *DeschedulerPolicy) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.MaxNoOfPodsToEvictPerOwner != nil {
+		in, out := &in.MaxNoOfPodsToEvictPerOwner, &out.MaxNoOfPodsToEvictPerOwner
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxNoOfPodsToEvictPerTopologyDomain != nil {
+		in, out := &in.MaxNoOfPodsToEvictPerTopologyDomain, &out.MaxNoOfPodsToEvictPerTopologyDomain
+		*out = new(uint)
+		**out = **in
+	}
 	if in.EvictionFailureEventNotification != nil {
 		in, out := &in.EvictionFailureEventNotification, &out.EvictionFailureEventNotification
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NodeEvictionAnnotations != nil {
+		in, out := &in.NodeEvictionAnnotations, &out.NodeEvictionAnnotations
+		*out = new(bool)
+		**out = **in
+	}
 	if in.MetricsCollector != nil {
 		in, out := &in.MetricsCollector, &out.MetricsCollector
 		*out = new(MetricsCollector)
@@ -94,11 +184,138 @@ func (in *DeschedulerPolicy) DeepCopyInto(out *DeschedulerPolicy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaxEvictionFailuresPerCycle != nil {
+		in, out := &in.MaxEvictionFailuresPerCycle, &out.MaxEvictionFailuresPerCycle
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	if in.GracePeriodSeconds != nil {
 		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(Notifications)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictionSpreading != nil {
+		in, out := &in.EvictionSpreading, &out.EvictionSpreading
+		*out = new(EvictionSpreading)
+		**out = **in
+	}
+	if in.NodeCooldownCycles != nil {
+		in, out := &in.NodeCooldownCycles, &out.NodeCooldownCycles
+		*out = new(uint)
+		**out = **in
+	}
+	if in.DefaultEvictorArgs != nil {
+		out.DefaultEvictorArgs = in.DefaultEvictorArgs.DeepCopyObject()
+	}
+	if in.PodWatch != nil {
+		in, out := &in.PodWatch, &out.PodWatch
+		*out = new(PodWatch)
+		**out = **in
+	}
+	if in.EvictionFairness != nil {
+		in, out := &in.EvictionFairness, &out.EvictionFairness
+		*out = new(EvictionFairness)
+		**out = **in
+	}
+	if in.NamespaceDisruptionQuotas != nil {
+		in, out := &in.NamespaceDisruptionQuotas, &out.NamespaceDisruptionQuotas
+		*out = make([]NamespaceDisruptionQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AggregatedEvictionEvents != nil {
+		in, out := &in.AggregatedEvictionEvents, &out.AggregatedEvictionEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EvictionVeto != nil {
+		in, out := &in.EvictionVeto, &out.EvictionVeto
+		*out = new(EvictionVeto)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConcurrentDrains != nil {
+		in, out := &in.ConcurrentDrains, &out.ConcurrentDrains
+		*out = new(ConcurrentDrains)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeLeases != nil {
+		in, out := &in.NodeLeases, &out.NodeLeases
+		*out = new(NodeLeases)
+		**out = **in
+	}
+	if in.DeschedulingExemptions != nil {
+		in, out := &in.DeschedulingExemptions, &out.DeschedulingExemptions
+		*out = new(DeschedulingExemptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneOutageBrake != nil {
+		in, out := &in.ZoneOutageBrake, &out.ZoneOutageBrake
+		*out = new(ZoneOutageBrake)
+		**out = **in
+	}
+	if in.MinClusterHeadroom != nil {
+		in, out := &in.MinClusterHeadroom, &out.MinClusterHeadroom
+		*out = make(ResourceThresholds, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConvergenceDetection != nil {
+		in, out := &in.ConvergenceDetection, &out.ConvergenceDetection
+		*out = new(ConvergenceDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipExplanations != nil {
+		in, out := &in.SkipExplanations, &out.SkipExplanations
+		*out = new(SkipExplanations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreferredNodeHints != nil {
+		in, out := &in.PreferredNodeHints, &out.PreferredNodeHints
+		*out = new(PreferredNodeHints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GangScheduling != nil {
+		in, out := &in.GangScheduling, &out.GangScheduling
+		*out = new(GangScheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictionVerification != nil {
+		in, out := &in.EvictionVerification, &out.EvictionVerification
+		*out = new(EvictionVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RandomSeed != nil {
+		in, out := &in.RandomSeed, &out.RandomSeed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EvictionRateLimits != nil {
+		in, out := &in.EvictionRateLimits, &out.EvictionRateLimits
+		*out = new(EvictionRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceEvictionIntervals != nil {
+		in, out := &in.NamespaceEvictionIntervals, &out.NamespaceEvictionIntervals
+		*out = new(NamespaceEvictionIntervals)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerminationPacing != nil {
+		in, out := &in.TerminationPacing, &out.TerminationPacing
+		*out = new(TerminationPacing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -131,6 +348,21 @@ func (in *DeschedulerProfile) DeepCopyInto(out *DeschedulerProfile) {
 		}
 	}
 	in.Plugins.DeepCopyInto(&out.Plugins)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(Namespaces)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientIdentity != nil {
+		in, out := &in.ClientIdentity, &out.ClientIdentity
+		*out = new(ClientIdentity)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -144,6 +376,43 @@ func (in *DeschedulerProfile) DeepCopy() *DeschedulerProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeschedulingExemptions) DeepCopyInto(out *DeschedulingExemptions) {
+	*out = *in
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeschedulingExemptions.
+func (in *DeschedulingExemptions) DeepCopy() *DeschedulingExemptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DeschedulingExemptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionFairness) DeepCopyInto(out *EvictionFairness) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionFairness.
+func (in *EvictionFairness) DeepCopy() *EvictionFairness {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionFairness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EvictionLimits) DeepCopyInto(out *EvictionLimits) {
 	*out = *in
@@ -165,6 +434,175 @@ func (in *EvictionLimits) DeepCopy() *EvictionLimits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionRateLimit) DeepCopyInto(out *EvictionRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionRateLimit.
+func (in *EvictionRateLimit) DeepCopy() *EvictionRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionRateLimits) DeepCopyInto(out *EvictionRateLimits) {
+	*out = *in
+	if in.PerNode != nil {
+		in, out := &in.PerNode, &out.PerNode
+		*out = new(EvictionRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PerNamespace != nil {
+		in, out := &in.PerNamespace, &out.PerNamespace
+		*out = new(EvictionRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionRateLimits.
+func (in *EvictionRateLimits) DeepCopy() *EvictionRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionSpreading) DeepCopyInto(out *EvictionSpreading) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionSpreading.
+func (in *EvictionSpreading) DeepCopy() *EvictionSpreading {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionSpreading)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionVerification) DeepCopyInto(out *EvictionVerification) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
+		*out = new(EvictionWait)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionVerification.
+func (in *EvictionVerification) DeepCopy() *EvictionVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionVeto) DeepCopyInto(out *EvictionVeto) {
+	*out = *in
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]VetoValidation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionVeto.
+func (in *EvictionVeto) DeepCopy() *EvictionVeto {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionVeto)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionWait) DeepCopyInto(out *EvictionWait) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionWait.
+func (in *EvictionWait) DeepCopy() *EvictionWait {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionWait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GangScheduling) DeepCopyInto(out *GangScheduling) {
+	*out = *in
+	if in.Pacing != nil {
+		in, out := &in.Pacing, &out.Pacing
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GangScheduling.
+func (in *GangScheduling) DeepCopy() *GangScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(GangScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsCollector) DeepCopyInto(out *MetricsCollector) {
 	*out = *in
@@ -202,6 +640,49 @@ func (in *MetricsProvider) DeepCopy() *MetricsProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDisruptionQuota) DeepCopyInto(out *NamespaceDisruptionQuota) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Period = in.Period
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDisruptionQuota.
+func (in *NamespaceDisruptionQuota) DeepCopy() *NamespaceDisruptionQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDisruptionQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceEvictionIntervals) DeepCopyInto(out *NamespaceEvictionIntervals) {
+	*out = *in
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceEvictionIntervals.
+func (in *NamespaceEvictionIntervals) DeepCopy() *NamespaceEvictionIntervals {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceEvictionIntervals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Namespaces) DeepCopyInto(out *Namespaces) {
 	*out = *in
@@ -228,12 +709,60 @@ func (in *Namespaces) DeepCopy() *Namespaces {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLeases) DeepCopyInto(out *NodeLeases) {
+	*out = *in
+	out.LeaseDuration = in.LeaseDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLeases.
+func (in *NodeLeases) DeepCopy() *NodeLeases {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLeases)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifications) DeepCopyInto(out *Notifications) {
+	*out = *in
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]Webhook, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifications.
+func (in *Notifications) DeepCopy() *Notifications {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfig) DeepCopyInto(out *PluginConfig) {
 	*out = *in
 	if in.Args != nil {
 		out.Args = in.Args.DeepCopyObject()
 	}
+	if in.MaxPodsToEvictPerNode != nil {
+		in, out := &in.MaxPodsToEvictPerNode, &out.MaxPodsToEvictPerNode
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxPodsToEvictPerCycle != nil {
+		in, out := &in.MaxPodsToEvictPerCycle, &out.MaxPodsToEvictPerCycle
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
@@ -295,6 +824,43 @@ func (in *Plugins) DeepCopy() *Plugins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodWatch) DeepCopyInto(out *PodWatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodWatch.
+func (in *PodWatch) DeepCopy() *PodWatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PodWatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferredNodeHints) DeepCopyInto(out *PreferredNodeHints) {
+	*out = *in
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredNodeHints.
+func (in *PreferredNodeHints) DeepCopy() *PreferredNodeHints {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferredNodeHints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PriorityThreshold) DeepCopyInto(out *PriorityThreshold) {
 	*out = *in
@@ -303,6 +869,11 @@ func (in *PriorityThreshold) DeepCopyInto(out *PriorityThreshold) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -359,6 +930,27 @@ func (in ResourceThresholds) DeepCopy() ResourceThresholds {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -374,3 +966,103 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkipExplanations) DeepCopyInto(out *SkipExplanations) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkipExplanations.
+func (in *SkipExplanations) DeepCopy() *SkipExplanations {
+	if in == nil {
+		return nil
+	}
+	out := new(SkipExplanations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerminationPacing) DeepCopyInto(out *TerminationPacing) {
+	*out = *in
+	if in.MaxTerminatingPodsPerNode != nil {
+		in, out := &in.MaxTerminatingPodsPerNode, &out.MaxTerminatingPodsPerNode
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxTerminatingPods != nil {
+		in, out := &in.MaxTerminatingPods, &out.MaxTerminatingPods
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerminationPacing.
+func (in *TerminationPacing) DeepCopy() *TerminationPacing {
+	if in == nil {
+		return nil
+	}
+	out := new(TerminationPacing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VetoValidation) DeepCopyInto(out *VetoValidation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VetoValidation.
+func (in *VetoValidation) DeepCopy() *VetoValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(VetoValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Webhook) DeepCopyInto(out *Webhook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Webhook.
+func (in *Webhook) DeepCopy() *Webhook {
+	if in == nil {
+		return nil
+	}
+	out := new(Webhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneOutageBrake) DeepCopyInto(out *ZoneOutageBrake) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneOutageBrake.
+func (in *ZoneOutageBrake) DeepCopy() *ZoneOutageBrake {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneOutageBrake)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -39,9 +39,35 @@ type DeschedulerConfiguration struct {
 	// PolicyConfigFile is the filepath to the descheduler policy configuration.
 	PolicyConfigFile string
 
+	// PolicyURL is the HTTPS URL the descheduler policy configuration is fetched from instead of the policy config file.
+	PolicyURL string
+
+	// PolicyURLPollInterval is the interval the policy configuration is polled from the policy URL at,
+	// the descheduler restarts with every changed policy. The polling is disabled when zero.
+	PolicyURLPollInterval time.Duration
+
+	// PolicyURLCAFile is the file with the CA bundle the server of the policy URL is verified with instead of the system roots.
+	PolicyURLCAFile string
+
+	// PolicySignatureKeyFile is the file with the PEM encoded public key the signature of the policy configuration,
+	// read from the policy config file or fetched from the policy URL, is verified with. Unsigned or tampered
+	// policy configurations are refused. The policy configuration is not verified when empty.
+	PolicySignatureKeyFile string
+
+	// PolicySignatureFile is the file with the base64 encoded signature of the policy config file,
+	// the policy config file with the .sig suffix when empty.
+	PolicySignatureFile string
+
 	// Dry run
 	DryRun bool
 
+	// ReadOnly rejects every mutating request sent to the apiserver, the descheduler runs in the dry run mode
+	ReadOnly bool
+
+	// DryRunValidation validates the evictions of the dry run mode through the Eviction API in the server
+	// dry run mode (DryRun=All), the PDBs and the admission webhooks are checked by the apiserver
+	DryRunValidation bool
+
 	// Node selectors
 	NodeSelector string
 
@@ -63,7 +63,14 @@ func autoConvert_v1alpha1_DeschedulerConfiguration_To_componentconfig_Deschedule
 	out.DeschedulingInterval = time.Duration(in.DeschedulingInterval)
 	out.KubeconfigFile = in.KubeconfigFile
 	out.PolicyConfigFile = in.PolicyConfigFile
+	out.PolicyURL = in.PolicyURL
+	out.PolicyURLPollInterval = time.Duration(in.PolicyURLPollInterval)
+	out.PolicyURLCAFile = in.PolicyURLCAFile
+	out.PolicySignatureKeyFile = in.PolicySignatureKeyFile
+	out.PolicySignatureFile = in.PolicySignatureFile
 	out.DryRun = in.DryRun
+	out.ReadOnly = in.ReadOnly
+	out.DryRunValidation = in.DryRunValidation
 	out.NodeSelector = in.NodeSelector
 	out.MaxNoOfPodsToEvictPerNode = in.MaxNoOfPodsToEvictPerNode
 	out.EvictLocalStoragePods = in.EvictLocalStoragePods
@@ -86,7 +93,14 @@ func autoConvert_componentconfig_DeschedulerConfiguration_To_v1alpha1_Deschedule
 	out.DeschedulingInterval = time.Duration(in.DeschedulingInterval)
 	out.KubeconfigFile = in.KubeconfigFile
 	out.PolicyConfigFile = in.PolicyConfigFile
+	out.PolicyURL = in.PolicyURL
+	out.PolicyURLPollInterval = time.Duration(in.PolicyURLPollInterval)
+	out.PolicyURLCAFile = in.PolicyURLCAFile
+	out.PolicySignatureKeyFile = in.PolicySignatureKeyFile
+	out.PolicySignatureFile = in.PolicySignatureFile
 	out.DryRun = in.DryRun
+	out.ReadOnly = in.ReadOnly
+	out.DryRunValidation = in.DryRunValidation
 	out.NodeSelector = in.NodeSelector
 	out.MaxNoOfPodsToEvictPerNode = in.MaxNoOfPodsToEvictPerNode
 	out.EvictLocalStoragePods = in.EvictLocalStoragePods
@@ -0,0 +1,414 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/klog/v2"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler/client"
+	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const (
+	// BenchLabelKey labels all the objects created by the bench in a cluster
+	BenchLabelKey = "descheduler.alpha.kubernetes.io/bench"
+)
+
+// BenchOptions describes the synthetic cluster and the number of the measured cycles
+type BenchOptions struct {
+	Nodes int
+	Pods  int
+	// PodsPerWorkload is the number of pods owned by a single replica set
+	PodsPerWorkload int
+	// HotNodes is the number of nodes receiving half of the pods, the other half
+	// is spread over all nodes. No node is preferred when zero.
+	HotNodes     int
+	NodeMilliCPU int64
+	NodeMemory   int64
+	PodMilliCPU  int64
+	PodMemory    int64
+	// Namespace holding the synthetic pods
+	Namespace string
+	Cycles    int
+	// Cleanup deletes the objects created in the cluster once the bench finishes
+	Cleanup bool
+}
+
+// NewBenchOptions returns the default bench options
+func NewBenchOptions() *BenchOptions {
+	return &BenchOptions{
+		Nodes:           100,
+		Pods:            3000,
+		PodsPerWorkload: 10,
+		NodeMilliCPU:    4000,
+		NodeMemory:      16 * 1024 * 1024 * 1024,
+		PodMilliCPU:     100,
+		PodMemory:       128 * 1024 * 1024,
+		Namespace:       "descheduler-bench",
+		Cycles:          3,
+		Cleanup:         true,
+	}
+}
+
+// CycleStats describes a single measured descheduling cycle
+type CycleStats struct {
+	Duration time.Duration `json:"duration"`
+	Evicted  uint          `json:"evicted"`
+	// APICalls counts the requests sent to the apiserver during the cycle by "<verb> <resource>"
+	APICalls map[string]uint `json:"apiCalls"`
+	// AllocatedBytes is the amount of heap memory allocated during the cycle
+	AllocatedBytes uint64 `json:"allocatedBytes"`
+}
+
+// BenchResult describes the measured descheduling cycles
+type BenchResult struct {
+	Nodes  int          `json:"nodes"`
+	Pods   int          `json:"pods"`
+	Cycles []CycleStats `json:"cycles"`
+	// SyncDuration is the time it took to sync the informers
+	SyncDuration time.Duration `json:"syncDuration"`
+	// HeapInUseBytes is the heap memory in use after the last cycle, mostly the informer caches
+	HeapInUseBytes uint64 `json:"heapInUseBytes"`
+}
+
+// apiCallCounter counts the requests sent to the apiserver once enabled
+type apiCallCounter struct {
+	mu      sync.Mutex
+	enabled bool
+	calls   map[string]uint
+}
+
+func (c *apiCallCounter) record(verb, resource, subresource string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.enabled {
+		return
+	}
+	if subresource != "" {
+		resource = resource + "/" + subresource
+	}
+	c.calls[verb+" "+resource]++
+}
+
+// reset returns the calls counted so far and starts counting from scratch
+func (c *apiCallCounter) reset() map[string]uint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	calls := c.calls
+	c.enabled = true
+	c.calls = make(map[string]uint)
+	return calls
+}
+
+func (c *apiCallCounter) reactor(action core.Action) (bool, k8sruntime.Object, error) {
+	c.record(action.GetVerb(), action.GetResource().Resource, action.GetSubresource())
+	return false, nil, nil
+}
+
+type countingRoundTripper struct {
+	counter  *apiCallCounter
+	resolver *request.RequestInfoFactory
+	rt       http.RoundTripper
+}
+
+func (c *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if info, err := c.resolver.NewRequestInfo(req); err == nil && info.IsResourceRequest {
+		c.counter.record(info.Verb, info.Resource, info.Subresource)
+	}
+	return c.rt.RoundTrip(req)
+}
+
+// Bench measures the duration, the memory and the API calls of descheduling cycles of the policy.
+// The synthetic nodes and pods are created in the cluster given by the kubeconfig, e.g. a kwok cluster.
+// When no kubeconfig is given an in-memory fake apiserver is used. Pods evicted from the in-memory
+// apiserver are kept so every cycle sees the same cluster.
+func Bench(ctx context.Context, rs *options.DeschedulerServer, opts *BenchOptions) (*BenchResult, error) {
+	if opts.Nodes <= 1 || opts.Pods <= 0 || opts.PodsPerWorkload <= 0 || opts.Cycles <= 0 {
+		return nil, fmt.Errorf("the bench needs at least 2 nodes, 1 pod and 1 cycle")
+	}
+	if opts.HotNodes > opts.Nodes {
+		return nil, fmt.Errorf("the number of hot nodes (%d) exceeds the number of nodes (%d)", opts.HotNodes, opts.Nodes)
+	}
+
+	counter := &apiCallCounter{calls: make(map[string]uint)}
+	var evictionPolicyGroupVersion string
+	if rs.ClientConnection.Kubeconfig != "" {
+		resolver := &request.RequestInfoFactory{APIPrefixes: sets.NewString("api", "apis"), GrouplessAPIPrefixes: sets.NewString("api")}
+		rsclient, err := client.CreateClientWithTransportWrapper(rs.ClientConnection, "descheduler", func(rt http.RoundTripper) http.RoundTripper {
+			return &countingRoundTripper{counter: counter, resolver: resolver, rt: rt}
+		})
+		if err != nil {
+			return nil, err
+		}
+		rs.Client = rsclient
+		evictionPolicyGroupVersion, err = eutils.SupportEviction(rs.Client)
+		if err != nil || len(evictionPolicyGroupVersion) == 0 {
+			return nil, err
+		}
+		if err := createBenchObjects(ctx, rs.Client, opts); err != nil {
+			return nil, err
+		}
+		if opts.Cleanup {
+			defer deleteBenchObjects(rs.Client, opts)
+		}
+	} else {
+		fakeClient := fakeclientset.NewSimpleClientset()
+		for _, obj := range benchObjects(opts) {
+			if err := fakeClient.Tracker().Add(obj); err != nil {
+				return nil, err
+			}
+		}
+		fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, k8sruntime.Object, error) {
+			// accept evictions without removing the pods
+			return action.GetSubresource() == "eviction", nil, nil
+		})
+		fakeClient.PrependReactor("*", "*", counter.reactor)
+		rs.Client = fakeClient
+		evictionPolicyGroupVersion = "v1"
+	}
+
+	deschedulerPolicy, err := LoadPolicyConfig(rs.PolicyConfigFile, rs.Client, pluginregistry.PluginRegistry)
+	if err != nil {
+		return nil, err
+	}
+	if deschedulerPolicy == nil {
+		return nil, fmt.Errorf("deschedulerPolicy is nil")
+	}
+	// The bench measures the descheduling itself
+	deschedulerPolicy.Notifications = nil
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields))
+	eventBroadcaster, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, fakeclientset.NewSimpleClientset())
+	defer eventBroadcaster.Shutdown()
+
+	descheduler, err := newDescheduler(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion, eventRecorder, sharedInformerFactory, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BenchResult{Nodes: opts.Nodes, Pods: opts.Pods}
+	counter.reset()
+	syncStart := time.Now()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+	result.SyncDuration = time.Since(syncStart)
+
+	var nodeSelector string
+	if deschedulerPolicy.NodeSelector != nil {
+		nodeSelector = *deschedulerPolicy.NodeSelector
+	}
+
+	var memStats runtime.MemStats
+	for i := 0; i < opts.Cycles; i++ {
+		runtime.GC()
+		runtime.ReadMemStats(&memStats)
+		allocated := memStats.TotalAlloc
+		counter.reset()
+		start := time.Now()
+
+		nodes, err := nodeutil.ReadyNodes(ctx, rs.Client, sharedInformerFactory.Core().V1().Nodes().Lister(), nodeSelector)
+		if err != nil {
+			return nil, err
+		}
+		if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+			return nil, err
+		}
+
+		stats := CycleStats{Duration: time.Since(start), Evicted: descheduler.podEvictor.TotalEvicted(), APICalls: counter.reset()}
+		runtime.ReadMemStats(&memStats)
+		stats.AllocatedBytes = memStats.TotalAlloc - allocated
+		result.Cycles = append(result.Cycles, stats)
+		klog.V(1).InfoS("Bench cycle finished", "cycle", i, "duration", stats.Duration, "evicted", stats.Evicted)
+	}
+
+	runtime.GC()
+	runtime.ReadMemStats(&memStats)
+	result.HeapInUseBytes = memStats.HeapInuse
+	return result, nil
+}
+
+// benchNodeName returns the name of the node the pod with the given index is placed on
+func benchNodeName(opts *BenchOptions, pod int) string {
+	if opts.HotNodes > 0 && pod%2 == 0 {
+		return fmt.Sprintf("descheduler-bench-node-%d", (pod/2)%opts.HotNodes)
+	}
+	return fmt.Sprintf("descheduler-bench-node-%d", pod%opts.Nodes)
+}
+
+// benchObjects generates the nodes, replica sets and pods of the synthetic cluster
+func benchObjects(opts *BenchOptions) []k8sruntime.Object {
+	var objects []k8sruntime.Object
+	objects = append(objects, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: opts.Namespace, Labels: map[string]string{BenchLabelKey: "true"}}})
+	for i := 0; i < opts.Nodes; i++ {
+		allocatable := v1.ResourceList{
+			v1.ResourceCPU:    *resource.NewMilliQuantity(opts.NodeMilliCPU, resource.DecimalSI),
+			v1.ResourceMemory: *resource.NewQuantity(opts.NodeMemory, resource.BinarySI),
+			v1.ResourcePods:   *resource.NewQuantity(int64(opts.Pods), resource.DecimalSI),
+		}
+		objects = append(objects, &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("descheduler-bench-node-%d", i),
+				Labels: map[string]string{BenchLabelKey: "true", v1.LabelHostname: fmt.Sprintf("descheduler-bench-node-%d", i)},
+				// Let kwok manage the node
+				Annotations: map[string]string{nodeutil.SimulatedNodeAnnotationKey: "fake"},
+			},
+			Spec: v1.NodeSpec{
+				Taints: []v1.Taint{{Key: nodeutil.SimulatedNodeAnnotationKey, Value: "fake", Effect: v1.TaintEffectNoSchedule}},
+			},
+			Status: v1.NodeStatus{
+				Capacity:    allocatable,
+				Allocatable: allocatable,
+				Conditions:  []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+			},
+		})
+	}
+
+	for w := 0; w*opts.PodsPerWorkload < opts.Pods; w++ {
+		name := fmt.Sprintf("bench-%d", w)
+		replicas := opts.PodsPerWorkload
+		if (w+1)*opts.PodsPerWorkload > opts.Pods {
+			replicas = opts.Pods - w*opts.PodsPerWorkload
+		}
+		// The replica sets only own the pods so the pods are not garbage collected.
+		// They are scaled down and their selector does not match the pods so the
+		// pods are neither adopted nor deleted by the replica set controller.
+		selector := map[string]string{"descheduler-bench-replicaset": name}
+		rs := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: opts.Namespace, Labels: map[string]string{BenchLabelKey: "true"}, UID: uuid.NewUUID()},
+			Spec: appsv1.ReplicaSetSpec{
+				Replicas: utilptr.To[int32](0),
+				Selector: &metav1.LabelSelector{MatchLabels: selector},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: selector},
+					Spec:       benchPodSpec(opts),
+				},
+			},
+		}
+		objects = append(objects, rs)
+		for r := 0; r < replicas; r++ {
+			objects = append(objects, benchPod(opts, rs, w*opts.PodsPerWorkload+r))
+		}
+	}
+	return objects
+}
+
+func benchPodSpec(opts *BenchOptions) v1.PodSpec {
+	return v1.PodSpec{
+		Containers: []v1.Container{{
+			Name:  "bench",
+			Image: "registry.k8s.io/pause",
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    *resource.NewMilliQuantity(opts.PodMilliCPU, resource.DecimalSI),
+					v1.ResourceMemory: *resource.NewQuantity(opts.PodMemory, resource.BinarySI),
+				},
+			},
+		}},
+		Tolerations: []v1.Toleration{{Key: nodeutil.SimulatedNodeAnnotationKey, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+	}
+}
+
+func benchPod(opts *BenchOptions, rs *appsv1.ReplicaSet, index int) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%d", rs.Name, index),
+			Namespace:       rs.Namespace,
+			UID:             uuid.NewUUID(),
+			Labels:          map[string]string{BenchLabelKey: "true", "app": rs.Name},
+			OwnerReferences: []metav1.OwnerReference{benchOwnerRef(rs)},
+		},
+		Spec:   benchPodSpec(opts),
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	pod.Spec.NodeName = benchNodeName(opts, index)
+	return pod
+}
+
+func benchOwnerRef(rs *appsv1.ReplicaSet) metav1.OwnerReference {
+	return metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: rs.Name, UID: rs.UID}
+}
+
+// createBenchObjects creates the synthetic cluster. The owner references of the pods
+// are updated with the uids of the created replica sets.
+func createBenchObjects(ctx context.Context, client clientset.Interface, opts *BenchOptions) error {
+	klog.V(1).InfoS("Creating bench objects", "nodes", opts.Nodes, "pods", opts.Pods)
+	replicaSets := make(map[string]*appsv1.ReplicaSet)
+	for _, obj := range benchObjects(opts) {
+		var err error
+		switch o := obj.(type) {
+		case *v1.Namespace:
+			_, err = client.CoreV1().Namespaces().Create(ctx, o, metav1.CreateOptions{})
+		case *v1.Node:
+			_, err = client.CoreV1().Nodes().Create(ctx, o, metav1.CreateOptions{})
+		case *appsv1.ReplicaSet:
+			o.UID = ""
+			var created *appsv1.ReplicaSet
+			created, err = client.AppsV1().ReplicaSets(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+			replicaSets[o.Name] = created
+		case *v1.Pod:
+			rs := replicaSets[o.OwnerReferences[0].Name]
+			if rs == nil {
+				return fmt.Errorf("replica set %q of pod %q not created", o.OwnerReferences[0].Name, o.Name)
+			}
+			o.UID = ""
+			o.OwnerReferences = []metav1.OwnerReference{benchOwnerRef(rs)}
+			_, err = client.CoreV1().Pods(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("unable to create bench objects: %v", err)
+		}
+	}
+	return nil
+}
+
+func deleteBenchObjects(client clientset.Interface, opts *BenchOptions) {
+	ctx := context.Background()
+	klog.V(1).InfoS("Deleting bench objects")
+	if err := client.CoreV1().Namespaces().Delete(ctx, opts.Namespace, metav1.DeleteOptions{}); err != nil {
+		klog.ErrorS(err, "unable to delete bench namespace", "namespace", opts.Namespace)
+	}
+	if err := client.CoreV1().Nodes().DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: BenchLabelKey + "=true"}); err != nil {
+		klog.ErrorS(err, "unable to delete bench nodes")
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"k8s.io/klog/v2"
+)
+
+// maxCircuitBreakerBackoffCycles caps the number of the cycles skipped after a cycle aborted by the circuit breaker
+const maxCircuitBreakerBackoffCycles = 8
+
+// updateCircuitBreakerBackoff backs off after a descheduling cycle aborted as too many evictions failed,
+// the number of the skipped cycles doubles with every consecutive aborted cycle. Returns whether the
+// current cycle was aborted.
+func (d *descheduler) updateCircuitBreakerBackoff() bool {
+	if !d.podEvictor.CircuitBreakerOpen() {
+		d.abortedCycles = 0
+		return false
+	}
+	d.abortedCycles++
+	d.backoffCycles = min(uint(1)<<min(d.abortedCycles-1, 3), maxCircuitBreakerBackoffCycles)
+	klog.InfoS("Descheduling cycle aborted, too many evictions failed", "consecutiveAbortedCycles", d.abortedCycles, "skippedCycles", d.backoffCycles)
+	return true
+}
+
+// backingOff counts down the cycles skipped after an aborted cycle
+func (d *descheduler) backingOff() bool {
+	if d.backoffCycles == 0 {
+		return false
+	}
+	d.backoffCycles--
+	return true
+}
@@ -19,16 +19,20 @@ package client
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	promapi "github.com/prometheus/client_golang/api"
 	"github.com/prometheus/common/config"
 
+	"k8s.io/client-go/dynamic"
 	// Ensure to load all auth plugins.
 	clientset "k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -81,6 +85,82 @@ func CreateClient(clientConnection componentbaseconfig.ClientConnectionConfigura
 	return clientset.NewForConfig(cfg)
 }
 
+// CreateClientWithTransportWrapper creates a client with the transport wrapped by the wrapper,
+// e.g. to observe the requests sent to the apiserver
+func CreateClientWithTransportWrapper(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt string, wrapper transport.WrapperFunc) (clientset.Interface, error) {
+	cfg, err := createConfig(clientConnection, userAgt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create config: %v", err)
+	}
+	cfg.Wrap(wrapper)
+
+	return clientset.NewForConfig(cfg)
+}
+
+// CreateClientWithTokenFile creates a client authenticated with the token of the file instead of
+// the credentials of the client connection, e.g. with a projected ServiceAccount token
+func CreateClientWithTokenFile(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt, tokenFile string) (clientset.Interface, error) {
+	cfg, err := createConfig(clientConnection, userAgt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create config: %v", err)
+	}
+	if _, err := os.Stat(tokenFile); err != nil {
+		return nil, fmt.Errorf("unable to read the token file: %v", err)
+	}
+	cfg = tokenFileConfig(cfg, tokenFile)
+
+	return clientset.NewForConfig(cfg)
+}
+
+// tokenFileConfig drops all the credentials of the config and authenticates with the token of the file.
+// The token is re-read periodically so the rotated tokens are picked up.
+func tokenFileConfig(cfg *rest.Config, tokenFile string) *rest.Config {
+	cfg = rest.AnonymousClientConfig(cfg)
+	cfg.BearerTokenFile = tokenFile
+	return cfg
+}
+
+// ErrReadOnly is returned for every mutating request sent through a read-only client
+var ErrReadOnly = errors.New("the client is read-only")
+
+// readOnlyRoundTripper rejects the mutating requests before they leave the process.
+// The self access and rules reviews are let through, they are evaluated without being persisted.
+type readOnlyRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (r *readOnlyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return r.rt.RoundTrip(req)
+	case http.MethodPost:
+		if strings.HasPrefix(req.URL.Path, "/apis/authorization.k8s.io/") {
+			return r.rt.RoundTrip(req)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s %s rejected", ErrReadOnly, req.Method, req.URL.Path)
+}
+
+// ReadOnlyWrapper wraps the transport of a client to reject all the mutating requests
+func ReadOnlyWrapper(rt http.RoundTripper) http.RoundTripper {
+	return &readOnlyRoundTripper{rt: rt}
+}
+
+// CreateReadOnlyClient creates a client rejecting all the mutating requests
+func CreateReadOnlyClient(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt string) (clientset.Interface, error) {
+	return CreateClientWithTransportWrapper(clientConnection, userAgt, ReadOnlyWrapper)
+}
+
+// CreateDynamicClient creates a client of the resources not served by the typed clients, e.g. the custom resources
+func CreateDynamicClient(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt string) (dynamic.Interface, error) {
+	cfg, err := createConfig(clientConnection, userAgt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create config: %v", err)
+	}
+
+	return dynamic.NewForConfig(cfg)
+}
+
 func CreateMetricsClient(clientConnection componentbaseconfig.ClientConnectionConfiguration, userAgt string) (metricsclient.Interface, error) {
 	cfg, err := createConfig(clientConnection, userAgt)
 	if err != nil {
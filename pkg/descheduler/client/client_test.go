@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestReadOnlyWrapper(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	cfg := &rest.Config{Host: server.URL}
+	cfg.Wrap(ReadOnlyWrapper)
+	client, err := clientset.NewForConfig(cfg)
+	if err != nil {
+		t.Fatalf("Unable to create the client: %v", err)
+	}
+
+	ctx := context.TODO()
+	if _, err := client.CoreV1().Pods("default").Get(ctx, "p1", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected the get to pass, got: %v", err)
+	}
+	if _, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{}, metav1.CreateOptions{}); err != nil {
+		t.Errorf("Expected the self access review to pass, got: %v", err)
+	}
+
+	mutations := map[string]error{
+		"delete": client.CoreV1().Events("default").Delete(ctx, "e1", metav1.DeleteOptions{}),
+		"evict":  client.CoreV1().Pods("default").EvictV1(ctx, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"}}),
+	}
+	_, err = client.CoreV1().Events("default").Create(ctx, &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "e1"}}, metav1.CreateOptions{})
+	mutations["create"] = err
+	_, err = client.CoreV1().Nodes().Update(ctx, &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}}, metav1.UpdateOptions{})
+	mutations["update"] = err
+	_, err = client.CoreV1().Nodes().Patch(ctx, "n1", "application/merge-patch+json", []byte(`{}`), metav1.PatchOptions{})
+	mutations["patch"] = err
+	for name, err := range mutations {
+		if !errors.Is(err, ErrReadOnly) {
+			t.Errorf("Expected the %v to be rejected as read-only, got: %v", name, err)
+		}
+	}
+
+	expected := []string{"GET /api/v1/namespaces/default/pods/p1", "POST /apis/authorization.k8s.io/v1/selfsubjectaccessreviews"}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Expected requests %v, got %v", expected, requests)
+		}
+	}
+}
+
+func TestTokenFileConfig(t *testing.T) {
+	var authorization []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = append(authorization, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("team-a"), 0o600); err != nil {
+		t.Fatalf("Unable to write the token file: %v", err)
+	}
+
+	cfg := &rest.Config{Host: server.URL, BearerToken: "descheduler", Username: "admin", Password: "secret"}
+	client, err := clientset.NewForConfig(tokenFileConfig(cfg, tokenFile))
+	if err != nil {
+		t.Fatalf("Unable to create the client: %v", err)
+	}
+	if _, err := client.CoreV1().Pods("default").Get(context.TODO(), "p1", metav1.GetOptions{}); err != nil {
+		t.Fatalf("Expected the get to pass, got: %v", err)
+	}
+
+	if len(authorization) != 1 || authorization[0] != "Bearer team-a" {
+		t.Errorf("Expected the request to be authenticated with the token of the file, got %v", authorization)
+	}
+	if cfg.BearerToken != "descheduler" {
+		t.Errorf("Expected the original config to be kept, got token %q", cfg.BearerToken)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+)
+
+const (
+	// DefaultConvergenceCycles is the number of consecutive similar cycles a plugin is reported non-converging after
+	DefaultConvergenceCycles = 3
+	// DefaultConvergenceMinSimilarityPercentage of the evicted workload and node pairs two similar cycles have in common
+	DefaultConvergenceMinSimilarityPercentage = 50
+)
+
+// pluginKey identifies a plugin of a profile
+type pluginKey struct {
+	profile string
+	plugin  string
+}
+
+// evictedWorkload is an evicted workload and node pair, bare pods are their own workload
+type evictedWorkload struct {
+	namespace string
+	kind      string
+	name      string
+	node      string
+}
+
+// pluginConvergence keeps the evictions of a plugin in the previous cycle
+type pluginConvergence struct {
+	previous sets.Set[evictedWorkload]
+	// similarCycles is the number of consecutive cycles up to the previous one with similar evictions
+	similarCycles uint
+}
+
+// similarity returns the percentage of the pairs of both sets in common
+func similarity(a, b sets.Set[evictedWorkload]) float64 {
+	union := a.Union(b).Len()
+	if union == 0 {
+		return 0
+	}
+	return float64(a.Intersection(b).Len()) * 100 / float64(union)
+}
+
+// workloadsEvictedByPlugin groups the evicted workload and node pairs by the plugins evicting them
+func workloadsEvictedByPlugin(evicted []evictions.RecentEviction) (map[pluginKey]sets.Set[evictedWorkload], map[evictedWorkload]*v1.ObjectReference) {
+	byPlugin := make(map[pluginKey]sets.Set[evictedWorkload])
+	references := make(map[evictedWorkload]*v1.ObjectReference)
+	for _, eviction := range evicted {
+		workload := evictedWorkload{namespace: eviction.Namespace, kind: "Pod", name: eviction.Name, node: eviction.Node}
+		reference := &v1.ObjectReference{Kind: "Pod", Namespace: eviction.Namespace, Name: eviction.Name, UID: eviction.PodUID}
+		if eviction.Owner != nil {
+			workload.kind = eviction.Owner.Kind
+			workload.name = eviction.Owner.Name
+			reference = &v1.ObjectReference{
+				APIVersion: eviction.Owner.APIVersion,
+				Kind:       eviction.Owner.Kind,
+				Namespace:  eviction.Namespace,
+				Name:       eviction.Owner.Name,
+				UID:        eviction.Owner.UID,
+			}
+		}
+		key := pluginKey{profile: eviction.Profile, plugin: eviction.Strategy}
+		if byPlugin[key] == nil {
+			byPlugin[key] = sets.New[evictedWorkload]()
+		}
+		byPlugin[key].Insert(workload)
+		references[workload] = reference
+	}
+	return byPlugin, references
+}
+
+// updateConvergence compares the evictions of every plugin in the cycle started at the given time with
+// its evictions in the previous cycle. The plugins evicting similar pods for the configured number of
+// cycles are reported non-converging, with a warning event for every workload evicted again, and paused
+// when configured so. A plugin evicting nothing in a cycle starts over.
+func (d *descheduler) updateConvergence(cycleStart time.Time) {
+	detection := d.deschedulerPolicy.ConvergenceDetection
+	if detection == nil {
+		return
+	}
+	cycles := ptr.Deref(detection.Cycles, DefaultConvergenceCycles)
+	minSimilarity := float64(ptr.Deref(detection.MinSimilarityPercentage, DefaultConvergenceMinSimilarityPercentage))
+	pauseCycles := ptr.Deref(detection.PauseCycles, 0)
+
+	for key, remaining := range d.pausedPlugins {
+		if remaining <= 1 {
+			delete(d.pausedPlugins, key)
+		} else {
+			d.pausedPlugins[key] = remaining - 1
+		}
+	}
+
+	byPlugin, references := workloadsEvictedByPlugin(d.podEvictor.RecentEvictions().EvictedSince(cycleStart))
+	for key := range d.convergence {
+		if _, ok := byPlugin[key]; !ok {
+			delete(d.convergence, key)
+		}
+	}
+
+	metrics.NonConvergingPlugins.Reset()
+	for key, evicted := range byPlugin {
+		state, ok := d.convergence[key]
+		if !ok {
+			d.convergence[key] = &pluginConvergence{previous: evicted}
+			continue
+		}
+		repeated := state.previous.Intersection(evicted)
+		if similarity(state.previous, evicted) >= minSimilarity {
+			state.similarCycles++
+		} else {
+			state.similarCycles = 0
+		}
+		state.previous = evicted
+		if state.similarCycles+1 < cycles {
+			continue
+		}
+
+		klog.InfoS("Plugin not converging, evicting the pods of the same workloads from the same nodes every cycle", "profile", key.profile, "plugin", key.plugin, "cycles", state.similarCycles+1)
+		metrics.NonConvergingPlugins.WithLabelValues(key.profile, key.plugin).Set(1)
+		workloads := repeated.UnsortedList()
+		sort.Slice(workloads, func(i, j int) bool {
+			return workloads[i].namespace+"/"+workloads[i].name+"/"+workloads[i].node < workloads[j].namespace+"/"+workloads[j].name+"/"+workloads[j].node
+		})
+		for _, workload := range workloads {
+			d.eventRecorder.Eventf(references[workload], nil, v1.EventTypeWarning, "NonConvergingPolicy", "Descheduled", "plugin %s of profile %s evicted pods of %s %s from node %s in %d consecutive cycles", key.plugin, key.profile, workload.kind, workload.name, workload.node, state.similarCycles+1)
+		}
+		if pauseCycles > 0 {
+			klog.InfoS("Pausing the non-converging plugin", "profile", key.profile, "plugin", key.plugin, "cycles", pauseCycles)
+			d.pausedPlugins[key] = pauseCycles
+			delete(d.convergence, key)
+		}
+	}
+}
+
+// withoutPausedPlugins returns the profile without its paused deschedule and balance plugins
+func (d *descheduler) withoutPausedPlugins(profile api.DeschedulerProfile) api.DeschedulerProfile {
+	if len(d.pausedPlugins) == 0 {
+		return profile
+	}
+	unpaused := func(enabled []string) []string {
+		var plugins []string
+		for _, name := range enabled {
+			if _, paused := d.pausedPlugins[pluginKey{profile: profile.Name, plugin: name}]; paused {
+				klog.V(1).InfoS("Skipping the paused plugin", "profile", profile.Name, "plugin", name)
+				continue
+			}
+			plugins = append(plugins, name)
+		}
+		return plugins
+	}
+	profile.Plugins.Deschedule.Enabled = unpaused(profile.Plugins.Deschedule.Enabled)
+	profile.Plugins.Balance.Enabled = unpaused(profile.Plugins.Balance.Enabled)
+	return profile
+}
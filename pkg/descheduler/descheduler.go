@@ -18,8 +18,10 @@ package descheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"net/http"
 	"strconv"
 	"time"
@@ -29,22 +31,29 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	utilversion "k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	clientset "k8s.io/client-go/kubernetes"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	core "k8s.io/client-go/testing"
@@ -62,8 +71,12 @@ import (
 	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	"sigs.k8s.io/descheduler/pkg/descheduler/notifications"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/descheduler/source"
+	"sigs.k8s.io/descheduler/pkg/features"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	frameworkprofile "sigs.k8s.io/descheduler/pkg/framework/profile"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/tracing"
@@ -99,6 +112,58 @@ type descheduler struct {
 	queue                             workqueue.RateLimitingInterface
 	currentPrometheusAuthToken        string
 	metricsProviders                  map[api.MetricsSource]*api.MetricsProvider
+	notifiers                         []notifications.Notifier
+	// nodeCooldowns keeps the number of remaining cycles no pods are evicted from a node
+	nodeCooldowns map[string]uint
+	// convergence keeps the evictions of every plugin in the previous cycle
+	convergence map[pluginKey]*pluginConvergence
+	// pausedPlugins keeps the number of remaining cycles the non-converging plugins are paused for
+	pausedPlugins map[pluginKey]uint
+	// cycleSeed seeds the randomized choices of the plugins in the current cycle, nextCycleSeed in the next one
+	cycleSeed     int64
+	nextCycleSeed int64
+	// abortedCycles counts the consecutive cycles aborted by the eviction circuit breaker,
+	// backoffCycles keeps the number of the remaining cycles skipped after the last one
+	abortedCycles uint
+	backoffCycles uint
+}
+
+// cachedResources are the resources copied to the fake client in the dry run mode
+var cachedResources = []schema.GroupVersionResource{
+	v1.SchemeGroupVersion.WithResource("pods"),
+	v1.SchemeGroupVersion.WithResource("nodes"),
+	// Future work could be to let each plugin declare what type of resources it needs; that way dry runs would stay
+	// consistent with the real runs without having to keep the list here in sync.
+	v1.SchemeGroupVersion.WithResource("namespaces"),                 // Used by the defaultevictor plugin
+	schedulingv1.SchemeGroupVersion.WithResource("priorityclasses"),  // Used by the defaultevictor plugin
+	policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"), // Used by the defaultevictor plugin
+}
+
+// pluginResources returns the resources used by the plugins only when configured so,
+// e.g. the PVCs used by the defaultevictor plugin with the PVC pods policy
+// or the Jobs used with the batch protection
+func pluginResources(deschedulerPolicy *api.DeschedulerPolicy) []schema.GroupVersionResource {
+	var resources []schema.GroupVersionResource
+	for _, profile := range deschedulerPolicy.Profiles {
+		pluginConfig, _ := GetPluginConfig(defaultevictor.PluginName, profile.PluginConfigs)
+		if pluginConfig == nil {
+			continue
+		}
+		args, ok := pluginConfig.Args.(*defaultevictor.DefaultEvictorArgs)
+		if !ok {
+			continue
+		}
+		if args.IgnorePvcPods && args.PvcPods != nil {
+			resources = append(resources, v1.SchemeGroupVersion.WithResource("persistentvolumeclaims"))
+			if args.PvcPods.AttachedReadWriteOnceOnly {
+				resources = append(resources, storagev1.SchemeGroupVersion.WithResource("volumeattachments"))
+			}
+		}
+		if args.BatchProtection != nil && args.BatchProtection.JobProgress {
+			resources = append(resources, batchv1.SchemeGroupVersion.WithResource("jobs"))
+		}
+	}
+	return resources
 }
 
 type informerResources struct {
@@ -154,24 +219,55 @@ func metricsProviderListToMap(providersList []api.MetricsProvider) map[api.Metri
 }
 
 func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string, eventRecorder events.EventRecorder, sharedInformerFactory, namespacedSharedInformerFactory informers.SharedInformerFactory) (*descheduler, error) {
-	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	podInformer := scopedPodInformer(sharedInformerFactory, deschedulerPolicy.PodWatch)
+	scopedNodeInformer(sharedInformerFactory, deschedulerPolicy.NodeSelector)
 
 	ir := newInformerResources(sharedInformerFactory)
-	ir.Uses(v1.SchemeGroupVersion.WithResource("pods"),
-		v1.SchemeGroupVersion.WithResource("nodes"),
-		// Future work could be to let each plugin declare what type of resources it needs; that way dry runs would stay
-		// consistent with the real runs without having to keep the list here in sync.
-		v1.SchemeGroupVersion.WithResource("namespaces"),                 // Used by the defaultevictor plugin
-		schedulingv1.SchemeGroupVersion.WithResource("priorityclasses"),  // Used by the defaultevictor plugin
-		policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"), // Used by the defaultevictor plugin
-
-	) // Used by the defaultevictor plugin
+	ir.Uses(cachedResources...)
+	if err := ir.Uses(pluginResources(deschedulerPolicy)...); err != nil {
+		return nil, err
+	}
 
 	getPodsAssignedToNode, err := podutil.BuildGetPodsAssignedToNodeFunc(podInformer)
 	if err != nil {
 		return nil, fmt.Errorf("build get pods assigned to node function error: %v", err)
 	}
 
+	var spreadingTopologyKey string
+	if deschedulerPolicy.EvictionSpreading != nil {
+		spreadingTopologyKey = deschedulerPolicy.EvictionSpreading.TopologyKey
+	}
+	topologyDomainKey := deschedulerPolicy.TopologyDomainKey
+	if topologyDomainKey == "" {
+		topologyDomainKey = v1.LabelTopologyZone
+	}
+	var fairnessBy string
+	if deschedulerPolicy.EvictionFairness != nil {
+		fairnessBy = string(deschedulerPolicy.EvictionFairness.By)
+		if fairnessBy == "" {
+			fairnessBy = string(api.FairnessByNamespace)
+		}
+	}
+
+	var evacuationClient dynamic.Interface
+	if deschedulerPolicy.EvictionBackend == api.EvacuationAPIBackend {
+		// the pods of the object sources are evicted from the sources
+		if rs.DynamicClient == nil && !rs.DryRun && rs.ObjectSource == nil {
+			return nil, fmt.Errorf("the %q eviction backend requires a dynamic client", api.EvacuationAPIBackend)
+		}
+		evacuationClient = rs.DynamicClient
+	}
+
+	var validationClient clientset.Interface
+	if rs.DryRun && rs.DryRunValidation {
+		validationClient = rs.Client
+	}
+
+	var replicaSetLister appsv1listers.ReplicaSetLister
+	if deschedulerPolicy.EvictionOrder == api.OlderRevisionsFirstEvictionOrder {
+		replicaSetLister = sharedInformerFactory.Apps().V1().ReplicaSets().Lister()
+	}
+
 	podEvictor, err := evictions.NewPodEvictor(
 		ctx,
 		rs.Client,
@@ -183,8 +279,26 @@ func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedu
 			WithMaxPodsToEvictPerNode(deschedulerPolicy.MaxNoOfPodsToEvictPerNode).
 			WithMaxPodsToEvictPerNamespace(deschedulerPolicy.MaxNoOfPodsToEvictPerNamespace).
 			WithMaxPodsToEvictTotal(deschedulerPolicy.MaxNoOfPodsToEvictTotal).
+			WithMaxPodsToEvictPerOwner(deschedulerPolicy.MaxNoOfPodsToEvictPerOwner).
+			WithMaxPodsToEvictPerTopologyDomain(deschedulerPolicy.MaxNoOfPodsToEvictPerTopologyDomain, topologyDomainKey).
+			WithEvictionSpreading(spreadingTopologyKey).
+			WithEvictionFairness(fairnessBy).
+			WithNamespaceDisruptionQuotas(deschedulerPolicy.NamespaceDisruptionQuotas).
+			WithAggregatedEvents(deschedulerPolicy.AggregatedEvictionEvents).
+			WithEvictionVeto(deschedulerPolicy.EvictionVeto).
+			WithNodeLeases(deschedulerPolicy.NodeLeases).
+			WithSkipExplanations(deschedulerPolicy.SkipExplanations).
+			WithPreferredNodeHints(deschedulerPolicy.PreferredNodeHints).
+			WithGangScheduling(deschedulerPolicy.GangScheduling).
+			WithEvictionVerification(deschedulerPolicy.EvictionVerification).
+			WithEvictionRateLimits(deschedulerPolicy.EvictionRateLimits).
+			WithTerminationPacing(deschedulerPolicy.TerminationPacing).
 			WithEvictionFailureEventNotification(deschedulerPolicy.EvictionFailureEventNotification).
 			WithGracePeriodSeconds(deschedulerPolicy.GracePeriodSeconds).
+			WithEvacuationClient(evacuationClient).
+			WithMaxEvictionFailuresPerCycle(deschedulerPolicy.MaxEvictionFailuresPerCycle).
+			WithRevisionOrder(replicaSetLister).
+			WithDryRunValidation(validationClient).
 			WithDryRun(rs.DryRun).
 			WithMetricsEnabled(!rs.DisableMetrics),
 	)
@@ -204,6 +318,14 @@ func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedu
 		prometheusClient:       rs.PrometheusClient,
 		queue:                  workqueue.NewRateLimitingQueueWithConfig(workqueue.DefaultControllerRateLimiter(), workqueue.RateLimitingQueueConfig{Name: "descheduler"}),
 		metricsProviders:       metricsProviderListToMap(deschedulerPolicy.MetricsProviders),
+		notifiers:              notifications.NewNotifiers(deschedulerPolicy.Notifications),
+		nodeCooldowns:          make(map[string]uint),
+		convergence:            make(map[pluginKey]*pluginConvergence),
+		pausedPlugins:          make(map[pluginKey]uint),
+		nextCycleSeed:          rand.Int63(),
+	}
+	if deschedulerPolicy.RandomSeed != nil {
+		desch.nextCycleSeed = *deschedulerPolicy.RandomSeed
 	}
 
 	if rs.MetricsClient != nil {
@@ -216,6 +338,9 @@ func newDescheduler(ctx context.Context, rs *options.DeschedulerServer, deschedu
 			nodeSelector = sel
 		}
 		desch.metricsCollector = metricscollector.NewMetricsCollector(sharedInformerFactory.Core().V1().Nodes().Lister(), rs.MetricsClient, nodeSelector)
+		if rs.DefaultFeatureGates.Enabled(features.SimulatedNodes) {
+			desch.metricsCollector.IgnoreSimulatedNodes()
+		}
 	}
 
 	prometheusProvider := desch.metricsProviders[api.PrometheusMetrics]
@@ -349,9 +474,12 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 	var span trace.Span
 	ctx, span = tracing.Tracer().Start(ctx, "runDeschedulerLoop")
 	defer span.End()
+	loopStartTime := time.Now()
 	defer func(loopStartDuration time.Time) {
 		metrics.DeschedulerLoopDuration.With(map[string]string{}).Observe(time.Since(loopStartDuration).Seconds())
-	}(time.Now())
+	}(loopStartTime)
+	d.recordScopedNodes()
+	d.advanceCycleSeed()
 
 	// if len is still <= 1 error out
 	if len(nodes) <= 1 {
@@ -359,6 +487,39 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 		return fmt.Errorf("the cluster size is 0 or 1")
 	}
 
+	// The stale marks are removed even when the cycle evicts nothing
+	d.cleanupSoftEvictionMarks(ctx)
+
+	// Evicting keeps failing while the API server is unavailable
+	if d.backingOff() {
+		klog.InfoS("Skipping the descheduling cycle, backing off after too many failed evictions", "remainingCycles", d.backoffCycles)
+		return nil
+	}
+
+	// The profiles run in their maintenance windows only
+	profiles := d.scheduledProfiles(loopStartTime)
+	if len(profiles) == 0 {
+		klog.InfoS("Skipping the descheduling cycle, no profile is in its maintenance windows")
+		return nil
+	}
+
+	// Evicting in a nearly full cluster only leaves the evicted pods Pending
+	if len(d.deschedulerPolicy.MinClusterHeadroom) > 0 && d.headroomBelowMinimum(nodes, d.deschedulerPolicy.MinClusterHeadroom) {
+		klog.InfoS("Skipping the descheduling cycle, the cluster headroom is below the minimum")
+		return nil
+	}
+
+	// The exempted pods are kept, no pods are evicted while the exemptions are not known
+	var exemptions []evictions.Exemption
+	if d.deschedulerPolicy.DeschedulingExemptions != nil && d.deschedulerPolicy.DeschedulingExemptions.Enabled && d.rs.DynamicClient != nil {
+		var err error
+		exemptions, err = exemptionsInForce(ctx, d.rs.DynamicClient, d.deschedulerPolicy.DeschedulingExemptions, time.Now())
+		if err != nil {
+			klog.ErrorS(err, "Skipping the descheduling cycle")
+			return nil
+		}
+	}
+
 	var client clientset.Interface
 	// When the dry mode is enable, collect all the relevant objects (mostly pods) under a fake client.
 	// So when evicting pods while running multiple strategies in a row have the cummulative effect
@@ -369,6 +530,10 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 		fakeClient := fakeclientset.NewSimpleClientset()
 		// simulate a pod eviction by deleting a pod
 		fakeClient.PrependReactor("create", "pods", d.podEvictionReactionFnc(fakeClient))
+		// the plugins discover the core API of the cluster, e.g. the in-place pod resize support
+		if resources, err := d.rs.Client.Discovery().ServerResourcesForGroupVersion(v1.SchemeGroupVersion.String()); err == nil {
+			fakeClient.Resources = []*metav1.APIResourceList{resources}
+		}
 		fakeSharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
 
 		err := d.ir.CopyTo(fakeClient, fakeSharedInformerFactory)
@@ -394,62 +559,214 @@ func (d *descheduler) runDeschedulerLoop(ctx context.Context, nodes []*v1.Node)
 		client = d.rs.Client
 	}
 
+	// The drained nodes are neither sources nor targets of the plugins while the drains overlap the cycle
+	drained := sets.New[string]()
+	if d.deschedulerPolicy.ConcurrentDrains != nil {
+		drained = drainedNodes(ctx, d.rs.Client, nodes, d.deschedulerPolicy.ConcurrentDrains, d.deschedulerPolicy.NodeLeases, time.Now())
+		nodes = withoutNodes(nodes, drained)
+	}
+
 	klog.V(3).Infof("Setting up the pod evictor")
 	d.podEvictor.SetClient(client)
 	d.podEvictor.ResetCounters()
-
-	d.runProfiles(ctx, client, nodes)
+	d.podEvictor.SetNodes(nodes)
+	if d.deschedulerPolicy.EvictionFairness != nil {
+		d.setFairShares(nodes)
+	}
+	if d.deschedulerPolicy.NamespaceEvictionIntervals != nil && d.deschedulerPolicy.NamespaceEvictionIntervals.Enabled {
+		d.podEvictor.SetNamespaceIntervals(d.namespaceEvictionIntervals())
+	}
+	d.podEvictor.SetNodesInCooldown(sets.KeySet(d.nodeCooldowns))
+	d.podEvictor.SetDrainedNodes(drained)
+	d.podEvictor.SetExemptions(exemptions)
+	d.podEvictor.VerifyEvictions(time.Now())
+	d.podEvictor.TrackReplacementPlacements(time.Now())
+
+	errs := d.runProfiles(ctx, client, nodes, profiles, d.balanceSuspended())
+	if d.updateCircuitBreakerBackoff() {
+		errs = append(errs, fmt.Errorf("descheduling cycle aborted: %w", evictions.NewEvictionCircuitBreakerError()))
+	}
+	d.podEvictor.EmitAggregatedEvents()
+	d.podEvictor.EmitSkipExplanations(time.Now())
+	d.podEvictor.ApplyPreferredNodeHints(ctx, time.Now())
+	d.podEvictor.UpdateRecommendedEvictions()
+	d.podEvictor.ReleaseNodeLeases(ctx)
+	d.updateNodeCooldowns()
+	d.updateConvergence(loopStartTime)
 
 	klog.V(1).InfoS("Number of evictions/requests", "totalEvicted", d.podEvictor.TotalEvicted(), "evictionRequests", d.podEvictor.TotalEvictionRequests())
 
+	if !d.rs.DryRun && d.deschedulerPolicy.NodeEvictionAnnotations != nil && *d.deschedulerPolicy.NodeEvictionAnnotations {
+		d.annotateNodeEvictions(ctx)
+	}
+
+	if len(d.notifiers) > 0 {
+		summary := &notifications.CycleSummary{
+			StartTime:          loopStartTime,
+			EndTime:            time.Now(),
+			DryRun:             d.rs.DryRun,
+			TotalEvicted:       d.podEvictor.TotalEvicted(),
+			EvictionRequests:   d.podEvictor.TotalEvictionRequests(),
+			FailedEvictions:    d.podEvictor.TotalFailed(),
+			SkippedEvictions:   d.podEvictor.TotalSkipped(),
+			EvictedByStrategy:  d.podEvictor.StrategyEvicted(),
+			EvictedByNamespace: d.podEvictor.NamespaceEvicted(),
+		}
+		for _, err := range errs {
+			summary.Errors = append(summary.Errors, err.Error())
+		}
+		notifications.NotifyAll(ctx, d.notifiers, summary)
+	}
+
+	// a configuration bug is not going away, abort instead of failing every cycle
+	for _, err := range errs {
+		if isFatalConfigError(err) {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// runProfiles runs all the deschedule plugins of all profiles and
-// later runs through all balance plugins of all profiles. (All Balance plugins should come after all Deschedule plugins)
+// updateNodeCooldowns counts down the cool-down of the nodes and starts
+// the cool-down of every node pods got evicted from in the current cycle
+func (d *descheduler) updateNodeCooldowns() {
+	if d.deschedulerPolicy.NodeCooldownCycles == nil || *d.deschedulerPolicy.NodeCooldownCycles == 0 {
+		return
+	}
+	for nodeName, cycles := range d.nodeCooldowns {
+		if cycles <= 1 {
+			delete(d.nodeCooldowns, nodeName)
+		} else {
+			d.nodeCooldowns[nodeName] = cycles - 1
+		}
+	}
+	for nodeName, evicted := range d.podEvictor.NodesEvicted() {
+		if evicted > 0 {
+			d.nodeCooldowns[nodeName] = *d.deschedulerPolicy.NodeCooldownCycles
+		}
+	}
+}
+
+// annotateNodeEvictions annotates every node pods got evicted from in the current cycle
+// with the timestamp and the number of the evictions.
+func (d *descheduler) annotateNodeEvictions(ctx context.Context) {
+	now := time.Now()
+	for nodeName, evicted := range d.podEvictor.NodesEvicted() {
+		if evicted == 0 {
+			continue
+		}
+		if err := nodeutil.AnnotateNodeEvictions(ctx, d.rs.Client, nodeName, evicted, now); err != nil {
+			klog.ErrorS(err, "unable to record the last evictions in the node annotations", "node", nodeName)
+		}
+	}
+}
+
+// advanceCycleSeed moves to the seed of the next cycle, derived from the seed of the
+// previous cycle, and logs it so the cycle can be reproduced in a simulation
+func (d *descheduler) advanceCycleSeed() {
+	d.cycleSeed = d.nextCycleSeed
+	d.nextCycleSeed = rand.New(rand.NewSource(d.cycleSeed)).Int63()
+	klog.InfoS("Descheduling cycle seed", "seed", d.cycleSeed)
+}
+
+// runProfiles runs all the deschedule plugins of the profiles and
+// later runs through all balance plugins of the profiles. (All Balance plugins should come after all Deschedule plugins)
 // see https://github.com/kubernetes-sigs/descheduler/issues/979
-func (d *descheduler) runProfiles(ctx context.Context, client clientset.Interface, nodes []*v1.Node) {
+// Errors of the profiles that failed to run are returned for reporting purposes.
+func (d *descheduler) runProfiles(ctx context.Context, client clientset.Interface, nodes []*v1.Node, profiles []api.DeschedulerProfile, suspendBalance bool) []error {
 	var span trace.Span
 	ctx, span = tracing.Tracer().Start(ctx, "runProfiles")
 	defer span.End()
+	var errs []error
 	var profileRunners []profileRunner
-	for _, profile := range d.deschedulerPolicy.Profiles {
+	for _, profile := range profiles {
+		profileClient := client
+		var evictionClient clientset.Interface
+		// The dry runs keep evicting through the cached client
+		if pc, ok := d.rs.ProfileClients[profile.Name]; ok && !d.rs.DryRun {
+			profileClient = pc
+			evictionClient = pc
+		}
+		metricsCollector, prometheusClient := d.profileMetrics(profile)
 		currProfile, err := frameworkprofile.NewProfile(
-			profile,
+			d.withoutPausedPlugins(profile),
 			pluginregistry.PluginRegistry,
-			frameworkprofile.WithClientSet(client),
+			frameworkprofile.WithClientSet(profileClient),
+			frameworkprofile.WithEvictionClient(evictionClient),
 			frameworkprofile.WithSharedInformerFactory(d.sharedInformerFactory),
 			frameworkprofile.WithPodEvictor(d.podEvictor),
 			frameworkprofile.WithGetPodsAssignedToNodeFnc(d.getPodsAssignedToNode),
-			frameworkprofile.WithMetricsCollector(d.metricsCollector),
-			frameworkprofile.WithPrometheusClient(d.prometheusClient),
+			frameworkprofile.WithMetricsCollector(metricsCollector),
+			frameworkprofile.WithPrometheusClient(prometheusClient),
+			frameworkprofile.WithRandomSeed(d.cycleSeed),
 		)
 		if err != nil {
 			klog.ErrorS(err, "unable to create a profile", "profile", profile.Name)
+			errs = append(errs, fmt.Errorf("profile %q: %v", profile.Name, err))
 			continue
 		}
+		currProfile.StartCycle(ctx)
 		profileRunners = append(profileRunners, profileRunner{profile.Name, currProfile.RunDeschedulePlugins, currProfile.RunBalancePlugins})
 	}
 
+	// the profiles throttled while descheduling back off until the next cycle
+	throttled := sets.New[string]()
 	for _, profileR := range profileRunners {
+		if d.podEvictor.CircuitBreakerOpen() {
+			span.AddEvent("remaining deschedule operations aborted, too many evictions failed")
+			break
+		}
 		// First deschedule
 		status := profileR.descheduleEPs(ctx, nodes)
 		if status != nil && status.Err != nil {
 			span.AddEvent("failed to perform deschedule operations", trace.WithAttributes(attribute.String("err", status.Err.Error()), attribute.String("profile", profileR.name), attribute.String("operation", tracing.DescheduleOperation)))
 			klog.ErrorS(status.Err, "running deschedule extension point failed with error", "profile", profileR.name)
+			errs = append(errs, fmt.Errorf("profile %q: %w", profileR.name, status.Err))
+			if isFatalConfigError(status.Err) {
+				return errs
+			}
+			var throttledErr *frameworktypes.ThrottledError
+			if errors.As(status.Err, &throttledErr) {
+				throttled.Insert(profileR.name)
+			}
 			continue
 		}
 	}
 
+	if suspendBalance {
+		span.AddEvent("balance plugins suspended during a zone outage")
+		return errs
+	}
 	for _, profileR := range profileRunners {
+		if d.podEvictor.CircuitBreakerOpen() {
+			span.AddEvent("remaining balance operations aborted, too many evictions failed")
+			break
+		}
 		// Balance Later
+		if throttled.Has(profileR.name) {
+			klog.V(1).InfoS("Skipping the balance extension point of the throttled profile", "profile", profileR.name)
+			continue
+		}
 		status := profileR.balanceEPs(ctx, nodes)
 		if status != nil && status.Err != nil {
 			span.AddEvent("failed to perform balance operations", trace.WithAttributes(attribute.String("err", status.Err.Error()), attribute.String("profile", profileR.name), attribute.String("operation", tracing.BalanceOperation)))
 			klog.ErrorS(status.Err, "running balance extension point failed with error", "profile", profileR.name)
+			errs = append(errs, fmt.Errorf("profile %q: %w", profileR.name, status.Err))
+			if isFatalConfigError(status.Err) {
+				return errs
+			}
 			continue
 		}
 	}
+
+	return errs
+}
+
+// isFatalConfigError checks whether a plugin reported a configuration it can never run with
+func isFatalConfigError(err error) bool {
+	var fatalConfigErr *frameworktypes.FatalConfigError
+	return errors.As(err, &fatalConfigErr)
 }
 
 func Run(ctx context.Context, rs *options.DeschedulerServer) error {
@@ -462,14 +779,55 @@ func Run(ctx context.Context, rs *options.DeschedulerServer) error {
 	if rs.KubeconfigFile != "" && clientConnection.Kubeconfig == "" {
 		clientConnection.Kubeconfig = rs.KubeconfigFile
 	}
-	rsclient, eventClient, err := createClients(clientConnection)
-	if err != nil {
-		return err
+	// The read-only mode simulates the evictions so the cycles report what they would do,
+	// the clients reject every mutating request in case anything slips past the dry run
+	if rs.ReadOnly && !rs.DryRun {
+		klog.V(1).InfoS("Read-only mode enabled, running in the dry run mode")
+		rs.DryRun = true
+	}
+	// The evictions are validated by the apiserver of the cluster, not by an object source
+	if rs.DryRunValidation {
+		if rs.ObjectSource != nil {
+			return fmt.Errorf("the dry run validation requires a cluster, the evictions can not be validated by an object source")
+		}
+		if rs.ReadOnly {
+			return fmt.Errorf("the dry run validation sends the evictions to the apiserver, the read-only mode rejects them")
+		}
+		if !rs.DryRun {
+			klog.V(1).InfoS("Dry run validation enabled, running in the dry run mode")
+			rs.DryRun = true
+		}
+	}
+	if rs.ObjectSource != nil {
+		rsclient, err := rs.ObjectSource.Client()
+		if err != nil {
+			return err
+		}
+		// The events are recorded to the source as well
+		rs.Client = rsclient
+		rs.EventClient = rsclient
+	} else {
+		rsclient, eventClient, err := createClients(clientConnection, rs.ReadOnly)
+		if err != nil {
+			return err
+		}
+		rs.Client = rsclient
+		rs.EventClient = eventClient
 	}
-	rs.Client = rsclient
-	rs.EventClient = eventClient
 
-	deschedulerPolicy, err := LoadPolicyConfig(rs.PolicyConfigFile, rs.Client, pluginregistry.PluginRegistry)
+	var policySource *urlPolicySource
+	var deschedulerPolicy *api.DeschedulerPolicy
+	var err error
+	if rs.PolicyURL != "" {
+		if policySource, err = newURLPolicySource(rs); err != nil {
+			return err
+		}
+		deschedulerPolicy, err = policySource.load(ctx, rs.Client, pluginregistry.PluginRegistry)
+	} else if rs.PolicySignatureKeyFile != "" {
+		deschedulerPolicy, err = loadSignedPolicyConfig(rs, rs.Client, pluginregistry.PluginRegistry)
+	} else {
+		deschedulerPolicy, err = LoadPolicyConfig(rs.PolicyConfigFile, rs.Client, pluginregistry.PluginRegistry)
+	}
 	if err != nil {
 		return err
 	}
@@ -487,17 +845,46 @@ func Run(ctx context.Context, rs *options.DeschedulerServer) error {
 		return err
 	}
 
-	if (deschedulerPolicy.MetricsCollector != nil && deschedulerPolicy.MetricsCollector.Enabled) || metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.KubernetesMetrics] != nil {
-		metricsClient, err := client.CreateMetricsClient(clientConnection, "descheduler")
-		if err != nil {
-			return err
-		}
-		rs.MetricsClient = metricsClient
+	// The object sources serve no authorization API
+	if rs.ObjectSource == nil {
+		checkPermissions(ctx, rs, deschedulerPolicy)
+	}
+
+	if err := setupMetricsClient(rs, deschedulerPolicy); err != nil {
+		return err
+	}
+
+	if err := setupProfileClients(rs, clientConnection, deschedulerPolicy); err != nil {
+		return err
+	}
+
+	if err := setupDynamicClient(rs, clientConnection, deschedulerPolicy); err != nil {
+		return err
 	}
 
 	runFn := func() error {
 		return RunDeschedulerStrategies(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion)
 	}
+	if policySource != nil && rs.PolicyURLPollInterval > 0 {
+		runFn = func() error {
+			return runWithPolicyUpdates(ctx, policySource, rs.PolicyURLPollInterval, rs.Client, deschedulerPolicy, func(ctx context.Context, policy *api.DeschedulerPolicy) error {
+				if policy != deschedulerPolicy {
+					// The clients of the updated policy are set up anew, the profiles may have changed
+					rs.ProfileClients = nil
+					if err := setupMetricsClient(rs, policy); err != nil {
+						return err
+					}
+					if err := setupProfileClients(rs, clientConnection, policy); err != nil {
+						return err
+					}
+					if err := setupDynamicClient(rs, clientConnection, policy); err != nil {
+						return err
+					}
+				}
+				return RunDeschedulerStrategies(ctx, rs, policy, evictionPolicyGroupVersion)
+			})
+		}
+	}
 
 	if rs.LeaderElection.LeaderElect && rs.DeschedulingInterval.Seconds() == 0 {
 		span.AddEvent("Validation Failure", trace.WithAttributes(attribute.String("err", "leaderElection must be used with deschedulingInterval")))
@@ -509,7 +896,7 @@ func Run(ctx context.Context, rs *options.DeschedulerServer) error {
 	}
 
 	if rs.LeaderElection.LeaderElect && !rs.DryRun {
-		if err := NewLeaderElection(runFn, rsclient, &rs.LeaderElection, ctx); err != nil {
+		if err := NewLeaderElection(runFn, rs.Client, &rs.LeaderElection, ctx); err != nil {
 			span.AddEvent("Leader Election Failure", trace.WithAttributes(attribute.String("err", err.Error())))
 			return fmt.Errorf("leaderElection: %w", err)
 		}
@@ -696,13 +1083,104 @@ func GetPluginConfig(pluginName string, pluginConfigs []api.PluginConfig) (*api.
 	return nil, 0
 }
 
-func createClients(clientConnection componentbaseconfig.ClientConnectionConfiguration) (clientset.Interface, clientset.Interface, error) {
-	kClient, err := client.CreateClient(clientConnection, "descheduler")
+// objectSource returns the source the clients of the server are created from
+func objectSource(rs *options.DeschedulerServer) source.ObjectSource {
+	if rs.ObjectSource != nil {
+		return rs.ObjectSource
+	}
+	clientConnection := rs.ClientConnection
+	if rs.KubeconfigFile != "" && clientConnection.Kubeconfig == "" {
+		clientConnection.Kubeconfig = rs.KubeconfigFile
+	}
+	return source.NewClusterSource(clientConnection)
+}
+
+// setupClient creates the client of the server from the object source unless already set
+func setupClient(rs *options.DeschedulerServer) error {
+	if rs.Client != nil {
+		return nil
+	}
+	rsclient, err := objectSource(rs).Client()
+	if err != nil {
+		return err
+	}
+	rs.Client = rsclient
+	return nil
+}
+
+// setupMetricsClient creates the metrics client of the server from the object source
+// unless already set or not needed by the policy
+func setupMetricsClient(rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy) error {
+	if rs.MetricsClient != nil {
+		return nil
+	}
+	if (deschedulerPolicy.MetricsCollector == nil || !deschedulerPolicy.MetricsCollector.Enabled) && metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.KubernetesMetrics] == nil {
+		return nil
+	}
+	metricsClient, err := objectSource(rs).MetricsClient()
+	if err != nil {
+		return err
+	}
+	rs.MetricsClient = metricsClient
+	return nil
+}
+
+// setupProfileClients creates the clients of the profiles configuring their own client identity unless already set.
+// The dry runs and the object sources evict no pods, the profiles use the client of the server then.
+func setupProfileClients(rs *options.DeschedulerServer, clientConnection componentbaseconfig.ClientConnectionConfiguration, deschedulerPolicy *api.DeschedulerPolicy) error {
+	if rs.ProfileClients != nil {
+		return nil
+	}
+	profileClients := make(map[string]clientset.Interface)
+	for _, profile := range deschedulerPolicy.Profiles {
+		if profile.ClientIdentity == nil {
+			continue
+		}
+		if rs.DryRun || rs.ObjectSource != nil {
+			klog.V(1).InfoS("Ignoring the client identity of the profile, no pods are evicted", "profile", profile.Name)
+			continue
+		}
+		profileClient, err := client.CreateClientWithTokenFile(clientConnection, "descheduler/"+profile.Name, profile.ClientIdentity.TokenFile)
+		if err != nil {
+			return fmt.Errorf("unable to create the client of profile %q: %v", profile.Name, err)
+		}
+		profileClients[profile.Name] = profileClient
+	}
+	rs.ProfileClients = profileClients
+	return nil
+}
+
+// setupDynamicClient creates the dynamic client of the server unless already set or not needed by the policy.
+// The object sources serve no custom resources, no pods are exempted nor evacuated then.
+func setupDynamicClient(rs *options.DeschedulerServer, clientConnection componentbaseconfig.ClientConnectionConfiguration, deschedulerPolicy *api.DeschedulerPolicy) error {
+	exemptionsEnabled := deschedulerPolicy.DeschedulingExemptions != nil && deschedulerPolicy.DeschedulingExemptions.Enabled
+	if rs.DynamicClient != nil || (!exemptionsEnabled && deschedulerPolicy.EvictionBackend != api.EvacuationAPIBackend) {
+		return nil
+	}
+	if rs.ObjectSource != nil {
+		klog.V(1).InfoS("Ignoring the descheduling exemptions and evacuations, the object source serves no custom resources")
+		return nil
+	}
+	dynamicClient, err := client.CreateDynamicClient(clientConnection, "descheduler")
+	if err != nil {
+		return err
+	}
+	rs.DynamicClient = dynamicClient
+	return nil
+}
+
+func createClients(clientConnection componentbaseconfig.ClientConnectionConfiguration, readOnly bool) (clientset.Interface, clientset.Interface, error) {
+	createClient := client.CreateClient
+	if readOnly {
+		createClient = client.CreateReadOnlyClient
+	}
+
+	kClient, err := createClient(clientConnection, "descheduler")
 	if err != nil {
 		return nil, nil, err
 	}
 
-	eventClient, err := client.CreateClient(clientConnection, "")
+	eventClient, err := createClient(clientConnection, "")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -710,6 +1188,113 @@ func createClients(clientConnection componentbaseconfig.ClientConnectionConfigur
 	return kClient, eventClient, nil
 }
 
+// scopedPodInformer returns the pod informer of the factory listing and watching only the pods
+// matching the pod watch selectors. It has to be called before the pod informer of the factory
+// is requested anywhere else, the factory keeps serving the first pod informer registered.
+func scopedPodInformer(sharedInformerFactory informers.SharedInformerFactory, podWatch *api.PodWatch) cache.SharedIndexInformer {
+	if podWatch == nil || (podWatch.LabelSelector == "" && podWatch.FieldSelector == "") {
+		return sharedInformerFactory.Core().V1().Pods().Informer()
+	}
+	return sharedInformerFactory.InformerFor(&v1.Pod{}, func(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return coreinformers.NewFilteredPodInformer(client, metav1.NamespaceAll, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(options *metav1.ListOptions) {
+			options.LabelSelector = podWatch.LabelSelector
+			options.FieldSelector = podWatch.FieldSelector
+		})
+	})
+}
+
+// scopedNodeInformer returns the node informer of the factory listing and watching only the nodes
+// matching the policy node selector. Like scopedPodInformer, it has to be called before the node
+// informer of the factory is requested anywhere else.
+func scopedNodeInformer(sharedInformerFactory informers.SharedInformerFactory, nodeSelector *string) cache.SharedIndexInformer {
+	if nodeSelector == nil || *nodeSelector == "" {
+		return sharedInformerFactory.Core().V1().Nodes().Informer()
+	}
+	selector := *nodeSelector
+	return sharedInformerFactory.InformerFor(&v1.Node{}, func(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return coreinformers.NewFilteredNodeInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(options *metav1.ListOptions) {
+			options.LabelSelector = selector
+		})
+	})
+}
+
+// setFairShares shares the total eviction limit across the namespaces or the owners of the pods on the nodes,
+// weighted by the eviction weight annotation of the namespaces
+func (d *descheduler) setFairShares(nodes []*v1.Node) {
+	var pods []*v1.Pod
+	for _, node := range nodes {
+		nodePods, err := d.getPodsAssignedToNode(node.Name, nil)
+		if err != nil {
+			klog.ErrorS(err, "Unable to list the pods of the node", "node", node.Name)
+			continue
+		}
+		pods = append(pods, nodePods...)
+	}
+
+	namespaces, err := d.sharedInformerFactory.Core().V1().Namespaces().Lister().List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the namespaces, all the namespaces weigh 1")
+	}
+	namespaceWeights := make(map[string]uint)
+	for _, namespace := range namespaces {
+		value, ok := namespace.Annotations[evictions.EvictionWeightAnnotationKey]
+		if !ok {
+			continue
+		}
+		weight, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			klog.ErrorS(err, "Invalid eviction weight, the namespace weighs 1", "namespace", namespace.Name, "annotation", evictions.EvictionWeightAnnotationKey)
+			continue
+		}
+		namespaceWeights[namespace.Name] = uint(weight)
+	}
+
+	d.podEvictor.SetFairShares(pods, namespaceWeights)
+}
+
+// namespaceEvictionIntervals reads the least intervals between the evictions of the namespaces from the
+// min eviction interval annotation of the namespaces, capped by the max interval of the policy
+func (d *descheduler) namespaceEvictionIntervals() map[string]time.Duration {
+	namespaces, err := d.sharedInformerFactory.Core().V1().Namespaces().Lister().List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the namespaces, no namespace eviction intervals enforced")
+	}
+	intervals := make(map[string]time.Duration)
+	for _, namespace := range namespaces {
+		value, ok := namespace.Annotations[evictions.MinEvictionIntervalAnnotationKey]
+		if !ok {
+			continue
+		}
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval < 0 {
+			klog.ErrorS(err, "Invalid min eviction interval, ignoring it", "namespace", namespace.Name, "annotation", evictions.MinEvictionIntervalAnnotationKey, "value", value)
+			continue
+		}
+		if maxInterval := d.deschedulerPolicy.NamespaceEvictionIntervals.MaxInterval; maxInterval != nil && interval > maxInterval.Duration {
+			interval = maxInterval.Duration
+		}
+		intervals[namespace.Name] = interval
+	}
+	return intervals
+}
+
+// recordScopedNodes exposes the number of the nodes matching the policy node selector by their readiness
+func (d *descheduler) recordScopedNodes() {
+	nodes, err := d.sharedInformerFactory.Core().V1().Nodes().Lister().List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the scoped nodes")
+		return
+	}
+	ready := 0
+	for _, node := range nodes {
+		if nodeutil.IsReady(node) {
+			ready++
+		}
+	}
+	metrics.ScopedNodes.WithLabelValues("true").Set(float64(ready))
+	metrics.ScopedNodes.WithLabelValues("false").Set(float64(len(nodes) - ready))
+}
+
 func trimManagedFields(obj interface{}) (interface{}, error) {
 	if accessor, err := meta.Accessor(obj); err == nil {
 		accessor.SetManagedFields(nil)
@@ -1,26 +1,41 @@
 package descheduler
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	apiversion "k8s.io/apimachinery/pkg/version"
 	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
 	"k8s.io/component-base/featuregate"
+	"k8s.io/component-base/metrics/testutil"
 	"k8s.io/klog/v2"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
@@ -28,13 +43,17 @@ import (
 	utilptr "k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/metrics"
 	"sigs.k8s.io/descheduler/pkg/api"
+	exemptionsv1alpha1 "sigs.k8s.io/descheduler/pkg/api/exemptions/v1alpha1"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/descheduler/source"
 	"sigs.k8s.io/descheduler/pkg/features"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsfromnotreadynodes"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodetaints"
 	"sigs.k8s.io/descheduler/pkg/utils"
 	deschedulerversion "sigs.k8s.io/descheduler/pkg/version"
@@ -59,6 +78,7 @@ func initFeatureGates() featuregate.FeatureGate {
 	featureGates := featuregate.NewFeatureGate()
 	featureGates.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		features.EvictionsInBackground: {Default: false, PreRelease: featuregate.Alpha},
+		features.SimulatedNodes:        {Default: false, PreRelease: featuregate.Alpha},
 	})
 	return featureGates
 }
@@ -310,6 +330,344 @@ func TestDuplicate(t *testing.T) {
 	}
 }
 
+func TestForecast(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "replicaset-1",
+			Namespace: "dev",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Deployment", APIVersion: "apps/v1", Name: "web", Controller: utilptr.To(true)},
+			},
+		},
+	}
+
+	var objects []runtime.Object
+	for _, name := range []string{"p1", "p2", "p3"} {
+		pod := test.BuildTestPod(name, 100, 0, node1.Name, nil)
+		pod.Namespace = "dev"
+		pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		objects = append(objects, pod)
+	}
+	objects = append(objects, node1, node2, replicaSet)
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.Client = client
+	rs.DefaultFeatureGates = initFeatureGates()
+
+	exposures, err := forecast(ctx, rs, removeDuplicatesPolicy(), "v1")
+	if err != nil {
+		t.Fatalf("Unable to forecast disruption exposure: %v", err)
+	}
+
+	expected := []WorkloadExposure{
+		{
+			Namespace: "dev",
+			Kind:      "Deployment",
+			Name:      "web",
+			Plugins:   []PluginExposure{{Profile: "Profile", Plugin: removeduplicates.PluginName, Pods: 1}},
+		},
+	}
+	if diff := cmp.Diff(expected, exposures); diff != "" {
+		t.Errorf("Unexpected exposures (-want +got):\n%s", diff)
+	}
+	if len(evictedPods) != 0 {
+		t.Errorf("Expected no pod to be evicted, got %v", evictedPods)
+	}
+}
+
+func TestForecastIgnoresEvictionBudgets(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	var objects []runtime.Object
+	for _, name := range []string{"p1", "p2", "p3", "p4"} {
+		pod := test.BuildTestPod(name, 400, 0, node1.Name, nil)
+		pod.Namespace = "dev"
+		pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		objects = append(objects, pod)
+	}
+	objects = append(objects, node1, node2)
+
+	withBudgets := func(policy *api.DeschedulerPolicy) *api.DeschedulerPolicy {
+		policy.MaxNoOfPodsToEvictPerNode = utilptr.To[uint](0)
+		policy.MaxNoOfPodsToEvictPerNamespace = utilptr.To[uint](0)
+		policy.MaxNoOfPodsToEvictTotal = utilptr.To[uint](0)
+		policy.MaxNoOfPodsToEvictPerOwner = utilptr.To[uint](0)
+		policy.MaxNoOfPodsToEvictPerTopologyDomain = utilptr.To[uint](0)
+		policy.TopologyDomainKey = "topology.kubernetes.io/zone"
+		policy.MaxEvictionFailuresPerCycle = utilptr.To(intstr.FromInt32(0))
+		policy.EvictionSpreading = &api.EvictionSpreading{TopologyKey: "topology.kubernetes.io/zone"}
+		policy.EvictionFairness = &api.EvictionFairness{By: api.FairnessByNamespace}
+		policy.NamespaceDisruptionQuotas = []api.NamespaceDisruptionQuota{{Namespaces: []string{"dev"}, MaxEvictions: 0, Period: metav1.Duration{Duration: time.Hour}}}
+		policy.EvictionRateLimits = &api.EvictionRateLimits{
+			PerNode:      &api.EvictionRateLimit{EvictionsPerMinute: 1, Burst: utilptr.To[uint](0)},
+			PerNamespace: &api.EvictionRateLimit{EvictionsPerMinute: 1, Burst: utilptr.To[uint](0)},
+		}
+		policy.NamespaceEvictionIntervals = &api.NamespaceEvictionIntervals{Enabled: true}
+		policy.TerminationPacing = &api.TerminationPacing{MaxTerminatingPodsPerNode: utilptr.To[uint](0), MaxTerminatingPods: utilptr.To[uint](0)}
+		policy.NodeCooldownCycles = utilptr.To[uint](1)
+		for i := range policy.Profiles {
+			for j := range policy.Profiles[i].PluginConfigs {
+				pluginConfig := &policy.Profiles[i].PluginConfigs[j]
+				pluginConfig.MaxPodsToEvictPerNode = utilptr.To[uint](0)
+				pluginConfig.MaxPodsToEvictPerCycle = utilptr.To[uint](0)
+				if args, ok := pluginConfig.Args.(*nodeutilization.LowNodeUtilizationArgs); ok {
+					args.EvictionLimits = &api.EvictionLimits{Node: utilptr.To[uint](0)}
+				}
+			}
+		}
+		return policy
+	}
+
+	tests := []struct {
+		description string
+		policy      func() *api.DeschedulerPolicy
+	}{
+		{
+			description: "policy limits",
+			policy:      removeDuplicatesPolicy,
+		},
+		{
+			description: "plugin limits",
+			policy: func() *api.DeschedulerPolicy {
+				policy := lowNodeUtilizationPolicy(api.ResourceThresholds{v1.ResourceCPU: 30, v1.ResourcePods: 30}, api.ResourceThresholds{v1.ResourceCPU: 50, v1.ResourcePods: 50}, false)
+				policy.Profiles[0].PluginConfigs[0].Args.(*nodeutilization.LowNodeUtilizationArgs).MetricsUtilization = nil
+				return policy
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			forecastPolicy := func(policy *api.DeschedulerPolicy) []WorkloadExposure {
+				rs, err := options.NewDeschedulerServer()
+				if err != nil {
+					t.Fatalf("Unable to initialize server: %v", err)
+				}
+				rs.Client = fakeclientset.NewSimpleClientset(objects...)
+				rs.DefaultFeatureGates = initFeatureGates()
+				exposures, err := forecast(ctx, rs, policy, "v1")
+				if err != nil {
+					t.Fatalf("Unable to forecast disruption exposure: %v", err)
+				}
+				return exposures
+			}
+
+			expected := forecastPolicy(tc.policy())
+			if len(expected) == 0 {
+				t.Fatalf("Expected some disruption exposure without the eviction budgets")
+			}
+			if diff := cmp.Diff(expected, forecastPolicy(withBudgets(tc.policy()))); diff != "" {
+				t.Errorf("Unexpected exposures with the eviction budgets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffSimulations(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	node3 := test.BuildTestNode("n3", 2000, 3000, 10, taintNodeNoSchedule)
+
+	var objects []runtime.Object
+	for _, name := range []string{"p1", "p2", "p3"} {
+		pod := test.BuildTestPod(name, 100, 0, node1.Name, test.SetRSOwnerRef)
+		objects = append(objects, pod)
+	}
+	objects = append(objects, test.BuildTestPod("p4", 100, 0, node3.Name, test.SetNormalOwnerRef))
+	objects = append(objects, node1, node2, node3)
+
+	client := fakeclientset.NewSimpleClientset(objects...)
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.Client = client
+	rs.DefaultFeatureGates = initFeatureGates()
+
+	diff, err := diffSimulations(ctx, rs, removeDuplicatesPolicy(), removePodsViolatingNodeTaintsPolicy(), "v1")
+	if err != nil {
+		t.Fatalf("Unable to diff the simulations: %v", err)
+	}
+
+	if diff.BaseEvicted != 1 || diff.ComparedEvicted != 1 || diff.Unchanged != 0 {
+		t.Errorf("Unexpected number of evictions, got %v base, %v compared, %v unchanged", diff.BaseEvicted, diff.ComparedEvicted, diff.Unchanged)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Node != node1.Name || diff.Removed[0].Plugin != removeduplicates.PluginName {
+		t.Errorf("Expected a duplicate pod from n1 to be evicted only under the base policy, got %v", diff.Removed)
+	}
+	expectedAdded := []SimulatedEviction{
+		{Namespace: "default", Pod: "p4", Node: node3.Name, Profile: "Profile", Plugin: removepodsviolatingnodetaints.PluginName},
+	}
+	if d := cmp.Diff(expectedAdded, diff.Added); d != "" {
+		t.Errorf("Unexpected added evictions (-want +got):\n%s", d)
+	}
+	if len(evictedPods) != 0 {
+		t.Errorf("Expected no pod to be evicted, got %v", evictedPods)
+	}
+	if rs.Client != client {
+		t.Errorf("Expected the live client to be restored")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+
+	var objects []runtime.Object
+	for _, name := range []string{"p1", "p2", "p3"} {
+		objects = append(objects, test.BuildTestPod(name, 100, 0, node1.Name, test.SetRSOwnerRef))
+	}
+	objects = append(objects, node1, node2)
+
+	metricsClientset := fakemetricsclient.NewSimpleClientset()
+	metricsClientset.Tracker().Create(nodesgvr, test.BuildNodeMetrics("n1", 1200, 0), "")
+	metricsClientset.Tracker().Create(podsgvr, test.BuildPodMetrics("p1", 400, 0), "default")
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.Client = fakeclientset.NewSimpleClientset(objects...)
+	rs.MetricsClient = metricsClientset
+
+	var buf bytes.Buffer
+	if err := ExportSnapshot(ctx, rs, &buf, true); err != nil {
+		t.Fatalf("Unable to export the snapshot: %v", err)
+	}
+	snapshotFile := filepath.Join(t.TempDir(), "snapshot.yaml")
+	if err := os.WriteFile(snapshotFile, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("Unable to write snapshot file: %v", err)
+	}
+
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	policy := `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: Profile
+    pluginConfig:
+    - name: "RemoveDuplicates"
+    plugins:
+      balance:
+        enabled:
+          - "RemoveDuplicates"
+`
+	if err := os.WriteFile(policyFile, []byte(policy), 0o600); err != nil {
+		t.Fatalf("Unable to write policy file: %v", err)
+	}
+
+	loaded, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	loaded.PolicyConfigFile = policyFile
+	loaded.DefaultFeatureGates = initFeatureGates()
+	loaded.ObjectSource, err = source.NewFileSource(snapshotFile)
+	if err != nil {
+		t.Fatalf("Unable to load the snapshot: %v", err)
+	}
+	if err := setupClient(loaded); err != nil {
+		t.Fatalf("Unable to create the client from the snapshot: %v", err)
+	}
+	loaded.MetricsClient, err = loaded.ObjectSource.MetricsClient()
+	if err != nil {
+		t.Fatalf("Unable to create the metrics client from the snapshot: %v", err)
+	}
+
+	pods, err := loaded.Client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil || len(pods.Items) != 3 {
+		t.Errorf("Expected 3 pods in the snapshot, got %v (%v)", len(pods.Items), err)
+	}
+	nodeMetrics, err := loaded.MetricsClient.MetricsV1beta1().NodeMetricses().Get(ctx, "n1", metav1.GetOptions{})
+	if err != nil || nodeMetrics.Usage.Cpu().MilliValue() != 1200 {
+		t.Errorf("Expected node metrics of n1 in the snapshot, got %v (%v)", nodeMetrics, err)
+	}
+	if _, err := loaded.MetricsClient.MetricsV1beta1().PodMetricses("default").Get(ctx, "p1", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected pod metrics of p1 in the snapshot: %v", err)
+	}
+
+	exposures, err := Forecast(ctx, loaded)
+	if err != nil {
+		t.Fatalf("Unable to forecast disruption exposure from the snapshot: %v", err)
+	}
+	if len(exposures) != 1 || len(exposures[0].Plugins) != 1 || exposures[0].Plugins[0].Pods != 1 {
+		t.Errorf("Expected a single pod exposed to RemoveDuplicates, got %v", exposures)
+	}
+}
+
+func TestBench(t *testing.T) {
+	initPluginRegistry()
+
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	policy := `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: Profile
+    pluginConfig:
+    - name: "RemoveDuplicates"
+    plugins:
+      balance:
+        enabled:
+          - "RemoveDuplicates"
+`
+	if err := os.WriteFile(policyFile, []byte(policy), 0o600); err != nil {
+		t.Fatalf("Unable to write policy file: %v", err)
+	}
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.PolicyConfigFile = policyFile
+	rs.DefaultFeatureGates = initFeatureGates()
+
+	opts := NewBenchOptions()
+	opts.Nodes = 4
+	opts.Pods = 20
+	opts.HotNodes = 1
+	opts.Cycles = 2
+
+	result, err := Bench(context.Background(), rs, opts)
+	if err != nil {
+		t.Fatalf("Unable to run the bench: %v", err)
+	}
+	if len(result.Cycles) != opts.Cycles {
+		t.Fatalf("Expected %v cycles, got %v", opts.Cycles, len(result.Cycles))
+	}
+	for idx, cycle := range result.Cycles {
+		// The evicted pods are kept so every cycle evicts the same duplicates from the hot node
+		if cycle.Evicted != 4 {
+			t.Errorf("Expected 4 evictions in cycle %v, got %v", idx, cycle.Evicted)
+		}
+		if cycle.APICalls["create pods/eviction"] != 4 {
+			t.Errorf("Expected 4 eviction API calls in cycle %v, got %v", idx, cycle.APICalls)
+		}
+	}
+}
+
 func TestRootCancel(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
@@ -594,6 +952,7 @@ func TestEvictionRequestsCache(t *testing.T) {
 	featureGates := featuregate.NewFeatureGate()
 	featureGates.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		features.EvictionsInBackground: {Default: true, PreRelease: featuregate.Alpha},
+		features.SimulatedNodes:        {Default: false, PreRelease: featuregate.Alpha},
 	})
 	_, descheduler, client := initDescheduler(t, ctxCancel, featureGates, internalDeschedulerPolicy, nil, node1, node2, p1, p2, p3, p4)
 	defer cancel()
@@ -736,6 +1095,7 @@ func TestDeschedulingLimits(t *testing.T) {
 			featureGates := featuregate.NewFeatureGate()
 			featureGates.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 				features.EvictionsInBackground: {Default: true, PreRelease: featuregate.Alpha},
+				features.SimulatedNodes:        {Default: false, PreRelease: featuregate.Alpha},
 			})
 			_, descheduler, client := initDescheduler(t, ctxCancel, featureGates, tc.policy, nil, node1, node2)
 			defer cancel()
@@ -867,3 +1227,765 @@ func TestLoadAwareDescheduling(t *testing.T) {
 	}
 	t.Logf("Total evictions: %v", totalEs)
 }
+
+func TestPodWatch(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	p1 := test.BuildTestPod("p1", 100, 0, n1.Name, func(pod *v1.Pod) {
+		pod.Labels = map[string]string{"app": "web"}
+	})
+	p2 := test.BuildTestPod("p2", 100, 0, n1.Name, nil)
+
+	client := fakeclientset.NewSimpleClientset(n1, p1, p2)
+	var podListRestrictions []core.ListRestrictions
+	client.PrependReactor("list", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		podListRestrictions = append(podListRestrictions, action.(core.ListAction).GetListRestrictions())
+		return false, nil, nil
+	})
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.Client = client
+	rs.EventClient = client
+	rs.DefaultFeatureGates = initFeatureGates()
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.PodWatch = &api.PodWatch{LabelSelector: "app=web", FieldSelector: "metadata.namespace!=kube-system"}
+
+	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields))
+	eventBroadcaster, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, client)
+	defer eventBroadcaster.Shutdown()
+
+	descheduler, err := newDescheduler(ctx, rs, deschedulerPolicy, "v1", eventRecorder, sharedInformerFactory, nil)
+	if err != nil {
+		t.Fatalf("Unable to create a descheduler instance: %v", err)
+	}
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	if len(podListRestrictions) != 1 {
+		t.Fatalf("Expected a single pod list, got %d", len(podListRestrictions))
+	}
+	if labels := podListRestrictions[0].Labels.String(); labels != "app=web" {
+		t.Errorf("Expected the pods to be listed with the app=web label selector, got %q", labels)
+	}
+	if fields := podListRestrictions[0].Fields.String(); fields != "metadata.namespace!=kube-system" {
+		t.Errorf("Expected the pods to be listed with the metadata.namespace!=kube-system field selector, got %q", fields)
+	}
+
+	pods, err := descheduler.getPodsAssignedToNode(n1.Name, nil)
+	if err != nil {
+		t.Fatalf("Unable to list the pods of %s: %v", n1.Name, err)
+	}
+	if len(pods) != 1 || pods[0].Name != p1.Name {
+		t.Errorf("Expected only %s to be watched, got %v", p1.Name, pods)
+	}
+}
+
+func TestNodeSelectorScope(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+
+	ctx := context.Background()
+	pool := func(name string) func(node *v1.Node) {
+		return func(node *v1.Node) {
+			node.Labels = map[string]string{"pool": name}
+		}
+	}
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, pool("a"))
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, pool("a"))
+	n2.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}}
+	n3 := test.BuildTestNode("n3", 2000, 3000, 10, pool("b"))
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.NodeSelector = utilptr.To("pool=a")
+	_, descheduler, _ := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, n1, n2, n3)
+
+	nodes, err := descheduler.sharedInformerFactory.Core().V1().Nodes().Lister().List(labels.Everything())
+	if err != nil {
+		t.Fatalf("Unable to list the nodes: %v", err)
+	}
+	if len(nodes) != 2 {
+		t.Errorf("Expected only the nodes of pool a to be watched, got %d nodes", len(nodes))
+	}
+
+	descheduler.recordScopedNodes()
+	for ready, expected := range map[string]float64{"true": 1, "false": 1} {
+		value, err := testutil.GetGaugeMetricValue(metrics.ScopedNodes.WithLabelValues(ready))
+		if err != nil {
+			t.Fatalf("Unable to read the scoped nodes metric: %v", err)
+		}
+		if value != expected {
+			t.Errorf("Expected %v scoped nodes with ready=%s, got %v", expected, ready, value)
+		}
+	}
+}
+
+func TestNotReadyNodesScope(t *testing.T) {
+	initPluginRegistry()
+	pluginregistry.Register(removepodsfromnotreadynodes.PluginName, removepodsfromnotreadynodes.New, &removepodsfromnotreadynodes.RemovePodsFromNotReadyNodes{}, &removepodsfromnotreadynodes.RemovePodsFromNotReadyNodesArgs{}, removepodsfromnotreadynodes.ValidateRemovePodsFromNotReadyNodesArgs, removepodsfromnotreadynodes.SetDefaults_RemovePodsFromNotReadyNodesArgs, pluginregistry.PluginRegistry)
+
+	ctx := context.Background()
+	inPool := func(name string, ready bool) func(node *v1.Node) {
+		return func(node *v1.Node) {
+			node.Labels = map[string]string{"pool": name}
+			if !ready {
+				node.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionUnknown, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))}}
+			}
+		}
+	}
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, inPool("a", true))
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, inPool("a", true))
+	n3 := test.BuildTestNode("n3", 2000, 3000, 10, inPool("a", false))
+	n4 := test.BuildTestNode("n4", 2000, 3000, 10, inPool("b", false))
+	p3 := test.BuildTestPod("p3", 100, 0, n3.Name, test.SetRSOwnerRef)
+	p4 := test.BuildTestPod("p4", 100, 0, n4.Name, test.SetRSOwnerRef)
+
+	args := &removepodsfromnotreadynodes.RemovePodsFromNotReadyNodesArgs{}
+	removepodsfromnotreadynodes.SetDefaults_RemovePodsFromNotReadyNodesArgs(args)
+	deschedulerPolicy := &api.DeschedulerPolicy{
+		NodeSelector: utilptr.To("pool=a"),
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name: "Profile",
+				PluginConfigs: []api.PluginConfig{
+					{Name: removepodsfromnotreadynodes.PluginName, Args: args},
+					{Name: defaultevictor.PluginName, Args: &defaultevictor.DefaultEvictorArgs{}},
+				},
+				Plugins: api.Plugins{
+					Filter:     api.PluginSet{Enabled: []string{defaultevictor.PluginName}},
+					Deschedule: api.PluginSet{Enabled: []string{removepodsfromnotreadynodes.PluginName}},
+				},
+			},
+		},
+	}
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, n1, n2, n3, n4, p3, p4)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, []*v1.Node{n1, n2}); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	// The NotReady node outside of the policy node selector neither loses its pods nor counts as NotReady
+	if len(evictedPods) != 1 || evictedPods[0] != p3.Name {
+		t.Errorf("Expected only %s evicted, got %v", p3.Name, evictedPods)
+	}
+}
+
+func TestEvictionFairnessWeights(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	weighted := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "weighted", Annotations: map[string]string{evictions.EvictionWeightAnnotationKey: "3"}}}
+	invalid := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "invalid", Annotations: map[string]string{evictions.EvictionWeightAnnotationKey: "many"}}}
+	var objects []runtime.Object
+	objects = append(objects, node, weighted, invalid)
+	for _, namespace := range []string{"weighted", "invalid"} {
+		for i := 0; i < 4; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%s-%d", namespace, i), 100, 0, node.Name, func(pod *v1.Pod) {
+				pod.Namespace = namespace
+			}))
+		}
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.MaxNoOfPodsToEvictTotal = utilptr.To[uint](4)
+	deschedulerPolicy.EvictionFairness = &api.EvictionFairness{}
+	_, descheduler, _ := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	descheduler.setFairShares([]*v1.Node{node})
+
+	// weighted gets 3 of the 4 evictions, invalid falls back to the weight 1 and gets 1
+	for namespace, expected := range map[string]int{"weighted": 3, "invalid": 1} {
+		evicted := 0
+		for i := 0; i < 4; i++ {
+			pod, err := descheduler.rs.Client.CoreV1().Pods(namespace).Get(ctx, fmt.Sprintf("%s-%d", namespace, i), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unable to get the pod: %v", err)
+			}
+			if err := descheduler.podEvictor.EvictPod(ctx, pod, evictions.EvictOptions{}); err == nil {
+				evicted++
+			}
+		}
+		if evicted != expected {
+			t.Errorf("Expected %d evictions in namespace %s, got %d", expected, namespace, evicted)
+		}
+	}
+}
+
+func TestNamespaceEvictionIntervals(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	var objects []runtime.Object
+	objects = append(objects, node)
+	for namespace, interval := range map[string]string{"stable": "24h", "capped": "72h", "invalid": "soon", "default": ""} {
+		annotations := map[string]string{}
+		if interval != "" {
+			annotations[evictions.MinEvictionIntervalAnnotationKey] = interval
+		}
+		objects = append(objects, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Annotations: annotations}})
+		for i := 0; i < 3; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%s-%d", namespace, i), 100, 0, node.Name, func(pod *v1.Pod) {
+				pod.Namespace = namespace
+			}))
+		}
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.NamespaceEvictionIntervals = &api.NamespaceEvictionIntervals{Enabled: true, MaxInterval: &metav1.Duration{Duration: 48 * time.Hour}}
+	_, descheduler, _ := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	intervals := descheduler.namespaceEvictionIntervals()
+	expected := map[string]time.Duration{"stable": 24 * time.Hour, "capped": 48 * time.Hour}
+	if diff := cmp.Diff(expected, intervals); diff != "" {
+		t.Fatalf("Unexpected namespace eviction intervals (-want +got):\n%s", diff)
+	}
+	descheduler.podEvictor.SetNamespaceIntervals(intervals)
+
+	evict := func(namespace string, i int) error {
+		pod, err := descheduler.rs.Client.CoreV1().Pods(namespace).Get(ctx, fmt.Sprintf("%s-%d", namespace, i), metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unable to get the pod: %v", err)
+		}
+		return descheduler.podEvictor.EvictPod(ctx, pod, evictions.EvictOptions{})
+	}
+
+	// All the evictions of the first cycle evicting from a namespace are allowed
+	for _, namespace := range []string{"stable", "default"} {
+		for i := 0; i < 2; i++ {
+			if err := evict(namespace, i); err != nil {
+				t.Fatalf("Unexpected error when evicting %s-%d: %v", namespace, i, err)
+			}
+		}
+	}
+
+	// The next cycles evict no pods from the namespaces with an interval until it elapses
+	descheduler.podEvictor.ResetCounters()
+	var intervalError *evictions.EvictionNamespaceIntervalError
+	if err := evict("stable", 2); !errors.As(err, &intervalError) {
+		t.Errorf("Expected the eviction of stable-2 to fail on the namespace interval, got %v", err)
+	}
+	if err := evict("default", 2); err != nil {
+		t.Errorf("Unexpected error when evicting default-2: %v", err)
+	}
+}
+
+func TestConcurrentDrains(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	cordoned := test.BuildTestNode("n1", 2000, 3000, 10, func(node *v1.Node) {
+		node.Spec.Unschedulable = true
+	})
+	leased := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	node3 := test.BuildTestNode("n3", 2000, 3000, 10, nil)
+	node4 := test.BuildTestNode("n4", 2000, 3000, 10, nil)
+	held := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: leased.Name, Namespace: "drains"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       utilptr.To("upgrade-controller"),
+			LeaseDurationSeconds: utilptr.To[int32](600),
+			RenewTime:            &metav1.MicroTime{Time: time.Now()},
+		},
+	}
+	expired := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: node3.Name, Namespace: "drains"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       utilptr.To("upgrade-controller"),
+			LeaseDurationSeconds: utilptr.To[int32](60),
+			RenewTime:            &metav1.MicroTime{Time: time.Now().Add(-time.Hour)},
+		},
+	}
+
+	objects := []runtime.Object{cordoned, leased, node3, node4, held, expired}
+	for _, node := range []*v1.Node{cordoned, leased, node3} {
+		for i := 0; i < 3; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%s-p%d", node.Name, i), 100, 0, node.Name, func(pod *v1.Pod) {
+				pod.Namespace = "dev"
+				pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+			}))
+		}
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.ConcurrentDrains = &api.ConcurrentDrains{
+		Cordoned:       true,
+		LeaseNamespace: "drains",
+	}
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	drained := drainedNodes(ctx, client, []*v1.Node{cordoned, leased, node3, node4}, deschedulerPolicy.ConcurrentDrains, nil, time.Now())
+	if !drained.Equal(sets.New(cordoned.Name, leased.Name)) {
+		t.Fatalf("Expected nodes n1 and n2 drained, got %v", sets.List(drained))
+	}
+
+	if err := descheduler.runDeschedulerLoop(ctx, []*v1.Node{cordoned, leased, node3, node4}); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) == 0 {
+		t.Fatalf("Expected pods of n3 evicted, got none")
+	}
+	for _, pod := range evictedPods {
+		if !strings.HasPrefix(pod, node3.Name+"-") {
+			t.Errorf("Expected no pods evicted from the drained nodes, got %v", pod)
+		}
+	}
+
+	// Pods of the drained nodes are not evicted even when a plugin picks them
+	pod, err := client.CoreV1().Pods("dev").Get(ctx, "n1-p0", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unable to get the pod: %v", err)
+	}
+	err = descheduler.podEvictor.EvictPod(ctx, pod, evictions.EvictOptions{})
+	var drainedErr *evictions.EvictionNodeDrainedError
+	if !errors.As(err, &drainedErr) {
+		t.Errorf("Expected the eviction from the drained node to fail, got: %v", err)
+	}
+}
+
+func TestProfileClientIdentity(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	p1 := test.BuildTestPod("p1", 100, 0, node1.Name, test.SetRSOwnerRef)
+	p2 := test.BuildTestPod("p2", 100, 0, node1.Name, test.SetRSOwnerRef)
+
+	deschedulerPolicy := removePodsViolatingNodeTaintsPolicy()
+	deschedulerPolicy.Profiles[0].ClientIdentity = &api.ClientIdentity{TokenFile: "/var/run/secrets/tokens/team-a"}
+	rs, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, node1, node2, p1, p2)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+	profileClient := fakeclientset.NewSimpleClientset(node1, node2, p1, p2)
+	var profileEvictedPods []string
+	profileClient.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&profileEvictedPods, nil, nil))
+	rs.ProfileClients = map[string]clientset.Interface{"Profile": profileClient}
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) != 0 || len(profileEvictedPods) != 2 {
+		t.Fatalf("Expected (0,2) pods evicted with the (descheduler,profile) clients, got (%v, %v) instead", len(evictedPods), len(profileEvictedPods))
+	}
+
+	// The dry runs evict through the cached client
+	rs.DryRun = true
+	var fakeEvictedPods []string
+	descheduler.podEvictionReactionFnc = func(*fakeclientset.Clientset) func(action core.Action) (bool, runtime.Object, error) {
+		return podEvictionReactionTestingFnc(&fakeEvictedPods, nil, nil)
+	}
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(profileEvictedPods) != 2 || len(fakeEvictedPods) != 2 {
+		t.Fatalf("Expected (2,2) pods evicted with the (profile,cached) clients, got (%v, %v) instead", len(profileEvictedPods), len(fakeEvictedPods))
+	}
+}
+
+func TestDeschedulingExemptions(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	now := time.Now()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, taintNodeNoSchedule)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	var pods []runtime.Object
+	for _, app := range []string{"exempted", "expired", "too-long"} {
+		pods = append(pods, test.BuildTestPod(app, 100, 0, node1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			pod.Labels = map[string]string{"app": app}
+		}))
+	}
+	exemption := func(app string, created, expiry time.Time) *exemptionsv1alpha1.DeschedulingExemption {
+		return &exemptionsv1alpha1.DeschedulingExemption{
+			TypeMeta:   metav1.TypeMeta{APIVersion: exemptionsv1alpha1.SchemeGroupVersion.String(), Kind: "DeschedulingExemption"},
+			ObjectMeta: metav1.ObjectMeta{Name: app, Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+			Spec: exemptionsv1alpha1.DeschedulingExemptionSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+				Expiry:   metav1.NewTime(expiry),
+			},
+		}
+	}
+	scheme := runtime.NewScheme()
+	if err := exemptionsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Unable to add the exemptions to the scheme: %v", err)
+	}
+	dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{exemptionsv1alpha1.DeschedulingExemptionsResource: "DeschedulingExemptionList"},
+		exemption("exempted", now.Add(-time.Hour), now.Add(time.Hour)),
+		exemption("expired", now.Add(-2*time.Hour), now.Add(-time.Hour)),
+		exemption("too-long", now, now.Add(48*time.Hour)),
+	)
+
+	deschedulerPolicy := removePodsViolatingNodeTaintsPolicy()
+	deschedulerPolicy.DeschedulingExemptions = &api.DeschedulingExemptions{Enabled: true, MaxDuration: &metav1.Duration{Duration: 24 * time.Hour}}
+	rs, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, append(pods, node1, node2)...)
+	rs.DynamicClient = dynamicClient
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	// No pods are evicted while the exemptions can not be listed
+	dynamicClient.PrependReactor("list", "deschedulingexemptions", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("exemptions unavailable")
+	})
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) != 0 {
+		t.Fatalf("Expected no pods evicted while the exemptions are unavailable, got %v", evictedPods)
+	}
+
+	dynamicClient.ReactionChain = dynamicClient.ReactionChain[1:]
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	sort.Strings(evictedPods)
+	if !reflect.DeepEqual(evictedPods, []string{"expired", "too-long"}) {
+		t.Errorf("Expected the pods of the expired and too long exemptions evicted, got %v", evictedPods)
+	}
+	var exemptedErr *evictions.EvictionExemptedError
+	if err := descheduler.podEvictor.EvictPod(ctx, pods[0].(*v1.Pod), evictions.EvictOptions{}); !errors.As(err, &exemptedErr) {
+		t.Errorf("Expected the eviction of the exempted pod to be refused, got: %v", err)
+	}
+}
+
+func TestZoneOutageBrake(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+
+	ctx := context.Background()
+	zone := func(name string) func(node *v1.Node) {
+		return func(node *v1.Node) {
+			node.Labels = map[string]string{v1.LabelTopologyZone: name}
+		}
+	}
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, zone("a"))
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, zone("a"))
+	n3 := test.BuildTestNode("n3", 2000, 3000, 10, zone("b"))
+	n4 := test.BuildTestNode("n4", 2000, 3000, 10, zone("b"))
+	n4.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}}
+	readyNodes := []*v1.Node{n1, n2, n3}
+
+	objects := []runtime.Object{n1, n2, n3, n4}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, n1.Name, func(pod *v1.Pod) {
+			pod.Namespace = "dev"
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		}))
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.ZoneOutageBrake = &api.ZoneOutageBrake{MaxUnavailablePercentage: 40}
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	// Half of the nodes of zone b are NotReady
+	if err := descheduler.runDeschedulerLoop(ctx, readyNodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) != 0 {
+		t.Fatalf("Expected no pods evicted during the zone outage, got %v", evictedPods)
+	}
+	if value, err := testutil.GetGaugeMetricValue(metrics.ZoneOutage.WithLabelValues("b")); err != nil || value != 1 {
+		t.Errorf("Expected zone b reported in an outage, got %v (%v)", value, err)
+	}
+
+	deschedulerPolicy.ZoneOutageBrake.MaxUnavailablePercentage = 50
+	if err := descheduler.runDeschedulerLoop(ctx, readyNodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) == 0 {
+		t.Errorf("Expected the duplicates evicted with no zone in an outage")
+	}
+}
+
+func TestMinClusterHeadroom(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+
+	ctx := context.Background()
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 2000, 3000, 10, func(node *v1.Node) {
+		node.Spec.Unschedulable = true
+	})
+	nodes := []*v1.Node{n1, n2, n3}
+
+	objects := []runtime.Object{n1, n2, n3}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, n1.Name, func(pod *v1.Pod) {
+			pod.Namespace = "dev"
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		}))
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.MinClusterHeadroom = api.ResourceThresholds{v1.ResourceCPU: 93}
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	// 300m of the 4000m cpu of the schedulable nodes are requested, the cordoned n3 is not counted
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) != 0 {
+		t.Fatalf("Expected no pods evicted below the minimum headroom, got %v", evictedPods)
+	}
+	if value, err := testutil.GetGaugeMetricValue(metrics.ClusterHeadroom.WithLabelValues(string(v1.ResourceCPU))); err != nil || value != 92.5 {
+		t.Errorf("Expected 92.5%% cpu headroom reported, got %v (%v)", value, err)
+	}
+
+	deschedulerPolicy.MinClusterHeadroom[v1.ResourceCPU] = 92
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) == 0 {
+		t.Errorf("Expected the duplicates evicted above the minimum headroom")
+	}
+}
+
+func TestConvergenceDetection(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+
+	ctx := context.Background()
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	objects := []runtime.Object{n1, n2}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, n1.Name, func(pod *v1.Pod) {
+			pod.Namespace = "dev"
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		}))
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.ConvergenceDetection = &api.ConvergenceDetection{
+		Cycles:      utilptr.To[uint](2),
+		PauseCycles: utilptr.To[uint](1),
+	}
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	// The evicted pods are never deleted, the duplicates are evicted again every cycle
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	profile := deschedulerPolicy.Profiles[0].Name
+	evictedPerCycle := make([]int, 0, 4)
+	for cycle := 0; cycle < 4; cycle++ {
+		evictedBefore := len(evictedPods)
+		if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+			t.Fatalf("Unable to run a descheduling loop: %v", err)
+		}
+		evictedPerCycle = append(evictedPerCycle, len(evictedPods)-evictedBefore)
+
+		value, err := testutil.GetGaugeMetricValue(metrics.NonConvergingPlugins.WithLabelValues(profile, removeduplicates.PluginName))
+		if err != nil {
+			t.Fatalf("Unable to read the non converging plugins metric: %v", err)
+		}
+		if expected := map[int]float64{1: 1}[cycle]; value != expected {
+			t.Errorf("Expected the non converging plugins metric %v after the cycle %d, got %v", expected, cycle, value)
+		}
+	}
+
+	// The plugin is reported after the second similar cycle and paused for the third one
+	if evictedPerCycle[0] == 0 || evictedPerCycle[1] != evictedPerCycle[0] || evictedPerCycle[2] != 0 || evictedPerCycle[3] != evictedPerCycle[0] {
+		t.Errorf("Unexpected evictions per cycle: %v", evictedPerCycle)
+	}
+}
+
+func TestSkipExplanations(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+
+	ctx := context.Background()
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	objects := []runtime.Object{n1, n2}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, n1.Name, func(pod *v1.Pod) {
+			pod.Namespace = "explained"
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+			pod.Spec.Volumes = []v1.Volume{{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+		}))
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.SkipExplanations = &api.SkipExplanations{}
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) != 0 {
+		t.Fatalf("Expected the duplicates with local storage skipped, got %v evicted", evictedPods)
+	}
+	value, err := testutil.GetCounterMetricValue(metrics.PodsSkipped.WithLabelValues("explained", "ReplicaSet", "replicaset-1", "pod has local storage and descheduler is not configured with evictLocalStoragePods"))
+	if err != nil {
+		t.Fatalf("Unable to read the skipped pods metric: %v", err)
+	}
+	if value == 0 {
+		t.Errorf("Expected the skips of the duplicates with local storage explained")
+	}
+}
+
+func TestAdvanceCycleSeed(t *testing.T) {
+	seeds := func(d *descheduler) []int64 {
+		var cycleSeeds []int64
+		for range 3 {
+			d.advanceCycleSeed()
+			cycleSeeds = append(cycleSeeds, d.cycleSeed)
+		}
+		return cycleSeeds
+	}
+
+	first := seeds(&descheduler{nextCycleSeed: 42})
+	if first[0] != 42 {
+		t.Errorf("Expected the first cycle seeded with the random seed of the policy, got %d", first[0])
+	}
+	if first[1] == first[0] || first[2] == first[1] {
+		t.Errorf("Expected every cycle seeded differently, got %v", first)
+	}
+	// A simulation seeded with the logged seed of the second cycle reproduces it
+	replayed := seeds(&descheduler{nextCycleSeed: first[1]})
+	if !reflect.DeepEqual(replayed[:2], first[1:]) {
+		t.Errorf("Expected the seeds %v reproduced, got %v", first[1:], replayed[:2])
+	}
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	initPluginRegistry()
+
+	ctx := context.Background()
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{n1, n2}
+
+	objects := []runtime.Object{n1, n2}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		}))
+	}
+
+	// February 30 never comes
+	closed := &api.Schedule{Windows: []api.MaintenanceWindow{{Cron: "0 0 30 2 *", Duration: metav1.Duration{Duration: time.Hour}}}}
+	open := &api.Schedule{Windows: []api.MaintenanceWindow{{Cron: "* * * * *", Duration: metav1.Duration{Duration: time.Minute}}}}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	deschedulerPolicy.Schedule = closed
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionTestingFnc(&evictedPods, nil, nil))
+
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) != 0 {
+		t.Fatalf("Expected no pods evicted outside of the maintenance windows, got %v", evictedPods)
+	}
+
+	// The schedule of the profile overrides the schedule of the policy
+	deschedulerPolicy.Profiles[0].Schedule = open
+	if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+		t.Fatalf("Unable to run a descheduling loop: %v", err)
+	}
+	if len(evictedPods) == 0 {
+		t.Errorf("Expected the duplicates evicted in the maintenance window of the profile")
+	}
+}
+
+func TestEvictionCircuitBreakerBackoff(t *testing.T) {
+	initPluginRegistry()
+	metrics.Register()
+
+	ctx := context.Background()
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	nodes := []*v1.Node{node1, node2}
+
+	objects := []runtime.Object{node1, node2}
+	for i := 0; i < 3; i++ {
+		objects = append(objects, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, node1.Name, func(pod *v1.Pod) {
+			pod.Namespace = "dev"
+			pod.ObjectMeta.OwnerReferences = test.GetReplicaSetOwnerRefList()
+		}))
+	}
+
+	deschedulerPolicy := removeDuplicatesPolicy()
+	maxFailures := intstr.FromInt32(0)
+	deschedulerPolicy.MaxEvictionFailuresPerCycle = &maxFailures
+	_, descheduler, client := initDescheduler(t, ctx, initFeatureGates(), deschedulerPolicy, nil, objects...)
+
+	var evictedPods []string
+	var evictionErr error = apierrors.NewServiceUnavailable("the API server is unavailable")
+	var evictionRequests int
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" {
+			evictionRequests++
+		}
+		return podEvictionReactionTestingFnc(&evictedPods, nil, evictionErr)(action)
+	})
+
+	// The cycles run, skipped once, run, skipped twice and run again
+	steps := []struct {
+		requests int
+		evicting bool
+	}{
+		{requests: 1},
+		{requests: 0},
+		{requests: 1},
+		{requests: 0},
+		{requests: 0},
+		{requests: 1, evicting: true},
+		{requests: 1, evicting: true},
+	}
+	for i, step := range steps {
+		if step.evicting {
+			evictionErr = nil
+		}
+		evictionRequests = 0
+		if err := descheduler.runDeschedulerLoop(ctx, nodes); err != nil {
+			t.Fatalf("Unable to run descheduling loop %d: %v", i, err)
+		}
+		if evictionRequests != step.requests {
+			t.Errorf("Expected %d eviction requests in descheduling loop %d, got %d", step.requests, i, evictionRequests)
+		}
+	}
+	if len(evictedPods) != 2 {
+		t.Errorf("Expected a duplicate evicted in both cycles once the API server is available, got %v", evictedPods)
+	}
+	if descheduler.abortedCycles != 0 || descheduler.backoffCycles != 0 {
+		t.Errorf("Expected no backoff once the evictions succeed, got %d aborted and %d skipped cycles", descheduler.abortedCycles, descheduler.backoffCycles)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+)
+
+// drainedNodes returns the names of the nodes showing any of the signals of the concurrent drains.
+// The leases held by the descheduler itself do not count when the node leases are configured.
+// The nodes with a held lease are not detected when the leases can not be listed.
+func drainedNodes(ctx context.Context, client clientset.Interface, nodes []*v1.Node, drains *api.ConcurrentDrains, nodeLeases *api.NodeLeases, now time.Time) sets.Set[string] {
+	drained := sets.New[string]()
+	heldLeases := sets.New[string]()
+	if drains.LeaseNamespace != "" {
+		leases, err := client.CoordinationV1().Leases(drains.LeaseNamespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Unable to list the drain leases", "namespace", drains.LeaseNamespace)
+		} else {
+			for i := range leases.Items {
+				holder := evictions.LeaseHolder(&leases.Items[i], now)
+				if holder == "" || (nodeLeases != nil && holder == evictions.NodeLeaseHolderIdentity(nodeLeases)) {
+					continue
+				}
+				heldLeases.Insert(leases.Items[i].Name)
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		if heldLeases.Has(node.Name) || nodeDrained(node, drains) {
+			drained.Insert(node.Name)
+		}
+	}
+	if drained.Len() > 0 {
+		klog.V(1).InfoS("Skipping the nodes drained concurrently", "nodes", sets.List(drained))
+	}
+	return drained
+}
+
+// nodeDrained checks whether the node is cordoned, annotated or tainted as drained
+func nodeDrained(node *v1.Node, drains *api.ConcurrentDrains) bool {
+	if drains.Cordoned && node.Spec.Unschedulable {
+		return true
+	}
+	for _, annotation := range drains.NodeAnnotations {
+		key, value, hasValue := strings.Cut(annotation, "=")
+		if nodeValue, ok := node.Annotations[key]; ok && (!hasValue || nodeValue == value) {
+			return true
+		}
+	}
+	for _, taint := range node.Spec.Taints {
+		for _, key := range drains.NodeTaints {
+			if taint.Key == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withoutNodes returns the nodes not in the excluded set
+func withoutNodes(nodes []*v1.Node, excluded sets.Set[string]) []*v1.Node {
+	if excluded.Len() == 0 {
+		return nodes
+	}
+	filtered := make([]*v1.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if !excluded.Has(node.Name) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
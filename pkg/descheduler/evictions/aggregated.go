@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// workloadEvictions accumulates the evictions of the pods of an owner in a descheduling cycle
+type workloadEvictions struct {
+	namespace  string
+	owner      metav1.OwnerReference
+	pods       uint
+	evicted    uint
+	strategies map[string]uint
+}
+
+// ownerKey returns the key the evictions of the pods of the owner are aggregated under
+func ownerKey(namespace string, owner *metav1.OwnerReference) string {
+	return namespace + "/" + owner.Kind + "/" + owner.Name
+}
+
+// ownedPods gives a number of pods of the owner in the namespace known to the pod informer.
+// Returns 0 when the pods can not be listed.
+func ownedPods(indexer cache.Indexer, namespace string, owner *metav1.OwnerReference) uint {
+	if indexer == nil {
+		return 0
+	}
+	objs, err := indexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the pods of the owner", "namespace", namespace, "owner", owner.Name)
+		return 0
+	}
+	var pods uint
+	for _, obj := range objs {
+		pod, ok := obj.(*v1.Pod)
+		if !ok {
+			continue
+		}
+		if podOwner := podOwner(pod); podOwner != nil && podOwner.Kind == owner.Kind && podOwner.Name == owner.Name {
+			pods++
+		}
+	}
+	return pods
+}
+
+// aggregateEviction accumulates the eviction of the pod under its owner.
+// Returns false for pods without an owner which are reported on their own.
+// No locking, expected to be invoked from protected methods only.
+func (pe *PodEvictor) aggregateEviction(pod *v1.Pod, opts EvictOptions) bool {
+	owner := podOwner(pod)
+	if owner == nil {
+		return false
+	}
+	key := ownerKey(pod.Namespace, owner)
+	workload, ok := pe.workloadEvictions[key]
+	if !ok {
+		workload = &workloadEvictions{
+			namespace:  pod.Namespace,
+			owner:      *owner,
+			pods:       ownedPods(pe.podIndexer, pod.Namespace, owner),
+			strategies: make(map[string]uint),
+		}
+		pe.workloadEvictions[key] = workload
+	}
+	workload.evicted++
+	workload.strategies[opts.StrategyName]++
+	return true
+}
+
+// EmitAggregatedEvents emits one event per owner the pods of got evicted since the last call,
+// e.g. "descheduled 4/20 pods of ReplicaSet web-5d4f for LowNodeUtilization". No-op unless
+// the aggregated eviction events are enabled.
+func (pe *PodEvictor) EmitAggregatedEvents() {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	keys := make([]string, 0, len(pe.workloadEvictions))
+	for key := range pe.workloadEvictions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		workload := pe.workloadEvictions[key]
+		regarding := &v1.ObjectReference{
+			APIVersion: workload.owner.APIVersion,
+			Kind:       workload.owner.Kind,
+			Namespace:  workload.namespace,
+			Name:       workload.owner.Name,
+			UID:        workload.owner.UID,
+		}
+		// The evicted pods may be gone from the informer when counted
+		pods := max(workload.pods, workload.evicted)
+		pe.eventRecorder.Eventf(regarding, nil, v1.EventTypeNormal, "Descheduled", "Descheduled", "descheduled %d/%d pods of %s %s for %s", workload.evicted, pods, workload.owner.Kind, workload.owner.Name, strategyList(workload.strategies))
+	}
+	pe.workloadEvictions = make(map[string]*workloadEvictions)
+}
+
+// strategyList lists the strategies by the number of evictions, e.g. "LowNodeUtilization (3), RemoveDuplicates (1)"
+func strategyList(strategies map[string]uint) string {
+	names := make([]string, 0, len(strategies))
+	for name := range strategies {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if strategies[names[i]] != strategies[names[j]] {
+			return strategies[names[i]] > strategies[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if len(names) == 1 {
+		return strategyName(names[0])
+	}
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		items = append(items, fmt.Sprintf("%s (%d)", strategyName(name), strategies[name]))
+	}
+	return strings.Join(items, ", ")
+}
+
+func strategyName(name string) string {
+	if len(name) == 0 {
+		return "NotSet"
+	}
+	return name
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestAggregatedEvents(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+
+	pods := []*v1.Pod{
+		test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("web-2", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("web-3", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("web-4", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("api-1", 100, 0, "n1", ownedBy("api")),
+		test.BuildTestPod("bare", 100, 0, "n1", nil),
+	}
+	var objs []runtime.Object
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	eventRecorder := events.NewFakeRecorder(100)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		eventRecorder,
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithAggregatedEvents(utilptr.To(true)),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	for _, eviction := range []struct {
+		pod      *v1.Pod
+		strategy string
+	}{
+		{pods[0], "LowNodeUtilization"},
+		{pods[1], "RemoveDuplicates"},
+		{pods[2], "LowNodeUtilization"},
+		{pods[4], "RemoveDuplicates"},
+		{pods[5], "RemoveDuplicates"},
+	} {
+		if err := podEvictor.EvictPod(ctx, eviction.pod, EvictOptions{StrategyName: eviction.strategy}); err != nil {
+			t.Fatalf("Unexpected error when evicting %v: %v", eviction.pod.Name, err)
+		}
+	}
+
+	// Pods without an owner are reported on their own right away
+	assertEqualEvents(t, []string{"Normal RemoveDuplicates pod eviction from n1 node by sigs.k8s.io/descheduler"}, eventRecorder.Events)
+
+	podEvictor.EmitAggregatedEvents()
+	assertEqualEvents(t, []string{
+		"Normal Descheduled descheduled 1/1 pods of ReplicaSet api for RemoveDuplicates",
+		"Normal Descheduled descheduled 3/4 pods of ReplicaSet web for LowNodeUtilization (2), RemoveDuplicates (1)",
+	}, eventRecorder.Events)
+
+	// The aggregated evictions are emitted once
+	podEvictor.EmitAggregatedEvents()
+	assertEqualEvents(t, nil, eventRecorder.Events)
+}
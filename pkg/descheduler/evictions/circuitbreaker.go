@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// circuitBreaker counts the eviction requests of the descheduling cycle failing, e.g. while the API server is
+// unavailable, and opens once the failures exceed the max failures, a count or a percentage of the requests.
+// No pods are evicted while open. A nil circuitBreaker never opens. Guarded by the mutex of the pod evictor.
+type circuitBreaker struct {
+	maxFailures *intstr.IntOrString
+	requests    int
+	failures    int
+	open        bool
+}
+
+func newCircuitBreaker(maxFailures *intstr.IntOrString) *circuitBreaker {
+	if maxFailures == nil {
+		return nil
+	}
+	return &circuitBreaker{maxFailures: maxFailures}
+}
+
+// isOpen checks whether the circuit breaker stops the evictions
+func (b *circuitBreaker) isOpen() bool {
+	return b != nil && b.open
+}
+
+// record counts the eviction request and returns true when its failure opens the circuit breaker
+func (b *circuitBreaker) record(failed bool) bool {
+	if b == nil || b.open {
+		return false
+	}
+	b.requests++
+	if !failed {
+		return false
+	}
+	b.failures++
+	// the failures allowed by a percentage round up, the first failed request alone opens the circuit breaker at 0% only
+	maxFailures, err := intstr.GetScaledValueFromIntOrPercent(b.maxFailures, b.requests, true)
+	if err != nil || b.failures <= maxFailures {
+		return false
+	}
+	b.open = true
+	return true
+}
+
+// reset closes the circuit breaker for the next descheduling cycle
+func (b *circuitBreaker) reset() {
+	if b == nil {
+		return
+	}
+	b.requests = 0
+	b.failures = 0
+	b.open = false
+}
+
+// CircuitBreakerOpen checks whether too many evictions failed in the descheduling cycle,
+// the remaining plugins of the cycle are not run then
+func (pe *PodEvictor) CircuitBreakerOpen() bool {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return pe.circuitBreaker.isOpen()
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		description string
+		maxFailures intstr.IntOrString
+		requests    []bool
+		open        bool
+	}{
+		{
+			description: "failures within the count",
+			maxFailures: intstr.FromInt32(2),
+			requests:    []bool{true, false, true},
+		},
+		{
+			description: "failures exceeding the count",
+			maxFailures: intstr.FromInt32(2),
+			requests:    []bool{true, true, true},
+			open:        true,
+		},
+		{
+			description: "any failure with no failures allowed",
+			maxFailures: intstr.FromInt32(0),
+			requests:    []bool{false, true},
+			open:        true,
+		},
+		{
+			description: "first failure within the percentage",
+			maxFailures: intstr.FromString("10%"),
+			requests:    []bool{true},
+		},
+		{
+			description: "failures within the percentage",
+			maxFailures: intstr.FromString("50%"),
+			requests:    []bool{false, true, false, true},
+		},
+		{
+			description: "failures exceeding the percentage",
+			maxFailures: intstr.FromString("50%"),
+			requests:    []bool{false, true, true, true},
+			open:        true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			breaker := newCircuitBreaker(&tc.maxFailures)
+			for _, failed := range tc.requests {
+				breaker.record(failed)
+			}
+			if breaker.isOpen() != tc.open {
+				t.Errorf("Expected the circuit breaker open to be %v, got %v", tc.open, breaker.isOpen())
+			}
+			breaker.reset()
+			if breaker.isOpen() {
+				t.Errorf("Expected the circuit breaker closed after a reset")
+			}
+		})
+	}
+
+	var none *circuitBreaker
+	if none.record(true) || none.isOpen() {
+		t.Errorf("Expected no circuit breaker to never open")
+	}
+}
+
+func TestEvictPodCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	var pods []runtime.Object
+	for _, name := range []string{"p1", "p2", "p3"} {
+		pods = append(pods, test.BuildTestPod(name, 100, 0, "n1", nil))
+	}
+
+	fakeClient := fake.NewSimpleClientset(pods...)
+	var evictionRequests int
+	fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		evictionRequests++
+		return true, nil, apierrors.NewServiceUnavailable("the API server is unavailable")
+	})
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	maxFailures := intstr.FromInt32(1)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithMaxEvictionFailuresPerCycle(&maxFailures),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	evict := func(i int) error {
+		return podEvictor.EvictPod(ctx, pods[i].(*v1.Pod), EvictOptions{StrategyName: "RemoveFailedPods"})
+	}
+	for i := 0; i < 2; i++ {
+		if err := evict(i); err == nil {
+			t.Fatalf("Expected the eviction of %v to fail", pods[i].(*v1.Pod).Name)
+		}
+	}
+	if !podEvictor.CircuitBreakerOpen() {
+		t.Fatalf("Expected the circuit breaker open after 2 failed evictions")
+	}
+	var circuitBreakerErr *EvictionCircuitBreakerError
+	if err := evict(2); !errors.As(err, &circuitBreakerErr) {
+		t.Errorf("Expected the eviction of p3 to be refused by the circuit breaker, got %v", err)
+	}
+	if evictionRequests != 2 {
+		t.Errorf("Expected no eviction requested while the circuit breaker is open, got %v requests", evictionRequests)
+	}
+
+	podEvictor.ResetCounters()
+	if podEvictor.CircuitBreakerOpen() {
+		t.Errorf("Expected the circuit breaker closed in the next cycle")
+	}
+	if err := evict(2); errors.As(err, &circuitBreakerErr) {
+		t.Errorf("Expected the eviction of p3 to be requested in the next cycle, got %v", err)
+	}
+}
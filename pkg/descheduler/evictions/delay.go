@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// PreEvictionDelayAnnotationKey delays the eviction of the pod once all the checks pass, e.g. "30s",
+	// for the external load balancers to deregister the pod before it is terminated
+	PreEvictionDelayAnnotationKey = "descheduler.alpha.kubernetes.io/pre-eviction-delay"
+	// MaxPreEvictionDelay caps the delay of the annotation
+	MaxPreEvictionDelay = 5 * time.Minute
+	// MaxPreEvictionDelayPerCycle caps the delays of all the evictions of a descheduling cycle, the plugins evicting
+	// their pods one after another would otherwise stretch the cycle by the delay of every pod
+	MaxPreEvictionDelayPerCycle = 15 * time.Minute
+)
+
+// preEvictionDelay returns the delay from the annotation of the pod, capped at MaxPreEvictionDelay.
+// The pods without the annotation or with an invalid one are not delayed.
+func preEvictionDelay(pod *v1.Pod) time.Duration {
+	value, ok := pod.Annotations[PreEvictionDelayAnnotationKey]
+	if !ok {
+		return 0
+	}
+	delay, err := time.ParseDuration(value)
+	if err != nil || delay < 0 {
+		klog.ErrorS(err, "Invalid pre-eviction delay, the eviction is not delayed", "pod", klog.KObj(pod), "annotation", PreEvictionDelayAnnotationKey, "value", value)
+		return 0
+	}
+	return min(delay, MaxPreEvictionDelay)
+}
+
+// reservePreEvictionDelay counts the delay of the pod towards the delays of the cycle,
+// the pod is not evicted once the delays of the cycle would exceed MaxPreEvictionDelayPerCycle.
+// Guarded by the mutex of the pod evictor.
+func (pe *PodEvictor) reservePreEvictionDelay(ctx context.Context, span trace.Span, pod *v1.Pod, opts EvictOptions, delay time.Duration) error {
+	if delay == 0 {
+		return nil
+	}
+	if pe.preEvictionDelayed+delay > MaxPreEvictionDelayPerCycle {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionPreEvictionDelayLimitError(), fmt.Sprintf("pre-eviction delay per cycle exceeded (%v)", MaxPreEvictionDelayPerCycle))
+	}
+	pe.preEvictionDelayed += delay
+	return nil
+}
+
+// waitPreEvictionDelay waits the pre-eviction delay of the pod, failing when the context is done first
+func waitPreEvictionDelay(ctx context.Context, pod *v1.Pod, delay time.Duration) error {
+	if delay == 0 {
+		return nil
+	}
+	klog.V(3).InfoS("Delaying the eviction of the pod", "pod", klog.KObj(pod), "delay", delay)
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("pre-eviction delay interrupted: %v", ctx.Err())
+	case <-time.After(delay):
+		return nil
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestPreEvictionDelay(t *testing.T) {
+	testCases := []struct {
+		description string
+		annotation  string
+		expected    time.Duration
+	}{
+		{
+			description: "no annotation",
+			expected:    0,
+		},
+		{
+			description: "delay",
+			annotation:  "30s",
+			expected:    30 * time.Second,
+		},
+		{
+			description: "delay capped",
+			annotation:  "1h",
+			expected:    MaxPreEvictionDelay,
+		},
+		{
+			description: "invalid delay",
+			annotation:  "soon",
+			expected:    0,
+		},
+		{
+			description: "negative delay",
+			annotation:  "-5s",
+			expected:    0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			pod := test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) {
+				if tc.annotation != "" {
+					pod.Annotations = map[string]string{PreEvictionDelayAnnotationKey: tc.annotation}
+				}
+			})
+			if delay := preEvictionDelay(pod); delay != tc.expected {
+				t.Errorf("Expected the pre-eviction delay %v, got %v", tc.expected, delay)
+			}
+		})
+	}
+}
+
+func TestEvictPodPreEvictionDelay(t *testing.T) {
+	delay := 50 * time.Millisecond
+	delayed := test.BuildTestPod("delayed", 100, 0, "n1", func(pod *v1.Pod) {
+		pod.Annotations = map[string]string{PreEvictionDelayAnnotationKey: delay.String()}
+	})
+	interrupted := test.BuildTestPod("interrupted", 100, 0, "n1", func(pod *v1.Pod) {
+		pod.Annotations = map[string]string{PreEvictionDelayAnnotationKey: "1m"}
+	})
+	fakeClient := fake.NewSimpleClientset(delayed, interrupted)
+
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	podEvictor, err := NewPodEvictor(context.Background(), fakeClient, events.NewFakeRecorder(100), podInformer, initFeatureGates(), NewOptions())
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	start := time.Now()
+	if err := podEvictor.EvictPod(context.Background(), delayed, EvictOptions{}); err != nil {
+		t.Fatalf("Unexpected error when evicting the pod: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Errorf("Expected the eviction to be delayed by %v, evicted after %v", delay, elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := podEvictor.EvictPod(ctx, interrupted, EvictOptions{}); err == nil {
+		t.Errorf("Expected the eviction to fail once the context is done during the delay")
+	}
+	if podEvictor.TotalEvicted() != 1 {
+		t.Errorf("Expected 1 pod evicted, got %d", podEvictor.TotalEvicted())
+	}
+}
+
+func TestEvictPodPreEvictionDelayPerCycle(t *testing.T) {
+	var pods []*v1.Pod
+	for i := 0; i < 4; i++ {
+		pods = append(pods, test.BuildTestPod(fmt.Sprintf("p%d", i), 100, 0, "n1", func(pod *v1.Pod) {
+			pod.Annotations = map[string]string{PreEvictionDelayAnnotationKey: MaxPreEvictionDelay.String()}
+		}))
+	}
+	fakeClient := fake.NewSimpleClientset(pods[0], pods[1], pods[2], pods[3])
+
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	podEvictor, err := NewPodEvictor(context.Background(), fakeClient, events.NewFakeRecorder(100), podInformer, initFeatureGates(), NewOptions())
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	// The delays are interrupted right away yet they count towards the delays of the cycle
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i, pod := range pods {
+		err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+		_, limited := err.(*EvictionPreEvictionDelayLimitError)
+		if expected := i == 3; limited != expected {
+			t.Errorf("Expected the pre-eviction delay per cycle to be reached for %v to be %v, got %v", pod.Name, expected, err)
+		}
+	}
+
+	podEvictor.ResetCounters()
+	if _, limited := podEvictor.EvictPod(ctx, pods[3], EvictOptions{}).(*EvictionPreEvictionDelayLimitError); limited {
+		t.Errorf("Expected the pre-eviction delays to be reset with the counters")
+	}
+}
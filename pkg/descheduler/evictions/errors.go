@@ -1,5 +1,7 @@
 package evictions
 
+import "fmt"
+
 type EvictionNodeLimitError struct {
 	node string
 }
@@ -32,6 +34,184 @@ func NewEvictionNamespaceLimitError(namespace string) *EvictionNamespaceLimitErr
 
 var _ error = &EvictionNamespaceLimitError{}
 
+type EvictionOwnerLimitError struct {
+	owner string
+}
+
+func (e EvictionOwnerLimitError) Error() string {
+	return "maximum number of evicted pods per owner reached"
+}
+
+func NewEvictionOwnerLimitError(owner string) *EvictionOwnerLimitError {
+	return &EvictionOwnerLimitError{
+		owner: owner,
+	}
+}
+
+var _ error = &EvictionOwnerLimitError{}
+
+type EvictionTopologyDomainLimitError struct {
+	domain string
+}
+
+func (e EvictionTopologyDomainLimitError) Error() string {
+	return "maximum number of evicted pods per topology domain reached"
+}
+
+func NewEvictionTopologyDomainLimitError(domain string) *EvictionTopologyDomainLimitError {
+	return &EvictionTopologyDomainLimitError{
+		domain: domain,
+	}
+}
+
+var _ error = &EvictionTopologyDomainLimitError{}
+
+type EvictionNamespaceQuotaError struct {
+	namespace string
+}
+
+func (e EvictionNamespaceQuotaError) Error() string {
+	return "namespace disruption quota exhausted"
+}
+
+func NewEvictionNamespaceQuotaError(namespace string) *EvictionNamespaceQuotaError {
+	return &EvictionNamespaceQuotaError{
+		namespace: namespace,
+	}
+}
+
+var _ error = &EvictionNamespaceQuotaError{}
+
+type EvictionNodeDrainedError struct {
+	node string
+}
+
+func (e EvictionNodeDrainedError) Error() string {
+	return "node drained concurrently"
+}
+
+func NewEvictionNodeDrainedError(node string) *EvictionNodeDrainedError {
+	return &EvictionNodeDrainedError{
+		node: node,
+	}
+}
+
+var _ error = &EvictionNodeDrainedError{}
+
+type EvictionExemptedError struct {
+	exemption string
+}
+
+func (e EvictionExemptedError) Error() string {
+	return "pod exempted from descheduling"
+}
+
+func NewEvictionExemptedError(exemption string) *EvictionExemptedError {
+	return &EvictionExemptedError{
+		exemption: exemption,
+	}
+}
+
+var _ error = &EvictionExemptedError{}
+
+type EvictionGangMemberError struct {
+	gang string
+}
+
+func (e EvictionGangMemberError) Error() string {
+	return "pod is a member of a gang"
+}
+
+func NewEvictionGangMemberError(gang string) *EvictionGangMemberError {
+	return &EvictionGangMemberError{
+		gang: gang,
+	}
+}
+
+var _ error = &EvictionGangMemberError{}
+
+type EvictionUnscheduledReplacementError struct {
+	owner string
+}
+
+func (e EvictionUnscheduledReplacementError) Error() string {
+	return "replacement of an evicted pod of the owner not scheduled"
+}
+
+func NewEvictionUnscheduledReplacementError(owner string) *EvictionUnscheduledReplacementError {
+	return &EvictionUnscheduledReplacementError{
+		owner: owner,
+	}
+}
+
+var _ error = &EvictionUnscheduledReplacementError{}
+
+type EvictionReplacementWaitError struct {
+	pod string
+}
+
+func (e EvictionReplacementWaitError) Error() string {
+	return "replacement of an evicted pod not ready in time"
+}
+
+func NewEvictionReplacementWaitError(pod string) *EvictionReplacementWaitError {
+	return &EvictionReplacementWaitError{
+		pod: pod,
+	}
+}
+
+var _ error = &EvictionReplacementWaitError{}
+
+type EvictionNodeLeaseError struct {
+	node   string
+	holder string
+}
+
+func (e EvictionNodeLeaseError) Error() string {
+	return "node lease held by another controller"
+}
+
+func NewEvictionNodeLeaseError(node, holder string) *EvictionNodeLeaseError {
+	return &EvictionNodeLeaseError{
+		node:   node,
+		holder: holder,
+	}
+}
+
+var _ error = &EvictionNodeLeaseError{}
+
+type EvictionVetoedError struct {
+	message string
+}
+
+func (e EvictionVetoedError) Error() string {
+	return fmt.Sprintf("eviction vetoed: %s", e.message)
+}
+
+func NewEvictionVetoedError(message string) *EvictionVetoedError {
+	return &EvictionVetoedError{
+		message: message,
+	}
+}
+
+var _ error = &EvictionVetoedError{}
+
+type EvictionFairShareLimitError struct {
+	unit string
+}
+
+func (e EvictionFairShareLimitError) Error() string {
+	return "maximum number of evicted pods per fair share reached"
+}
+
+func NewEvictionFairShareLimitError(unit string) *EvictionFairShareLimitError {
+	return &EvictionFairShareLimitError{
+		unit: unit,
+	}
+}
+
+var _ error = &EvictionFairShareLimitError{}
+
 type EvictionTotalLimitError struct{}
 
 func (e EvictionTotalLimitError) Error() string {
@@ -43,3 +223,119 @@ func NewEvictionTotalLimitError() *EvictionTotalLimitError {
 }
 
 var _ error = &EvictionTotalLimitError{}
+
+type EvictionNodeRateLimitError struct {
+	node string
+}
+
+func (e EvictionNodeRateLimitError) Error() string {
+	return "node eviction rate limit reached"
+}
+
+func NewEvictionNodeRateLimitError(node string) *EvictionNodeRateLimitError {
+	return &EvictionNodeRateLimitError{
+		node: node,
+	}
+}
+
+var _ error = &EvictionNodeRateLimitError{}
+
+type EvictionNamespaceRateLimitError struct {
+	namespace string
+}
+
+func (e EvictionNamespaceRateLimitError) Error() string {
+	return "namespace eviction rate limit reached"
+}
+
+func NewEvictionNamespaceRateLimitError(namespace string) *EvictionNamespaceRateLimitError {
+	return &EvictionNamespaceRateLimitError{
+		namespace: namespace,
+	}
+}
+
+var _ error = &EvictionNamespaceRateLimitError{}
+
+type EvictionNamespaceIntervalError struct {
+	namespace string
+}
+
+func (e EvictionNamespaceIntervalError) Error() string {
+	return "namespace minimum eviction interval not elapsed"
+}
+
+func NewEvictionNamespaceIntervalError(namespace string) *EvictionNamespaceIntervalError {
+	return &EvictionNamespaceIntervalError{
+		namespace: namespace,
+	}
+}
+
+var _ error = &EvictionNamespaceIntervalError{}
+
+type EvictionNodeTerminatingLimitError struct {
+	node string
+}
+
+func (e EvictionNodeTerminatingLimitError) Error() string {
+	return "node terminating pods limit reached"
+}
+
+func NewEvictionNodeTerminatingLimitError(node string) *EvictionNodeTerminatingLimitError {
+	return &EvictionNodeTerminatingLimitError{
+		node: node,
+	}
+}
+
+var _ error = &EvictionNodeTerminatingLimitError{}
+
+type EvictionTerminatingLimitError struct{}
+
+func (e EvictionTerminatingLimitError) Error() string {
+	return "terminating pods limit reached"
+}
+
+func NewEvictionTerminatingLimitError() *EvictionTerminatingLimitError {
+	return &EvictionTerminatingLimitError{}
+}
+
+var _ error = &EvictionTerminatingLimitError{}
+
+type EvictionCircuitBreakerError struct{}
+
+func (e EvictionCircuitBreakerError) Error() string {
+	return "too many evictions failed in the descheduling cycle"
+}
+
+func NewEvictionCircuitBreakerError() *EvictionCircuitBreakerError {
+	return &EvictionCircuitBreakerError{}
+}
+
+var _ error = &EvictionCircuitBreakerError{}
+
+type EvictionPreEvictionDelayLimitError struct{}
+
+func (e EvictionPreEvictionDelayLimitError) Error() string {
+	return "maximum pre-eviction delay per cycle reached"
+}
+
+func NewEvictionPreEvictionDelayLimitError() *EvictionPreEvictionDelayLimitError {
+	return &EvictionPreEvictionDelayLimitError{}
+}
+
+var _ error = &EvictionPreEvictionDelayLimitError{}
+
+type EvictionNodeCooldownError struct {
+	node string
+}
+
+func (e EvictionNodeCooldownError) Error() string {
+	return "node in cool-down"
+}
+
+func NewEvictionNodeCooldownError(node string) *EvictionNodeCooldownError {
+	return &EvictionNodeCooldownError{
+		node: node,
+	}
+}
+
+var _ error = &EvictionNodeCooldownError{}
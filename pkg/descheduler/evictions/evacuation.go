@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// EvacuationResource is the resource of the Evacuation objects of the Evacuation API (KEP-4563).
+// No typed client is available for the alpha API, the objects are created through the dynamic client.
+var EvacuationResource = schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1alpha1", Resource: "evacuations"}
+
+// EvacuationKind is the kind of the Evacuation objects
+const EvacuationKind = "Evacuation"
+
+// newEvacuation builds the Evacuation of the pod. The evacuation is named after the pod, so a single
+// evacuation of the pod is in progress at once.
+func newEvacuation(pod *v1.Pod) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": EvacuationResource.GroupVersion().String(),
+		"kind":       EvacuationKind,
+		"metadata": map[string]interface{}{
+			"name":      pod.Name,
+			"namespace": pod.Namespace,
+		},
+		"spec": map[string]interface{}{
+			"podRef": map[string]interface{}{
+				"name": pod.Name,
+				"uid":  string(pod.UID),
+			},
+		},
+	}}
+}
+
+// evacuatePod requests the evacuation of the pod through the Evacuation API. The evacuation controllers
+// of the workload migrate the pod before its deletion. An evacuation already requested for the pod is
+// ignored, the pod is not counted against the limits twice.
+// return (ignore, err)
+func (pe *PodEvictor) evacuatePod(ctx context.Context, pod *v1.Pod) (bool, error) {
+	_, err := pe.evacuationClient.Resource(EvacuationResource).Namespace(pod.Namespace).Create(ctx, newEvacuation(pod), metav1.CreateOptions{})
+	if err == nil {
+		return false, nil
+	}
+	if apierrors.IsAlreadyExists(err) {
+		klog.V(3).InfoS("Evacuation of the pod already requested", "pod", klog.KObj(pod))
+		return true, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("unable to request the evacuation of %q, the Evacuation API is not served: %v", pod.Name, err)
+	}
+	return false, fmt.Errorf("error when requesting the evacuation of %q: %w", pod.Name, err)
+}
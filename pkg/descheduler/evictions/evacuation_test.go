@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestEvacuatePod(t *testing.T) {
+	for _, dryRun := range []bool{false, true} {
+		ctx := context.Background()
+		node1 := test.BuildTestNode("node1", 1000, 2000, 9, nil)
+		pod1 := test.BuildTestPod("p1", 400, 0, node1.Name, test.SetRSOwnerRef)
+		pod2 := test.BuildTestPod("p2", 400, 0, node1.Name, test.SetRSOwnerRef)
+
+		fakeClient := fake.NewClientset(node1, pod1, pod2)
+		var evicted []string
+		fakeClient.PrependReactor("create", "pods/eviction", func(action core.Action) (bool, runtime.Object, error) {
+			evicted = append(evicted, action.(core.CreateAction).GetObject().(metav1.Object).GetName())
+			return true, nil, nil
+		})
+		dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{EvacuationResource: "EvacuationList"},
+		)
+		sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+		sharedInformerFactory.Start(ctx.Done())
+		sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+		podEvictor, err := NewPodEvictor(
+			ctx,
+			fakeClient,
+			&events.FakeRecorder{},
+			sharedInformerFactory.Core().V1().Pods().Informer(),
+			initFeatureGates(),
+			NewOptions().WithEvacuationClient(dynamicClient).WithDryRun(dryRun),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+		}
+
+		for _, pod := range []*v1.Pod{pod1, pod2, pod1} {
+			if err := podEvictor.EvictPod(ctx, pod, EvictOptions{}); err != nil {
+				t.Fatalf("Unexpected error when evicting %v: %v", pod.Name, err)
+			}
+		}
+
+		evacuations, err := dynamicClient.Resource(EvacuationResource).Namespace(pod1.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("Unable to list the evacuations: %v", err)
+		}
+		if dryRun {
+			// the dry run never requests an evacuation, the pods are evicted from the fake client
+			if len(evacuations.Items) != 0 || len(evicted) != 3 || podEvictor.TotalEvicted() != 3 {
+				t.Fatalf("Expected no evacuations and 3 evictions in the dry run, got %v evacuations and %v evictions", len(evacuations.Items), evicted)
+			}
+			continue
+		}
+		if len(evicted) != 0 {
+			t.Fatalf("Expected no evictions, got %v", evicted)
+		}
+		if len(evacuations.Items) != 2 {
+			t.Fatalf("Expected 2 evacuations, got %v", len(evacuations.Items))
+		}
+		// the second evacuation of p1 was already requested
+		if podEvictor.TotalEvicted() != 2 {
+			t.Fatalf("Expected 2 pods to be counted as evicted, got %v", podEvictor.TotalEvicted())
+		}
+		uid, _, _ := unstructured.NestedString(evacuations.Items[0].Object, "spec", "podRef", "uid")
+		if evacuations.Items[0].GetName() != pod1.Name || uid != string(pod1.UID) {
+			t.Fatalf("Expected the evacuation of %v with uid %v, got %v with uid %v", pod1.Name, pod1.UID, evacuations.Items[0].GetName(), uid)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// EvictableCapacityCache computes the evictable capacity of each node once.
+// A cache is meant to live for a single descheduling cycle, the pods evicted
+// within the cycle are still included in the capacity computed earlier.
+type EvictableCapacityCache struct {
+	getPodsAssignedToNode podutil.GetPodsAssignedToNodeFunc
+	evictor               frameworktypes.Evictor
+
+	mu    sync.Mutex
+	nodes map[string]*frameworktypes.NodeEvictableCapacity
+}
+
+// NewEvictableCapacityCache creates a cache filtering the pods through the given evictor
+func NewEvictableCapacityCache(getPodsAssignedToNode podutil.GetPodsAssignedToNodeFunc, evictor frameworktypes.Evictor) *EvictableCapacityCache {
+	return &EvictableCapacityCache{
+		getPodsAssignedToNode: getPodsAssignedToNode,
+		evictor:               evictor,
+		nodes:                 make(map[string]*frameworktypes.NodeEvictableCapacity),
+	}
+}
+
+// Get returns the evictable capacity of the node, computing it on the first call
+func (c *EvictableCapacityCache) Get(node *v1.Node) (*frameworktypes.NodeEvictableCapacity, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if capacity, ok := c.nodes[node.Name]; ok {
+		return capacity, nil
+	}
+
+	pods, err := podutil.ListPodsOnANode(node.Name, c.getPodsAssignedToNode, func(pod *v1.Pod) bool {
+		return c.evictor.Filter(pod) && c.evictor.PreEvictionFilter(pod)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the pods of node %q: %w", node.Name, err)
+	}
+	capacity := &frameworktypes.NodeEvictableCapacity{
+		Pods:     pods,
+		Requests: v1.ResourceList{},
+	}
+	for _, pod := range pods {
+		requests, _ := utils.PodRequestsAndLimits(pod)
+		for name, quantity := range requests {
+			total := capacity.Requests[name]
+			total.Add(quantity)
+			capacity.Requests[name] = total
+		}
+	}
+	c.nodes[node.Name] = capacity
+	return capacity, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/test"
+)
+
+type fakeEvictor struct {
+	filter            podutil.FilterFunc
+	preEvictionFilter podutil.FilterFunc
+}
+
+func (e *fakeEvictor) Filter(pod *v1.Pod) bool {
+	return e.filter(pod)
+}
+
+func (e *fakeEvictor) PreEvictionFilter(pod *v1.Pod) bool {
+	return e.preEvictionFilter(pod)
+}
+
+func (e *fakeEvictor) Evict(context.Context, *v1.Pod, EvictOptions) error {
+	return nil
+}
+
+func TestEvictableCapacityCache(t *testing.T) {
+	n1 := test.BuildTestNode("n1", 4000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 4000, 3000, 10, nil)
+	pods := map[string][]*v1.Pod{
+		n1.Name: {
+			test.BuildTestPod("p1", 100, 200, n1.Name, nil),
+			test.BuildTestPod("p2", 300, 400, n1.Name, nil),
+			test.BuildTestPod("not-evictable", 500, 500, n1.Name, nil),
+			test.BuildTestPod("not-fitting", 500, 500, n1.Name, nil),
+			test.BuildTestPod("succeeded", 500, 500, n1.Name, func(pod *v1.Pod) {
+				pod.Status.Phase = v1.PodSucceeded
+			}),
+		},
+	}
+
+	listed := 0
+	getPodsAssignedToNode := func(nodeName string, filter podutil.FilterFunc) ([]*v1.Pod, error) {
+		listed++
+		var result []*v1.Pod
+		for _, pod := range pods[nodeName] {
+			if filter(pod) {
+				result = append(result, pod)
+			}
+		}
+		return result, nil
+	}
+	evictor := &fakeEvictor{
+		filter:            func(pod *v1.Pod) bool { return pod.Name != "not-evictable" },
+		preEvictionFilter: func(pod *v1.Pod) bool { return pod.Name != "not-fitting" },
+	}
+	cache := NewEvictableCapacityCache(getPodsAssignedToNode, evictor)
+
+	capacity, err := cache.Get(n1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(capacity.Pods) != 2 || capacity.Pods[0].Name != "p1" || capacity.Pods[1].Name != "p2" {
+		t.Errorf("Expected pods p1 and p2 to be evictable, got %v", capacity.Pods)
+	}
+	if cpu := capacity.Requests[v1.ResourceCPU]; cpu.Cmp(*resource.NewMilliQuantity(400, resource.DecimalSI)) != 0 {
+		t.Errorf("Expected 400m of cpu requests, got %v", cpu.String())
+	}
+	if memory := capacity.Requests[v1.ResourceMemory]; memory.Cmp(*resource.NewQuantity(600, resource.DecimalSI)) != 0 {
+		t.Errorf("Expected 600 of memory requests, got %v", memory.String())
+	}
+
+	if _, err := cache.Get(n1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if listed != 1 {
+		t.Errorf("Expected the pods of the node to be listed once, got %v", listed)
+	}
+
+	capacity, err = cache.Get(n2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(capacity.Pods) != 0 || len(capacity.Requests) != 0 {
+		t.Errorf("Expected no evictable capacity, got %v pods and %v requests", len(capacity.Pods), capacity.Requests)
+	}
+}
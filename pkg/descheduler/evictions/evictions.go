@@ -29,17 +29,25 @@ import (
 	policy "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
 	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	"sigs.k8s.io/descheduler/pkg/features"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/tracing"
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 var (
@@ -197,12 +205,27 @@ var (
 	EvictionRequestAnnotationKey    = "descheduler.alpha.kubernetes.io/request-evict-only"
 	EvictionInProgressAnnotationKey = "descheduler.alpha.kubernetes.io/eviction-in-progress"
 	EvictionInBackgroundErrorText   = "Eviction triggered evacuation"
+	// EvictionWeightAnnotationKey sets the weight of a namespace in the eviction fairness shares
+	EvictionWeightAnnotationKey = "descheduler.alpha.kubernetes.io/eviction-weight"
+	// MinEvictionIntervalAnnotationKey sets the least interval between the descheduling cycles evicting the pods of a namespace
+	MinEvictionIntervalAnnotationKey = "descheduler.alpha.kubernetes.io/min-eviction-interval"
 )
 
+// namespaceQuota limits the evictions of every namespace matching the patterns over a sliding period
+type namespaceQuota struct {
+	namespaces   *utils.NamePatterns
+	maxEvictions uint
+	period       time.Duration
+}
+
 // nodePodEvictedCount keeps count of pods evicted on node
 type (
-	nodePodEvictedCount    map[string]uint
-	namespacePodEvictCount map[string]uint
+	nodePodEvictedCount     map[string]uint
+	namespacePodEvictCount  map[string]uint
+	strategyPodEvictedCount map[string]uint
+	domainPodEvictedCount   map[string]uint
+	fairnessPodEvictedCount map[string]uint
+	ownerPodEvictedCount    map[string]uint
 )
 
 type PodEvictor struct {
@@ -214,14 +237,59 @@ type PodEvictor struct {
 	maxPodsToEvictPerNode            *uint
 	maxPodsToEvictPerNamespace       *uint
 	maxPodsToEvictTotal              *uint
+	maxPodsToEvictPerOwner           *uint
+	maxPodsToEvictPerTopologyDomain  *uint
+	topologyDomainKey                string
+	topologyDomains                  map[string]string
+	topologyDomainPodCount           domainPodEvictedCount
 	gracePeriodSeconds               *int64
+	evacuationClient                 dynamic.Interface
+	spreadingTopologyKey             string
+	nodeDomains                      map[string]string
+	domainLimits                     map[string]uint
+	domainPodCount                   domainPodEvictedCount
+	fairnessBy                       string
+	fairnessLimits                   map[string]uint
+	fairnessPodCount                 fairnessPodEvictedCount
+	namespaceQuotas                  []namespaceQuota
+	aggregatedEvents                 bool
+	evictionVeto                     *EvictionVeto
+	nodeLeases                       *nodeLeases
+	workloadEvictions                map[string]*workloadEvictions
+	recommendedEvictions             map[recommendation]uint
+	podIndexer                       cache.Indexer
+	nodesInCooldown                  sets.Set[string]
+	drainedNodes                     sets.Set[string]
+	exemptions                       []Exemption
 	nodePodCount                     nodePodEvictedCount
 	namespacePodCount                namespacePodEvictCount
+	ownerPodCount                    ownerPodEvictedCount
+	strategyPodCount                 strategyPodEvictedCount
 	totalPodCount                    uint
+	failedPodCount                   uint
+	skippedPodCount                  uint
 	metricsEnabled                   bool
 	eventRecorder                    events.EventRecorder
 	erCache                          *evictionRequestsCache
 	featureGates                     featuregate.FeatureGate
+	evictionObservers                []EvictionObserver
+	recentEvictions                  *RecentEvictions
+	skipExplanations                 *skipExplanations
+	preferredNodeHints               *preferredNodeHints
+	gangScheduling                   *api.GangScheduling
+	evictedGangs                     sets.Set[string]
+	evictionVerification             *evictionVerification
+	evictionWait                     *evictionWait
+	replacementPlacements            *replacementPlacements
+	nodeRateLimiter                  *evictionRateLimiter
+	namespaceRateLimiter             *evictionRateLimiter
+	terminationPacing                *terminationPacing
+	circuitBreaker                   *circuitBreaker
+	revisionOrder                    *revisionOrder
+	validationClient                 clientset.Interface
+	namespaceIntervals               map[string]time.Duration
+	lastNamespaceEviction            map[string]time.Time
+	preEvictionDelayed               time.Duration
 
 	// registeredHandlers contains the registrations of all handlers. It's used to check if all handlers have finished syncing before the scheduling cycles start.
 	registeredHandlers []cache.ResourceEventHandlerRegistration
@@ -239,6 +307,32 @@ func NewPodEvictor(
 		options = NewOptions()
 	}
 
+	var quotas []namespaceQuota
+	retention := DefaultRecentEvictionsRetention
+	for _, quota := range options.namespaceQuotas {
+		namespaces, err := utils.NewNamePatterns(quota.Namespaces...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace disruption quota: %v", err)
+		}
+		quotas = append(quotas, namespaceQuota{
+			namespaces:   namespaces,
+			maxEvictions: quota.MaxEvictions,
+			period:       quota.Period.Duration,
+		})
+		// The quotas are counted in the recent evictions which must cover the longest period
+		retention = max(retention, quota.Period.Duration)
+	}
+
+	evictionVeto, err := NewEvictionVeto(options.evictionVeto)
+	if err != nil {
+		return nil, fmt.Errorf("invalid eviction veto: %v", err)
+	}
+
+	skipExplanations, err := newSkipExplanations(options.skipExplanations)
+	if err != nil {
+		return nil, fmt.Errorf("invalid skip explanations: %v", err)
+	}
+
 	podEvictor := &PodEvictor{
 		client:                           client,
 		eventRecorder:                    eventRecorder,
@@ -248,11 +342,50 @@ func NewPodEvictor(
 		maxPodsToEvictPerNode:            options.maxPodsToEvictPerNode,
 		maxPodsToEvictPerNamespace:       options.maxPodsToEvictPerNamespace,
 		maxPodsToEvictTotal:              options.maxPodsToEvictTotal,
+		maxPodsToEvictPerOwner:           options.maxPodsToEvictPerOwner,
+		maxPodsToEvictPerTopologyDomain:  options.maxPodsToEvictPerTopologyDomain,
+		topologyDomainKey:                options.topologyDomainKey,
+		topologyDomainPodCount:           make(domainPodEvictedCount),
 		gracePeriodSeconds:               options.gracePeriodSeconds,
+		evacuationClient:                 options.evacuationClient,
+		spreadingTopologyKey:             options.spreadingTopologyKey,
+		fairnessBy:                       options.fairnessBy,
 		metricsEnabled:                   options.metricsEnabled,
+		domainPodCount:                   make(domainPodEvictedCount),
+		fairnessPodCount:                 make(fairnessPodEvictedCount),
 		nodePodCount:                     make(nodePodEvictedCount),
 		namespacePodCount:                make(namespacePodEvictCount),
+		ownerPodCount:                    make(ownerPodEvictedCount),
+		strategyPodCount:                 make(strategyPodEvictedCount),
 		featureGates:                     featureGates,
+		namespaceQuotas:                  quotas,
+		aggregatedEvents:                 options.aggregatedEvents,
+		evictionVeto:                     evictionVeto,
+		nodeLeases:                       newNodeLeases(client, options.nodeLeases, options.dryRun),
+		workloadEvictions:                make(map[string]*workloadEvictions),
+		recommendedEvictions:             make(map[recommendation]uint),
+		recentEvictions:                  NewRecentEvictions(retention),
+		skipExplanations:                 skipExplanations,
+		preferredNodeHints:               newPreferredNodeHints(options.preferredNodeHints),
+		gangScheduling:                   options.gangScheduling,
+		evictedGangs:                     sets.New[string](),
+		evictionVerification:             newEvictionVerification(options.evictionVerification),
+		evictionWait:                     newEvictionWait(options.evictionVerification),
+		replacementPlacements:            newReplacementPlacements(options.metricsEnabled),
+		terminationPacing:                newTerminationPacing(options.terminationPacing),
+		circuitBreaker:                   newCircuitBreaker(options.maxEvictionFailures),
+		revisionOrder:                    newRevisionOrder(options.replicaSetLister),
+		validationClient:                 options.validationClient,
+		lastNamespaceEviction:            make(map[string]time.Time),
+	}
+
+	if limits := options.evictionRateLimits; limits != nil {
+		podEvictor.nodeRateLimiter = newEvictionRateLimiter(limits.PerNode)
+		podEvictor.namespaceRateLimiter = newEvictionRateLimiter(limits.PerNamespace)
+	}
+
+	if podInformer != nil {
+		podEvictor.podIndexer = podInformer.GetIndexer()
 	}
 
 	if featureGates.Enabled(features.EvictionsInBackground) {
@@ -389,12 +522,248 @@ func (pe *PodEvictor) TotalEvicted() uint {
 	return pe.totalPodCount
 }
 
+// NodesEvicted gives a number of pods evicted per node
+func (pe *PodEvictor) NodesEvicted() map[string]uint {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	nodesEvicted := make(map[string]uint, len(pe.nodePodCount))
+	for node, count := range pe.nodePodCount {
+		nodesEvicted[node] = count
+	}
+	return nodesEvicted
+}
+
+// NamespaceEvicted gives a number of pods evicted per namespace
+func (pe *PodEvictor) NamespaceEvicted() map[string]uint {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	namespaceEvicted := make(map[string]uint, len(pe.namespacePodCount))
+	for namespace, count := range pe.namespacePodCount {
+		namespaceEvicted[namespace] = count
+	}
+	return namespaceEvicted
+}
+
+// StrategyEvicted gives a number of pods evicted per strategy
+func (pe *PodEvictor) StrategyEvicted() map[string]uint {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	strategyEvicted := make(map[string]uint, len(pe.strategyPodCount))
+	for strategy, count := range pe.strategyPodCount {
+		strategyEvicted[strategy] = count
+	}
+	return strategyEvicted
+}
+
+// TotalFailed gives a number of pods that failed to be evicted, the evictions refused by the checks of the evictor are not counted
+func (pe *PodEvictor) TotalFailed() uint {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return pe.failedPodCount
+}
+
+// TotalSkipped gives a number of pod evictions refused by the checks of the evictor, e.g. the eviction limits
+func (pe *PodEvictor) TotalSkipped() uint {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return pe.skippedPodCount
+}
+
 func (pe *PodEvictor) ResetCounters() {
 	pe.mu.Lock()
 	defer pe.mu.Unlock()
 	pe.nodePodCount = make(nodePodEvictedCount)
+	pe.domainPodCount = make(domainPodEvictedCount)
+	pe.topologyDomainPodCount = make(domainPodEvictedCount)
+	pe.fairnessPodCount = make(fairnessPodEvictedCount)
 	pe.namespacePodCount = make(namespacePodEvictCount)
+	pe.ownerPodCount = make(ownerPodEvictedCount)
+	pe.strategyPodCount = make(strategyPodEvictedCount)
 	pe.totalPodCount = 0
+	pe.failedPodCount = 0
+	pe.skippedPodCount = 0
+	pe.evictedGangs = sets.New[string]()
+	pe.evictionWait.reset()
+	pe.circuitBreaker.reset()
+	pe.preEvictionDelayed = 0
+	now := time.Now()
+	pe.nodeRateLimiter.prune(now)
+	pe.namespaceRateLimiter.prune(now)
+}
+
+// SetNodes splits the total eviction limit into the limits of the topology domains
+// proportionally to the number of the given nodes in every domain.
+// Nodes without the topology label form a domain of their own.
+// No-op unless both the eviction spreading and the total eviction limit are configured.
+// The nodes are also mapped to the topology domains of the per topology domain eviction limit.
+func (pe *PodEvictor) SetNodes(nodes []*v1.Node) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	if pe.maxPodsToEvictPerTopologyDomain != nil {
+		pe.topologyDomains = make(map[string]string, len(nodes))
+		for _, node := range nodes {
+			pe.topologyDomains[node.Name] = node.Labels[pe.topologyDomainKey]
+		}
+	}
+	if pe.spreadingTopologyKey == "" || pe.maxPodsToEvictTotal == nil || len(nodes) == 0 {
+		return
+	}
+	pe.nodeDomains = make(map[string]string, len(nodes))
+	domainNodes := make(map[string]uint)
+	for _, node := range nodes {
+		domain := node.Labels[pe.spreadingTopologyKey]
+		pe.nodeDomains[node.Name] = domain
+		domainNodes[domain]++
+	}
+	pe.domainLimits = make(map[string]uint, len(domainNodes))
+	for domain, count := range domainNodes {
+		// Rounded up so every domain with nodes gets at least one eviction when the total limit allows
+		pe.domainLimits[domain] = (*pe.maxPodsToEvictTotal*count + uint(len(nodes)) - 1) / uint(len(nodes))
+	}
+}
+
+// SetFairShares splits the total eviction limit into the limits of the namespaces or the pod owners
+// of the given pods proportionally to their weights. The weight of a unit is the weight of its namespace,
+// namespaces missing in namespaceWeights weigh 1. A unit with the weight 0 gets no evictions.
+// No-op unless both the eviction fairness and the total eviction limit are configured.
+func (pe *PodEvictor) SetFairShares(pods []*v1.Pod, namespaceWeights map[string]uint) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.fairnessLimits = nil
+	if pe.fairnessBy == "" || pe.maxPodsToEvictTotal == nil || len(pods) == 0 {
+		return
+	}
+	unitWeights := make(map[string]uint)
+	for _, pod := range pods {
+		weight, ok := namespaceWeights[pod.Namespace]
+		if !ok {
+			weight = 1
+		}
+		unitWeights[pe.fairnessUnit(pod)] = weight
+	}
+	var totalWeight uint
+	for _, weight := range unitWeights {
+		totalWeight += weight
+	}
+	pe.fairnessLimits = make(map[string]uint, len(unitWeights))
+	for unit, weight := range unitWeights {
+		if totalWeight == 0 {
+			pe.fairnessLimits[unit] = 0
+			continue
+		}
+		// Rounded up so every unit with a non-zero weight gets at least one eviction when the total limit allows
+		pe.fairnessLimits[unit] = (*pe.maxPodsToEvictTotal*weight + totalWeight - 1) / totalWeight
+	}
+}
+
+// fairnessUnit returns the namespace or the namespace/kind/name of the owner of the pod,
+// pods without an owner are units of their own
+func (pe *PodEvictor) fairnessUnit(pod *v1.Pod) string {
+	if pe.fairnessBy != string(api.FairnessByOwner) {
+		return pod.Namespace
+	}
+	if owner := podOwner(pod); owner != nil {
+		return pod.Namespace + "/" + owner.Kind + "/" + owner.Name
+	}
+	return pod.Namespace + "/Pod/" + pod.Name
+}
+
+// podOwnerKey returns the key of the owner of the pod, an empty key for the pods without an owner
+func podOwnerKey(pod *v1.Pod) string {
+	if owner := podOwner(pod); owner != nil {
+		return ownerKey(pod.Namespace, owner)
+	}
+	return ""
+}
+
+// namespaceQuota returns the first quota matching the namespace
+func (pe *PodEvictor) namespaceQuota(namespace string) (namespaceQuota, bool) {
+	for _, quota := range pe.namespaceQuotas {
+		if quota.namespaces.Has(namespace) {
+			return quota, true
+		}
+	}
+	return namespaceQuota{}, false
+}
+
+// namespaceEvictedSince gives a number of pods evicted from the namespace since the given time
+func (pe *PodEvictor) namespaceEvictedSince(namespace string, since time.Time) uint {
+	var evicted uint
+	for _, eviction := range pe.recentEvictions.EvictedSince(since) {
+		if eviction.Namespace == namespace {
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// SetNodesInCooldown sets the nodes no pods can be evicted from in the current cycle
+func (pe *PodEvictor) SetNodesInCooldown(nodes sets.Set[string]) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.nodesInCooldown = nodes
+}
+
+// SetNamespaceIntervals sets the least intervals between the descheduling cycles evicting the pods of the namespaces
+func (pe *PodEvictor) SetNamespaceIntervals(intervals map[string]time.Duration) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.namespaceIntervals = intervals
+}
+
+// namespaceIntervalElapsed returns false when pods got evicted from the namespace in a previous cycle less than
+// the interval of the namespace ago, the evictions of the cycle the namespace got evicted from first are allowed
+func (pe *PodEvictor) namespaceIntervalElapsed(namespace string, now time.Time) bool {
+	interval, ok := pe.namespaceIntervals[namespace]
+	if !ok || pe.namespacePodCount[namespace] > 0 {
+		return true
+	}
+	last, ok := pe.lastNamespaceEviction[namespace]
+	return !ok || now.Sub(last) >= interval
+}
+
+// Exemption exempts the pods of the namespace matching the selector from the evictions
+type Exemption struct {
+	// Name of the exemption, reported when an eviction is refused
+	Name      string
+	Namespace string
+	Selector  labels.Selector
+}
+
+// SetExemptions sets the exemptions in force in the current cycle
+func (pe *PodEvictor) SetExemptions(exemptions []Exemption) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.exemptions = exemptions
+}
+
+// exemption returns the exemption the pod is exempted by, nil when the pod is not exempted
+func (pe *PodEvictor) exemption(pod *v1.Pod) *Exemption {
+	for i := range pe.exemptions {
+		if pe.exemptions[i].Namespace == pod.Namespace && pe.exemptions[i].Selector.Matches(labels.Set(pod.Labels)) {
+			return &pe.exemptions[i]
+		}
+	}
+	return nil
+}
+
+// SetDrainedNodes sets the nodes drained by other actors no pods can be evicted from in the current cycle
+func (pe *PodEvictor) SetDrainedNodes(nodes sets.Set[string]) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.drainedNodes = nodes
+}
+
+// AddEvictionObserver registers an observer notified about every successful eviction
+func (pe *PodEvictor) AddEvictionObserver(observer EvictionObserver) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.evictionObservers = append(pe.evictionObservers, observer)
+}
+
+// RecentEvictions returns the cache of the pods evicted in the recent descheduling cycles
+func (pe *PodEvictor) RecentEvictions() *RecentEvictions {
+	return pe.recentEvictions
 }
 
 func (pe *PodEvictor) SetClient(client clientset.Interface) {
@@ -419,6 +788,20 @@ func (pe *PodEvictor) evictionRequestsPerNode(node string) uint {
 	}
 }
 
+// evictionRequestsPerDomain gives a number of eviction requests in progress on the nodes of the domain
+func (pe *PodEvictor) evictionRequestsPerDomain(nodeDomains map[string]string, domain string) uint {
+	if pe.erCache == nil {
+		return 0
+	}
+	var requests uint
+	for node, nodeDomain := range nodeDomains {
+		if nodeDomain == domain {
+			requests += pe.erCache.evictionRequestsPerNode(node)
+		}
+	}
+	return requests
+}
+
 func (pe *PodEvictor) evictionRequestsPerNamespace(ns string) uint {
 	if pe.featureGates.Enabled(features.EvictionsInBackground) {
 		return pe.erCache.evictionRequestsPerNamespace(ns)
@@ -444,18 +827,24 @@ func (pe *PodEvictor) TotalEvictionRequests() uint {
 }
 
 // EvictOptions provides a handle for passing additional info to EvictPod
-type EvictOptions struct {
-	// Reason allows for passing details about the specific eviction for logging.
-	Reason string
-	// ProfileName allows for passing details about profile for observability.
-	ProfileName string
-	// StrategyName allows for passing details about strategy for observability.
-	StrategyName string
-}
+type EvictOptions = frameworktypes.EvictOptions
+
+// EvictionObserver is notified about every pod evicted successfully (including evictions in dry run mode).
+// Observers are invoked with the evictor lock held and must not call back into the evictor.
+type EvictionObserver func(pod *v1.Pod, opts EvictOptions)
 
 // EvictPod evicts a pod while exercising eviction limits.
 // Returns true when the pod is evicted on the server side.
+// With the gangs evicted together the whole gang of the pod is evicted.
 func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptions) error {
+	if gang := podutil.PodGroupName(pod); gang != "" && pe.gangMembersPolicy() == api.GangMembersEvictTogether && !opts.ForceDelete {
+		return pe.evictGang(ctx, pod, gang, opts)
+	}
+	return pe.evictSinglePod(ctx, pod, opts)
+}
+
+// evictSinglePod evicts the pod alone while exercising eviction limits
+func (pe *PodEvictor) evictSinglePod(ctx context.Context, pod *v1.Pod, opts EvictOptions) error {
 	if len(pod.UID) == 0 {
 		klog.InfoS("Ignoring pod eviction due to missing UID", "pod", pod)
 		return fmt.Errorf("Pod %v is missing UID", klog.KObj(pod))
@@ -471,55 +860,81 @@ func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptio
 		}
 	}
 
-	pe.mu.Lock()
-	defer pe.mu.Unlock()
-
 	var span trace.Span
 	ctx, span = tracing.Tracer().Start(ctx, "EvictPod", trace.WithAttributes(attribute.String("podName", pod.Name), attribute.String("podNamespace", pod.Namespace), attribute.String("reason", opts.Reason), attribute.String("operation", tracing.EvictOperation)))
 	defer span.End()
 
-	if pe.maxPodsToEvictTotal != nil && pe.totalPodCount+pe.evictionRequestsTotal()+1 > *pe.maxPodsToEvictTotal {
-		err := NewEvictionTotalLimitError()
-		if pe.metricsEnabled {
-			metrics.PodsEvicted.With(map[string]string{"result": err.Error(), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+	// The pod is prepared for the eviction without holding the lock, the preparation may take long, e.g. a lead time,
+	// and the other evictions carry on meanwhile. The limits are checked before the preparation so no pod failing them
+	// is prepared, and once more after the preparation as the other evictions may have used them up in the meantime.
+	var err error
+	var delay time.Duration
+	prepared := false
+	if !pe.dryRun && !opts.ForceDelete {
+		delay = preEvictionDelay(pod)
+	}
+	if !pe.dryRun && !opts.ForceDelete && (opts.PreEvictionHook != nil || delay > 0) {
+		pe.mu.Lock()
+		err = pe.admit(ctx, span, pod, opts)
+		if err == nil {
+			err = pe.reservePreEvictionDelay(ctx, span, pod, opts, delay)
 		}
-		span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
-		klog.ErrorS(err, "Error evicting pod", "limit", *pe.maxPodsToEvictTotal)
-		if pe.evictionFailureEventNotification {
-			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: total eviction limit exceeded (%v)", pod.Spec.NodeName, *pe.maxPodsToEvictTotal)
+		pe.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if opts.PreEvictionHook != nil {
+			if hookErr := opts.PreEvictionHook(ctx, pod); hookErr != nil {
+				err = fmt.Errorf("pre-eviction hook failed: %v", hookErr)
+			} else {
+				prepared = true
+			}
+		}
+		if err == nil {
+			err = waitPreEvictionDelay(ctx, pod, delay)
 		}
-		return err
 	}
 
-	if pod.Spec.NodeName != "" {
-		if pe.maxPodsToEvictPerNode != nil && pe.nodePodCount[pod.Spec.NodeName]+pe.evictionRequestsPerNode(pod.Spec.NodeName)+1 > *pe.maxPodsToEvictPerNode {
-			err := NewEvictionNodeLimitError(pod.Spec.NodeName)
-			if pe.metricsEnabled {
-				metrics.PodsEvicted.With(map[string]string{"result": err.Error(), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
-			}
-			span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
-			klog.ErrorS(err, "Error evicting pod", "limit", *pe.maxPodsToEvictPerNode, "node", pod.Spec.NodeName)
-			if pe.evictionFailureEventNotification {
-				pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: node eviction limit exceeded (%v)", pod.Spec.NodeName, *pe.maxPodsToEvictPerNode)
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	if err == nil {
+		if err = pe.admit(ctx, span, pod, opts); err != nil {
+			if prepared && opts.PreEvictionRevert != nil {
+				opts.PreEvictionRevert(ctx, pod)
 			}
 			return err
 		}
 	}
 
-	if pe.maxPodsToEvictPerNamespace != nil && pe.namespacePodCount[pod.Namespace]+pe.evictionRequestsPerNamespace(pod.Namespace)+1 > *pe.maxPodsToEvictPerNamespace {
-		err := NewEvictionNamespaceLimitError(pod.Namespace)
-		if pe.metricsEnabled {
-			metrics.PodsEvicted.With(map[string]string{"result": err.Error(), "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+	var ignore bool
+	if err == nil {
+		evictionStart := time.Now()
+		client := pe.client
+		if opts.Client != nil && !pe.dryRun {
+			client = opts.Client
 		}
-		span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
-		klog.ErrorS(err, "Error evicting pod", "limit", *pe.maxPodsToEvictPerNamespace, "namespace", pod.Namespace, "pod", klog.KObj(pod))
-		if pe.evictionFailureEventNotification {
-			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: namespace eviction limit exceeded (%v)", pod.Spec.NodeName, *pe.maxPodsToEvictPerNamespace)
+		if opts.ForceDelete {
+			err = forceDeletePod(ctx, client, pod)
+		} else {
+			softEvicted := false
+			// The dry runs request the disruption through the cached client, the marks are never written to the cluster
+			if opts.SoftEviction != nil {
+				softEvicted, err = opts.SoftEviction(ctx, client, pod)
+			}
+			if err == nil && !softEvicted {
+				ignore, err = pe.evictPod(ctx, client, pod)
+			}
+		}
+		if pe.metricsEnabled {
+			result := "success"
+			if err != nil {
+				result = "error"
+			}
+			// The context carries the EvictPod span so the observation gets the trace exemplar attached
+			metrics.PodEvictionDuration.WithContext(ctx).With(map[string]string{"result": result, "strategy": opts.StrategyName, "profile": opts.ProfileName}).Observe(time.Since(evictionStart).Seconds())
 		}
-		return err
 	}
-
-	ignore, err := pe.evictPod(ctx, pod)
 	if err != nil {
 		// err is used only for logging purposes
 		span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
@@ -530,8 +945,21 @@ func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptio
 		if pe.evictionFailureEventNotification {
 			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: %v", pod.Spec.NodeName, err.Error())
 		}
+		pe.skipExplanations.record(pod, opts.StrategyName, evictionFailureReason(err))
+		pe.failedPodCount++
+		if prepared && opts.PreEvictionRevert != nil {
+			opts.PreEvictionRevert(ctx, pod)
+		}
+		if pe.circuitBreaker.record(true) {
+			klog.InfoS("Too many evictions failed, stopping the evictions of the descheduling cycle", "failedEvictions", pe.circuitBreaker.failures, "evictionRequests", pe.circuitBreaker.requests, "limit", pe.circuitBreaker.maxFailures.String())
+			if pe.metricsEnabled {
+				metrics.EvictionCircuitBreakerTrips.With(map[string]string{}).Inc()
+			}
+			pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionCircuitBreakerOpen", "Descheduled", "%d of %d evictions failed in the descheduling cycle, the remaining plugins of the cycle are aborted", pe.circuitBreaker.failures, pe.circuitBreaker.requests)
+		}
 		return err
 	}
+	pe.circuitBreaker.record(false)
 
 	if ignore {
 		return nil
@@ -540,36 +968,209 @@ func (pe *PodEvictor) EvictPod(ctx context.Context, pod *v1.Pod, opts EvictOptio
 	if pod.Spec.NodeName != "" {
 		pe.nodePodCount[pod.Spec.NodeName]++
 	}
+	if domain, ok := pe.nodeDomains[pod.Spec.NodeName]; ok {
+		pe.domainPodCount[domain]++
+	}
+	if domain, ok := pe.topologyDomains[pod.Spec.NodeName]; ok {
+		pe.topologyDomainPodCount[domain]++
+	}
+	if pe.fairnessLimits != nil {
+		pe.fairnessPodCount[pe.fairnessUnit(pod)]++
+	}
 	pe.namespacePodCount[pod.Namespace]++
+	if owner := podOwnerKey(pod); owner != "" {
+		pe.ownerPodCount[owner]++
+	}
+	pe.strategyPodCount[opts.StrategyName]++
 	pe.totalPodCount++
 
+	evictedAt := time.Now()
+	if pod.Spec.NodeName != "" {
+		pe.nodeRateLimiter.take(pod.Spec.NodeName, evictedAt)
+	}
+	pe.namespaceRateLimiter.take(pod.Namespace, evictedAt)
+	pe.lastNamespaceEviction[pod.Namespace] = evictedAt
+	pe.terminationPacing.record(pod, pe.gracePeriodSeconds, evictedAt)
+	pe.recentEvictions.Add(NewRecentEviction(pod, opts, evictedAt))
+	if !pe.dryRun {
+		pe.preferredNodeHints.record(pod, opts.PreferredNodes)
+		pe.evictionVerification.record(pod, opts, evictedAt)
+		pe.replacementPlacements.record(pod, opts, evictedAt)
+	}
+	for _, observer := range pe.evictionObservers {
+		observer(pod, opts)
+	}
+
 	if pe.metricsEnabled {
 		metrics.PodsEvicted.With(map[string]string{"result": "success", "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+		pe.recommendedEvictions[newRecommendation(pod, opts)]++
 	}
 
 	if pe.dryRun {
 		klog.V(1).InfoS("Evicted pod in dry run mode", "pod", klog.KObj(pod), "reason", opts.Reason, "strategy", opts.StrategyName, "node", pod.Spec.NodeName, "profile", opts.ProfileName)
 	} else {
 		klog.V(1).InfoS("Evicted pod", "pod", klog.KObj(pod), "reason", opts.Reason, "strategy", opts.StrategyName, "node", pod.Spec.NodeName, "profile", opts.ProfileName)
-		reason := opts.Reason
-		if len(reason) == 0 {
-			reason = opts.StrategyName
+		if !pe.aggregatedEvents || !pe.aggregateEviction(pod, opts) {
+			reason := opts.Reason
 			if len(reason) == 0 {
-				reason = "NotSet"
+				reason = opts.StrategyName
+				if len(reason) == 0 {
+					reason = "NotSet"
+				}
+			}
+			if opts.ForceDelete {
+				pe.eventRecorder.Eventf(pod, nil, v1.EventTypeNormal, reason, "Descheduled", "pod force deleted from %v node by sigs.k8s.io/descheduler", pod.Spec.NodeName)
+			} else {
+				pe.eventRecorder.Eventf(pod, nil, v1.EventTypeNormal, reason, "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler", pod.Spec.NodeName)
 			}
 		}
-		pe.eventRecorder.Eventf(pod, nil, v1.EventTypeNormal, reason, "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler", pod.Spec.NodeName)
+		pe.waitForReplacement(ctx, pod, opts, evictedAt)
 	}
 	return nil
 }
 
-// return (ignore, err)
-func (pe *PodEvictor) evictPod(ctx context.Context, pod *v1.Pod) (bool, error) {
+// admit checks the eviction of the pod passes the limits, the exemptions and the veto and acquires the lease of its node
+func (pe *PodEvictor) admit(ctx context.Context, span trace.Span, pod *v1.Pod, opts EvictOptions) error {
+	if pe.circuitBreaker.isOpen() {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionCircuitBreakerError(), "")
+	}
+
+	if pe.maxPodsToEvictTotal != nil && pe.totalPodCount+pe.evictionRequestsTotal()+1 > *pe.maxPodsToEvictTotal {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionTotalLimitError(), fmt.Sprintf("total eviction limit exceeded (%v)", *pe.maxPodsToEvictTotal))
+	}
+
+	if pe.evictionWait.halts() {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionReplacementWaitError(pe.evictionWait.timedOut), "")
+	}
+
+	pe.terminationPacing.prune(pe.podIndexer, time.Now())
+	if !pe.terminationPacing.allows() {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionTerminatingLimitError(), fmt.Sprintf("terminating evicted pods limit reached (%v)", *pe.terminationPacing.maxTotal))
+	}
+
+	if domain, ok := pe.nodeDomains[pod.Spec.NodeName]; ok && pe.domainPodCount[domain]+pe.evictionRequestsPerDomain(pe.nodeDomains, domain)+1 > pe.domainLimits[domain] {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionTopologyDomainLimitError(domain), fmt.Sprintf("topology domain eviction limit exceeded (%v)", pe.domainLimits[domain]))
+	}
+
+	if domain, ok := pe.topologyDomains[pod.Spec.NodeName]; ok && pe.topologyDomainPodCount[domain]+pe.evictionRequestsPerDomain(pe.topologyDomains, domain)+1 > *pe.maxPodsToEvictPerTopologyDomain {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionTopologyDomainLimitError(domain), fmt.Sprintf("topology domain eviction limit exceeded (%v)", *pe.maxPodsToEvictPerTopologyDomain))
+	}
+
+	if pe.fairnessLimits != nil {
+		unit := pe.fairnessUnit(pod)
+		if limit, ok := pe.fairnessLimits[unit]; ok && pe.fairnessPodCount[unit]+1 > limit {
+			return pe.refuse(ctx, span, pod, opts, NewEvictionFairShareLimitError(unit), fmt.Sprintf("fair share eviction limit exceeded (%v)", limit))
+		}
+	}
+
+	if exemption := pe.exemption(pod); exemption != nil {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionExemptedError(exemption.Name), "")
+	}
+
+	if gang := podutil.PodGroupName(pod); gang != "" && pe.gangMembersPolicy() == api.GangMembersSkip {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionGangMemberError(gang), "")
+	}
+
+	if pe.evictionVerification.blocks(pod) {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionUnscheduledReplacementError(podOwner(pod).Name), "")
+	}
+
+	if pod.Spec.NodeName != "" {
+		// The pods of a drained node are evicted by the drainer already
+		if pe.drainedNodes.Has(pod.Spec.NodeName) {
+			return pe.refuse(ctx, span, pod, opts, NewEvictionNodeDrainedError(pod.Spec.NodeName), "")
+		}
+		if pe.nodesInCooldown.Has(pod.Spec.NodeName) {
+			return pe.refuse(ctx, span, pod, opts, NewEvictionNodeCooldownError(pod.Spec.NodeName), "")
+		}
+		if pe.maxPodsToEvictPerNode != nil && pe.nodePodCount[pod.Spec.NodeName]+pe.evictionRequestsPerNode(pod.Spec.NodeName)+1 > *pe.maxPodsToEvictPerNode {
+			return pe.refuse(ctx, span, pod, opts, NewEvictionNodeLimitError(pod.Spec.NodeName), fmt.Sprintf("node eviction limit exceeded (%v)", *pe.maxPodsToEvictPerNode))
+		}
+		if !pe.nodeRateLimiter.allows(pod.Spec.NodeName, time.Now()) {
+			return pe.refuse(ctx, span, pod, opts, NewEvictionNodeRateLimitError(pod.Spec.NodeName), "")
+		}
+		if !pe.terminationPacing.allowsOnNode(pod.Spec.NodeName) {
+			return pe.refuse(ctx, span, pod, opts, NewEvictionNodeTerminatingLimitError(pod.Spec.NodeName), fmt.Sprintf("terminating evicted pods per node limit reached (%v)", *pe.terminationPacing.maxPerNode))
+		}
+	}
+
+	if pe.maxPodsToEvictPerNamespace != nil && pe.namespacePodCount[pod.Namespace]+pe.evictionRequestsPerNamespace(pod.Namespace)+1 > *pe.maxPodsToEvictPerNamespace {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionNamespaceLimitError(pod.Namespace), fmt.Sprintf("namespace eviction limit exceeded (%v)", *pe.maxPodsToEvictPerNamespace))
+	}
+
+	if owner := podOwnerKey(pod); owner != "" && pe.maxPodsToEvictPerOwner != nil && pe.ownerPodCount[owner]+1 > *pe.maxPodsToEvictPerOwner {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionOwnerLimitError(owner), fmt.Sprintf("owner eviction limit exceeded (%v)", *pe.maxPodsToEvictPerOwner))
+	}
+
+	if !pe.namespaceRateLimiter.allows(pod.Namespace, time.Now()) {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionNamespaceRateLimitError(pod.Namespace), "")
+	}
+
+	if !pe.namespaceIntervalElapsed(pod.Namespace, time.Now()) {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionNamespaceIntervalError(pod.Namespace), "")
+	}
+
+	if quota, ok := pe.namespaceQuota(pod.Namespace); ok && pe.namespaceEvictedSince(pod.Namespace, time.Now().Add(-quota.period))+1 > quota.maxEvictions {
+		return pe.refuse(ctx, span, pod, opts, NewEvictionNamespaceQuotaError(pod.Namespace), fmt.Sprintf("namespace disruption quota exhausted (%v per %v)", quota.maxEvictions, quota.period))
+	}
+
+	// The veto is evaluated once the limits are checked, right before the pod is evicted
+	if err := pe.evictionVeto.Evaluate(pod, opts); err != nil {
+		return pe.refuse(ctx, span, pod, opts, err, "")
+	}
+
+	// The lease is acquired last, no lease is held for a pod failing the checks
+	if pod.Spec.NodeName != "" {
+		if err := pe.nodeLeases.acquire(ctx, pod.Spec.NodeName, time.Now()); err != nil {
+			if _, ok := err.(*EvictionNodeLeaseError); ok {
+				return pe.refuse(ctx, span, pod, opts, err, "")
+			}
+			if pe.metricsEnabled {
+				metrics.PodsEvicted.With(map[string]string{"result": "error", "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+			}
+			span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
+			klog.ErrorS(err, "Error evicting pod", "pod", klog.KObj(pod), "node", pod.Spec.NodeName)
+			if pe.evictionFailureEventNotification {
+				pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: %v", pod.Spec.NodeName, err.Error())
+			}
+			pe.failedPodCount++
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refuse reports the eviction of the pod refused by the checks of the evictor and returns the refusal,
+// the message describes the refusal in the log and the event, the error by default
+func (pe *PodEvictor) refuse(ctx context.Context, span trace.Span, pod *v1.Pod, opts EvictOptions, err error, message string) error {
+	if message == "" {
+		message = err.Error()
+	}
+	result := err.Error()
+	if _, ok := err.(*EvictionVetoedError); ok {
+		// The messages of the vetoes come from the policy, the vetoed evictions are counted together
+		result = "vetoed"
+	}
+	if pe.metricsEnabled {
+		metrics.PodsEvicted.With(map[string]string{"result": result, "strategy": opts.StrategyName, "namespace": pod.Namespace, "node": pod.Spec.NodeName, "profile": opts.ProfileName}).Inc()
+	}
+	span.AddEvent("Eviction Failed", trace.WithAttributes(attribute.String("node", pod.Spec.NodeName), attribute.String("err", err.Error())))
+	klog.V(2).InfoS("Skipping pod eviction", "pod", klog.KObj(pod), "node", pod.Spec.NodeName, "err", message, "strategy", opts.StrategyName, "profile", opts.ProfileName)
+	if pe.evictionFailureEventNotification {
+		pe.eventRecorder.Eventf(pod, nil, v1.EventTypeWarning, "EvictionFailed", "Descheduled", "pod eviction from %v node by sigs.k8s.io/descheduler failed: %v", pod.Spec.NodeName, message)
+	}
+	pe.skippedPodCount++
+	return err
+}
+
+// newEviction builds the eviction of the pod
+func (pe *PodEvictor) newEviction(pod *v1.Pod) *policy.Eviction {
 	deleteOptions := &metav1.DeleteOptions{
 		GracePeriodSeconds: pe.gracePeriodSeconds,
 	}
 	// GracePeriodSeconds ?
-	eviction := &policy.Eviction{
+	return &policy.Eviction{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: pe.policyGroupVersion,
 			Kind:       eutils.EvictionKind,
@@ -580,7 +1181,32 @@ func (pe *PodEvictor) evictPod(ctx context.Context, pod *v1.Pod) (bool, error) {
 		},
 		DeleteOptions: deleteOptions,
 	}
-	err := pe.client.PolicyV1().Evictions(eviction.Namespace).Evict(ctx, eviction)
+}
+
+// forceDeletePod deletes the pod with a zero grace period, the termination of the pod is not confirmed by its kubelet
+func forceDeletePod(ctx context.Context, client clientset.Interface, pod *v1.Pod) error {
+	err := client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{
+		GracePeriodSeconds: ptr.To[int64](0),
+		Preconditions:      metav1.NewUIDPreconditions(string(pod.UID)),
+	})
+	if err != nil {
+		return fmt.Errorf("error when force deleting pod: %v", err)
+	}
+	return nil
+}
+
+// return (ignore, err)
+func (pe *PodEvictor) evictPod(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error) {
+	if pe.evacuationClient != nil && !pe.dryRun {
+		return pe.evacuatePod(ctx, pod)
+	}
+	if pe.dryRun && pe.validationClient != nil {
+		if err := pe.validateEviction(ctx, pod); err != nil {
+			return false, err
+		}
+	}
+	eviction := pe.newEviction(pod)
+	err := client.PolicyV1().Evictions(eviction.Namespace).Evict(ctx, eviction)
 	if err == nil {
 		return false, nil
 	}
@@ -606,7 +1232,7 @@ func (pe *PodEvictor) evictPod(ctx context.Context, pod *v1.Pod) (bool, error) {
 	}
 
 	if apierrors.IsTooManyRequests(err) {
-		return false, fmt.Errorf("error when evicting pod (ignoring) %q: %v", pod.Name, err)
+		return false, fmt.Errorf("error when evicting pod (ignoring) %q: %w", pod.Name, err)
 	}
 	if apierrors.IsNotFound(err) {
 		return false, fmt.Errorf("pod not found when evicting %q: %v", pod.Name, err)
@@ -29,8 +29,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
@@ -39,6 +41,7 @@ import (
 	"k8s.io/klog/v2"
 	utilptr "k8s.io/utils/ptr"
 
+	"sigs.k8s.io/descheduler/pkg/api"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	"sigs.k8s.io/descheduler/pkg/features"
 	"sigs.k8s.io/descheduler/pkg/utils"
@@ -114,7 +117,7 @@ func TestEvictPod(t *testing.T) {
 				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
 			}
 
-			_, got := podEvictor.evictPod(ctx, test.evictedPod)
+			_, got := podEvictor.evictPod(ctx, fakeClient, test.evictedPod)
 			if got != test.wantErr {
 				t.Errorf("Test error for Desc: %s. Expected %v pod eviction to be %v, got %v", test.description, test.evictedPod.Name, test.wantErr, got)
 			}
@@ -373,6 +376,491 @@ func TestNewPodEvictor(t *testing.T) {
 	}
 }
 
+func TestEvictionSpreading(t *testing.T) {
+	ctx := context.Background()
+
+	var nodes []*v1.Node
+	for i := 0; i < 3; i++ {
+		nodes = append(nodes, test.BuildTestNode(fmt.Sprintf("a%d", i), 1000, 1000, 10, func(node *v1.Node) {
+			node.Labels["topology.kubernetes.io/zone"] = "a"
+		}))
+	}
+	nodes = append(nodes, test.BuildTestNode("b0", 1000, 1000, 10, func(node *v1.Node) {
+		node.Labels["topology.kubernetes.io/zone"] = "b"
+	}))
+
+	// The pods in zone b are evicted first and must not exhaust the total limit
+	var pods []*v1.Pod
+	for i := 0; i < 2; i++ {
+		pods = append(pods, test.BuildTestPod(fmt.Sprintf("b-pod%d", i), 100, 0, "b0", nil))
+	}
+	for i := 0; i < 4; i++ {
+		pods = append(pods, test.BuildTestPod(fmt.Sprintf("a-pod%d", i), 100, 0, nodes[i%3].Name, nil))
+	}
+
+	var objs []runtime.Object
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().
+			WithMaxPodsToEvictTotal(utilptr.To[uint](4)).
+			WithEvictionSpreading("topology.kubernetes.io/zone"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	podEvictor.SetNodes(nodes)
+
+	expectedErrors := []error{nil, NewEvictionTopologyDomainLimitError("b"), nil, nil, nil, NewEvictionTotalLimitError()}
+	for i, pod := range pods {
+		err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+		if !reflect.DeepEqual(err, expectedErrors[i]) {
+			t.Errorf("Expected error %v when evicting %v, got %v", expectedErrors[i], pod.Name, err)
+		}
+	}
+	if evictions := podEvictor.TotalEvicted(); evictions != 4 {
+		t.Errorf("Expected 4 total evictions, got %d instead", evictions)
+	}
+
+	podEvictor.ResetCounters()
+	if err := podEvictor.EvictPod(ctx, pods[1], EvictOptions{}); err != nil {
+		t.Errorf("Expected the domain limits to be reset, got %v", err)
+	}
+}
+
+func TestEvictPodTopologyDomainLimit(t *testing.T) {
+	ctx := context.Background()
+	inRack := func(rack string) func(*v1.Node) {
+		return func(node *v1.Node) {
+			node.Labels["rack"] = rack
+		}
+	}
+	nodes := []*v1.Node{
+		test.BuildTestNode("r1-n1", 1000, 1000, 10, inRack("r1")),
+		test.BuildTestNode("r1-n2", 1000, 1000, 10, inRack("r1")),
+		test.BuildTestNode("r2-n1", 1000, 1000, 10, inRack("r2")),
+		test.BuildTestNode("unlabeled", 1000, 1000, 10, nil),
+	}
+	pods := []*v1.Pod{
+		test.BuildTestPod("p1", 100, 0, "r1-n1", nil),
+		test.BuildTestPod("p2", 100, 0, "r1-n2", nil),
+		test.BuildTestPod("p3", 100, 0, "r1-n2", nil),
+		test.BuildTestPod("p4", 100, 0, "r2-n1", nil),
+		test.BuildTestPod("p5", 100, 0, "unlabeled", nil),
+	}
+
+	var objs []runtime.Object
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithMaxPodsToEvictPerTopologyDomain(utilptr.To[uint](2), "rack"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	podEvictor.SetNodes(nodes)
+
+	// The nodes without the label form a domain of their own
+	expectedErrors := []error{nil, nil, NewEvictionTopologyDomainLimitError("r1"), nil, nil}
+	for i, pod := range pods {
+		err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+		if !reflect.DeepEqual(err, expectedErrors[i]) {
+			t.Errorf("Expected error %v when evicting %v, got %v", expectedErrors[i], pod.Name, err)
+		}
+	}
+
+	podEvictor.ResetCounters()
+	if err := podEvictor.EvictPod(ctx, pods[2], EvictOptions{}); err != nil {
+		t.Errorf("Expected the topology domain limit to be reset, got %v", err)
+	}
+}
+
+func TestEvictionFairness(t *testing.T) {
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+	inNamespace := func(namespace string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Namespace = namespace
+		}
+	}
+
+	tests := []struct {
+		description      string
+		by               string
+		pods             []*v1.Pod
+		namespaceWeights map[string]uint
+		expectedErrors   []error
+	}{
+		{
+			description: "namespaces share the budget evenly",
+			by:          "Namespace",
+			pods: []*v1.Pod{
+				test.BuildTestPod("big-1", 100, 0, "n1", inNamespace("big")),
+				test.BuildTestPod("big-2", 100, 0, "n1", inNamespace("big")),
+				test.BuildTestPod("big-3", 100, 0, "n1", inNamespace("big")),
+				test.BuildTestPod("big-4", 100, 0, "n1", inNamespace("big")),
+				test.BuildTestPod("small-1", 100, 0, "n1", inNamespace("small")),
+				test.BuildTestPod("small-2", 100, 0, "n1", inNamespace("small")),
+			},
+			expectedErrors: []error{nil, nil, NewEvictionFairShareLimitError("big"), NewEvictionFairShareLimitError("big"), nil, nil},
+		},
+		{
+			description: "namespaces share the budget by their weights",
+			by:          "Namespace",
+			pods: []*v1.Pod{
+				test.BuildTestPod("big-1", 100, 0, "n1", inNamespace("big")),
+				test.BuildTestPod("big-2", 100, 0, "n1", inNamespace("big")),
+				test.BuildTestPod("frozen-1", 100, 0, "n1", inNamespace("frozen")),
+				test.BuildTestPod("small-1", 100, 0, "n1", inNamespace("small")),
+				test.BuildTestPod("small-2", 100, 0, "n1", inNamespace("small")),
+				test.BuildTestPod("small-3", 100, 0, "n1", inNamespace("small")),
+			},
+			namespaceWeights: map[string]uint{"small": 3, "frozen": 0},
+			expectedErrors:   []error{nil, NewEvictionFairShareLimitError("big"), NewEvictionFairShareLimitError("frozen"), nil, nil, nil},
+		},
+		{
+			description: "owners share the budget evenly",
+			by:          "Owner",
+			pods: []*v1.Pod{
+				test.BuildTestPod("rs1-1", 100, 0, "n1", ownedBy("rs1")),
+				test.BuildTestPod("rs1-2", 100, 0, "n1", ownedBy("rs1")),
+				test.BuildTestPod("rs1-3", 100, 0, "n1", ownedBy("rs1")),
+				test.BuildTestPod("rs2-1", 100, 0, "n1", ownedBy("rs2")),
+				test.BuildTestPod("bare", 100, 0, "n1", nil),
+			},
+			expectedErrors: []error{nil, nil, NewEvictionFairShareLimitError("default/ReplicaSet/rs1"), nil, nil},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx := context.Background()
+
+			var objs []runtime.Object
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			podEvictor, err := NewPodEvictor(
+				ctx,
+				fakeClient,
+				events.NewFakeRecorder(100),
+				sharedInformerFactory.Core().V1().Pods().Informer(),
+				initFeatureGates(),
+				NewOptions().
+					WithMaxPodsToEvictTotal(utilptr.To[uint](4)).
+					WithEvictionFairness(tc.by),
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+			}
+			podEvictor.SetFairShares(tc.pods, tc.namespaceWeights)
+
+			for i, pod := range tc.pods {
+				err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+				if !reflect.DeepEqual(err, tc.expectedErrors[i]) {
+					t.Errorf("Expected error %v when evicting %v, got %v", tc.expectedErrors[i], pod.Name, err)
+				}
+			}
+
+			podEvictor.ResetCounters()
+			if err := podEvictor.EvictPod(ctx, tc.pods[0], EvictOptions{}); err != nil {
+				t.Errorf("Expected the fair share counters to be reset, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNamespaceDisruptionQuotas(t *testing.T) {
+	ctx := context.Background()
+	inNamespace := func(namespace string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Namespace = namespace
+		}
+	}
+
+	pods := []*v1.Pod{
+		test.BuildTestPod("a-1", 100, 0, "n1", inNamespace("prod-a")),
+		test.BuildTestPod("a-2", 100, 0, "n1", inNamespace("prod-a")),
+		test.BuildTestPod("a-3", 100, 0, "n1", inNamespace("prod-a")),
+		test.BuildTestPod("b-1", 100, 0, "n1", inNamespace("prod-b")),
+		test.BuildTestPod("batch-1", 100, 0, "n1", inNamespace("batch")),
+		test.BuildTestPod("batch-2", 100, 0, "n1", inNamespace("batch")),
+		test.BuildTestPod("batch-3", 100, 0, "n1", inNamespace("batch")),
+	}
+	var objs []runtime.Object
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithNamespaceDisruptionQuotas([]api.NamespaceDisruptionQuota{
+			{Namespaces: []string{"prod-*"}, MaxEvictions: 2, Period: metav1.Duration{Duration: 2 * time.Hour}},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	if retention := podEvictor.RecentEvictions().retention; retention != 2*time.Hour {
+		t.Errorf("Expected the recent evictions to be kept for the quota period, got %v", retention)
+	}
+
+	// The evictions older than the period do not count against the quota
+	for i := 0; i < 2; i++ {
+		podEvictor.RecentEvictions().Add(RecentEviction{Namespace: "prod-b", Name: fmt.Sprintf("old-%d", i), Time: time.Now().Add(-3 * time.Hour)})
+	}
+
+	expectedErrors := []error{nil, nil, NewEvictionNamespaceQuotaError("prod-a"), nil, nil, nil, nil}
+	for i, pod := range pods {
+		err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+		if !reflect.DeepEqual(err, expectedErrors[i]) {
+			t.Errorf("Expected error %v when evicting %v, got %v", expectedErrors[i], pod.Name, err)
+		}
+	}
+
+	// The quotas span the descheduling cycles
+	podEvictor.ResetCounters()
+	if err := podEvictor.EvictPod(ctx, pods[2], EvictOptions{}); !reflect.DeepEqual(err, NewEvictionNamespaceQuotaError("prod-a")) {
+		t.Errorf("Expected the namespace quota to be kept across the cycles, got %v", err)
+	}
+}
+
+func TestNodesInCooldown(t *testing.T) {
+	ctx := context.Background()
+
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n2", nil)
+
+	fakeClient := fake.NewSimpleClientset(p1, p2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions(),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	podEvictor.SetNodesInCooldown(sets.New("n1"))
+
+	if err := podEvictor.EvictPod(ctx, p1, EvictOptions{}); !reflect.DeepEqual(err, NewEvictionNodeCooldownError("n1")) {
+		t.Errorf("Expected node cool-down error for a node in cool-down, got %v", err)
+	}
+	if err := podEvictor.EvictPod(ctx, p2, EvictOptions{}); err != nil {
+		t.Errorf("Expected pod to be evicted from a node not in cool-down, got %v", err)
+	}
+	if evictions := podEvictor.TotalEvicted(); evictions != 1 {
+		t.Errorf("Expected 1 total eviction, got %d instead", evictions)
+	}
+}
+
+func TestEvictPodWithClient(t *testing.T) {
+	ctx := context.Background()
+
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset(p1, p2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions(),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	profileClient := fake.NewSimpleClientset(p1, p2)
+	if err := podEvictor.EvictPod(ctx, p1, EvictOptions{Client: profileClient}); err != nil {
+		t.Fatalf("Expected the pod to be evicted, got %v", err)
+	}
+	if err := podEvictor.EvictPod(ctx, p2, EvictOptions{}); err != nil {
+		t.Fatalf("Expected the pod to be evicted, got %v", err)
+	}
+
+	evictedBy := func(client *fake.Clientset) []string {
+		var evicted []string
+		for _, action := range client.Actions() {
+			if action.GetSubresource() == "eviction" {
+				evicted = append(evicted, action.(core.CreateAction).GetObject().(*policy.Eviction).Name)
+			}
+		}
+		return evicted
+	}
+	if evicted := evictedBy(profileClient); !reflect.DeepEqual(evicted, []string{"p1"}) {
+		t.Errorf("Expected p1 to be evicted with the client of the options, got %v", evicted)
+	}
+	if evicted := evictedBy(fakeClient); !reflect.DeepEqual(evicted, []string{"p2"}) {
+		t.Errorf("Expected p2 to be evicted with the client of the evictor, got %v", evicted)
+	}
+}
+
+func TestEvictPodSoftEviction(t *testing.T) {
+	ctx := context.Background()
+
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset(p1, p2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions(),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	// The disruption of p1 is requested, p2 is left to the Eviction API
+	var requested []string
+	softEviction := func(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error) {
+		if client != clientset.Interface(fakeClient) {
+			t.Errorf("Expected the disruption requested with the client of the evictor")
+		}
+		if pod.Name != "p1" {
+			return false, nil
+		}
+		requested = append(requested, pod.Name)
+		return true, nil
+	}
+	for _, pod := range []*v1.Pod{p1, p2} {
+		if err := podEvictor.EvictPod(ctx, pod, EvictOptions{SoftEviction: softEviction}); err != nil {
+			t.Fatalf("Expected the pod %v to be evicted, got %v", pod.Name, err)
+		}
+	}
+
+	var evicted []string
+	for _, action := range fakeClient.Actions() {
+		if action.GetSubresource() == "eviction" {
+			evicted = append(evicted, action.(core.CreateAction).GetObject().(*policy.Eviction).Name)
+		}
+	}
+	if !reflect.DeepEqual(requested, []string{"p1"}) {
+		t.Errorf("Expected the disruption of p1 to be requested, got %v", requested)
+	}
+	if !reflect.DeepEqual(evicted, []string{"p2"}) {
+		t.Errorf("Expected p2 only to be evicted through the Eviction API, got %v", evicted)
+	}
+	if total := podEvictor.TotalEvicted(); total != 2 {
+		t.Errorf("Expected both pods counted as evicted, got %v", total)
+	}
+}
+
+func TestEvictPodPreEvictionRevert(t *testing.T) {
+	ctx := context.Background()
+
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+	p3 := test.BuildTestPod("p3", 100, 0, "n2", nil)
+
+	fakeClient := fake.NewSimpleClientset(p1, p2, p3)
+	// p3 is protected by a PodDisruptionBudget
+	fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "eviction" && action.(core.CreateAction).GetObject().(*policy.Eviction).Name == "p3" {
+			return true, nil, apierrors.NewTooManyRequests("pod disruption budget", 0)
+		}
+		return false, nil, nil
+	})
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithMaxPodsToEvictPerNode(utilptr.To[uint](1)),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	var prepared, reverted []string
+	var opts EvictOptions
+	opts = EvictOptions{
+		PreEvictionHook: func(ctx context.Context, pod *v1.Pod) error {
+			prepared = append(prepared, pod.Name)
+			// The pod is prepared without holding the lock, p2 is evicted meanwhile and uses up the limit of n1
+			if pod.Name == "p1" {
+				if err := podEvictor.EvictPod(ctx, p2, opts); err != nil {
+					t.Errorf("Expected p2 to be evicted while p1 is prepared, got %v", err)
+				}
+			}
+			return nil
+		},
+		PreEvictionRevert: func(ctx context.Context, pod *v1.Pod) {
+			reverted = append(reverted, pod.Name)
+		},
+	}
+	if err := podEvictor.EvictPod(ctx, p1, opts); err == nil {
+		t.Errorf("Expected p1 not to be evicted once the node limit is used up during its preparation")
+	}
+	if err := podEvictor.EvictPod(ctx, p3, opts); err == nil {
+		t.Errorf("Expected the eviction of p3 to be refused")
+	}
+
+	if !reflect.DeepEqual(prepared, []string{"p1", "p2", "p3"}) {
+		t.Errorf("Expected p1, p2 and p3 prepared, got %v", prepared)
+	}
+	if !reflect.DeepEqual(reverted, []string{"p1", "p3"}) {
+		t.Errorf("Expected the preparations of p1 and p3 not evicted to be reverted, got %v", reverted)
+	}
+	if total := podEvictor.TotalEvicted(); total != 1 {
+		t.Errorf("Expected p2 only to be evicted, got %v", total)
+	}
+}
+
 func TestEvictionRequestsCacheCleanup(t *testing.T) {
 	ctx := context.Background()
 	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
@@ -482,3 +970,55 @@ func assertEqualEvents(t *testing.T, expected []string, actual <-chan string) {
 		}
 	}
 }
+
+func TestEvictPodOwnerLimit(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+	a1 := test.BuildTestPod("a1", 100, 0, "n1", ownedBy("a"))
+	a2 := test.BuildTestPod("a2", 100, 0, "n2", ownedBy("a"))
+	b1 := test.BuildTestPod("b1", 100, 0, "n1", ownedBy("b"))
+	standalone1 := test.BuildTestPod("standalone1", 100, 0, "n1", nil)
+	standalone2 := test.BuildTestPod("standalone2", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset(a1, a2, b1, standalone1, standalone2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithMaxPodsToEvictPerOwner(utilptr.To[uint](1)),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	evict := func(pod *v1.Pod) error {
+		return podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "RemoveDuplicates"})
+	}
+	if err := evict(a1); err != nil {
+		t.Fatalf("Unexpected error when evicting a1: %v", err)
+	}
+	if err := evict(a2); !reflect.DeepEqual(err, NewEvictionOwnerLimitError("default/ReplicaSet/a")) {
+		t.Errorf("Expected the eviction of a2 to fail on the owner limit, got %v", err)
+	}
+	if err := evict(b1); err != nil {
+		t.Errorf("Unexpected error when evicting a pod of another owner: %v", err)
+	}
+	// The pods without an owner share no owner
+	for _, pod := range []*v1.Pod{standalone1, standalone2} {
+		if err := evict(pod); err != nil {
+			t.Errorf("Unexpected error when evicting %v: %v", pod.Name, err)
+		}
+	}
+
+	podEvictor.ResetCounters()
+	if err := evict(a2); err != nil {
+		t.Errorf("Unexpected error when evicting a2 in the next cycle: %v", err)
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+)
+
+// groupKey returns the key of the gang all the pods of the group are members of, or of the owner
+// all the pods of the group share
+func groupKey(pods []*v1.Pod) (string, error) {
+	if gang := podutil.PodGroupName(pods[0]); gang != "" {
+		key := gangKey(pods[0].Namespace, gang)
+		sameGang := true
+		for _, pod := range pods[1:] {
+			sameGang = sameGang && gangKey(pod.Namespace, podutil.PodGroupName(pod)) == key
+		}
+		if sameGang {
+			return key, nil
+		}
+	}
+
+	var key string
+	for _, pod := range pods {
+		owner := podOwner(pod)
+		if owner == nil {
+			return "", fmt.Errorf("pod %v of the group has no owner", klog.KObj(pod))
+		}
+		if len(key) == 0 {
+			key = ownerKey(pod.Namespace, owner)
+		} else if podKey := ownerKey(pod.Namespace, owner); podKey != key {
+			return "", fmt.Errorf("pod %v of the group is owned by %v instead of %v", klog.KObj(pod), podKey, key)
+		}
+	}
+	return key, nil
+}
+
+// checkGroup checks the pods of the group against the eviction limits, the exemptions and the veto as
+// a whole, i.e. the pods of the group count towards the limits together. Returns the error the eviction
+// of a pod of the group would fail with, nil when all the pods can be evicted.
+func (pe *PodEvictor) checkGroup(pods []*v1.Pod, opts EvictOptions) error {
+	if pe.circuitBreaker.isOpen() {
+		return NewEvictionCircuitBreakerError()
+	}
+	if pe.maxPodsToEvictTotal != nil && pe.totalPodCount+pe.evictionRequestsTotal()+uint(len(pods)) > *pe.maxPodsToEvictTotal {
+		return NewEvictionTotalLimitError()
+	}
+
+	nodePods := map[string]uint{}
+	domainPods := map[string]uint{}
+	topologyDomainPods := map[string]uint{}
+	fairnessPods := map[string]uint{}
+	namespacePods := map[string]uint{}
+	ownerPods := map[string]uint{}
+	for _, pod := range pods {
+		if len(pod.UID) == 0 {
+			return fmt.Errorf("Pod %v is missing UID", klog.KObj(pod))
+		}
+		if exemption := pe.exemption(pod); exemption != nil {
+			return NewEvictionExemptedError(exemption.Name)
+		}
+		if gang := podutil.PodGroupName(pod); gang != "" && pe.gangMembersPolicy() == api.GangMembersSkip {
+			return NewEvictionGangMemberError(gang)
+		}
+		if pod.Spec.NodeName != "" {
+			if pe.drainedNodes.Has(pod.Spec.NodeName) {
+				return NewEvictionNodeDrainedError(pod.Spec.NodeName)
+			}
+			if pe.nodesInCooldown.Has(pod.Spec.NodeName) {
+				return NewEvictionNodeCooldownError(pod.Spec.NodeName)
+			}
+			nodePods[pod.Spec.NodeName]++
+		}
+		if domain, ok := pe.nodeDomains[pod.Spec.NodeName]; ok {
+			domainPods[domain]++
+		}
+		if domain, ok := pe.topologyDomains[pod.Spec.NodeName]; ok {
+			topologyDomainPods[domain]++
+		}
+		if pe.fairnessLimits != nil {
+			fairnessPods[pe.fairnessUnit(pod)]++
+		}
+		namespacePods[pod.Namespace]++
+		if owner := podOwnerKey(pod); owner != "" {
+			ownerPods[owner]++
+		}
+		if err := pe.evictionVeto.Evaluate(pod, opts); err != nil {
+			return err
+		}
+	}
+
+	for node, count := range nodePods {
+		if pe.maxPodsToEvictPerNode != nil && pe.nodePodCount[node]+pe.evictionRequestsPerNode(node)+count > *pe.maxPodsToEvictPerNode {
+			return NewEvictionNodeLimitError(node)
+		}
+	}
+	for domain, count := range domainPods {
+		if pe.domainPodCount[domain]+pe.evictionRequestsPerDomain(pe.nodeDomains, domain)+count > pe.domainLimits[domain] {
+			return NewEvictionTopologyDomainLimitError(domain)
+		}
+	}
+	for domain, count := range topologyDomainPods {
+		if pe.topologyDomainPodCount[domain]+pe.evictionRequestsPerDomain(pe.topologyDomains, domain)+count > *pe.maxPodsToEvictPerTopologyDomain {
+			return NewEvictionTopologyDomainLimitError(domain)
+		}
+	}
+	for unit, count := range fairnessPods {
+		if limit, ok := pe.fairnessLimits[unit]; ok && pe.fairnessPodCount[unit]+count > limit {
+			return NewEvictionFairShareLimitError(unit)
+		}
+	}
+	for namespace, count := range namespacePods {
+		if pe.maxPodsToEvictPerNamespace != nil && pe.namespacePodCount[namespace]+pe.evictionRequestsPerNamespace(namespace)+count > *pe.maxPodsToEvictPerNamespace {
+			return NewEvictionNamespaceLimitError(namespace)
+		}
+		if quota, ok := pe.namespaceQuota(namespace); ok && pe.namespaceEvictedSince(namespace, time.Now().Add(-quota.period))+count > quota.maxEvictions {
+			return NewEvictionNamespaceQuotaError(namespace)
+		}
+	}
+	for owner, count := range ownerPods {
+		if pe.maxPodsToEvictPerOwner != nil && pe.ownerPodCount[owner]+count > *pe.maxPodsToEvictPerOwner {
+			return NewEvictionOwnerLimitError(owner)
+		}
+	}
+	return nil
+}
+
+// EvictGroup evicts the pods of a single owner or gang as a group, e.g. the pods of a co-scheduled gang
+// workload a partial eviction of is worse than none. The pods are first checked against the eviction limits, the
+// exemptions and the veto together and none is evicted unless all of them pass. The pods are then evicted
+// one after another from the lowest deletion cost, pacing apart. The evictions stop at the first failure, the evictions done already
+// are not undone, e.g. when the lease of a node is held by another controller or a PodDisruptionBudget
+// refuses an eviction. Returns the number of the pods evicted.
+func (pe *PodEvictor) EvictGroup(ctx context.Context, pods []*v1.Pod, opts EvictOptions, pacing time.Duration) (int, error) {
+	if len(pods) == 0 {
+		return 0, nil
+	}
+	key, err := groupKey(pods)
+	if err != nil {
+		return 0, err
+	}
+	return pe.evictGroup(ctx, key, pods, opts, pacing)
+}
+
+// evictGroup checks and evicts the pods of the group identified by the key
+func (pe *PodEvictor) evictGroup(ctx context.Context, group string, pods []*v1.Pod, opts EvictOptions, pacing time.Duration) (int, error) {
+	pe.mu.RLock()
+	err := pe.checkGroup(pods, opts)
+	pe.mu.RUnlock()
+	if err != nil {
+		klog.V(2).InfoS("Group eviction refused, no pods of the group evicted", "group", group, "pods", len(pods), "err", err, "strategy", opts.StrategyName, "profile", opts.ProfileName)
+		return 0, err
+	}
+
+	pods = append([]*v1.Pod(nil), pods...)
+	podutil.SortPodsBasedOnDeletionCost(pods)
+	for i, pod := range pods {
+		if i > 0 && pacing > 0 {
+			select {
+			case <-ctx.Done():
+				return i, ctx.Err()
+			case <-time.After(pacing):
+			}
+		}
+		if err := pe.evictSinglePod(ctx, pod, opts); err != nil {
+			klog.ErrorS(err, "Group eviction stopped", "group", group, "evicted", i, "pods", len(pods))
+			return i, fmt.Errorf("group eviction of %v stopped after %d of %d pods: %w", group, i, len(pods), err)
+		}
+	}
+	klog.V(1).InfoS("Evicted the pods of the group", "group", group, "pods", len(pods))
+	return len(pods), nil
+}
+
+// gangKey identifies the gang of the namespace
+func gangKey(namespace, gang string) string {
+	return namespace + "/PodGroup/" + gang
+}
+
+// gangMembersPolicy returns the policy for the members of the gangs, none when the gangs are ignored
+func (pe *PodEvictor) gangMembersPolicy() api.GangMembersPolicy {
+	if pe.gangScheduling == nil {
+		return ""
+	}
+	return pe.gangScheduling.Members
+}
+
+// gangMembers lists the members of the gang known to the pod informer, sorted by name, the pod
+// included. The members terminating or terminated are left out.
+func (pe *PodEvictor) gangMembers(pod *v1.Pod, gang string) []*v1.Pod {
+	members := []*v1.Pod{pod}
+	if pe.podIndexer == nil {
+		return members
+	}
+	objs, err := pe.podIndexer.ByIndex(cache.NamespaceIndex, pod.Namespace)
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the members of the gang", "gang", klog.KRef(pod.Namespace, gang))
+		return members
+	}
+	for _, obj := range objs {
+		member, ok := obj.(*v1.Pod)
+		if !ok || member.UID == pod.UID || podutil.PodGroupName(member) != gang {
+			continue
+		}
+		if member.DeletionTimestamp != nil || member.Status.Phase == v1.PodSucceeded || member.Status.Phase == v1.PodFailed {
+			continue
+		}
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+	return members
+}
+
+// evictGang evicts all the members of the gang of the pod together. A gang is evicted once per
+// descheduling cycle, the later evictions of its members are no-ops.
+func (pe *PodEvictor) evictGang(ctx context.Context, pod *v1.Pod, gang string, opts EvictOptions) error {
+	key := gangKey(pod.Namespace, gang)
+	pe.mu.RLock()
+	evicted := pe.evictedGangs.Has(key)
+	pe.mu.RUnlock()
+	if evicted {
+		klog.V(3).InfoS("Gang of the pod evicted already", "pod", klog.KObj(pod), "gang", klog.KRef(pod.Namespace, gang))
+		return nil
+	}
+
+	var pacing time.Duration
+	if pe.gangScheduling.Pacing != nil {
+		pacing = pe.gangScheduling.Pacing.Duration
+	}
+	members := pe.gangMembers(pod, gang)
+	for _, member := range members {
+		if member != pod && opts.GroupFilter != nil && !opts.GroupFilter(member) {
+			klog.V(2).InfoS("Gang eviction refused, a member of the gang can not be evicted", "pod", klog.KObj(pod), "gang", klog.KRef(pod.Namespace, gang), "member", klog.KObj(member))
+			return fmt.Errorf("member %v of gang %v can not be evicted", klog.KObj(member), gang)
+		}
+	}
+	count, err := pe.evictGroup(ctx, key, members, opts, pacing)
+	if count > 0 {
+		pe.mu.Lock()
+		pe.evictedGangs.Insert(key)
+		pe.mu.Unlock()
+	}
+	return err
+}
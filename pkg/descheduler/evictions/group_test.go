@@ -0,0 +1,322 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestEvictGroup(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+	gang1 := test.BuildTestPod("gang-1", 100, 0, "n1", ownedBy("gang"))
+	gang2 := test.BuildTestPod("gang-2", 100, 0, "n1", ownedBy("gang"))
+	gang3 := test.BuildTestPod("gang-3", 100, 0, "n2", ownedBy("gang"))
+	other := test.BuildTestPod("other", 100, 0, "n2", ownedBy("other"))
+	standalone := test.BuildTestPod("standalone", 100, 0, "n2", nil)
+
+	tests := []struct {
+		description     string
+		pods            []*v1.Pod
+		options         *Options
+		expectedEvicted int
+		expectedErr     error
+	}{
+		{
+			description:     "all the pods of the group are evicted",
+			pods:            []*v1.Pod{gang1, gang2, gang3},
+			options:         NewOptions().WithMaxPodsToEvictTotal(utilptr.To[uint](3)),
+			expectedEvicted: 3,
+		},
+		{
+			description:     "the total limit refuses the whole group",
+			pods:            []*v1.Pod{gang1, gang2, gang3},
+			options:         NewOptions().WithMaxPodsToEvictTotal(utilptr.To[uint](2)),
+			expectedEvicted: 0,
+			expectedErr:     NewEvictionTotalLimitError(),
+		},
+		{
+			description:     "the node limit refuses the whole group",
+			pods:            []*v1.Pod{gang3, gang1, gang2},
+			options:         NewOptions().WithMaxPodsToEvictPerNode(utilptr.To[uint](1)),
+			expectedEvicted: 0,
+			expectedErr:     NewEvictionNodeLimitError("n1"),
+		},
+		{
+			description:     "the namespace limit refuses the whole group",
+			pods:            []*v1.Pod{gang1, gang2, gang3},
+			options:         NewOptions().WithMaxPodsToEvictPerNamespace(utilptr.To[uint](2)),
+			expectedEvicted: 0,
+			expectedErr:     NewEvictionNamespaceLimitError("default"),
+		},
+		{
+			description:     "the owner limit refuses the whole group",
+			pods:            []*v1.Pod{gang1, gang2, gang3},
+			options:         NewOptions().WithMaxPodsToEvictPerOwner(utilptr.To[uint](2)),
+			expectedEvicted: 0,
+			expectedErr:     NewEvictionOwnerLimitError("default/ReplicaSet/gang"),
+		},
+		{
+			description:     "pods of different owners are refused",
+			pods:            []*v1.Pod{gang1, other},
+			options:         NewOptions(),
+			expectedEvicted: 0,
+			expectedErr:     errors.New("pod default/other of the group is owned by default/ReplicaSet/other instead of default/ReplicaSet/gang"),
+		},
+		{
+			description:     "pods without an owner are refused",
+			pods:            []*v1.Pod{standalone},
+			options:         NewOptions(),
+			expectedEvicted: 0,
+			expectedErr:     errors.New("pod default/standalone of the group has no owner"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			objs := []runtime.Object{}
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+			podEvictor, err := NewPodEvictor(ctx, fakeClient, events.NewFakeRecorder(100), podInformer, initFeatureGates(), tc.options)
+			if err != nil {
+				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+			}
+
+			evicted, err := podEvictor.EvictGroup(ctx, tc.pods, EvictOptions{StrategyName: "GangPlugin"}, 0)
+			if evicted != tc.expectedEvicted {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, evicted)
+			}
+			if uint(evicted) != podEvictor.TotalEvicted() {
+				t.Errorf("Expected %v pods counted as evicted, got %v", evicted, podEvictor.TotalEvicted())
+			}
+			if (err == nil) != (tc.expectedErr == nil) || (err != nil && err.Error() != tc.expectedErr.Error()) {
+				t.Errorf("Expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestEvictGroupPacing(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(pod *v1.Pod) {
+		pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: "gang", Controller: utilptr.To(true)}}
+	}
+	pods := []*v1.Pod{
+		test.BuildTestPod("gang-1", 100, 0, "n1", ownedBy),
+		test.BuildTestPod("gang-2", 100, 0, "n1", ownedBy),
+		test.BuildTestPod("gang-3", 100, 0, "n1", ownedBy),
+	}
+	fakeClient := fake.NewSimpleClientset(pods[0], pods[1], pods[2])
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	podEvictor, err := NewPodEvictor(ctx, fakeClient, events.NewFakeRecorder(100), podInformer, initFeatureGates(), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	pacing := 20 * time.Millisecond
+	start := time.Now()
+	if _, err := podEvictor.EvictGroup(ctx, pods, EvictOptions{StrategyName: "GangPlugin"}, pacing); err != nil {
+		t.Fatalf("Unexpected error when evicting the group: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 2*pacing {
+		t.Errorf("Expected the evictions paced at least %v apart, the group got evicted in %v", pacing, elapsed)
+	}
+
+	// The pacing is interrupted with the context
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	evicted, err := podEvictor.EvictGroup(cancelledCtx, pods, EvictOptions{StrategyName: "GangPlugin"}, time.Hour)
+	if evicted != 1 || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected one pod evicted before the cancellation, got %v pods evicted and %v", evicted, err)
+	}
+}
+
+func TestEvictGroupDeletionCost(t *testing.T) {
+	ctx := context.Background()
+	withCost := func(cost string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: "gang", Controller: utilptr.To(true)}}
+			if cost != "" {
+				pod.Annotations = map[string]string{podutil.PodDeletionCostAnnotationKey: cost}
+			}
+		}
+	}
+	pods := []*v1.Pod{
+		test.BuildTestPod("gang-1", 100, 0, "n1", withCost("10")),
+		test.BuildTestPod("gang-2", 100, 0, "n1", withCost("")),
+		test.BuildTestPod("gang-3", 100, 0, "n1", withCost("-1")),
+	}
+	fakeClient := fake.NewSimpleClientset(pods[0], pods[1], pods[2])
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	podEvictor, err := NewPodEvictor(ctx, fakeClient, events.NewFakeRecorder(100), podInformer, initFeatureGates(), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	if _, err := podEvictor.EvictGroup(ctx, pods, EvictOptions{StrategyName: "GangPlugin"}, 0); err != nil {
+		t.Fatalf("Unexpected error when evicting the group: %v", err)
+	}
+
+	var evicted []string
+	for _, action := range fakeClient.Actions() {
+		if action.GetSubresource() == "eviction" {
+			evicted = append(evicted, action.(core.CreateAction).GetObject().(*policy.Eviction).Name)
+		}
+	}
+	if !reflect.DeepEqual(evicted, []string{"gang-3", "gang-2", "gang-1"}) {
+		t.Errorf("Expected the pods evicted from the lowest deletion cost, got %v", evicted)
+	}
+	if pods[0].Name != "gang-1" {
+		t.Errorf("Expected the pods of the caller left in their order, got %v first", pods[0].Name)
+	}
+}
+
+func TestEvictGang(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	memberOf := func(gang string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Labels = map[string]string{podutil.PodGroupLabelKey: gang}
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", APIVersion: "batch/v1", Name: gang, Controller: utilptr.To(true)}}
+		}
+	}
+	trainer1 := test.BuildTestPod("trainer-1", 100, 0, "n1", memberOf("trainer"))
+	trainer2 := test.BuildTestPod("trainer-2", 100, 0, "n2", memberOf("trainer"))
+	trainer3 := test.BuildTestPod("trainer-3", 100, 0, "n3", memberOf("trainer"))
+	solver1 := test.BuildTestPod("solver-1", 100, 0, "n1", memberOf("solver"))
+	web := test.BuildTestPod("web", 100, 0, "n1", test.SetRSOwnerRef)
+
+	tests := []struct {
+		description     string
+		gangScheduling  *api.GangScheduling
+		options         *Options
+		pod             *v1.Pod
+		groupFilter     func(pod *v1.Pod) bool
+		expectedEvicted uint
+		expectedErr     bool
+	}{
+		{
+			description:     "the members of the gangs are evicted alone without gang scheduling",
+			pod:             trainer1,
+			expectedEvicted: 1,
+		},
+		{
+			description:     "the whole gang is evicted together",
+			gangScheduling:  &api.GangScheduling{Members: api.GangMembersEvictTogether},
+			pod:             trainer2,
+			expectedEvicted: 3,
+		},
+		{
+			description:     "the pods outside of the gangs are evicted alone",
+			gangScheduling:  &api.GangScheduling{Members: api.GangMembersEvictTogether},
+			pod:             web,
+			expectedEvicted: 1,
+		},
+		{
+			description:     "no member is evicted when the limits do not allow the whole gang",
+			gangScheduling:  &api.GangScheduling{Members: api.GangMembersEvictTogether},
+			options:         NewOptions().WithMaxPodsToEvictTotal(utilptr.To[uint](2)),
+			pod:             trainer1,
+			expectedEvicted: 0,
+			expectedErr:     true,
+		},
+		{
+			description:    "no member is evicted when a member does not pass the filters",
+			gangScheduling: &api.GangScheduling{Members: api.GangMembersEvictTogether},
+			pod:            trainer1,
+			groupFilter: func(pod *v1.Pod) bool {
+				return pod.Name != trainer3.Name
+			},
+			expectedEvicted: 0,
+			expectedErr:     true,
+		},
+		{
+			description:     "the members of the gangs are skipped",
+			gangScheduling:  &api.GangScheduling{Members: api.GangMembersSkip},
+			pod:             solver1,
+			expectedEvicted: 0,
+			expectedErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset(trainer1, trainer2, trainer3, solver1, web)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			options := tc.options
+			if options == nil {
+				options = NewOptions()
+			}
+			podEvictor, err := NewPodEvictor(ctx, fakeClient, events.NewFakeRecorder(100), podInformer, initFeatureGates(), options.WithGangScheduling(tc.gangScheduling))
+			if err != nil {
+				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+			}
+
+			err = podEvictor.EvictPod(ctx, tc.pod, EvictOptions{StrategyName: "LowNodeUtilization", GroupFilter: tc.groupFilter})
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("Unexpected eviction error: %v", err)
+			}
+			if podEvictor.TotalEvicted() != tc.expectedEvicted {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, podEvictor.TotalEvicted())
+			}
+
+			// The gang is evicted once per cycle
+			if tc.expectedEvicted > 1 {
+				if err := podEvictor.EvictPod(ctx, trainer3, EvictOptions{StrategyName: "LowNodeUtilization"}); err != nil {
+					t.Errorf("Unexpected error when evicting a member of the gang evicted already: %v", err)
+				}
+				if podEvictor.TotalEvicted() != tc.expectedEvicted {
+					t.Errorf("Expected the gang evicted once, got %v pods evicted", podEvictor.TotalEvicted())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// PreferredNodesAnnotationKey lists the nodes the replacement pods of the owner are preferably scheduled to
+	PreferredNodesAnnotationKey = "descheduler.alpha.kubernetes.io/preferred-nodes"
+	// PreferredNodesTimestampAnnotationKey is the time the preferred nodes of the owner were hinted at
+	PreferredNodesTimestampAnnotationKey = "descheduler.alpha.kubernetes.io/preferred-nodes-timestamp"
+	// DefaultPreferredNodeHintsMaxNodes is the number of nodes a hint lists at most
+	DefaultPreferredNodeHintsMaxNodes = 10
+)
+
+// ownerHint accumulates the preferred nodes of the pods of an owner evicted during a cycle
+type ownerHint struct {
+	namespace string
+	owner     metav1.OwnerReference
+	nodes     sets.Set[string]
+}
+
+// preferredNodeHints collects the hints of a cycle, a nil preferredNodeHints hints nothing
+type preferredNodeHints struct {
+	mu       sync.Mutex
+	maxNodes int
+	owners   map[string]*ownerHint
+}
+
+func newPreferredNodeHints(config *api.PreferredNodeHints) *preferredNodeHints {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	return &preferredNodeHints{
+		maxNodes: int(ptr.Deref(config.MaxNodes, DefaultPreferredNodeHintsMaxNodes)),
+		owners:   make(map[string]*ownerHint),
+	}
+}
+
+// record adds the preferred nodes of the evicted pod to the hint of its owner
+func (h *preferredNodeHints) record(pod *v1.Pod, nodes []string) {
+	if h == nil || len(nodes) == 0 {
+		return
+	}
+	owner := podOwner(pod)
+	if owner == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	key := ownerKey(pod.Namespace, owner)
+	hint, ok := h.owners[key]
+	if !ok {
+		hint = &ownerHint{namespace: pod.Namespace, owner: *owner, nodes: sets.New[string]()}
+		h.owners[key] = hint
+	}
+	hint.nodes.Insert(nodes...)
+}
+
+// hintedNodes lists the nodes of the hint sorted by name, at most maxNodes of them
+func hintedNodes(nodes sets.Set[string], maxNodes int) string {
+	names := sets.List(nodes)
+	if len(names) > maxNodes {
+		names = names[:maxNodes]
+	}
+	return strings.Join(names, ",")
+}
+
+// patchOwner annotates the owner with the preferred nodes. Only the owners of a kind creating the
+// replacement pods themselves are annotated.
+func patchOwner(ctx context.Context, client clientset.Interface, hint *ownerHint, patch []byte) error {
+	name := hint.owner.Name
+	var err error
+	switch hint.owner.Kind {
+	case "ReplicaSet":
+		_, err = client.AppsV1().ReplicaSets(hint.namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	case "StatefulSet":
+		_, err = client.AppsV1().StatefulSets(hint.namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	case "ReplicationController":
+		_, err = client.CoreV1().ReplicationControllers(hint.namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	case "Job":
+		_, err = client.BatchV1().Jobs(hint.namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	default:
+		return fmt.Errorf("owner kind %q is not supported", hint.owner.Kind)
+	}
+	return err
+}
+
+// ApplyPreferredNodeHints annotates the owners of the pods evicted since the last call with the nodes
+// the plugins considered good targets for their replacement pods, e.g. "n2,n3".
+// No-op unless the preferred node hints are enabled.
+func (pe *PodEvictor) ApplyPreferredNodeHints(ctx context.Context, now time.Time) {
+	h := pe.preferredNodeHints
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	owners := h.owners
+	h.owners = make(map[string]*ownerHint)
+	h.mu.Unlock()
+
+	keys := make([]string, 0, len(owners))
+	for key := range owners {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		hint := owners[key]
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"annotations": map[string]string{
+					PreferredNodesAnnotationKey:          hintedNodes(hint.nodes, h.maxNodes),
+					PreferredNodesTimestampAnnotationKey: now.UTC().Format(time.RFC3339),
+				},
+			},
+		})
+		if err != nil {
+			klog.ErrorS(err, "Unable to build the preferred nodes patch", "owner", key)
+			continue
+		}
+		if err := patchOwner(ctx, pe.client, hint, patch); err != nil {
+			klog.V(2).InfoS("Unable to hint the preferred nodes of the owner", "owner", key, "err", err)
+			continue
+		}
+		klog.V(3).InfoS("Hinted the preferred nodes of the owner", "owner", key, "nodes", hintedNodes(hint.nodes, h.maxNodes))
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestPreferredNodeHints(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(kind, owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: kind, APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+	web := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	web1 := test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("ReplicaSet", "web"))
+	web2 := test.BuildTestPod("web-2", 100, 0, "n1", ownedBy("ReplicaSet", "web"))
+	agent := test.BuildTestPod("agent", 100, 0, "n1", ownedBy("DaemonSet", "agent"))
+	standalone := test.BuildTestPod("standalone", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset(web, web1, web2, agent, standalone)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithPreferredNodeHints(&api.PreferredNodeHints{Enabled: true, MaxNodes: utilptr.To[uint](2)}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	for pod, nodes := range map[*v1.Pod][]string{
+		web1:       {"n4", "n2"},
+		web2:       {"n3"},
+		agent:      {"n2"},
+		standalone: {"n2"},
+	} {
+		if err := podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "LowNodeUtilization", PreferredNodes: nodes}); err != nil {
+			t.Fatalf("Unexpected error when evicting %v: %v", pod.Name, err)
+		}
+	}
+
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	podEvictor.ApplyPreferredNodeHints(ctx, now)
+
+	rs, err := fakeClient.AppsV1().ReplicaSets("default").Get(ctx, "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting the replica set: %v", err)
+	}
+	if nodes := rs.Annotations[PreferredNodesAnnotationKey]; nodes != "n2,n3" {
+		t.Errorf("Expected the preferred nodes %q, got %q", "n2,n3", nodes)
+	}
+	if timestamp := rs.Annotations[PreferredNodesTimestampAnnotationKey]; timestamp != "2026-10-15T12:00:00Z" {
+		t.Errorf("Expected the preferred nodes timestamp %q, got %q", "2026-10-15T12:00:00Z", timestamp)
+	}
+
+	// The hints are applied once
+	if err := fakeClient.AppsV1().ReplicaSets("default").Delete(ctx, "web", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Unexpected error when deleting the replica set: %v", err)
+	}
+	fakeClient.ClearActions()
+	podEvictor.ApplyPreferredNodeHints(ctx, now.Add(time.Minute))
+	if actions := fakeClient.Actions(); len(actions) != 0 {
+		t.Errorf("Expected no hints applied twice, got %v", actions)
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// DefaultNodeLeaseDuration is the duration of the node leases when not configured
+	DefaultNodeLeaseDuration = 2 * time.Minute
+	// DefaultNodeLeaseHolderIdentity is the identity the descheduler holds the node leases as when not configured
+	DefaultNodeLeaseHolderIdentity = "descheduler"
+)
+
+// NodeLeaseHolderIdentity returns the identity the descheduler holds the node leases as
+func NodeLeaseHolderIdentity(nodeLeases *api.NodeLeases) string {
+	if nodeLeases == nil || nodeLeases.HolderIdentity == "" {
+		return DefaultNodeLeaseHolderIdentity
+	}
+	return nodeLeases.HolderIdentity
+}
+
+// LeaseHolder returns the holder of the lease, empty when the lease has no holder or is expired.
+// A lease without the renew time or the duration does not expire.
+func LeaseHolder(lease *coordinationv1.Lease, now time.Time) string {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" {
+		return ""
+	}
+	renewTime := lease.Spec.RenewTime
+	if renewTime == nil {
+		renewTime = lease.Spec.AcquireTime
+	}
+	if renewTime != nil && lease.Spec.LeaseDurationSeconds != nil && renewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds)*time.Second).Before(now) {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+// nodeLeases acquires the leases of the nodes the pods are evicted from.
+// In the dry run mode the leases are checked but never acquired.
+type nodeLeases struct {
+	client    clientset.Interface
+	namespace string
+	duration  time.Duration
+	holder    string
+	dryRun    bool
+	// acquired keeps the renew time of the leases acquired in the current cycle
+	acquired map[string]time.Time
+	// held keeps the holders of the leases held by other controllers in the current cycle
+	held map[string]string
+}
+
+func newNodeLeases(client clientset.Interface, config *api.NodeLeases, dryRun bool) *nodeLeases {
+	if config == nil {
+		return nil
+	}
+	duration := config.LeaseDuration.Duration
+	if duration == 0 {
+		duration = DefaultNodeLeaseDuration
+	}
+	return &nodeLeases{
+		client:    client,
+		namespace: config.Namespace,
+		duration:  duration,
+		holder:    NodeLeaseHolderIdentity(config),
+		dryRun:    dryRun,
+		acquired:  make(map[string]time.Time),
+		held:      make(map[string]string),
+	}
+}
+
+// acquire acquires or renews the lease of the node. A lease held by another controller is
+// not checked again until the next cycle.
+func (l *nodeLeases) acquire(ctx context.Context, node string, now time.Time) error {
+	if l == nil {
+		return nil
+	}
+	if holder, ok := l.held[node]; ok {
+		return NewEvictionNodeLeaseError(node, holder)
+	}
+	// Renewed once half of the duration passed
+	if renewTime, ok := l.acquired[node]; ok && now.Sub(renewTime) < l.duration/2 {
+		return nil
+	}
+
+	lease, err := l.client.CoordinationV1().Leases(l.namespace).Get(ctx, node, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get the lease of node %v: %v", node, err)
+		}
+		if !l.dryRun {
+			lease = &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: node, Namespace: l.namespace},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       utilptr.To(l.holder),
+					LeaseDurationSeconds: utilptr.To(int32(l.duration.Seconds())),
+					AcquireTime:          &metav1.MicroTime{Time: now},
+					RenewTime:            &metav1.MicroTime{Time: now},
+				},
+			}
+			if _, err := l.client.CoordinationV1().Leases(l.namespace).Create(ctx, lease, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("unable to create the lease of node %v: %v", node, err)
+			}
+		}
+		l.acquired[node] = now
+		return nil
+	}
+
+	if holder := LeaseHolder(lease, now); holder != "" && holder != l.holder {
+		l.held[node] = holder
+		return NewEvictionNodeLeaseError(node, holder)
+	}
+	if !l.dryRun {
+		if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != l.holder {
+			lease.Spec.HolderIdentity = utilptr.To(l.holder)
+			lease.Spec.AcquireTime = &metav1.MicroTime{Time: now}
+			lease.Spec.LeaseTransitions = utilptr.To(utilptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+		}
+		lease.Spec.LeaseDurationSeconds = utilptr.To(int32(l.duration.Seconds()))
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+		// A conflict means another controller updated the lease in the meantime
+		if _, err := l.client.CoordinationV1().Leases(l.namespace).Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("unable to update the lease of node %v: %v", node, err)
+		}
+	}
+	l.acquired[node] = now
+	return nil
+}
+
+// release releases the leases acquired in the current cycle and forgets the leases held by the other controllers
+func (l *nodeLeases) release(ctx context.Context) {
+	if l == nil {
+		return
+	}
+	if !l.dryRun {
+		for node := range l.acquired {
+			lease, err := l.client.CoordinationV1().Leases(l.namespace).Get(ctx, node, metav1.GetOptions{})
+			if err != nil {
+				klog.ErrorS(err, "Unable to release the node lease", "node", node)
+				continue
+			}
+			if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != l.holder {
+				continue
+			}
+			lease.Spec.HolderIdentity = nil
+			if _, err := l.client.CoordinationV1().Leases(l.namespace).Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+				klog.ErrorS(err, "Unable to release the node lease", "node", node)
+			}
+		}
+	}
+	l.acquired = make(map[string]time.Time)
+	l.held = make(map[string]string)
+}
+
+// ReleaseNodeLeases releases the leases of the nodes the pods got evicted from since the last call.
+// No-op unless the node leases are configured.
+func (pe *PodEvictor) ReleaseNodeLeases(ctx context.Context) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.nodeLeases.release(ctx)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestNodeLeases(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	nodeLease := func(node, holder string, renewTime time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: node, Namespace: "node-leases"},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       utilptr.To(holder),
+				LeaseDurationSeconds: utilptr.To[int32](60),
+				RenewTime:            &metav1.MicroTime{Time: renewTime},
+			},
+		}
+	}
+
+	tests := []struct {
+		description    string
+		dryRun         bool
+		leases         []runtime.Object
+		expectedErr    error
+		expectedHolder *string
+	}{
+		{
+			description:    "missing lease is created",
+			expectedHolder: utilptr.To("descheduler"),
+		},
+		{
+			description:    "lease held by another controller blocks the evictions",
+			leases:         []runtime.Object{nodeLease("n1", "node-upgrader", now)},
+			expectedErr:    NewEvictionNodeLeaseError("n1", "node-upgrader"),
+			expectedHolder: utilptr.To("node-upgrader"),
+		},
+		{
+			description:    "expired lease is taken over",
+			leases:         []runtime.Object{nodeLease("n1", "node-upgrader", now.Add(-time.Hour))},
+			expectedHolder: utilptr.To("descheduler"),
+		},
+		{
+			description: "missing lease is not created in the dry run mode",
+			dryRun:      true,
+		},
+		{
+			description:    "lease held by another controller blocks the evictions in the dry run mode",
+			dryRun:         true,
+			leases:         []runtime.Object{nodeLease("n1", "node-upgrader", now)},
+			expectedErr:    NewEvictionNodeLeaseError("n1", "node-upgrader"),
+			expectedHolder: utilptr.To("node-upgrader"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			pod1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+			pod2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+			objs := append([]runtime.Object{pod1, pod2}, tc.leases...)
+			fakeClient := fake.NewSimpleClientset(objs...)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			podEvictor, err := NewPodEvictor(
+				ctx,
+				fakeClient,
+				events.NewFakeRecorder(100),
+				podInformer,
+				initFeatureGates(),
+				NewOptions().WithDryRun(tc.dryRun).WithNodeLeases(&api.NodeLeases{Namespace: "node-leases"}),
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+			}
+
+			for _, pod := range []*v1.Pod{pod1, pod2} {
+				err := podEvictor.EvictPod(ctx, pod, EvictOptions{})
+				if tc.expectedErr == nil && err != nil {
+					t.Fatalf("Unexpected error when evicting %v: %v", pod.Name, err)
+				}
+				var leaseErr *EvictionNodeLeaseError
+				if tc.expectedErr != nil && (!errors.As(err, &leaseErr) || *leaseErr != *tc.expectedErr.(*EvictionNodeLeaseError)) {
+					t.Fatalf("Expected %v when evicting %v, got: %v", tc.expectedErr, pod.Name, err)
+				}
+			}
+
+			lease, err := fakeClient.CoordinationV1().Leases("node-leases").Get(ctx, "n1", metav1.GetOptions{})
+			if tc.expectedHolder == nil {
+				if err == nil {
+					t.Fatalf("Expected no lease, got %v", lease.Spec.HolderIdentity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unable to get the lease: %v", err)
+			}
+			if holder := LeaseHolder(lease, time.Now()); holder != *tc.expectedHolder {
+				t.Errorf("Expected the lease held by %v, got %v", *tc.expectedHolder, holder)
+			}
+
+			// Only the leases of the descheduler are released
+			podEvictor.ReleaseNodeLeases(ctx)
+			lease, err = fakeClient.CoordinationV1().Leases("node-leases").Get(ctx, "n1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unable to get the lease: %v", err)
+			}
+			expectedHolder := *tc.expectedHolder
+			if expectedHolder == "descheduler" {
+				expectedHolder = ""
+			}
+			if holder := LeaseHolder(lease, time.Now()); holder != expectedHolder {
+				t.Errorf("Expected the lease held by %q after the release, got %q", expectedHolder, holder)
+			}
+		})
+	}
+}
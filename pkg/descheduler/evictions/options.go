@@ -2,6 +2,12 @@ package evictions
 
 import (
 	policy "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
+	clientset "k8s.io/client-go/kubernetes"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
 )
 
 type Options struct {
@@ -10,9 +16,28 @@ type Options struct {
 	maxPodsToEvictPerNode            *uint
 	maxPodsToEvictPerNamespace       *uint
 	maxPodsToEvictTotal              *uint
+	maxPodsToEvictPerOwner           *uint
+	maxPodsToEvictPerTopologyDomain  *uint
+	topologyDomainKey                string
 	evictionFailureEventNotification bool
 	metricsEnabled                   bool
 	gracePeriodSeconds               *int64
+	spreadingTopologyKey             string
+	fairnessBy                       string
+	namespaceQuotas                  []api.NamespaceDisruptionQuota
+	aggregatedEvents                 bool
+	evictionVeto                     *api.EvictionVeto
+	nodeLeases                       *api.NodeLeases
+	skipExplanations                 *api.SkipExplanations
+	preferredNodeHints               *api.PreferredNodeHints
+	gangScheduling                   *api.GangScheduling
+	evictionVerification             *api.EvictionVerification
+	evictionRateLimits               *api.EvictionRateLimits
+	terminationPacing                *api.TerminationPacing
+	evacuationClient                 dynamic.Interface
+	maxEvictionFailures              *intstr.IntOrString
+	replicaSetLister                 appsv1listers.ReplicaSetLister
+	validationClient                 clientset.Interface
 }
 
 // NewOptions returns an Options with default values.
@@ -42,6 +67,18 @@ func (o *Options) WithMaxPodsToEvictPerNamespace(maxPodsToEvictPerNamespace *uin
 	return o
 }
 
+func (o *Options) WithMaxPodsToEvictPerOwner(maxPodsToEvictPerOwner *uint) *Options {
+	o.maxPodsToEvictPerOwner = maxPodsToEvictPerOwner
+	return o
+}
+
+// WithMaxPodsToEvictPerTopologyDomain limits the evictions from the nodes of every topology domain identified by the node label
+func (o *Options) WithMaxPodsToEvictPerTopologyDomain(maxPodsToEvictPerTopologyDomain *uint, topologyKey string) *Options {
+	o.maxPodsToEvictPerTopologyDomain = maxPodsToEvictPerTopologyDomain
+	o.topologyDomainKey = topologyKey
+	return o
+}
+
 func (o *Options) WithMaxPodsToEvictTotal(maxPodsToEvictTotal *uint) *Options {
 	o.maxPodsToEvictTotal = maxPodsToEvictTotal
 	return o
@@ -52,11 +89,111 @@ func (o *Options) WithGracePeriodSeconds(gracePeriodSeconds *int64) *Options {
 	return o
 }
 
+// WithEvacuationClient requests the evacuation of the pods through the Evacuation API instead of evicting them
+func (o *Options) WithEvacuationClient(evacuationClient dynamic.Interface) *Options {
+	o.evacuationClient = evacuationClient
+	return o
+}
+
+// WithMaxEvictionFailuresPerCycle stops the evictions of the descheduling cycle once more evictions than
+// the count or the percentage of the eviction requests failed
+func (o *Options) WithMaxEvictionFailuresPerCycle(maxEvictionFailures *intstr.IntOrString) *Options {
+	o.maxEvictionFailures = maxEvictionFailures
+	return o
+}
+
 func (o *Options) WithMetricsEnabled(metricsEnabled bool) *Options {
 	o.metricsEnabled = metricsEnabled
 	return o
 }
 
+// WithEvictionSpreading spreads the total eviction limit across the topology domains identified by the node label
+func (o *Options) WithEvictionSpreading(topologyKey string) *Options {
+	o.spreadingTopologyKey = topologyKey
+	return o
+}
+
+// WithEvictionFairness shares the total eviction limit across the namespaces or the pod owners
+func (o *Options) WithEvictionFairness(by string) *Options {
+	o.fairnessBy = by
+	return o
+}
+
+// WithNamespaceDisruptionQuotas limits the evictions per namespace over sliding periods
+func (o *Options) WithNamespaceDisruptionQuotas(quotas []api.NamespaceDisruptionQuota) *Options {
+	o.namespaceQuotas = quotas
+	return o
+}
+
+// WithAggregatedEvents emits one event per owner per descheduling cycle instead of one event per evicted pod
+func (o *Options) WithAggregatedEvents(aggregatedEvents *bool) *Options {
+	if aggregatedEvents != nil {
+		o.aggregatedEvents = *aggregatedEvents
+	}
+	return o
+}
+
+// WithEvictionVeto evaluates the veto validations against every eviction as the final step before the pod is evicted
+func (o *Options) WithEvictionVeto(evictionVeto *api.EvictionVeto) *Options {
+	o.evictionVeto = evictionVeto
+	return o
+}
+
+// WithNodeLeases acquires the lease of a node before evicting the pods from the node
+func (o *Options) WithNodeLeases(nodeLeases *api.NodeLeases) *Options {
+	o.nodeLeases = nodeLeases
+	return o
+}
+
+// WithSkipExplanations explains why the pods of the workloads are skipped in a periodic event per workload
+func (o *Options) WithSkipExplanations(skipExplanations *api.SkipExplanations) *Options {
+	o.skipExplanations = skipExplanations
+	return o
+}
+
+// WithPreferredNodeHints annotates the owners of the evicted pods with the nodes the plugins prefer
+func (o *Options) WithPreferredNodeHints(preferredNodeHints *api.PreferredNodeHints) *Options {
+	o.preferredNodeHints = preferredNodeHints
+	return o
+}
+
+// WithGangScheduling skips the members of the gangs or evicts the gangs together
+func (o *Options) WithGangScheduling(gangScheduling *api.GangScheduling) *Options {
+	o.gangScheduling = gangScheduling
+	return o
+}
+
+// WithEvictionVerification verifies the replacements of the evicted pods get scheduled
+func (o *Options) WithEvictionVerification(evictionVerification *api.EvictionVerification) *Options {
+	o.evictionVerification = evictionVerification
+	return o
+}
+
+// WithEvictionRateLimits smooths the evictions per node and per namespace over time
+func (o *Options) WithEvictionRateLimits(evictionRateLimits *api.EvictionRateLimits) *Options {
+	o.evictionRateLimits = evictionRateLimits
+	return o
+}
+
+// WithTerminationPacing paces the evictions by the evicted pods still terminating
+func (o *Options) WithTerminationPacing(terminationPacing *api.TerminationPacing) *Options {
+	o.terminationPacing = terminationPacing
+	return o
+}
+
+// WithRevisionOrder orders the pods of the same Deployment picked for eviction by the revisions of their ReplicaSets
+func (o *Options) WithRevisionOrder(replicaSetLister appsv1listers.ReplicaSetLister) *Options {
+	o.replicaSetLister = replicaSetLister
+	return o
+}
+
+// WithDryRunValidation validates the evictions of the dry run mode with the Eviction API of the client
+// in the server dry run mode
+func (o *Options) WithDryRunValidation(validationClient clientset.Interface) *Options {
+	o.validationClient = validationClient
+	return o
+}
+
 func (o *Options) WithEvictionFailureEventNotification(evictionFailureEventNotification *bool) *Options {
 	if evictionFailureEventNotification != nil {
 		o.evictionFailureEventNotification = *evictionFailureEventNotification
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/metrics"
+)
+
+// replacementPlacementTimeout is how long the replacements of an evicted pod are looked for
+const replacementPlacementTimeout = 10 * time.Minute
+
+// placedEviction is an eviction the replacement of was not scheduled yet
+type placedEviction struct {
+	unverifiedEviction
+	node string
+}
+
+// placementKey identifies the strategy the replacements are counted for
+type placementKey struct {
+	strategy string
+	profile  string
+}
+
+// replacementPlacements tracks where the replacements of the evicted pods get scheduled,
+// a nil replacementPlacements tracks nothing
+type replacementPlacements struct {
+	mu        sync.Mutex
+	pending   []placedEviction
+	scheduled map[placementKey]uint
+	returned  map[placementKey]uint
+}
+
+func newReplacementPlacements(metricsEnabled bool) *replacementPlacements {
+	if !metricsEnabled {
+		return nil
+	}
+	return &replacementPlacements{
+		scheduled: make(map[placementKey]uint),
+		returned:  make(map[placementKey]uint),
+	}
+}
+
+// record starts tracking the replacement of the evicted pod, bare and unscheduled pods are not tracked
+func (r *replacementPlacements) record(pod *v1.Pod, opts EvictOptions, evictedAt time.Time) {
+	if r == nil || pod.Spec.NodeName == "" {
+		return
+	}
+	owner := podOwner(pod)
+	if owner == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = append(r.pending, placedEviction{
+		unverifiedEviction: unverifiedEviction{
+			namespace: pod.Namespace,
+			owner:     *owner,
+			podUID:    pod.UID,
+			podName:   pod.Name,
+			strategy:  opts.StrategyName,
+			profile:   opts.ProfileName,
+			evictedAt: evictedAt,
+		},
+		node: pod.Spec.NodeName,
+	})
+}
+
+// TrackReplacementPlacements checks where the replacements of the pods evicted in the previous cycles
+// got scheduled. Each eviction is matched with the oldest scheduled replacement of its owner not matched
+// with another eviction, and counted as returned when the replacement landed on the node the pod was
+// evicted from. A strategy with a high share of returned replacements fights the scheduler and wastes
+// its disruptions. The evictions without a scheduled replacement within the timeout are forgotten.
+// No-op unless the metrics are enabled.
+func (pe *PodEvictor) TrackReplacementPlacements(now time.Time) {
+	r := pe.replacementPlacements
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matched := make(map[types.UID]bool)
+	updated := make(map[placementKey]bool)
+	var pending []placedEviction
+	for _, eviction := range r.pending {
+		var replacement *v1.Pod
+		for _, pod := range replacements(pe.podIndexer, eviction.unverifiedEviction) {
+			if pod.Spec.NodeName == "" || matched[pod.UID] {
+				continue
+			}
+			if replacement == nil || pod.CreationTimestamp.Before(&replacement.CreationTimestamp) ||
+				(pod.CreationTimestamp.Equal(&replacement.CreationTimestamp) && pod.Name < replacement.Name) {
+				replacement = pod
+			}
+		}
+		if replacement == nil {
+			if now.Sub(eviction.evictedAt) < replacementPlacementTimeout {
+				pending = append(pending, eviction)
+			}
+			continue
+		}
+		matched[replacement.UID] = true
+
+		key := placementKey{strategy: eviction.strategy, profile: eviction.profile}
+		placement := "other_node"
+		if replacement.Spec.NodeName == eviction.node {
+			placement = "same_node"
+			r.returned[key]++
+			klog.V(2).InfoS("Replacement of the evicted pod scheduled back on the same node", "pod", klog.KRef(eviction.namespace, eviction.podName), "replacement", klog.KObj(replacement), "node", eviction.node, "strategy", eviction.strategy)
+		}
+		r.scheduled[key]++
+		updated[key] = true
+		metrics.ReplacementPlacements.With(map[string]string{"placement": placement, "strategy": eviction.strategy, "profile": eviction.profile}).Inc()
+	}
+	r.pending = pending
+
+	for key := range updated {
+		metrics.ReturnedReplacementsRatio.With(map[string]string{"strategy": key.strategy, "profile": key.profile}).Set(float64(r.returned[key]) / float64(r.scheduled[key]))
+	}
+}
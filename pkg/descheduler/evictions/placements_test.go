@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/component-base/metrics/testutil"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestTrackReplacementPlacements(t *testing.T) {
+	ctx := context.Background()
+	metrics.Register()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, UID: types.UID(owner), Controller: utilptr.To(true)}}
+		}
+	}
+	web1 := test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web"))
+	web2 := test.BuildTestPod("web-2", 100, 0, "n2", ownedBy("web"))
+	api1 := test.BuildTestPod("api-1", 100, 0, "n1", ownedBy("api"))
+	bare := test.BuildTestPod("bare", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset(web1, web2, api1, bare)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithMetricsEnabled(true),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	const strategy = "TrackReplacementPlacements"
+	for _, pod := range []*v1.Pod{web1, web2, api1, bare} {
+		if err := podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: strategy, ProfileName: "p"}); err != nil {
+			t.Fatalf("Unexpected error when evicting %v: %v", pod.Name, err)
+		}
+	}
+	if len(podEvictor.replacementPlacements.pending) != 3 {
+		t.Fatalf("Expected the evictions of the owned pods tracked, got %v", podEvictor.replacementPlacements.pending)
+	}
+
+	// The oldest replacement of web lands back on n1, the other one on n3, the replacement of api stays pending
+	evictedAt := time.Now()
+	replacementOf := func(owner string, created time.Time) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			ownedBy(owner)(pod)
+			pod.CreationTimestamp = metav1.NewTime(created)
+		}
+	}
+	for _, pod := range []*v1.Pod{
+		test.BuildTestPod("web-3", 100, 0, "n1", replacementOf("web", evictedAt)),
+		test.BuildTestPod("web-4", 100, 0, "n3", replacementOf("web", evictedAt.Add(time.Second))),
+		test.BuildTestPod("api-2", 100, 0, "", replacementOf("api", evictedAt)),
+	} {
+		if err := podInformer.GetIndexer().Add(pod); err != nil {
+			t.Fatalf("Unexpected error when indexing %v: %v", pod.Name, err)
+		}
+	}
+
+	assertMetrics := func(sameNode, otherNode, ratio float64) {
+		t.Helper()
+		for placement, expected := range map[string]float64{"same_node": sameNode, "other_node": otherNode} {
+			value, err := testutil.GetCounterMetricValue(metrics.ReplacementPlacements.WithLabelValues(placement, strategy, "p"))
+			if err != nil {
+				t.Fatalf("Unable to read the replacement placements metric: %v", err)
+			}
+			if value != expected {
+				t.Errorf("Expected %v replacements placed on the %v, got %v", expected, placement, value)
+			}
+		}
+		value, err := testutil.GetGaugeMetricValue(metrics.ReturnedReplacementsRatio.WithLabelValues(strategy, "p"))
+		if err != nil {
+			t.Fatalf("Unable to read the returned replacements ratio metric: %v", err)
+		}
+		if value != ratio {
+			t.Errorf("Expected the returned replacements ratio to be %v, got %v", ratio, value)
+		}
+	}
+
+	podEvictor.TrackReplacementPlacements(evictedAt.Add(time.Minute))
+	assertMetrics(1, 1, 0.5)
+	if pending := podEvictor.replacementPlacements.pending; len(pending) != 1 || pending[0].podName != "api-1" {
+		t.Errorf("Expected the eviction of api-1 pending, got %v", pending)
+	}
+
+	// Past the timeout the eviction without a scheduled replacement is forgotten
+	podEvictor.TrackReplacementPlacements(evictedAt.Add(replacementPlacementTimeout + time.Minute))
+	assertMetrics(1, 1, 0.5)
+	if pending := podEvictor.replacementPlacements.pending; len(pending) != 0 {
+		t.Errorf("Expected no evictions pending, got %v", pending)
+	}
+}
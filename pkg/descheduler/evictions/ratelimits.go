@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// tokenBucket holds the tokens left at the time of the last eviction
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// evictionRateLimiter keeps a token bucket per key, e.g. per node, across the descheduling cycles.
+// A nil evictionRateLimiter limits nothing. Guarded by the mutex of the pod evictor.
+type evictionRateLimiter struct {
+	perSecond float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+}
+
+func newEvictionRateLimiter(limit *api.EvictionRateLimit) *evictionRateLimiter {
+	if limit == nil {
+		return nil
+	}
+	return &evictionRateLimiter{
+		perSecond: float64(limit.EvictionsPerMinute) / 60,
+		burst:     float64(ptr.Deref(limit.Burst, 1)),
+		buckets:   make(map[string]*tokenBucket),
+	}
+}
+
+// tokens returns the tokens of the bucket of the key refilled until now, a full bucket when the key has none
+func (l *evictionRateLimiter) tokens(key string, now time.Time) float64 {
+	bucket, ok := l.buckets[key]
+	if !ok {
+		return l.burst
+	}
+	elapsed := max(now.Sub(bucket.last).Seconds(), 0)
+	return min(bucket.tokens+elapsed*l.perSecond, l.burst)
+}
+
+// allows returns true when the bucket of the key holds a token
+func (l *evictionRateLimiter) allows(key string, now time.Time) bool {
+	return l == nil || l.tokens(key, now) >= 1
+}
+
+// take takes a token from the bucket of the key
+func (l *evictionRateLimiter) take(key string, now time.Time) {
+	if l == nil {
+		return
+	}
+	l.buckets[key] = &tokenBucket{tokens: l.tokens(key, now) - 1, last: now}
+}
+
+// prune forgets the buckets refilled completely, they are the same as the buckets of the keys without any
+func (l *evictionRateLimiter) prune(now time.Time) {
+	if l == nil {
+		return
+	}
+	for key := range l.buckets {
+		if l.tokens(key, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestEvictionRateLimiter(t *testing.T) {
+	limiter := newEvictionRateLimiter(&api.EvictionRateLimit{EvictionsPerMinute: 2, Burst: utilptr.To[uint](2)})
+	start := time.Now()
+
+	steps := []struct {
+		after  time.Duration
+		allows bool
+	}{
+		// The bucket starts full
+		{after: 0, allows: true},
+		{after: 0, allows: true},
+		{after: 0, allows: false},
+		// A token is refilled every 30s
+		{after: 20 * time.Second, allows: false},
+		{after: 30 * time.Second, allows: true},
+		{after: 30 * time.Second, allows: false},
+		// The bucket does not fill beyond the burst
+		{after: 10 * time.Minute, allows: true},
+		{after: 10 * time.Minute, allows: true},
+		{after: 10 * time.Minute, allows: false},
+	}
+	for i, step := range steps {
+		now := start.Add(step.after)
+		allows := limiter.allows("n1", now)
+		if allows != step.allows {
+			t.Fatalf("Step %d: expected the limiter to allow the eviction to be %v, got %v", i, step.allows, allows)
+		}
+		if allows {
+			limiter.take("n1", now)
+		}
+	}
+	if !limiter.allows("n2", start) {
+		t.Errorf("Expected the limiter to allow the evictions of another key")
+	}
+
+	limiter.prune(start.Add(10 * time.Minute))
+	if len(limiter.buckets) != 1 {
+		t.Errorf("Expected the bucket still refilling to be kept, got %v", limiter.buckets)
+	}
+	limiter.prune(start.Add(20 * time.Minute))
+	if len(limiter.buckets) != 0 {
+		t.Errorf("Expected the refilled buckets to be forgotten, got %v", limiter.buckets)
+	}
+
+	var none *evictionRateLimiter
+	if !none.allows("n1", start) {
+		t.Errorf("Expected no limiter to allow every eviction")
+	}
+}
+
+func TestEvictPodRateLimits(t *testing.T) {
+	ctx := context.Background()
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+	p3 := test.BuildTestPod("p3", 100, 0, "n2", nil)
+	p4 := test.BuildTestPod("p4", 100, 0, "n3", func(pod *v1.Pod) { pod.Namespace = "other" })
+	p5 := test.BuildTestPod("p5", 100, 0, "n4", nil)
+
+	fakeClient := fake.NewSimpleClientset(p1, p2, p3, p4, p5)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithEvictionRateLimits(&api.EvictionRateLimits{
+			PerNode:      &api.EvictionRateLimit{EvictionsPerMinute: 1},
+			PerNamespace: &api.EvictionRateLimit{EvictionsPerMinute: 1, Burst: utilptr.To[uint](2)},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	var nodeLimited *EvictionNodeRateLimitError
+	var namespaceLimited *EvictionNamespaceRateLimitError
+	evict := func(pod *v1.Pod) error {
+		return podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "RemoveFailedPods"})
+	}
+	if err := evict(p1); err != nil {
+		t.Fatalf("Unexpected error when evicting p1: %v", err)
+	}
+	if err := evict(p2); !errors.As(err, &nodeLimited) {
+		t.Errorf("Expected the eviction of p2 to fail on the node rate limit, got %v", err)
+	}
+	if err := evict(p3); err != nil {
+		t.Fatalf("Unexpected error when evicting p3: %v", err)
+	}
+	if err := evict(p4); err != nil {
+		t.Fatalf("Unexpected error when evicting p4 from another namespace: %v", err)
+	}
+
+	// The buckets are kept across the cycles
+	podEvictor.ResetCounters()
+	if err := evict(p5); !errors.As(err, &namespaceLimited) {
+		t.Errorf("Expected the eviction of p5 to fail on the namespace rate limit, got %v", err)
+	}
+}
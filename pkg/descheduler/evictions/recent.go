@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// DefaultRecentEvictionsRetention is how long the pod evictor keeps the recent evictions
+const DefaultRecentEvictionsRetention = time.Hour
+
+// RecentEviction describes a pod evicted in the recent descheduling cycles
+type RecentEviction = frameworktypes.RecentEviction
+
+// NewRecentEviction describes the eviction of the pod at the given time
+func NewRecentEviction(pod *v1.Pod, opts EvictOptions, evictedAt time.Time) RecentEviction {
+	return RecentEviction{
+		PodUID:    pod.UID,
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Owner:     podOwner(pod),
+		Node:      pod.Spec.NodeName,
+		Time:      evictedAt,
+		Strategy:  opts.StrategyName,
+		Profile:   opts.ProfileName,
+	}
+}
+
+// RecentEvictions is a queryable cache of the pods evicted in the recent descheduling cycles
+// (including evictions in dry run mode). Plugins use it to avoid selecting the replacements of
+// the evicted pods and breaking eviction/reschedule loops. A nil cache holds no evictions.
+type RecentEvictions struct {
+	mu        sync.RWMutex
+	retention time.Duration
+	evictions []RecentEviction
+}
+
+// NewRecentEvictions creates a cache keeping the evictions for the retention period
+func NewRecentEvictions(retention time.Duration) *RecentEvictions {
+	return &RecentEvictions{retention: retention}
+}
+
+// Add records the eviction and forgets the evictions older than the retention period
+func (r *RecentEvictions) Add(eviction RecentEviction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.evictions = append(r.evictions, eviction)
+	sort.SliceStable(r.evictions, func(i, j int) bool {
+		return r.evictions[i].Time.Before(r.evictions[j].Time)
+	})
+	cutoff := eviction.Time.Add(-r.retention)
+	expired := sort.Search(len(r.evictions), func(i int) bool {
+		return !r.evictions[i].Time.Before(cutoff)
+	})
+	r.evictions = append([]RecentEviction(nil), r.evictions[expired:]...)
+}
+
+// List returns the retained evictions from the oldest to the newest
+func (r *RecentEvictions) List() []RecentEviction {
+	return r.EvictedSince(time.Time{})
+}
+
+// EvictedSince returns the evictions since the given time from the oldest to the newest
+func (r *RecentEvictions) EvictedSince(since time.Time) []RecentEviction {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var evictions []RecentEviction
+	for _, eviction := range r.evictions {
+		if !eviction.Time.Before(since) {
+			evictions = append(evictions, eviction)
+		}
+	}
+	return evictions
+}
+
+// Has returns true when the pod with the UID was evicted recently
+func (r *RecentEvictions) Has(uid types.UID) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, eviction := range r.evictions {
+		if eviction.PodUID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplacedEviction returns the latest recent eviction the pod is a replacement of, i.e. a pod
+// of the same controller evicted before the pod got created. Bare pods are never replacements.
+func (r *RecentEvictions) ReplacedEviction(pod *v1.Pod) (RecentEviction, bool) {
+	owner := podOwner(pod)
+	if r == nil || owner == nil {
+		return RecentEviction{}, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := len(r.evictions) - 1; i >= 0; i-- {
+		eviction := r.evictions[i]
+		if eviction.Owner == nil || eviction.Owner.UID != owner.UID || eviction.PodUID == pod.UID {
+			continue
+		}
+		// Creation timestamps have a precision of seconds
+		if !pod.CreationTimestamp.Time.Before(eviction.Time.Truncate(time.Second)) {
+			return eviction, true
+		}
+	}
+	return RecentEviction{}, false
+}
+
+func podOwner(pod *v1.Pod) *metav1.OwnerReference {
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		return owner
+	}
+	if len(pod.OwnerReferences) > 0 {
+		owner := pod.OwnerReferences[0]
+		return &owner
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRecentEvictions(t *testing.T) {
+	now := time.Now()
+	owner := metav1.OwnerReference{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: "rs", UID: "rs-uid", Controller: utilptr.To(true)}
+	buildPod := func(name string, created time.Time, owners ...metav1.OwnerReference) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, "n1", func(pod *v1.Pod) {
+			pod.UID = types.UID(name)
+			pod.CreationTimestamp = metav1.NewTime(created)
+			pod.OwnerReferences = owners
+		})
+	}
+
+	recent := NewRecentEvictions(time.Hour)
+	recent.Add(NewRecentEviction(buildPod("expired", now.Add(-3*time.Hour), owner), EvictOptions{StrategyName: "PodLifeTime"}, now.Add(-2*time.Hour)))
+	recent.Add(NewRecentEviction(buildPod("bare", now.Add(-time.Hour)), EvictOptions{StrategyName: "PodLifeTime"}, now.Add(-10*time.Minute)))
+	recent.Add(NewRecentEviction(buildPod("evicted", now.Add(-time.Hour), owner), EvictOptions{StrategyName: "RemoveDuplicates", ProfileName: "default"}, now.Add(-5*time.Minute)))
+
+	evictions := recent.List()
+	if len(evictions) != 2 || evictions[0].Name != "bare" || evictions[1].Name != "evicted" {
+		t.Fatalf("Expected the bare and evicted pods to be retained from the oldest, got %+v", evictions)
+	}
+	if evictions[1].Owner == nil || evictions[1].Owner.UID != owner.UID || evictions[1].Node != "n1" || evictions[1].Strategy != "RemoveDuplicates" || evictions[1].Profile != "default" {
+		t.Errorf("Unexpected eviction %+v", evictions[1])
+	}
+	if since := recent.EvictedSince(now.Add(-7 * time.Minute)); len(since) != 1 || since[0].Name != "evicted" {
+		t.Errorf("Expected only the evicted pod since 7 minutes, got %+v", since)
+	}
+	if !recent.Has("evicted") || recent.Has("expired") {
+		t.Errorf("Expected only the retained evictions to be found by UID")
+	}
+
+	if eviction, ok := recent.ReplacedEviction(buildPod("replacement", now, owner)); !ok || eviction.Name != "evicted" {
+		t.Errorf("Expected the pod created after the eviction to replace the evicted pod, got %+v (%v)", eviction, ok)
+	}
+	if _, ok := recent.ReplacedEviction(buildPod("sibling", now.Add(-30*time.Minute), owner)); ok {
+		t.Errorf("Expected the pod created before the eviction not to be a replacement")
+	}
+	if _, ok := recent.ReplacedEviction(buildPod("bare-replacement", now)); ok {
+		t.Errorf("Expected a bare pod not to be a replacement")
+	}
+
+	var nilRecent *RecentEvictions
+	if nilRecent.List() != nil || nilRecent.Has("evicted") {
+		t.Errorf("Expected a nil cache to hold no evictions")
+	}
+}
+
+func TestPodEvictorRecentEvictions(t *testing.T) {
+	ctx := context.Background()
+	pod := test.BuildTestPod("p1", 100, 0, "n1", test.SetRSOwnerRef)
+
+	fakeClient := fake.NewClientset(pod)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(ctx, fakeClient, events.NewFakeRecorder(100), sharedInformerFactory.Core().V1().Pods().Informer(), initFeatureGates(), NewOptions().WithDryRun(true))
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	if err := podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "RemoveDuplicates", ProfileName: "default"}); err != nil {
+		t.Fatalf("Unexpected error when evicting the pod: %v", err)
+	}
+	podEvictor.ResetCounters()
+
+	evictions := podEvictor.RecentEvictions().List()
+	if len(evictions) != 1 || evictions[0].PodUID != pod.UID || evictions[0].Strategy != "RemoveDuplicates" {
+		t.Errorf("Expected the eviction to be kept across the cycles, got %+v", evictions)
+	}
+}
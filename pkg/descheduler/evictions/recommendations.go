@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/descheduler/metrics"
+)
+
+// recommendation identifies the evictions of the pods of a workload by a strategy
+type recommendation struct {
+	namespace string
+	ownerKind string
+	ownerName string
+	strategy  string
+}
+
+// newRecommendation returns the recommendation the eviction of the pod is counted under.
+// Pods without an owner are their own workload.
+func newRecommendation(pod *v1.Pod, opts EvictOptions) recommendation {
+	r := recommendation{
+		namespace: pod.Namespace,
+		ownerKind: "Pod",
+		ownerName: pod.Name,
+		strategy:  strategyName(opts.StrategyName),
+	}
+	if owner := podOwner(pod); owner != nil {
+		r.ownerKind = owner.Kind
+		r.ownerName = owner.Name
+	}
+	return r
+}
+
+// UpdateRecommendedEvictions replaces the recommended evictions gauges with the evictions
+// since the last call, including the evictions in the dry run mode. Workloads without
+// evictions since the last call are dropped from the gauges. No-op unless the metrics are enabled.
+func (pe *PodEvictor) UpdateRecommendedEvictions() {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	if !pe.metricsEnabled {
+		return
+	}
+	metrics.RecommendedEvictions.Reset()
+	for r, count := range pe.recommendedEvictions {
+		metrics.RecommendedEvictions.WithLabelValues(r.namespace, r.ownerKind, r.ownerName, r.strategy).Set(float64(count))
+	}
+	pe.recommendedEvictions = make(map[recommendation]uint)
+}
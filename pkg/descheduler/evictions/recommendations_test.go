@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/component-base/metrics/testutil"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRecommendedEvictions(t *testing.T) {
+	ctx := context.Background()
+	metrics.Register()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+
+	pods := []*v1.Pod{
+		test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("web-2", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("web-3", 100, 0, "n1", ownedBy("web")),
+		test.BuildTestPod("api-1", 100, 0, "n1", ownedBy("api")),
+		test.BuildTestPod("bare", 100, 0, "n1", nil),
+	}
+
+	var objs []runtime.Object
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithDryRun(true).WithMetricsEnabled(true),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	evict := func(pod *v1.Pod, strategy string) {
+		if err := podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: strategy}); err != nil {
+			t.Fatalf("Unexpected error when evicting %v: %v", pod.Name, err)
+		}
+	}
+	assertGauge := func(labels []string, expected float64) {
+		t.Helper()
+		value, err := testutil.GetGaugeMetricValue(metrics.RecommendedEvictions.WithLabelValues(labels...))
+		if err != nil {
+			t.Fatalf("Unable to read the recommended evictions metric: %v", err)
+		}
+		if value != expected {
+			t.Errorf("Expected %v recommended evictions of %v, got %v", expected, labels, value)
+		}
+	}
+
+	evict(pods[0], "LowNodeUtilization")
+	evict(pods[1], "LowNodeUtilization")
+	evict(pods[2], "RemoveDuplicates")
+	evict(pods[3], "RemoveDuplicates")
+	evict(pods[4], "")
+	podEvictor.UpdateRecommendedEvictions()
+
+	assertGauge([]string{"default", "ReplicaSet", "web", "LowNodeUtilization"}, 2)
+	assertGauge([]string{"default", "ReplicaSet", "web", "RemoveDuplicates"}, 1)
+	assertGauge([]string{"default", "ReplicaSet", "api", "RemoveDuplicates"}, 1)
+	assertGauge([]string{"default", "Pod", "bare", "NotSet"}, 1)
+
+	// The workloads without evictions in the next cycle are dropped
+	podEvictor.ResetCounters()
+	evict(pods[0], "LowNodeUtilization")
+	podEvictor.UpdateRecommendedEvictions()
+
+	assertGauge([]string{"default", "ReplicaSet", "web", "LowNodeUtilization"}, 1)
+	assertGauge([]string{"default", "ReplicaSet", "api", "RemoveDuplicates"}, 0)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"sort"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+)
+
+// deploymentRevisionAnnotation is set by the Deployment controller on the ReplicaSets of a Deployment
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// revisionOrder orders the pods of the same Deployment by the revision of their ReplicaSets, so the pods
+// of the older revisions of a rollout are evicted before the pods of the newer ones, e.g. a canary.
+// A nil revisionOrder keeps the order of the pods.
+type revisionOrder struct {
+	replicaSetLister appsv1listers.ReplicaSetLister
+}
+
+func newRevisionOrder(replicaSetLister appsv1listers.ReplicaSetLister) *revisionOrder {
+	if replicaSetLister == nil {
+		return nil
+	}
+	return &revisionOrder{replicaSetLister: replicaSetLister}
+}
+
+// podRevision returns the Deployment of the pod and the revision of its ReplicaSet,
+// false for the pods of no Deployment or of a ReplicaSet with no valid revision
+func (o *revisionOrder) podRevision(pod *v1.Pod) (types.UID, int64, bool) {
+	ownerRef := metav1.GetControllerOf(pod)
+	if ownerRef == nil || ownerRef.Kind != "ReplicaSet" {
+		return "", 0, false
+	}
+	replicaSet, err := o.replicaSetLister.ReplicaSets(pod.Namespace).Get(ownerRef.Name)
+	if err != nil {
+		return "", 0, false
+	}
+	deploymentRef := metav1.GetControllerOf(replicaSet)
+	if deploymentRef == nil || deploymentRef.Kind != "Deployment" {
+		return "", 0, false
+	}
+	revision, err := strconv.ParseInt(replicaSet.Annotations[deploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return deploymentRef.UID, revision, true
+}
+
+// sort reorders the pods of every Deployment in place, the pods of the older revisions first. The pods of a
+// Deployment only trade the positions among themselves, so the order the plugin chose across the workloads,
+// e.g. by priority, is kept, as is the order of the pods of the same revision.
+func (o *revisionOrder) sort(pods []*v1.Pod) {
+	if o == nil {
+		return
+	}
+	type revisionedPod struct {
+		pod      *v1.Pod
+		revision int64
+	}
+	positions := make(map[types.UID][]int)
+	deploymentPods := make(map[types.UID][]revisionedPod)
+	for i, pod := range pods {
+		deployment, revision, ok := o.podRevision(pod)
+		if !ok {
+			continue
+		}
+		positions[deployment] = append(positions[deployment], i)
+		deploymentPods[deployment] = append(deploymentPods[deployment], revisionedPod{pod: pod, revision: revision})
+	}
+	for deployment, revisioned := range deploymentPods {
+		sort.SliceStable(revisioned, func(i, j int) bool {
+			return revisioned[i].revision < revisioned[j].revision
+		})
+		for i, position := range positions[deployment] {
+			pods[position] = revisioned[i].pod
+		}
+	}
+}
+
+// OrderPodsForEviction reorders the pods picked for eviction by a plugin in place as configured
+// by the eviction order of the policy, the order is kept when no eviction order is configured
+func (pe *PodEvictor) OrderPodsForEviction(pods []*v1.Pod) {
+	pe.revisionOrder.sort(pods)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRevisionOrder(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	replicaSet := func(name, deployment, revision string) {
+		rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{deploymentRevisionAnnotation: revision},
+		}}
+		if deployment != "" {
+			rs.OwnerReferences = []metav1.OwnerReference{{Kind: "Deployment", Name: deployment, UID: types.UID(deployment), Controller: utilptr.To(true)}}
+		}
+		if err := indexer.Add(rs); err != nil {
+			t.Fatalf("Unable to add the replica set: %v", err)
+		}
+	}
+	replicaSet("web-1", "web", "1")
+	replicaSet("web-2", "web", "2")
+	replicaSet("web-10", "web", "10")
+	replicaSet("api-3", "api", "3")
+	replicaSet("orphan", "", "1")
+	replicaSet("invalid", "web", "latest")
+
+	pod := func(name, replicaSet string) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, "n1", func(pod *v1.Pod) {
+			if replicaSet != "" {
+				pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: replicaSet, Controller: utilptr.To(true)}}
+			}
+		})
+	}
+	pods := []*v1.Pod{
+		pod("web-10-a", "web-10"),
+		pod("api-3-a", "api-3"),
+		pod("bare", ""),
+		pod("web-2-a", "web-2"),
+		pod("orphan-a", "orphan"),
+		pod("web-1-a", "web-1"),
+		pod("invalid-a", "invalid"),
+		pod("web-2-b", "web-2"),
+	}
+
+	newRevisionOrder(appsv1listers.NewReplicaSetLister(indexer)).sort(pods)
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	expected := []string{"web-1-a", "api-3-a", "bare", "web-2-a", "orphan-a", "web-2-b", "invalid-a", "web-10-a"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected the pods ordered %v, got %v", expected, names)
+	}
+
+	var none *revisionOrder
+	none.sort(pods)
+	if newRevisionOrder(nil) != nil {
+		t.Errorf("Expected no revision order without a replica set lister")
+	}
+}
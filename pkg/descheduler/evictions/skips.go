@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// DefaultSkipExplanationsInterval is the interval between two explanations of a workload
+	DefaultSkipExplanationsInterval = time.Hour
+	// maxExplainedReasons is the number of the most frequent reasons an explanation lists
+	maxExplainedReasons = 3
+)
+
+// workloadSkips accumulates the reasons the pods of a workload are skipped between two explanations
+type workloadSkips struct {
+	regarding  *v1.ObjectReference
+	reasons    map[string]uint
+	strategies map[string]uint
+}
+
+// skipExplanations aggregates the skips per workload, a nil skipExplanations explains nothing
+type skipExplanations struct {
+	mu        sync.Mutex
+	selector  labels.Selector
+	interval  time.Duration
+	workloads map[string]*workloadSkips
+	explained map[string]time.Time
+}
+
+func newSkipExplanations(config *api.SkipExplanations) (*skipExplanations, error) {
+	if config == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(config.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	if config.LabelSelector == nil {
+		selector = labels.Everything()
+	}
+	interval := DefaultSkipExplanationsInterval
+	if config.Interval != nil {
+		interval = config.Interval.Duration
+	}
+	return &skipExplanations{
+		selector:  selector,
+		interval:  interval,
+		workloads: make(map[string]*workloadSkips),
+		explained: make(map[string]time.Time),
+	}, nil
+}
+
+// explains checks if the skips of the pod are explained
+func (s *skipExplanations) explains(pod *v1.Pod) bool {
+	return s != nil && s.selector.Matches(labels.Set(pod.Labels))
+}
+
+// record counts the skip of the pod by the strategy under the workload of the pod
+func (s *skipExplanations) record(pod *v1.Pod, strategy string, reasons ...string) {
+	if !s.explains(pod) || len(reasons) == 0 {
+		return
+	}
+	key := pod.Namespace + "/Pod/" + pod.Name
+	regarding := &v1.ObjectReference{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name, UID: pod.UID}
+	if owner := podOwner(pod); owner != nil {
+		key = ownerKey(pod.Namespace, owner)
+		regarding = &v1.ObjectReference{
+			APIVersion: owner.APIVersion,
+			Kind:       owner.Kind,
+			Namespace:  pod.Namespace,
+			Name:       owner.Name,
+			UID:        owner.UID,
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	workload, ok := s.workloads[key]
+	if !ok {
+		workload = &workloadSkips{regarding: regarding, reasons: make(map[string]uint), strategies: make(map[string]uint)}
+		s.workloads[key] = workload
+	}
+	for _, reason := range reasons {
+		workload.reasons[reason]++
+	}
+	workload.strategies[strategyName(strategy)]++
+}
+
+// evictionFailureReason describes the failed eviction without the pod specific details
+func evictionFailureReason(err error) string {
+	if apierrors.IsTooManyRequests(err) {
+		return "eviction refused, a PodDisruptionBudget allows no disruption"
+	}
+	return "eviction failed"
+}
+
+// explainedReasons lists the most frequent reasons, e.g. "pod has higher priority than specified priority class threshold (12)"
+func explainedReasons(reasons map[string]uint) string {
+	names := make([]string, 0, len(reasons))
+	for reason := range reasons {
+		names = append(names, reason)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if reasons[names[i]] != reasons[names[j]] {
+			return reasons[names[i]] > reasons[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > maxExplainedReasons {
+		names = names[:maxExplainedReasons]
+	}
+	explained := make([]string, 0, len(names))
+	for _, reason := range names {
+		explained = append(explained, fmt.Sprintf("%s (%d)", reason, reasons[reason]))
+	}
+	return strings.Join(explained, ", ")
+}
+
+// ExplainsSkips checks if the reasons the pod is skipped are to be recorded
+func (pe *PodEvictor) ExplainsSkips(pod *v1.Pod) bool {
+	return pe.skipExplanations.explains(pod)
+}
+
+// RecordSkip counts the reasons the strategy skips the pod towards the explanation of its workload.
+// No-op unless the skips of the pod are explained.
+func (pe *PodEvictor) RecordSkip(pod *v1.Pod, strategy string, reasons ...string) {
+	pe.skipExplanations.record(pod, strategy, reasons...)
+}
+
+// EmitSkipExplanations emits an event with the most frequent reasons on every workload the pods of were
+// skipped since its last explanation, at most once per interval per workload, e.g. "pods skipped by
+// RemoveDuplicates (4): pod has higher priority than specified priority class threshold (4)".
+// No-op unless the skips are explained.
+func (pe *PodEvictor) EmitSkipExplanations(now time.Time) {
+	s := pe.skipExplanations
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.workloads))
+	for key := range s.workloads {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if last, ok := s.explained[key]; ok && now.Sub(last) < s.interval {
+			continue
+		}
+		workload := s.workloads[key]
+		pe.eventRecorder.Eventf(workload.regarding, nil, v1.EventTypeNormal, "DeschedulingSkipped", "Descheduled", "pods skipped by %s: %s", strategyList(workload.strategies), explainedReasons(workload.reasons))
+		if pe.metricsEnabled {
+			for reason, count := range workload.reasons {
+				metrics.PodsSkipped.WithLabelValues(workload.regarding.Namespace, workload.regarding.Kind, workload.regarding.Name, reason).Add(float64(count))
+			}
+		}
+		s.explained[key] = now
+		delete(s.workloads, key)
+	}
+	for key, last := range s.explained {
+		if now.Sub(last) >= s.interval {
+			if _, ok := s.workloads[key]; !ok {
+				delete(s.explained, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestSkipExplanations(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Labels = map[string]string{"tier": "critical"}
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, Controller: utilptr.To(true)}}
+		}
+	}
+	web1 := test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web"))
+	web2 := test.BuildTestPod("web-2", 100, 0, "n1", ownedBy("web"))
+	other := test.BuildTestPod("other", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset()
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	eventRecorder := events.NewFakeRecorder(100)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		eventRecorder,
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithSkipExplanations(&api.SkipExplanations{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "critical"}},
+			Interval:      &metav1.Duration{Duration: time.Hour},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	if podEvictor.ExplainsSkips(other) {
+		t.Errorf("Expected the skips of the pod not matching the selector not explained")
+	}
+	priority := "pod has higher priority than specified priority class threshold"
+	podEvictor.RecordSkip(web1, "RemoveDuplicates", priority)
+	podEvictor.RecordSkip(web2, "RemoveDuplicates", priority, "pod does not fit on any other node")
+	podEvictor.RecordSkip(web2, "LowNodeUtilization", priority)
+	podEvictor.RecordSkip(other, "RemoveDuplicates", priority)
+
+	now := time.Now()
+	podEvictor.EmitSkipExplanations(now)
+	assertEqualEvents(t, []string{
+		fmt.Sprintf("Normal DeschedulingSkipped pods skipped by RemoveDuplicates (2), LowNodeUtilization (1): %s (3), pod does not fit on any other node (1)", priority),
+	}, eventRecorder.Events)
+
+	// The workload is explained at most once per interval
+	podEvictor.RecordSkip(web1, "RemoveDuplicates", priority)
+	podEvictor.EmitSkipExplanations(now.Add(time.Minute))
+	assertEqualEvents(t, nil, eventRecorder.Events)
+
+	tooManyRequests := apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+	podEvictor.RecordSkip(web1, "RemoveDuplicates", evictionFailureReason(fmt.Errorf("error when evicting pod (ignoring) %q: %w", web1.Name, tooManyRequests)))
+	podEvictor.RecordSkip(web1, "RemoveDuplicates", evictionFailureReason(apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, web1.Name)))
+	podEvictor.EmitSkipExplanations(now.Add(time.Hour))
+	assertEqualEvents(t, []string{
+		fmt.Sprintf("Normal DeschedulingSkipped pods skipped by RemoveDuplicates: eviction failed (1), eviction refused, a PodDisruptionBudget allows no disruption (1), %s (1)", priority),
+	}, eventRecorder.Events)
+}
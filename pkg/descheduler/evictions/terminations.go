@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// terminatingPod is an evicted pod terminating until its grace period elapses
+type terminatingPod struct {
+	namespace string
+	name      string
+	uid       types.UID
+	node      string
+	deadline  time.Time
+}
+
+// terminationPacing tracks the evicted pods still terminating, a nil terminationPacing limits nothing.
+// Guarded by the mutex of the pod evictor.
+type terminationPacing struct {
+	maxPerNode  *uint
+	maxTotal    *uint
+	terminating []terminatingPod
+}
+
+func newTerminationPacing(config *api.TerminationPacing) *terminationPacing {
+	if config == nil || (config.MaxTerminatingPodsPerNode == nil && config.MaxTerminatingPods == nil) {
+		return nil
+	}
+	return &terminationPacing{
+		maxPerNode: config.MaxTerminatingPodsPerNode,
+		maxTotal:   config.MaxTerminatingPods,
+	}
+}
+
+// terminationGracePeriod returns the time the pod is given to terminate, the grace period of the evictions
+// overrides the grace period of the pod
+func terminationGracePeriod(pod *v1.Pod, gracePeriodSeconds *int64) time.Duration {
+	seconds := int64(v1.DefaultTerminationGracePeriodSeconds)
+	if gracePeriodSeconds != nil {
+		seconds = *gracePeriodSeconds
+	} else if pod.Spec.TerminationGracePeriodSeconds != nil {
+		seconds = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// record tracks the evicted pod as terminating until its grace period elapses
+func (p *terminationPacing) record(pod *v1.Pod, gracePeriodSeconds *int64, evictedAt time.Time) {
+	if p == nil {
+		return
+	}
+	p.terminating = append(p.terminating, terminatingPod{
+		namespace: pod.Namespace,
+		name:      pod.Name,
+		uid:       pod.UID,
+		node:      pod.Spec.NodeName,
+		deadline:  evictedAt.Add(terminationGracePeriod(pod, gracePeriodSeconds)),
+	})
+}
+
+// prune forgets the pods whose grace period elapsed and the pods gone from the pod informer already
+func (p *terminationPacing) prune(indexer cache.Indexer, now time.Time) {
+	if p == nil {
+		return
+	}
+	var terminating []terminatingPod
+	for _, pod := range p.terminating {
+		if !now.Before(pod.deadline) || (indexer != nil && !podExists(indexer, pod)) {
+			continue
+		}
+		terminating = append(terminating, pod)
+	}
+	p.terminating = terminating
+}
+
+// podExists returns true when the pod informer still knows the pod
+func podExists(indexer cache.Indexer, pod terminatingPod) bool {
+	obj, exists, err := indexer.GetByKey(pod.namespace + "/" + pod.name)
+	if err != nil || !exists {
+		return false
+	}
+	known, ok := obj.(*v1.Pod)
+	return ok && known.UID == pod.uid
+}
+
+// allowsOnNode returns true when fewer pods than the limit are terminating on the node
+func (p *terminationPacing) allowsOnNode(node string) bool {
+	if p == nil || p.maxPerNode == nil {
+		return true
+	}
+	var terminating uint
+	for _, pod := range p.terminating {
+		if pod.node == node {
+			terminating++
+		}
+	}
+	return terminating < *p.maxPerNode
+}
+
+// allows returns true when fewer pods than the limit are terminating in the cluster
+func (p *terminationPacing) allows() bool {
+	return p == nil || p.maxTotal == nil || uint(len(p.terminating)) < *p.maxTotal
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestTerminationGracePeriod(t *testing.T) {
+	pod := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	if got := terminationGracePeriod(pod, nil); got != 30*time.Second {
+		t.Errorf("Expected the default grace period, got %v", got)
+	}
+	pod.Spec.TerminationGracePeriodSeconds = utilptr.To[int64](120)
+	if got := terminationGracePeriod(pod, nil); got != 2*time.Minute {
+		t.Errorf("Expected the grace period of the pod, got %v", got)
+	}
+	if got := terminationGracePeriod(pod, utilptr.To[int64](5)); got != 5*time.Second {
+		t.Errorf("Expected the grace period of the evictions, got %v", got)
+	}
+}
+
+func TestTerminationPacing(t *testing.T) {
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) { pod.Spec.TerminationGracePeriodSeconds = utilptr.To[int64](60) })
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", func(pod *v1.Pod) { pod.Spec.TerminationGracePeriodSeconds = utilptr.To[int64](600) })
+	p3 := test.BuildTestPod("p3", 100, 0, "n2", func(pod *v1.Pod) { pod.Spec.TerminationGracePeriodSeconds = utilptr.To[int64](600) })
+	start := time.Now()
+
+	pacing := newTerminationPacing(&api.TerminationPacing{
+		MaxTerminatingPodsPerNode: utilptr.To[uint](2),
+		MaxTerminatingPods:        utilptr.To[uint](3),
+	})
+	pacing.record(p1, nil, start)
+	pacing.record(p2, nil, start)
+	if pacing.allowsOnNode("n1") {
+		t.Errorf("Expected no eviction from a node with two terminating pods")
+	}
+	if !pacing.allowsOnNode("n2") || !pacing.allows() {
+		t.Errorf("Expected the evictions from another node to be allowed")
+	}
+	pacing.record(p3, nil, start)
+	if pacing.allows() {
+		t.Errorf("Expected no eviction with three terminating pods")
+	}
+
+	// p1 terminated once its grace period elapsed
+	pacing.prune(nil, start.Add(time.Minute))
+	if !pacing.allowsOnNode("n1") || !pacing.allows() {
+		t.Errorf("Expected the evictions to be allowed once a pod terminated, got %v", pacing.terminating)
+	}
+
+	// p3 terminated before its grace period elapsed, it is gone from the informer
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(p2); err != nil {
+		t.Fatalf("Unexpected error when adding a pod to the indexer: %v", err)
+	}
+	pacing.prune(indexer, start.Add(time.Minute))
+	if len(pacing.terminating) != 1 || pacing.terminating[0].name != "p2" {
+		t.Errorf("Expected only p2 to be terminating, got %v", pacing.terminating)
+	}
+
+	if newTerminationPacing(&api.TerminationPacing{}) != nil {
+		t.Errorf("Expected no pacing without limits")
+	}
+	var none *terminationPacing
+	if !none.allows() || !none.allowsOnNode("n1") {
+		t.Errorf("Expected no pacing to allow every eviction")
+	}
+}
+
+func TestEvictPodTerminationPacing(t *testing.T) {
+	ctx := context.Background()
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+	p3 := test.BuildTestPod("p3", 100, 0, "n2", nil)
+	p4 := test.BuildTestPod("p4", 100, 0, "n3", nil)
+
+	fakeClient := fake.NewSimpleClientset(p1, p2, p3, p4)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	for _, pod := range []*v1.Pod{p1, p2, p3, p4} {
+		if err := podInformer.GetIndexer().Add(pod); err != nil {
+			t.Fatalf("Unexpected error when adding a pod to the indexer: %v", err)
+		}
+	}
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithTerminationPacing(&api.TerminationPacing{
+			MaxTerminatingPodsPerNode: utilptr.To[uint](1),
+			MaxTerminatingPods:        utilptr.To[uint](2),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	var nodeLimited *EvictionNodeTerminatingLimitError
+	var limited *EvictionTerminatingLimitError
+	evict := func(pod *v1.Pod) error {
+		return podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "RemoveFailedPods"})
+	}
+	if err := evict(p1); err != nil {
+		t.Fatalf("Unexpected error when evicting p1: %v", err)
+	}
+	if err := evict(p2); !errors.As(err, &nodeLimited) {
+		t.Errorf("Expected the eviction of p2 to fail on the node terminating pods limit, got %v", err)
+	}
+	if err := evict(p3); err != nil {
+		t.Fatalf("Unexpected error when evicting p3: %v", err)
+	}
+	if err := evict(p4); !errors.As(err, &limited) {
+		t.Errorf("Expected the eviction of p4 to fail on the terminating pods limit, got %v", err)
+	}
+
+	// p1 is gone
+	if err := podInformer.GetIndexer().Delete(p1); err != nil {
+		t.Fatalf("Unexpected error when deleting a pod from the indexer: %v", err)
+	}
+	if err := evict(p2); err != nil {
+		t.Errorf("Unexpected error when evicting p2 once p1 terminated: %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// validateEviction requests the eviction of the pod from the apiserver in the server dry run mode, so the
+// PDBs and the admission webhooks admit or reject the eviction without the pod being evicted. The apiserver
+// does not see the evictions simulated earlier in the cycle, a PDB is checked against the pods still running.
+func (pe *PodEvictor) validateEviction(ctx context.Context, pod *v1.Pod) error {
+	eviction := pe.newEviction(pod)
+	eviction.DeleteOptions.DryRun = []string{metav1.DryRunAll}
+	if err := pe.validationClient.PolicyV1().Evictions(eviction.Namespace).Evict(ctx, eviction); err != nil {
+		return fmt.Errorf("eviction of pod %q rejected by the apiserver in the dry run validation: %w", pod.Name, err)
+	}
+	return nil
+}
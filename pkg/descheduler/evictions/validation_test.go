@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	policy "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestEvictPodDryRunValidation(t *testing.T) {
+	ctx := context.Background()
+	p1 := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	p2 := test.BuildTestPod("p2", 100, 0, "n1", nil)
+
+	// The cached client simulating the evictions of the dry run
+	fakeClient := fake.NewSimpleClientset(p1, p2)
+	// The client of the cluster, a PDB protects p2
+	clusterClient := fake.NewSimpleClientset(p1, p2)
+	var dryRuns [][]string
+	clusterClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(core.CreateAction).GetObject().(*policy.Eviction)
+		dryRuns = append(dryRuns, eviction.DeleteOptions.DryRun)
+		if eviction.Name == "p2" {
+			return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		}
+		return true, nil, nil
+	})
+
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithDryRun(true).WithDryRunValidation(clusterClient),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	if err := podEvictor.EvictPod(ctx, p1, EvictOptions{StrategyName: "RemoveFailedPods"}); err != nil {
+		t.Fatalf("Expected the eviction of p1 to be admitted, got %v", err)
+	}
+	if err := podEvictor.EvictPod(ctx, p2, EvictOptions{StrategyName: "RemoveFailedPods"}); !apierrors.IsTooManyRequests(err) {
+		t.Errorf("Expected the eviction of p2 to be rejected by the PDB, got %v", err)
+	}
+	if podEvictor.TotalEvicted() != 1 || podEvictor.TotalFailed() != 1 {
+		t.Errorf("Expected 1 pod evicted and 1 failed, got %v and %v", podEvictor.TotalEvicted(), podEvictor.TotalFailed())
+	}
+	if expected := [][]string{{metav1.DryRunAll}, {metav1.DryRunAll}}; !reflect.DeepEqual(dryRuns, expected) {
+		t.Errorf("Expected the evictions validated in the server dry run mode, got %v", dryRuns)
+	}
+
+	// Only the admitted eviction is simulated
+	var simulated []string
+	for _, action := range fakeClient.Actions() {
+		if action.GetSubresource() == "eviction" {
+			simulated = append(simulated, action.(core.CreateAction).GetObject().(*policy.Eviction).Name)
+		}
+	}
+	if !reflect.DeepEqual(simulated, []string{"p1"}) {
+		t.Errorf("Expected the eviction of p1 simulated, got %v", simulated)
+	}
+	if _, err := clusterClient.Tracker().Get(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, "default", "p1"); err != nil {
+		t.Errorf("Expected p1 to keep running in the cluster, got %v", err)
+	}
+}
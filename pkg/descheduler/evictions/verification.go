@@ -0,0 +1,313 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// DefaultEvictionVerificationTimeout is the time the replacements of an evicted pod have to get scheduled
+	DefaultEvictionVerificationTimeout = 5 * time.Minute
+	// unscheduledReplacementBlockedCycles is the number of cycles the owner is blocked for,
+	// the cycle the failure is detected in and the next one
+	unscheduledReplacementBlockedCycles = 2
+	// DefaultEvictionWaitTimeout is the time the replacement of an evicted pod has to get ready before the evictions stop
+	DefaultEvictionWaitTimeout = time.Minute
+	// evictionWaitInterval is the interval the replacements of an evicted pod are checked at
+	evictionWaitInterval = time.Second
+)
+
+// unverifiedEviction is an eviction the replacements of were not scheduled yet
+type unverifiedEviction struct {
+	namespace string
+	owner     metav1.OwnerReference
+	podUID    types.UID
+	podName   string
+	strategy  string
+	profile   string
+	evictedAt time.Time
+}
+
+// evictionVerification tracks the evictions until their replacements get scheduled,
+// a nil evictionVerification verifies nothing
+type evictionVerification struct {
+	mu         sync.Mutex
+	timeout    time.Duration
+	unverified []unverifiedEviction
+	// blocked counts the cycles left the pods of the owners are not evicted in
+	blocked map[string]int
+}
+
+func newEvictionVerification(config *api.EvictionVerification) *evictionVerification {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	timeout := DefaultEvictionVerificationTimeout
+	if config.Timeout != nil {
+		timeout = config.Timeout.Duration
+	}
+	return &evictionVerification{
+		timeout: timeout,
+		blocked: make(map[string]int),
+	}
+}
+
+// record starts verifying the eviction of the pod, bare pods have no replacements to verify
+func (v *evictionVerification) record(pod *v1.Pod, opts EvictOptions, evictedAt time.Time) {
+	if v == nil {
+		return
+	}
+	owner := podOwner(pod)
+	if owner == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.unverified = append(v.unverified, unverifiedEviction{
+		namespace: pod.Namespace,
+		owner:     *owner,
+		podUID:    pod.UID,
+		podName:   pod.Name,
+		strategy:  opts.StrategyName,
+		profile:   opts.ProfileName,
+		evictedAt: evictedAt,
+	})
+}
+
+// blocks returns true when the replacements of an evicted pod of the owner of the pod failed the verification recently
+func (v *evictionVerification) blocks(pod *v1.Pod) bool {
+	if v == nil {
+		return false
+	}
+	owner := podOwner(pod)
+	if owner == nil {
+		return false
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.blocked[ownerKey(pod.Namespace, owner)] > 0
+}
+
+// replacements lists the pods of the owner of the evicted pod created since the eviction
+func replacements(indexer cache.Indexer, eviction unverifiedEviction) []*v1.Pod {
+	if indexer == nil {
+		return nil
+	}
+	objs, err := indexer.ByIndex(cache.NamespaceIndex, eviction.namespace)
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the replacements of the evicted pod", "pod", klog.KRef(eviction.namespace, eviction.podName))
+		return nil
+	}
+	var pods []*v1.Pod
+	for _, obj := range objs {
+		pod, ok := obj.(*v1.Pod)
+		if !ok || pod.UID == eviction.podUID {
+			continue
+		}
+		owner := podOwner(pod)
+		if owner == nil || owner.UID != eviction.owner.UID {
+			continue
+		}
+		// Creation timestamps have a precision of seconds
+		if !pod.CreationTimestamp.Time.Before(eviction.evictedAt.Truncate(time.Second)) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+// VerifyEvictions checks the replacements of the pods evicted in the previous cycles got scheduled.
+// An eviction fails the verification when its replacements are still not scheduled once the timeout
+// elapses: the failure is counted and reported in an event on the owner, and no further pods of the
+// owner are evicted in this cycle and in the next one. The evictions without replacements created
+// within the timeout are forgotten, the owner was likely scaled down. No-op unless the evictions
+// are verified.
+func (pe *PodEvictor) VerifyEvictions(now time.Time) {
+	v := pe.evictionVerification
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for key := range v.blocked {
+		v.blocked[key]--
+		if v.blocked[key] <= 0 {
+			delete(v.blocked, key)
+		}
+	}
+
+	var unverified []unverifiedEviction
+	failed := make(map[string]unverifiedEviction)
+	for _, eviction := range v.unverified {
+		pods := replacements(pe.podIndexer, eviction)
+		scheduled := false
+		for _, pod := range pods {
+			if pod.Spec.NodeName != "" {
+				scheduled = true
+				break
+			}
+		}
+		switch {
+		case scheduled:
+			klog.V(3).InfoS("Replacement of the evicted pod scheduled", "pod", klog.KRef(eviction.namespace, eviction.podName), "owner", eviction.owner.Name)
+		case now.Sub(eviction.evictedAt) < v.timeout:
+			unverified = append(unverified, eviction)
+		case len(pods) == 0:
+			klog.V(3).InfoS("No replacement of the evicted pod created", "pod", klog.KRef(eviction.namespace, eviction.podName), "owner", eviction.owner.Name)
+		default:
+			klog.V(1).InfoS("Replacement of the evicted pod not scheduled, skipping the evictions of the owner", "pod", klog.KRef(eviction.namespace, eviction.podName), "owner", eviction.owner.Name, "timeout", v.timeout)
+			if pe.metricsEnabled {
+				metrics.UnscheduledReplacements.With(map[string]string{"namespace": eviction.namespace, "owner_kind": eviction.owner.Kind, "owner_name": eviction.owner.Name, "strategy": eviction.strategy, "profile": eviction.profile}).Inc()
+			}
+			failed[ownerKey(eviction.namespace, &eviction.owner)] = eviction
+		}
+	}
+	v.unverified = unverified
+
+	keys := make([]string, 0, len(failed))
+	for key := range failed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		eviction := failed[key]
+		v.blocked[key] = unscheduledReplacementBlockedCycles
+		regarding := &v1.ObjectReference{
+			APIVersion: eviction.owner.APIVersion,
+			Kind:       eviction.owner.Kind,
+			Namespace:  eviction.namespace,
+			Name:       eviction.owner.Name,
+			UID:        eviction.owner.UID,
+		}
+		pe.eventRecorder.Eventf(regarding, nil, v1.EventTypeWarning, "ReplacementNotScheduled", "Descheduled", "replacement of the pod %s evicted by %s not scheduled within %v, pausing the evictions of %s %s", eviction.podName, eviction.strategy, v.timeout, eviction.owner.Kind, eviction.owner.Name)
+	}
+}
+
+// evictionWait holds the further evictions until the replacement of the evicted pod gets ready,
+// a nil evictionWait waits for nothing. Guarded by the mutex of the pod evictor.
+type evictionWait struct {
+	timeout  time.Duration
+	interval time.Duration
+	running  bool
+	// timedOut is the evicted pod whose replacement was not ready in time in this cycle
+	timedOut string
+}
+
+func newEvictionWait(config *api.EvictionVerification) *evictionWait {
+	if config == nil || config.Wait == nil {
+		return nil
+	}
+	timeout := DefaultEvictionWaitTimeout
+	if config.Wait.Timeout != nil {
+		timeout = config.Wait.Timeout.Duration
+	}
+	return &evictionWait{
+		timeout:  timeout,
+		interval: evictionWaitInterval,
+		running:  config.Wait.Running,
+	}
+}
+
+// halts returns true when a replacement was not ready in time in this cycle
+func (w *evictionWait) halts() bool {
+	return w != nil && w.timedOut != ""
+}
+
+func (w *evictionWait) reset() {
+	if w != nil {
+		w.timedOut = ""
+	}
+}
+
+func (w *evictionWait) ready(pod *v1.Pod) bool {
+	if w.running {
+		return pod.Status.Phase == v1.PodRunning
+	}
+	return pod.Spec.NodeName != ""
+}
+
+// waitForReplacement waits for a replacement of the evicted pod to get ready. When a replacement is created
+// but not ready once the timeout elapses, the failure is counted and reported in an event on the owner, and
+// no further pods are evicted in this cycle. Bare pods and pods without a replacement created within the
+// timeout, e.g. of an owner scaled down meanwhile, do not stop the evictions. No-op unless the evictions wait.
+func (pe *PodEvictor) waitForReplacement(ctx context.Context, pod *v1.Pod, opts EvictOptions, evictedAt time.Time) {
+	w := pe.evictionWait
+	if w == nil {
+		return
+	}
+	owner := podOwner(pod)
+	if owner == nil {
+		return
+	}
+	eviction := unverifiedEviction{
+		namespace: pod.Namespace,
+		owner:     *owner,
+		podUID:    pod.UID,
+		podName:   pod.Name,
+		strategy:  opts.StrategyName,
+		profile:   opts.ProfileName,
+		evictedAt: evictedAt,
+	}
+	created := false
+	err := wait.PollUntilContextTimeout(ctx, w.interval, w.timeout, true, func(context.Context) (bool, error) {
+		pods := replacements(pe.podIndexer, eviction)
+		created = len(pods) > 0
+		for _, replacement := range pods {
+			if w.ready(replacement) {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	switch {
+	case err == nil:
+		klog.V(3).InfoS("Replacement of the evicted pod ready", "pod", klog.KObj(pod), "owner", owner.Name)
+	case ctx.Err() != nil:
+		return
+	case !created:
+		klog.V(3).InfoS("No replacement of the evicted pod created", "pod", klog.KObj(pod), "owner", owner.Name)
+	default:
+		klog.V(1).InfoS("Replacement of the evicted pod not ready in time, skipping the further evictions of the cycle", "pod", klog.KObj(pod), "owner", owner.Name, "timeout", w.timeout)
+		w.timedOut = klog.KObj(pod).String()
+		if pe.metricsEnabled {
+			metrics.UnscheduledReplacements.With(map[string]string{"namespace": pod.Namespace, "owner_kind": owner.Kind, "owner_name": owner.Name, "strategy": opts.StrategyName, "profile": opts.ProfileName}).Inc()
+		}
+		regarding := &v1.ObjectReference{
+			APIVersion: owner.APIVersion,
+			Kind:       owner.Kind,
+			Namespace:  pod.Namespace,
+			Name:       owner.Name,
+			UID:        owner.UID,
+		}
+		pe.eventRecorder.Eventf(regarding, nil, v1.EventTypeWarning, "ReplacementNotReady", "Descheduled", "replacement of the pod %s evicted by %s not ready within %v, pausing the evictions of the cycle", pod.Name, opts.StrategyName, w.timeout)
+	}
+}
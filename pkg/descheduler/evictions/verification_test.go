@@ -0,0 +1,249 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestVerifyEvictions(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, UID: types.UID(owner), Controller: utilptr.To(true)}}
+		}
+	}
+	web1 := test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web"))
+	web2 := test.BuildTestPod("web-2", 100, 0, "n1", ownedBy("web"))
+	api1 := test.BuildTestPod("api-1", 100, 0, "n1", ownedBy("api"))
+	api2 := test.BuildTestPod("api-2", 100, 0, "n1", ownedBy("api"))
+	batch1 := test.BuildTestPod("batch-1", 100, 0, "n1", ownedBy("batch"))
+	batch2 := test.BuildTestPod("batch-2", 100, 0, "n1", ownedBy("batch"))
+
+	fakeClient := fake.NewSimpleClientset(web1, web2, api1, api2, batch1, batch2)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+
+	eventRecorder := events.NewFakeRecorder(100)
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		eventRecorder,
+		podInformer,
+		initFeatureGates(),
+		NewOptions().WithEvictionVerification(&api.EvictionVerification{Enabled: true, Timeout: &metav1.Duration{Duration: time.Minute}}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+
+	for _, pod := range []*v1.Pod{web1, api1, batch1} {
+		if err := podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "RemoveDuplicates"}); err != nil {
+			t.Fatalf("Unexpected error when evicting %v: %v", pod.Name, err)
+		}
+	}
+	assertEqualEvents(t, []string{
+		"Normal RemoveDuplicates pod eviction from n1 node by sigs.k8s.io/descheduler",
+		"Normal RemoveDuplicates pod eviction from n1 node by sigs.k8s.io/descheduler",
+		"Normal RemoveDuplicates pod eviction from n1 node by sigs.k8s.io/descheduler",
+	}, eventRecorder.Events)
+
+	// The replacement of web-1 stays pending, the replacement of api-1 gets scheduled and batch-1 is not replaced
+	evictedAt := time.Now()
+	replacementOf := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			ownedBy(owner)(pod)
+			pod.CreationTimestamp = metav1.NewTime(evictedAt)
+		}
+	}
+	for _, pod := range []*v1.Pod{
+		test.BuildTestPod("web-3", 100, 0, "", replacementOf("web")),
+		test.BuildTestPod("api-3", 100, 0, "n2", replacementOf("api")),
+		web2, api2, batch2,
+	} {
+		if err := podInformer.GetIndexer().Add(pod); err != nil {
+			t.Fatalf("Unexpected error when indexing %v: %v", pod.Name, err)
+		}
+	}
+
+	evict := func(pod *v1.Pod) error {
+		return podEvictor.EvictPod(ctx, pod, EvictOptions{StrategyName: "RemoveDuplicates"})
+	}
+	assertBlocked := func(cycle string, pod *v1.Pod, blocked bool) {
+		t.Helper()
+		var expected *EvictionUnscheduledReplacementError
+		if got := podEvictor.evictionVerification.blocks(pod); got != blocked {
+			t.Errorf("%s: expected the evictions of the owner of %v blocked to be %v, got %v", cycle, pod.Name, blocked, got)
+		}
+		if blocked {
+			if err := evict(pod); !errors.As(err, &expected) {
+				t.Errorf("%s: expected the eviction of %v to fail on the unscheduled replacement, got %v", cycle, pod.Name, err)
+			}
+		}
+	}
+
+	// Within the timeout nothing fails the verification
+	podEvictor.VerifyEvictions(evictedAt.Add(30 * time.Second))
+	assertEqualEvents(t, nil, eventRecorder.Events)
+	assertBlocked("within the timeout", web2, false)
+	if len(podEvictor.evictionVerification.unverified) != 2 {
+		t.Errorf("Expected the evictions of web-1 and batch-1 unverified, got %v", podEvictor.evictionVerification.unverified)
+	}
+
+	// Past the timeout the owner of the pending replacement is blocked in this cycle and the next one
+	podEvictor.VerifyEvictions(evictedAt.Add(2 * time.Minute))
+	assertEqualEvents(t, []string{
+		"Warning ReplacementNotScheduled replacement of the pod web-1 evicted by RemoveDuplicates not scheduled within 1m0s, pausing the evictions of ReplicaSet web",
+	}, eventRecorder.Events)
+	if len(podEvictor.evictionVerification.unverified) != 0 {
+		t.Errorf("Expected no evictions unverified, got %v", podEvictor.evictionVerification.unverified)
+	}
+	assertBlocked("failure cycle", web2, true)
+	assertBlocked("failure cycle", api2, false)
+	assertBlocked("failure cycle", batch2, false)
+
+	podEvictor.VerifyEvictions(evictedAt.Add(3 * time.Minute))
+	assertBlocked("next cycle", web2, true)
+
+	podEvictor.VerifyEvictions(evictedAt.Add(4 * time.Minute))
+	assertBlocked("cycle after the next one", web2, false)
+	if err := evict(web2); err != nil {
+		t.Errorf("Unexpected error when evicting web-2 once unblocked: %v", err)
+	}
+}
+
+func TestWaitForReplacement(t *testing.T) {
+	ctx := context.Background()
+	ownedBy := func(owner string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", APIVersion: "apps/v1", Name: owner, UID: types.UID(owner), Controller: utilptr.To(true)}}
+		}
+	}
+	// Replacements created in the future are created after the eviction whenever the eviction happens
+	replacementOf := func(owner, node string, phase v1.PodPhase) *v1.Pod {
+		return test.BuildTestPod(owner+"-replacement", 100, 0, node, func(pod *v1.Pod) {
+			ownedBy(owner)(pod)
+			pod.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Hour))
+			pod.Status.Phase = phase
+		})
+	}
+
+	tests := []struct {
+		description string
+		wait        api.EvictionWait
+		evicted     *v1.Pod
+		replacement *v1.Pod
+		halts       bool
+	}{
+		{
+			description: "replacement scheduled",
+			evicted:     test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+			replacement: replacementOf("web", "n2", v1.PodPending),
+		},
+		{
+			description: "replacement pending",
+			evicted:     test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+			replacement: replacementOf("web", "", v1.PodPending),
+			halts:       true,
+		},
+		{
+			description: "replacement running",
+			wait:        api.EvictionWait{Running: true},
+			evicted:     test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+			replacement: replacementOf("web", "n2", v1.PodRunning),
+		},
+		{
+			description: "replacement scheduled but not running",
+			wait:        api.EvictionWait{Running: true},
+			evicted:     test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+			replacement: replacementOf("web", "n2", v1.PodPending),
+			halts:       true,
+		},
+		{
+			description: "no replacement created",
+			evicted:     test.BuildTestPod("web-1", 100, 0, "n1", ownedBy("web")),
+			replacement: replacementOf("batch", "", v1.PodPending),
+		},
+		{
+			description: "bare pod",
+			evicted:     test.BuildTestPod("web-1", 100, 0, "n1", nil),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			next := test.BuildTestPod("web-2", 100, 0, "n1", ownedBy("web"))
+			fakeClient := fake.NewSimpleClientset(tc.evicted, next)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+			if tc.replacement != nil {
+				if err := podInformer.GetIndexer().Add(tc.replacement); err != nil {
+					t.Fatalf("Unexpected error when indexing %v: %v", tc.replacement.Name, err)
+				}
+			}
+
+			wait := tc.wait
+			wait.Timeout = &metav1.Duration{Duration: 50 * time.Millisecond}
+			eventRecorder := events.NewFakeRecorder(100)
+			podEvictor, err := NewPodEvictor(
+				ctx,
+				fakeClient,
+				eventRecorder,
+				podInformer,
+				initFeatureGates(),
+				NewOptions().WithEvictionVerification(&api.EvictionVerification{Wait: &wait}),
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+			}
+			podEvictor.evictionWait.interval = 5 * time.Millisecond
+
+			if err := podEvictor.EvictPod(ctx, tc.evicted, EvictOptions{StrategyName: "RemoveDuplicates"}); err != nil {
+				t.Fatalf("Unexpected error when evicting %v: %v", tc.evicted.Name, err)
+			}
+
+			var expected *EvictionReplacementWaitError
+			err = podEvictor.EvictPod(ctx, next, EvictOptions{StrategyName: "RemoveDuplicates"})
+			if tc.halts && !errors.As(err, &expected) {
+				t.Errorf("Expected the eviction of %v to fail on the replacement not ready, got %v", next.Name, err)
+			}
+			if !tc.halts && err != nil {
+				t.Errorf("Unexpected error when evicting %v: %v", next.Name, err)
+			}
+
+			// The evictions resume in the next cycle
+			podEvictor.ResetCounters()
+			if podEvictor.evictionWait.halts() {
+				t.Errorf("Expected the evictions to resume in the next cycle")
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// vetoCostLimit bounds the cost of evaluating a veto validation against an eviction
+const vetoCostLimit = 1000000
+
+type vetoValidation struct {
+	expression string
+	message    string
+	program    cel.Program
+}
+
+// EvictionVeto evaluates the veto validations against the evictions
+type EvictionVeto struct {
+	validations []vetoValidation
+}
+
+// NewEvictionVeto compiles the veto validations. Returns nil when no validations are given.
+func NewEvictionVeto(veto *api.EvictionVeto) (*EvictionVeto, error) {
+	if veto == nil || len(veto.Validations) == 0 {
+		return nil, nil
+	}
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("strategy", cel.StringType),
+		cel.Variable("profile", cel.StringType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the CEL environment: %v", err)
+	}
+	ev := &EvictionVeto{}
+	for i, validation := range veto.Validations {
+		ast, issues := env.Compile(validation.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("veto validation %d: %v", i, issues.Err())
+		}
+		if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+			return nil, fmt.Errorf("veto validation %d: expression must evaluate to bool, got %v", i, outputType)
+		}
+		program, err := env.Program(ast, cel.CostLimit(vetoCostLimit))
+		if err != nil {
+			return nil, fmt.Errorf("veto validation %d: %v", i, err)
+		}
+		message := validation.Message
+		if message == "" {
+			message = fmt.Sprintf("failed expression: %s", validation.Expression)
+		}
+		ev.validations = append(ev.validations, vetoValidation{
+			expression: validation.Expression,
+			message:    message,
+			program:    program,
+		})
+	}
+	return ev, nil
+}
+
+// Evaluate returns an EvictionVetoedError when the eviction fails any of the validations.
+// An eviction the validations can not be evaluated against is vetoed.
+func (ev *EvictionVeto) Evaluate(pod *v1.Pod, opts EvictOptions) error {
+	if ev == nil {
+		return nil
+	}
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return NewEvictionVetoedError(fmt.Sprintf("unable to convert the pod: %v", err))
+	}
+	activation := map[string]any{
+		"object":   object,
+		"strategy": opts.StrategyName,
+		"profile":  opts.ProfileName,
+	}
+	for _, validation := range ev.validations {
+		result, _, err := validation.program.Eval(activation)
+		if err != nil {
+			return NewEvictionVetoedError(fmt.Sprintf("unable to evaluate %q: %v", validation.expression, err))
+		}
+		allowed, ok := result.Value().(bool)
+		if !ok {
+			return NewEvictionVetoedError(fmt.Sprintf("expression %q evaluated to %v instead of bool", validation.expression, result.Type()))
+		}
+		if !allowed {
+			return NewEvictionVetoedError(validation.message)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evictions
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestEvictionVeto(t *testing.T) {
+	veto := &api.EvictionVeto{
+		Validations: []api.VetoValidation{
+			{
+				Expression: "!has(object.metadata.labels) || !('security.example.com/no-evict' in object.metadata.labels)",
+				Message:    "pod protected by the security team",
+			},
+			{
+				Expression: "object.metadata.namespace != 'kube-system' || strategy == 'RemoveFailedPods'",
+			},
+		},
+	}
+	ev, err := NewEvictionVeto(veto)
+	if err != nil {
+		t.Fatalf("Unexpected error compiling the veto: %v", err)
+	}
+
+	protected := test.BuildTestPod("protected", 100, 0, "n1", func(pod *v1.Pod) {
+		pod.Labels = map[string]string{"security.example.com/no-evict": "true"}
+	})
+	system := test.BuildTestPod("system", 100, 0, "n1", func(pod *v1.Pod) {
+		pod.Namespace = "kube-system"
+	})
+	regular := test.BuildTestPod("regular", 100, 0, "n1", nil)
+
+	tests := []struct {
+		description   string
+		pod           *v1.Pod
+		strategy      string
+		expectedError error
+	}{
+		{
+			description:   "labeled pod vetoed with the message",
+			pod:           protected,
+			strategy:      "LowNodeUtilization",
+			expectedError: NewEvictionVetoedError("pod protected by the security team"),
+		},
+		{
+			description:   "system pod vetoed with the expression",
+			pod:           system,
+			strategy:      "LowNodeUtilization",
+			expectedError: NewEvictionVetoedError("failed expression: object.metadata.namespace != 'kube-system' || strategy == 'RemoveFailedPods'"),
+		},
+		{
+			description: "system pod allowed for the strategy",
+			pod:         system,
+			strategy:    "RemoveFailedPods",
+		},
+		{
+			description: "regular pod allowed",
+			pod:         regular,
+			strategy:    "LowNodeUtilization",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ev.Evaluate(tc.pod, EvictOptions{StrategyName: tc.strategy})
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Errorf("Expected error %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+
+	// The evaluation errors veto the eviction
+	ev, err = NewEvictionVeto(&api.EvictionVeto{Validations: []api.VetoValidation{{Expression: "object.metadata.labels['app'] == 'web'"}}})
+	if err != nil {
+		t.Fatalf("Unexpected error compiling the veto: %v", err)
+	}
+	if err := ev.Evaluate(regular, EvictOptions{}); err == nil {
+		t.Errorf("Expected the eviction to be vetoed when the expression can not be evaluated")
+	}
+}
+
+func TestNewEvictionVetoErrors(t *testing.T) {
+	for _, expression := range []string{"object.metadata.name ==", "'a' + 'b'", "unknown == 1"} {
+		if _, err := NewEvictionVeto(&api.EvictionVeto{Validations: []api.VetoValidation{{Expression: expression}}}); err == nil {
+			t.Errorf("Expected an error compiling %q", expression)
+		}
+	}
+	if ev, err := NewEvictionVeto(&api.EvictionVeto{}); ev != nil || err != nil {
+		t.Errorf("Expected no veto without validations, got %v (%v)", ev, err)
+	}
+}
+
+func TestEvictPodVetoed(t *testing.T) {
+	ctx := context.Background()
+	pod := test.BuildTestPod("p1", 100, 0, "n1", nil)
+
+	fakeClient := fake.NewSimpleClientset(pod)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	podEvictor, err := NewPodEvictor(
+		ctx,
+		fakeClient,
+		events.NewFakeRecorder(100),
+		sharedInformerFactory.Core().V1().Pods().Informer(),
+		initFeatureGates(),
+		NewOptions().WithEvictionVeto(&api.EvictionVeto{Validations: []api.VetoValidation{{Expression: "false", Message: "frozen"}}}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error when creating a pod evictor: %v", err)
+	}
+	if err := podEvictor.EvictPod(ctx, pod, EvictOptions{}); !reflect.DeepEqual(err, NewEvictionVetoedError("frozen")) {
+		t.Errorf("Expected the eviction to be vetoed, got %v", err)
+	}
+	if evicted, failed, skipped := podEvictor.TotalEvicted(), podEvictor.TotalFailed(), podEvictor.TotalSkipped(); evicted != 0 || failed != 0 || skipped != 1 {
+		t.Errorf("Expected no eviction, no failure and one skipped eviction, got %d evicted, %d failed and %d skipped", evicted, failed, skipped)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	exemptionsv1alpha1 "sigs.k8s.io/descheduler/pkg/api/exemptions/v1alpha1"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+)
+
+// exemptionsInForce returns the DeschedulingExemption objects of all the namespaces not expired yet.
+// The exemptions with an invalid selector or exceeding the max duration are ignored.
+func exemptionsInForce(ctx context.Context, client dynamic.Interface, exemptions *api.DeschedulingExemptions, now time.Time) ([]evictions.Exemption, error) {
+	list, err := client.Resource(exemptionsv1alpha1.DeschedulingExemptionsResource).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the descheduling exemptions: %w", err)
+	}
+
+	var inForce []evictions.Exemption
+	for i := range list.Items {
+		exemption := &exemptionsv1alpha1.DeschedulingExemption{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, exemption); err != nil {
+			klog.ErrorS(err, "Ignoring the invalid descheduling exemption", "exemption", klog.KObj(&list.Items[i]))
+			continue
+		}
+		expiry := exemption.Spec.Expiry.Time
+		if !now.Before(expiry) {
+			klog.V(3).InfoS("Ignoring the expired descheduling exemption", "exemption", klog.KObj(exemption), "expiry", expiry)
+			continue
+		}
+		if exemptions.MaxDuration != nil && expiry.Sub(exemption.CreationTimestamp.Time) > exemptions.MaxDuration.Duration {
+			klog.V(1).InfoS("Ignoring the descheduling exemption exceeding the max duration", "exemption", klog.KObj(exemption), "expiry", expiry, "maxDuration", exemptions.MaxDuration.Duration)
+			continue
+		}
+		if exemption.Spec.Selector == nil {
+			klog.V(1).InfoS("Ignoring the descheduling exemption without a selector", "exemption", klog.KObj(exemption))
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(exemption.Spec.Selector)
+		if err != nil {
+			klog.ErrorS(err, "Ignoring the descheduling exemption with an invalid selector", "exemption", klog.KObj(exemption))
+			continue
+		}
+		inForce = append(inForce, evictions.Exemption{
+			Name:      exemption.Name,
+			Namespace: exemption.Namespace,
+			Selector:  selector,
+		})
+	}
+	return inForce, nil
+}
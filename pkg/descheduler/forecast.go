@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+)
+
+// PluginExposure describes how many pods of a workload match criteria of a plugin
+type PluginExposure struct {
+	Profile string `json:"profile"`
+	Plugin  string `json:"plugin"`
+	Pods    uint   `json:"pods"`
+}
+
+// WorkloadExposure lists plugins whose criteria pods of a workload currently match,
+// i.e. the plugins that would evict the pods in the next descheduling cycle.
+type WorkloadExposure struct {
+	Namespace string           `json:"namespace"`
+	Kind      string           `json:"kind"`
+	Name      string           `json:"name"`
+	Plugins   []PluginExposure `json:"plugins"`
+}
+
+type exposureRecorder struct {
+	mu        sync.Mutex
+	client    clientset.Interface
+	workloads map[string]*WorkloadExposure
+	// ownerCache caches the resolved workload of replica sets
+	ownerCache map[string]metav1.OwnerReference
+}
+
+func newExposureRecorder(client clientset.Interface) *exposureRecorder {
+	return &exposureRecorder{
+		client:     client,
+		workloads:  make(map[string]*WorkloadExposure),
+		ownerCache: make(map[string]metav1.OwnerReference),
+	}
+}
+
+// workloadOf resolves the top level workload of a pod. Pods owned by a replica set
+// owned by a deployment are reported under the deployment.
+func (er *exposureRecorder) workloadOf(ctx context.Context, pod *v1.Pod) (string, string) {
+	ownerRef := metav1.GetControllerOf(pod)
+	if ownerRef == nil {
+		if len(pod.OwnerReferences) == 0 {
+			return "Pod", pod.Name
+		}
+		ownerRef = &pod.OwnerReferences[0]
+	}
+	if ownerRef.Kind != "ReplicaSet" || er.client == nil {
+		return ownerRef.Kind, ownerRef.Name
+	}
+	key := pod.Namespace + "/" + ownerRef.Name
+	if owner, exists := er.ownerCache[key]; exists {
+		return owner.Kind, owner.Name
+	}
+	owner := *ownerRef
+	rs, err := er.client.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, ownerRef.Name, metav1.GetOptions{})
+	if err != nil {
+		klog.V(3).InfoS("Unable to get replica set owning a pod, reporting the replica set as the workload", "replicaSet", klog.KRef(pod.Namespace, ownerRef.Name), "err", err)
+	} else if rsOwnerRef := metav1.GetControllerOf(rs); rsOwnerRef != nil {
+		owner = *rsOwnerRef
+	}
+	er.ownerCache[key] = owner
+	return owner.Kind, owner.Name
+}
+
+func (er *exposureRecorder) observer(ctx context.Context) evictions.EvictionObserver {
+	return func(pod *v1.Pod, opts evictions.EvictOptions) {
+		er.mu.Lock()
+		defer er.mu.Unlock()
+		kind, name := er.workloadOf(ctx, pod)
+		key := fmt.Sprintf("%s/%s/%s", pod.Namespace, kind, name)
+		workload, exists := er.workloads[key]
+		if !exists {
+			workload = &WorkloadExposure{Namespace: pod.Namespace, Kind: kind, Name: name}
+			er.workloads[key] = workload
+		}
+		for idx := range workload.Plugins {
+			if workload.Plugins[idx].Profile == opts.ProfileName && workload.Plugins[idx].Plugin == opts.StrategyName {
+				workload.Plugins[idx].Pods++
+				return
+			}
+		}
+		workload.Plugins = append(workload.Plugins, PluginExposure{Profile: opts.ProfileName, Plugin: opts.StrategyName, Pods: 1})
+	}
+}
+
+// exposures returns the recorded workloads sorted by namespace, kind and name
+func (er *exposureRecorder) exposures() []WorkloadExposure {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+	result := make([]WorkloadExposure, 0, len(er.workloads))
+	for _, workload := range er.workloads {
+		sort.Slice(workload.Plugins, func(i, j int) bool {
+			if workload.Plugins[i].Profile != workload.Plugins[j].Profile {
+				return workload.Plugins[i].Profile < workload.Plugins[j].Profile
+			}
+			return workload.Plugins[i].Plugin < workload.Plugins[j].Plugin
+		})
+		result = append(result, *workload)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Forecast reports the disruption exposure of workloads under the configured policy.
+// A single descheduling cycle is simulated in the dry run mode and every simulated eviction
+// is attributed to the workload owning the evicted pod. The eviction limits, the quotas and the
+// paces of the policy and the plugins are ignored so the exposure reflects the plugins' criteria
+// rather than the eviction budgets.
+// No object in the cluster is modified.
+func Forecast(ctx context.Context, rs *options.DeschedulerServer) ([]WorkloadExposure, error) {
+	if err := setupClient(rs); err != nil {
+		return nil, err
+	}
+
+	deschedulerPolicy, err := LoadPolicyConfig(rs.PolicyConfigFile, rs.Client, pluginregistry.PluginRegistry)
+	if err != nil {
+		return nil, err
+	}
+	if deschedulerPolicy == nil {
+		return nil, fmt.Errorf("deschedulerPolicy is nil")
+	}
+
+	evictionPolicyGroupVersion, err := eutils.SupportEviction(rs.Client)
+	if err != nil || len(evictionPolicyGroupVersion) == 0 {
+		return nil, err
+	}
+
+	if err := setupMetricsClient(rs, deschedulerPolicy); err != nil {
+		return nil, err
+	}
+
+	return forecast(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion)
+}
+
+func forecast(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string) ([]WorkloadExposure, error) {
+	withoutEvictionBudgets(deschedulerPolicy)
+
+	recorder := newExposureRecorder(rs.Client)
+	if err := simulateCycle(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion, rs.Client, recorder.observer(ctx)); err != nil {
+		return nil, err
+	}
+	return recorder.exposures(), nil
+}
+
+// withoutEvictionBudgets clears the limits on the number and the pace of the evictions of the policy and its plugins
+func withoutEvictionBudgets(deschedulerPolicy *api.DeschedulerPolicy) {
+	deschedulerPolicy.MaxNoOfPodsToEvictPerNode = nil
+	deschedulerPolicy.MaxNoOfPodsToEvictPerNamespace = nil
+	deschedulerPolicy.MaxNoOfPodsToEvictTotal = nil
+	deschedulerPolicy.MaxNoOfPodsToEvictPerOwner = nil
+	deschedulerPolicy.MaxNoOfPodsToEvictPerTopologyDomain = nil
+	deschedulerPolicy.MaxEvictionFailuresPerCycle = nil
+	deschedulerPolicy.EvictionSpreading = nil
+	deschedulerPolicy.EvictionFairness = nil
+	deschedulerPolicy.NamespaceDisruptionQuotas = nil
+	deschedulerPolicy.EvictionRateLimits = nil
+	deschedulerPolicy.NamespaceEvictionIntervals = nil
+	deschedulerPolicy.TerminationPacing = nil
+	deschedulerPolicy.NodeCooldownCycles = nil
+
+	for i := range deschedulerPolicy.Profiles {
+		for j := range deschedulerPolicy.Profiles[i].PluginConfigs {
+			pluginConfig := &deschedulerPolicy.Profiles[i].PluginConfigs[j]
+			pluginConfig.MaxPodsToEvictPerNode = nil
+			pluginConfig.MaxPodsToEvictPerCycle = nil
+			if args, ok := pluginConfig.Args.(*nodeutilization.LowNodeUtilizationArgs); ok {
+				args.EvictionLimits = nil
+			}
+		}
+	}
+}
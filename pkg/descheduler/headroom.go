@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// clusterHeadroom returns the share of the allocatable resources of the schedulable nodes left free by the pod requests.
+// The evicted pods can not be scheduled on the cordoned nodes, their capacity is not counted.
+func clusterHeadroom(nodes []*v1.Node, getPodsAssignedToNode podutil.GetPodsAssignedToNodeFunc, resourceNames []v1.ResourceName) (map[v1.ResourceName]float64, error) {
+	allocatable := make(map[v1.ResourceName]int64)
+	requested := make(map[v1.ResourceName]int64)
+	for _, node := range nodes {
+		if nodeutil.IsNodeUnschedulable(node) {
+			continue
+		}
+		pods, err := podutil.ListPodsOnANode(node.Name, getPodsAssignedToNode, nil)
+		if err != nil {
+			return nil, err
+		}
+		usage, err := nodeutil.NodeUtilization(pods, resourceNames, func(pod *v1.Pod) (v1.ResourceList, error) {
+			req, _ := utils.PodRequestsAndLimits(pod)
+			return req, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range resourceNames {
+			quantity := node.Status.Allocatable[name]
+			allocatable[name] += quantity.MilliValue()
+			requested[name] += usage[name].MilliValue()
+		}
+	}
+
+	headroom := make(map[v1.ResourceName]float64, len(resourceNames))
+	for _, name := range resourceNames {
+		if allocatable[name] <= 0 {
+			headroom[name] = 0
+			continue
+		}
+		headroom[name] = float64(allocatable[name]-requested[name]) * 100 / float64(allocatable[name])
+	}
+	return headroom, nil
+}
+
+// headroomBelowMinimum tells whether the cluster is too full to evict, the evicted pods would only stay Pending
+func (d *descheduler) headroomBelowMinimum(nodes []*v1.Node, minimum api.ResourceThresholds) bool {
+	metrics.ClusterHeadroom.Reset()
+	resourceNames := make([]v1.ResourceName, 0, len(minimum))
+	for name := range minimum {
+		resourceNames = append(resourceNames, name)
+	}
+	headroom, err := clusterHeadroom(nodes, d.getPodsAssignedToNode, resourceNames)
+	if err != nil {
+		klog.ErrorS(err, "Unable to compute the cluster headroom")
+		return true
+	}
+
+	below := false
+	for _, name := range resourceNames {
+		metrics.ClusterHeadroom.WithLabelValues(string(name)).Set(headroom[name])
+		if headroom[name] < float64(minimum[name]) {
+			klog.InfoS("Cluster headroom below the minimum", "resource", name, "headroom", headroom[name], "minimum", minimum[name])
+			below = true
+		}
+	}
+	return below
+}
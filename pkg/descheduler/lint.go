@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
+)
+
+// Rules of the policy linting
+const (
+	LintRuleNoEvictionLimits                = "no-eviction-limits"
+	LintRuleThresholdsEqualTargetThresholds = "thresholds-equal-target-thresholds"
+	LintRuleEvictorDisabled                 = "evictor-disabled"
+	LintRuleEvictSystemCriticalPods         = "evict-system-critical-pods"
+	LintRuleUnscopedPodLifeTime             = "unscoped-pod-lifetime"
+)
+
+// LintWarning flags a valid but risky policy configuration
+type LintWarning struct {
+	Rule    string `json:"rule"`
+	Profile string `json:"profile,omitempty"`
+	Plugin  string `json:"plugin,omitempty"`
+	Message string `json:"message"`
+}
+
+// LintPolicyConfig loads the policy from the file and flags its risky configurations.
+// Invalid policies fail the same way as when the descheduler starts.
+func LintPolicyConfig(policyConfigFile string, registry pluginregistry.Registry) ([]LintWarning, error) {
+	policy, err := os.ReadFile(policyConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy config file %q: %+v", policyConfigFile, err)
+	}
+	internalPolicy, err := decodeAndValidate(policyConfigFile, policy, registry)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range internalPolicy.Profiles {
+		for idx := range profile.PluginConfigs {
+			setDefaultsPluginConfig(&profile.PluginConfigs[idx], registry)
+		}
+	}
+	return LintPolicy(internalPolicy), nil
+}
+
+// LintPolicy flags the risky configurations of the policy
+func LintPolicy(policy *api.DeschedulerPolicy) []LintWarning {
+	var warnings []LintWarning
+	if policy.MaxNoOfPodsToEvictPerNode == nil && policy.MaxNoOfPodsToEvictPerNamespace == nil && policy.MaxNoOfPodsToEvictTotal == nil && policy.MaxNoOfPodsToEvictPerOwner == nil {
+		warnings = append(warnings, LintWarning{
+			Rule:    LintRuleNoEvictionLimits,
+			Message: "none of maxNoOfPodsToEvictPerNode, maxNoOfPodsToEvictPerNamespace, maxNoOfPodsToEvictPerOwner and maxNoOfPodsToEvictTotal is set, a single cycle can evict any number of pods",
+		})
+	}
+	for _, profile := range policy.Profiles {
+		warnings = append(warnings, lintProfile(profile)...)
+	}
+	return warnings
+}
+
+func lintProfile(profile api.DeschedulerProfile) []LintWarning {
+	var warnings []LintWarning
+	if findPluginName(profile.Plugins.Filter.Disabled, defaultevictor.PluginName) || findPluginName(profile.Plugins.PreEvictionFilter.Disabled, defaultevictor.PluginName) {
+		warnings = append(warnings, LintWarning{
+			Rule:    LintRuleEvictorDisabled,
+			Profile: profile.Name,
+			Plugin:  defaultevictor.PluginName,
+			Message: "the DefaultEvictor is disabled, it is enabled regardless so the filtering of system critical, DaemonSet and bare pods stays in place, disable its protections through its args instead",
+		})
+	}
+
+	for _, pluginConfig := range profile.PluginConfigs {
+		switch args := pluginConfig.Args.(type) {
+		case *defaultevictor.DefaultEvictorArgs:
+			if args.EvictSystemCriticalPods {
+				warnings = append(warnings, LintWarning{
+					Rule:    LintRuleEvictSystemCriticalPods,
+					Profile: profile.Name,
+					Plugin:  pluginConfig.Name,
+					Message: "evictSystemCriticalPods is set, Kubernetes system pods (e.g. kube-dns) can be evicted",
+				})
+			}
+		case *nodeutilization.LowNodeUtilizationArgs:
+			if !findPluginName(profile.Plugins.Balance.Enabled, pluginConfig.Name) {
+				continue
+			}
+			for resource, threshold := range args.Thresholds {
+				if targetThreshold, ok := args.TargetThresholds[resource]; ok && targetThreshold == threshold {
+					warnings = append(warnings, LintWarning{
+						Rule:    LintRuleThresholdsEqualTargetThresholds,
+						Profile: profile.Name,
+						Plugin:  pluginConfig.Name,
+						Message: fmt.Sprintf("thresholds and targetThresholds of %q are both %v, pods evicted from overutilized nodes can make the underutilized nodes overutilized and the evictions flap", resource, threshold),
+					})
+				}
+			}
+		case *podlifetime.PodLifeTimeArgs:
+			if !findPluginName(profile.Plugins.Deschedule.Enabled, pluginConfig.Name) {
+				continue
+			}
+			if args.Namespaces == nil && profile.Namespaces == nil && args.LabelSelector == nil {
+				warnings = append(warnings, LintWarning{
+					Rule:    LintRuleUnscopedPodLifeTime,
+					Profile: profile.Name,
+					Plugin:  pluginConfig.Name,
+					Message: "neither namespaces nor labelSelector is set, pods of all the namespaces (including kube-system) are evicted once old enough",
+				})
+			}
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+func TestLintPolicyConfig(t *testing.T) {
+	SetupPlugins()
+
+	tests := []struct {
+		description string
+		policy      string
+		rules       []string
+		expectedErr bool
+	}{
+		{
+			description: "policy with limits and scoped plugins",
+			policy: `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+maxNoOfPodsToEvictPerNode: 5
+profiles:
+  - name: default
+    pluginConfig:
+    - name: PodLifeTime
+      args:
+        maxPodLifeTimeSeconds: 86400
+        namespaces:
+          include: ["dev"]
+    - name: LowNodeUtilization
+      args:
+        thresholds:
+          cpu: 20
+        targetThresholds:
+          cpu: 50
+    plugins:
+      deschedule:
+        enabled: ["PodLifeTime"]
+      balance:
+        enabled: ["LowNodeUtilization"]
+`,
+		},
+		{
+			description: "risky policy",
+			policy: `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: default
+    pluginConfig:
+    - name: DefaultEvictor
+      args:
+        evictSystemCriticalPods: true
+    - name: PodLifeTime
+      args:
+        maxPodLifeTimeSeconds: 86400
+    - name: LowNodeUtilization
+      args:
+        thresholds:
+          cpu: 50
+        targetThresholds:
+          cpu: 50
+    plugins:
+      filter:
+        disabled: ["DefaultEvictor"]
+      deschedule:
+        enabled: ["PodLifeTime"]
+      balance:
+        enabled: ["LowNodeUtilization"]
+`,
+			rules: []string{
+				LintRuleNoEvictionLimits,
+				LintRuleEvictorDisabled,
+				LintRuleEvictSystemCriticalPods,
+				LintRuleUnscopedPodLifeTime,
+				LintRuleThresholdsEqualTargetThresholds,
+			},
+		},
+		{
+			description: "PodLifeTime scoped through the profile namespaces",
+			policy: `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+maxNoOfPodsToEvictTotal: 10
+profiles:
+  - name: default
+    namespaces:
+      exclude: ["kube-system"]
+    pluginConfig:
+    - name: PodLifeTime
+      args:
+        maxPodLifeTimeSeconds: 86400
+    plugins:
+      deschedule:
+        enabled: ["PodLifeTime"]
+`,
+		},
+		{
+			description: "invalid policy",
+			policy: `apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: default
+    namespaces:
+      include: ["dev"]
+      exclude: ["kube-system"]
+    pluginConfig:
+    - name: PodLifeTime
+      args:
+        maxPodLifeTimeSeconds: 86400
+    plugins:
+      deschedule:
+        enabled: ["PodLifeTime"]
+`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "policy.yaml")
+			if err := os.WriteFile(file, []byte(tc.policy), 0o600); err != nil {
+				t.Fatalf("Unable to write the policy: %v", err)
+			}
+			warnings, err := LintPolicyConfig(file, pluginregistry.PluginRegistry)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.expectedErr, err)
+			}
+			var rules []string
+			for _, warning := range warnings {
+				if warning.Message == "" {
+					t.Errorf("Expected the %q warning to explain the risk", warning.Rule)
+				}
+				rules = append(rules, warning.Rule)
+			}
+			if diff := cmp.Diff(tc.rules, rules); diff != "" {
+				t.Errorf("Unexpected warnings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -33,6 +33,8 @@ import (
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
 	utilptr "k8s.io/utils/ptr"
 	"sigs.k8s.io/descheduler/pkg/api"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 )
 
 const (
@@ -45,6 +47,10 @@ type MetricsCollector struct {
 	nodeSelector     labels.Selector
 
 	nodes map[string]api.ReferencedResourceList
+	// samples keeps the latest metrics sample collected for every node
+	samples map[string]nodeSample
+	// ignoreSimulatedNodes skips collection of metrics for nodes simulated by kwok
+	ignoreSimulatedNodes bool
 
 	mu sync.RWMutex
 	// hasSynced signals at least one sync succeeded
@@ -57,9 +63,33 @@ func NewMetricsCollector(nodeLister listercorev1.NodeLister, metricsClientset me
 		metricsClientset: metricsClientset,
 		nodeSelector:     nodeSelector,
 		nodes:            make(map[string]api.ReferencedResourceList),
+		samples:          make(map[string]nodeSample),
 	}
 }
 
+// nodeSample is the latest metrics sample collected for a node
+type nodeSample struct {
+	timestamp time.Time
+	cpu       int64
+	memory    int64
+	// change of the usage since the previous sample in percentage
+	change float64
+}
+
+// NodeSample describes the latest metrics sample collected for a node
+type NodeSample = frameworktypes.NodeSample
+
+// IgnoreSimulatedNodes stops collection of metrics for nodes simulated by kwok.
+// Simulated nodes have no kubelet so no metrics are ever reported for them.
+func (mc *MetricsCollector) IgnoreSimulatedNodes() {
+	mc.ignoreSimulatedNodes = true
+}
+
+// SimulatedNodesIgnored checks whether metrics for nodes simulated by kwok are collected
+func (mc *MetricsCollector) SimulatedNodesIgnored() bool {
+	return mc.ignoreSimulatedNodes
+}
+
 func (mc *MetricsCollector) Run(ctx context.Context) {
 	wait.NonSlidingUntil(func() {
 		mc.Collect(ctx)
@@ -107,6 +137,29 @@ func (mc *MetricsCollector) NodeUsage(node *v1.Node) (api.ReferencedResourceList
 	}, nil
 }
 
+// NodeSample returns the latest metrics sample collected for the node
+func (mc *MetricsCollector) NodeSample(nodeName string) (NodeSample, bool) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	sample, exists := mc.samples[nodeName]
+	if !exists {
+		return NodeSample{}, false
+	}
+	return NodeSample{Timestamp: sample.timestamp, Change: sample.change}, true
+}
+
+// changePercentage returns the change from the previous to the current value in percentage
+func changePercentage(prevValue, value int64) float64 {
+	if prevValue == 0 {
+		if value == 0 {
+			return 0
+		}
+		return 100
+	}
+	return math.Abs(float64(value-prevValue)) * 100 / float64(prevValue)
+}
+
 func (mc *MetricsCollector) HasSynced() bool {
 	return mc.hasSynced
 }
@@ -124,6 +177,9 @@ func (mc *MetricsCollector) Collect(ctx context.Context) error {
 	}
 
 	for _, node := range nodes {
+		if mc.ignoreSimulatedNodes && nodeutil.IsSimulatedNode(node) {
+			continue
+		}
 		metrics, err := mc.metricsClientset.MetricsV1beta1().NodeMetricses().Get(ctx, node.Name, metav1.GetOptions{})
 		if err != nil {
 			klog.ErrorS(err, "Error fetching metrics", "node", node.Name)
@@ -131,6 +187,22 @@ func (mc *MetricsCollector) Collect(ctx context.Context) error {
 			continue
 		}
 
+		sample := nodeSample{
+			timestamp: metrics.Timestamp.Time,
+			cpu:       metrics.Usage.Cpu().MilliValue(),
+			memory:    metrics.Usage.Memory().Value(),
+		}
+		if sample.timestamp.IsZero() {
+			sample.timestamp = time.Now()
+		}
+		// The metrics server reports the same sample until the next scrape of the node
+		if prevSample, exists := mc.samples[node.Name]; !exists {
+			mc.samples[node.Name] = sample
+		} else if sample.timestamp.After(prevSample.timestamp) {
+			sample.change = math.Max(changePercentage(prevSample.cpu, sample.cpu), changePercentage(prevSample.memory, sample.memory))
+			mc.samples[node.Name] = sample
+		}
+
 		if _, exists := mc.nodes[node.Name]; !exists {
 			mc.nodes[node.Name] = api.ReferencedResourceList{
 				v1.ResourceCPU:    utilptr.To[resource.Quantity](metrics.Usage.Cpu().DeepCopy()),
@@ -20,9 +20,11 @@ import (
 	"context"
 	"math"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/informers"
@@ -140,3 +142,52 @@ func TestMetricsCollectorConvergence(t *testing.T) {
 		t.Fatalf("The node usage did not converged to 900+-1")
 	}
 }
+
+func TestMetricsCollectorNodeSample(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "nodes"}
+
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+
+	scrapeTime := time.Date(2025, time.May, 1, 12, 0, 0, 0, time.UTC)
+	n1metrics := test.BuildNodeMetrics("n1", 400, 1714978816)
+	n1metrics.Timestamp = metav1.NewTime(scrapeTime)
+
+	clientset := fakeclientset.NewSimpleClientset(n1, n2)
+	metricsClientset := fakemetricsclient.NewSimpleClientset()
+	metricsClientset.Tracker().Create(gvr, n1metrics, "")
+
+	ctx := context.TODO()
+	sharedInformerFactory := informers.NewSharedInformerFactory(clientset, 0)
+	nodeLister := sharedInformerFactory.Core().V1().Nodes().Lister()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	collector := NewMetricsCollector(nodeLister, metricsClientset, labels.Everything())
+	collector.Collect(ctx)
+	checkNodeSample := func(timestamp time.Time, change float64) {
+		t.Helper()
+		sample, ok := collector.NodeSample(n1.Name)
+		if !ok {
+			t.Fatalf("Expected a metrics sample of %v", n1.Name)
+		}
+		if !sample.Timestamp.Equal(timestamp) || sample.Change != change {
+			t.Fatalf("Expected a sample of %v with %v change, got %v with %v change", timestamp, change, sample.Timestamp, sample.Change)
+		}
+	}
+	checkNodeSample(scrapeTime, 0)
+	if _, ok := collector.NodeSample(n2.Name); ok {
+		t.Fatalf("Expected no metrics sample of %v", n2.Name)
+	}
+
+	t.Logf("Double the node cpu usage in the next scrape")
+	n1metrics.Usage[v1.ResourceCPU] = *resource.NewMilliQuantity(800, resource.DecimalSI)
+	n1metrics.Timestamp = metav1.NewTime(scrapeTime.Add(15 * time.Second))
+	metricsClientset.Tracker().Update(gvr, n1metrics, "")
+	collector.Collect(ctx)
+	checkNodeSample(scrapeTime.Add(15*time.Second), 100)
+
+	t.Logf("Collect the same scrape again")
+	collector.Collect(ctx)
+	checkNodeSample(scrapeTime.Add(15*time.Second), 100)
+}
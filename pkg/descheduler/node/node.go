@@ -18,14 +18,18 @@ package node
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync/atomic"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	clientset "k8s.io/client-go/kubernetes"
 	listersv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/workqueue"
@@ -37,6 +41,17 @@ import (
 
 const workersCount = 100
 
+const (
+	// LastEvictionTimestampAnnotationKey is the node annotation recording when
+	// the descheduler last evicted pods from the node
+	LastEvictionTimestampAnnotationKey = "descheduler.alpha.kubernetes.io/last-eviction-timestamp"
+	// LastEvictionCountAnnotationKey is the node annotation recording how many pods
+	// the descheduler evicted from the node in the last cycle with evictions
+	LastEvictionCountAnnotationKey = "descheduler.alpha.kubernetes.io/last-eviction-count"
+	// SimulatedNodeAnnotationKey is the annotation kwok manages the simulated nodes by
+	SimulatedNodeAnnotationKey = "kwok.x-k8s.io/node"
+)
+
 // ReadyNodes returns ready nodes irrespective of whether they are
 // schedulable or not.
 func ReadyNodes(ctx context.Context, client clientset.Interface, nodeLister listersv1.NodeLister, nodeSelector string) ([]*v1.Node, error) {
@@ -182,6 +197,31 @@ func PodFitsAnyOtherNode(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.
 	})
 }
 
+// PodFittingOtherNodes returns the given nodes, besides the node the pod is already running on,
+// the given pod will fit. The predicates used to determine if the pod will fit can be found in the NodeFit function.
+func PodFittingOtherNodes(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.Pod, nodes []*v1.Node) []*v1.Node {
+	fits := make([]bool, len(nodes))
+	checkNode := func(i int) {
+		if nodes[i].Name == pod.Spec.NodeName {
+			return
+		}
+		if err := NodeFit(nodeIndexer, pod, nodes[i]); err != nil {
+			klog.V(4).InfoS("Pod does not fit on node", "pod", klog.KObj(pod), "node", klog.KObj(nodes[i]), "err", err.Error())
+			return
+		}
+		fits[i] = true
+	}
+	workqueue.ParallelizeUntil(context.Background(), workersCount, len(nodes), checkNode)
+
+	var fittingNodes []*v1.Node
+	for i, node := range nodes {
+		if fits[i] {
+			fittingNodes = append(fittingNodes, node)
+		}
+	}
+	return fittingNodes
+}
+
 // PodFitsAnyNode checks if the given pod will fit any of the given nodes. The predicates used
 // to determine if the pod will fit can be found in the NodeFit function.
 func PodFitsAnyNode(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.Pod, nodes []*v1.Node) bool {
@@ -203,6 +243,35 @@ func PodFitsCurrentNode(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *v1.P
 	return false
 }
 
+// IsNodeGone checks if the pods bound to the node are not likely to run anymore, the node is being
+// deleted or the node lifecycle controller found the node unreachable or not ready and tainted it.
+// The node may still report the Ready condition until the node controller updates the condition.
+func IsNodeGone(node *v1.Node) bool {
+	if node.DeletionTimestamp != nil {
+		return true
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == v1.TaintEffectNoExecute && (taint.Key == v1.TaintNodeUnreachable || taint.Key == v1.TaintNodeNotReady) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithoutGoneNodes leaves out the nodes going away, the pods bound to them must not count
+// as running replicas.
+func WithoutGoneNodes(nodes []*v1.Node) []*v1.Node {
+	remaining := make([]*v1.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if IsNodeGone(node) {
+			klog.V(2).InfoS("Ignoring the pods of the node going away", "node", klog.KObj(node))
+			continue
+		}
+		remaining = append(remaining, node)
+	}
+	return remaining
+}
+
 // IsNodeUnschedulable checks if the node is unschedulable. This is a helper function to check only in case of
 // underutilized node so that they won't be accounted for.
 func IsNodeUnschedulable(node *v1.Node) bool {
@@ -395,3 +464,28 @@ func podMatchesInterPodAntiAffinity(nodeIndexer podutil.GetPodsAssignedToNodeFun
 
 	return false, nil
 }
+
+// AnnotateNodeEvictions records the timestamp and the number of pods
+// of the last evictions from the node in the node annotations.
+func AnnotateNodeEvictions(ctx context.Context, client clientset.Interface, nodeName string, evicted uint, timestamp time.Time) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				LastEvictionTimestampAnnotationKey: timestamp.UTC().Format(time.RFC3339),
+				LastEvictionCountAnnotationKey:     strconv.FormatUint(uint64(evicted), 10),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to build node %q annotations patch: %v", nodeName, err)
+	}
+	if _, err := client.CoreV1().Nodes().Patch(ctx, nodeName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to annotate node %q: %v", nodeName, err)
+	}
+	return nil
+}
+
+// IsSimulatedNode checks whether the node is simulated by kwok
+func IsSimulatedNode(node *v1.Node) bool {
+	return node.Annotations[SimulatedNodeAnnotationKey] == "fake"
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -85,6 +86,38 @@ func TestReadyNodesWithNodeSelector(t *testing.T) {
 	}
 }
 
+func TestAnnotateNodeEvictions(t *testing.T) {
+	node1 := test.BuildTestNode("node1", 1000, 2000, 9, func(node *v1.Node) {
+		node.Annotations = map[string]string{"foo": "bar"}
+	})
+	fakeClient := fake.NewSimpleClientset(node1)
+	ctx := context.TODO()
+
+	timestamp := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	if err := AnnotateNodeEvictions(ctx, fakeClient, node1.Name, 3, timestamp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	node, err := fakeClient.CoreV1().Nodes().Get(ctx, node1.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unable to get node: %v", err)
+	}
+	expected := map[string]string{
+		"foo":                              "bar",
+		LastEvictionTimestampAnnotationKey: "2025-02-03T04:05:06Z",
+		LastEvictionCountAnnotationKey:     "3",
+	}
+	for key, value := range expected {
+		if node.Annotations[key] != value {
+			t.Errorf("expected annotation %v=%v, got %v", key, value, node.Annotations[key])
+		}
+	}
+
+	if err := AnnotateNodeEvictions(ctx, fakeClient, "unknown", 1, timestamp); err == nil {
+		t.Errorf("expected an error when annotating a missing node")
+	}
+}
+
 func TestIsNodeUnschedulable(t *testing.T) {
 	tests := []struct {
 		description     string
@@ -114,6 +147,46 @@ func TestIsNodeUnschedulable(t *testing.T) {
 	}
 }
 
+func TestIsNodeGone(t *testing.T) {
+	tests := []struct {
+		description string
+		node        *v1.Node
+		gone        bool
+	}{
+		{
+			description: "Node is expected to run its pods",
+			node:        &v1.Node{},
+			gone:        false,
+		},
+		{
+			description: "Node is expected to be gone because of being deleted",
+			node: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &metav1.Time{}},
+			},
+			gone: true,
+		},
+		{
+			description: "Node is expected to be gone because of the unreachable taint",
+			node: &v1.Node{
+				Spec: v1.NodeSpec{Taints: []v1.Taint{{Key: v1.TaintNodeUnreachable, Effect: v1.TaintEffectNoExecute}}},
+			},
+			gone: true,
+		},
+		{
+			description: "Node is not expected to be gone because of the not ready taint not executing",
+			node: &v1.Node{
+				Spec: v1.NodeSpec{Taints: []v1.Taint{{Key: v1.TaintNodeNotReady, Effect: v1.TaintEffectNoSchedule}}},
+			},
+			gone: false,
+		},
+	}
+	for _, test := range tests {
+		if gone := IsNodeGone(test.node); gone != test.gone {
+			t.Errorf("Test %#v failed, expected %v, got %v", test.description, test.gone, gone)
+		}
+	}
+}
+
 func TestPodFitsCurrentNode(t *testing.T) {
 	nodeLabelKey := "kubernetes.io/desiredNode"
 	nodeLabelValue := "yes"
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// webhookTimeout bounds the time spent delivering a single notification
+	webhookTimeout = 10 * time.Second
+)
+
+// CycleSummary is a compact summary of a single descheduling cycle
+type CycleSummary struct {
+	StartTime          time.Time       `json:"startTime"`
+	EndTime            time.Time       `json:"endTime"`
+	DryRun             bool            `json:"dryRun"`
+	TotalEvicted       uint            `json:"totalEvicted"`
+	EvictionRequests   uint            `json:"evictionRequests"`
+	FailedEvictions    uint            `json:"failedEvictions"`
+	SkippedEvictions   uint            `json:"skippedEvictions"`
+	EvictedByStrategy  map[string]uint `json:"evictedByStrategy,omitempty"`
+	EvictedByNamespace map[string]uint `json:"evictedByNamespace,omitempty"`
+	Errors             []string        `json:"errors,omitempty"`
+}
+
+// Notifier sends a cycle summary to an external sink
+type Notifier interface {
+	Notify(ctx context.Context, summary *CycleSummary) error
+}
+
+// NewNotifiers builds a notifier for each sink configured in the policy
+func NewNotifiers(config *api.Notifications) []Notifier {
+	if config == nil {
+		return nil
+	}
+	var notifiers []Notifier
+	for _, webhook := range config.Webhooks {
+		notifiers = append(notifiers, NewWebhookNotifier(webhook, &http.Client{Timeout: webhookTimeout}))
+	}
+	return notifiers
+}
+
+// NotifyAll sends the summary to all the notifiers. Delivery failures are
+// logged and never interrupt the descheduling.
+func NotifyAll(ctx context.Context, notifiers []Notifier, summary *CycleSummary) {
+	for _, notifier := range notifiers {
+		if err := notifier.Notify(ctx, summary); err != nil {
+			klog.ErrorS(err, "unable to deliver descheduling cycle summary")
+		}
+	}
+}
+
+type webhookNotifier struct {
+	url          string
+	format       api.WebhookFormat
+	minEvictions uint
+	client       *http.Client
+}
+
+var _ Notifier = &webhookNotifier{}
+
+// NewWebhookNotifier returns a notifier POSTing the summary to the webhook URL
+func NewWebhookNotifier(webhook api.Webhook, client *http.Client) Notifier {
+	format := webhook.Format
+	if format == "" {
+		format = api.GenericWebhookFormat
+	}
+	return &webhookNotifier{
+		url:          webhook.URL,
+		format:       format,
+		minEvictions: webhook.MinEvictions,
+		client:       client,
+	}
+}
+
+// Notify posts the summary when the cycle evicted more than the configured minimum of pods
+func (wn *webhookNotifier) Notify(ctx context.Context, summary *CycleSummary) error {
+	if summary.TotalEvicted <= wn.minEvictions {
+		klog.V(4).InfoS("Skipping webhook notification, not enough evictions", "evicted", summary.TotalEvicted, "minEvictions", wn.minEvictions)
+		return nil
+	}
+
+	var payload interface{} = summary
+	if wn.format == api.SlackWebhookFormat {
+		payload = map[string]string{"text": slackText(summary)}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("unable to encode cycle summary: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wn.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to create webhook request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := wn.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to post cycle summary to webhook: %v", err)
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func slackText(summary *CycleSummary) string {
+	var b strings.Builder
+	mode := ""
+	if summary.DryRun {
+		mode = " (dry run)"
+	}
+	fmt.Fprintf(&b, "Descheduler evicted %d pods%s in %v", summary.TotalEvicted, mode, summary.EndTime.Sub(summary.StartTime).Round(time.Second))
+	if summary.EvictionRequests > 0 {
+		fmt.Fprintf(&b, ", %d eviction requests in progress", summary.EvictionRequests)
+	}
+	if summary.FailedEvictions > 0 {
+		fmt.Fprintf(&b, ", %d evictions failed", summary.FailedEvictions)
+	}
+	if summary.SkippedEvictions > 0 {
+		fmt.Fprintf(&b, ", %d evictions skipped", summary.SkippedEvictions)
+	}
+	writeCounts(&b, "By strategy", summary.EvictedByStrategy)
+	writeCounts(&b, "By namespace", summary.EvictedByNamespace)
+	if len(summary.Errors) > 0 {
+		fmt.Fprintf(&b, "\nErrors:")
+		for _, e := range summary.Errors {
+			fmt.Fprintf(&b, "\n• %s", e)
+		}
+	}
+	return b.String()
+}
+
+func writeCounts(b *strings.Builder, title string, counts map[string]uint) {
+	if len(counts) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(b, "\n%s:", title)
+	for _, key := range keys {
+		fmt.Fprintf(b, " %s=%d", key, counts[key])
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	summary := &CycleSummary{
+		StartTime:          start,
+		EndTime:            start.Add(5 * time.Second),
+		TotalEvicted:       3,
+		FailedEvictions:    1,
+		SkippedEvictions:   2,
+		EvictedByStrategy:  map[string]uint{"LowNodeUtilization": 2, "RemoveDuplicates": 1},
+		EvictedByNamespace: map[string]uint{"default": 3},
+		Errors:             []string{"plugin \"RemoveDuplicates\" finished with error: boom"},
+	}
+
+	tests := []struct {
+		description  string
+		webhook      api.Webhook
+		status       int
+		expectedBody func(t *testing.T, body []byte)
+		expectedPost bool
+		expectedErr  bool
+	}{
+		{
+			description:  "summary not sent when evictions do not exceed the minimum",
+			webhook:      api.Webhook{MinEvictions: 3},
+			status:       http.StatusOK,
+			expectedPost: false,
+		},
+		{
+			description:  "generic summary sent",
+			webhook:      api.Webhook{MinEvictions: 2},
+			status:       http.StatusOK,
+			expectedPost: true,
+			expectedBody: func(t *testing.T, body []byte) {
+				got := &CycleSummary{}
+				if err := json.Unmarshal(body, got); err != nil {
+					t.Fatalf("unable to decode the summary: %v", err)
+				}
+				if got.TotalEvicted != 3 || got.EvictedByStrategy["LowNodeUtilization"] != 2 || got.EvictedByNamespace["default"] != 3 || len(got.Errors) != 1 {
+					t.Errorf("unexpected summary received: %#v", got)
+				}
+			},
+		},
+		{
+			description:  "slack message sent",
+			webhook:      api.Webhook{Format: api.SlackWebhookFormat},
+			status:       http.StatusOK,
+			expectedPost: true,
+			expectedBody: func(t *testing.T, body []byte) {
+				got := map[string]string{}
+				if err := json.Unmarshal(body, &got); err != nil {
+					t.Fatalf("unable to decode the slack message: %v", err)
+				}
+				expected := "Descheduler evicted 3 pods in 5s, 1 evictions failed, 2 evictions skipped\nBy strategy: LowNodeUtilization=2 RemoveDuplicates=1\nBy namespace: default=3\nErrors:\n• plugin \"RemoveDuplicates\" finished with error: boom"
+				if got["text"] != expected {
+					t.Errorf("unexpected slack message, expected:\n%v\ngot:\n%v", expected, got["text"])
+				}
+			},
+		},
+		{
+			description:  "webhook failure reported",
+			webhook:      api.Webhook{},
+			status:       http.StatusInternalServerError,
+			expectedPost: true,
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			posted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				posted = true
+				if r.Method != http.MethodPost {
+					t.Errorf("expected POST request, got %v", r.Method)
+				}
+				if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+					t.Errorf("expected json content type, got %v", r.Header.Get("Content-Type"))
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("unable to read request body: %v", err)
+				}
+				if tc.expectedBody != nil {
+					tc.expectedBody(t, body)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			tc.webhook.URL = server.URL
+			err := NewWebhookNotifier(tc.webhook, server.Client()).Notify(context.TODO(), summary)
+			if tc.expectedErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", tc.expectedErr, err)
+			}
+			if tc.expectedPost != posted {
+				t.Errorf("expected summary posted: %v, got: %v", tc.expectedPost, posted)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package pod
 
 import (
 	"sort"
+	"strconv"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,19 +26,29 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 const (
 	nodeNameKeyIndex = "spec.nodeName"
+
+	// PodGroupLabelKey labels the members of a PodGroup of the scheduler-plugins coscheduling
+	PodGroupLabelKey = "scheduling.x-k8s.io/pod-group"
+	// LegacyPodGroupLabelKey labels the members of a PodGroup of the scheduler-plugins coscheduling before v0.28
+	LegacyPodGroupLabelKey = "pod-group.scheduling.sigs.k8s.io"
+	// VolcanoGroupNameAnnotationKey annotates the members of a Volcano PodGroup
+	VolcanoGroupNameAnnotationKey = "scheduling.k8s.io/group-name"
+	// PodDeletionCostAnnotationKey ranks the pods of a ReplicaSet scaled down, the pods with a lower cost are deleted first
+	PodDeletionCostAnnotationKey = "controller.kubernetes.io/pod-deletion-cost"
 )
 
 // FilterFunc is a filter for a pod.
-type FilterFunc func(*v1.Pod) bool
+type FilterFunc = frameworktypes.FilterFunc
 
 // GetPodsAssignedToNodeFunc is a function which accept a node name and a pod filter function
 // as input and returns the pods that assigned to the node.
-type GetPodsAssignedToNodeFunc func(string, FilterFunc) ([]*v1.Pod, error)
+type GetPodsAssignedToNodeFunc = frameworktypes.GetPodsAssignedToNodeFunc
 
 // PodUtilizationFnc is a function for getting pod's utilization. E.g. requested resources of utilization from metrics.
 type PodUtilizationFnc func(pod *v1.Pod) (v1.ResourceList, error)
@@ -101,11 +112,20 @@ func (o *Options) BuildFilterFunc() (FilterFunc, error) {
 			return nil, err
 		}
 	}
+	// The namespaces may hold glob patterns and regular expressions
+	included, err := utils.NewNamePatterns(sets.List(o.includedNamespaces)...)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := utils.NewNamePatterns(sets.List(o.excludedNamespaces)...)
+	if err != nil {
+		return nil, err
+	}
 	return func(pod *v1.Pod) bool {
-		if len(o.includedNamespaces) > 0 && !o.includedNamespaces.Has(pod.Namespace) {
+		if included.Len() > 0 && !included.Has(pod.Namespace) {
 			return false
 		}
-		if len(o.excludedNamespaces) > 0 && o.excludedNamespaces.Has(pod.Namespace) {
+		if excluded.Len() > 0 && excluded.Has(pod.Namespace) {
 			return false
 		}
 		if s != nil && !s.Matches(labels.Set(pod.GetLabels())) {
@@ -230,6 +250,17 @@ func OwnerRefUIDs(pod *v1.Pod) []string {
 	return ownerRefUIDs
 }
 
+// PodGroupName returns the name of the PodGroup the pod is co-scheduled with as a gang,
+// an empty string when the pod is not a member of a gang.
+func PodGroupName(pod *v1.Pod) string {
+	for _, key := range []string{PodGroupLabelKey, LegacyPodGroupLabelKey} {
+		if name := pod.Labels[key]; name != "" {
+			return name
+		}
+	}
+	return pod.Annotations[VolcanoGroupNameAnnotationKey]
+}
+
 func IsBestEffortPod(pod *v1.Pod) bool {
 	return utils.GetPodQOS(pod) == v1.PodQOSBestEffort
 }
@@ -242,9 +273,31 @@ func IsGuaranteedPod(pod *v1.Pod) bool {
 	return utils.GetPodQOS(pod) == v1.PodQOSGuaranteed
 }
 
+// PodDeletionCost returns the cost of deleting the pod set through the pod-deletion-cost annotation,
+// 0 when the annotation is not set or not a valid int32 as for the ReplicaSet controller
+func PodDeletionCost(pod *v1.Pod) int32 {
+	value, ok := pod.Annotations[PodDeletionCostAnnotationKey]
+	if !ok {
+		return 0
+	}
+	cost, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(cost)
+}
+
+// SortPodsBasedOnDeletionCost sorts pods based on their deletion cost from low to high in place,
+// the pods of the same cost keep their order
+func SortPodsBasedOnDeletionCost(pods []*v1.Pod) {
+	sort.SliceStable(pods, func(i, j int) bool {
+		return PodDeletionCost(pods[i]) < PodDeletionCost(pods[j])
+	})
+}
+
 // SortPodsBasedOnPriorityLowToHigh sorts pods based on their priorities from low to high.
-// If pods have same priorities, they will be sorted by QoS in the following order:
-// BestEffort, Burstable, Guaranteed
+// If pods have same priorities, they will be sorted by their deletion cost from low to high,
+// then by QoS in the following order: BestEffort, Burstable, Guaranteed
 func SortPodsBasedOnPriorityLowToHigh(pods []*v1.Pod) {
 	sort.Slice(pods, func(i, j int) bool {
 		if pods[i].Spec.Priority == nil && pods[j].Spec.Priority != nil {
@@ -254,6 +307,9 @@ func SortPodsBasedOnPriorityLowToHigh(pods []*v1.Pod) {
 			return false
 		}
 		if (pods[j].Spec.Priority == nil && pods[i].Spec.Priority == nil) || (*pods[i].Spec.Priority == *pods[j].Spec.Priority) {
+			if costI, costJ := PodDeletionCost(pods[i]), PodDeletionCost(pods[j]); costI != costJ {
+				return costI < costJ
+			}
 			if IsBestEffortPod(pods[i]) {
 				return true
 			}
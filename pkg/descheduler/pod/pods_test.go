@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -117,6 +118,51 @@ func TestListPodsOnANode(t *testing.T) {
 	}
 }
 
+func TestBuildFilterFuncNamespacePatterns(t *testing.T) {
+	pods := []*v1.Pod{
+		test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) { pod.Namespace = "kube-system" }),
+		test.BuildTestPod("p2", 100, 0, "n1", func(pod *v1.Pod) { pod.Namespace = "team-1" }),
+		test.BuildTestPod("p3", 100, 0, "n1", func(pod *v1.Pod) { pod.Namespace = "default" }),
+	}
+	testCases := []struct {
+		name     string
+		options  *Options
+		expected []string
+	}{
+		{
+			name:     "included glob and regular expression",
+			options:  NewOptions().WithNamespaces(sets.New("kube-*", "/^team-[0-9]+$/")),
+			expected: []string{"p1", "p2"},
+		},
+		{
+			name:     "excluded glob",
+			options:  NewOptions().WithoutNamespaces(sets.New("kube-*")),
+			expected: []string{"p2", "p3"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filter, err := testCase.options.BuildFilterFunc()
+			if err != nil {
+				t.Fatalf("Build filter function error: %v", err)
+			}
+			var matched []string
+			for _, pod := range pods {
+				if filter(pod) {
+					matched = append(matched, pod.Name)
+				}
+			}
+			if !reflect.DeepEqual(matched, testCase.expected) {
+				t.Errorf("Expected pods %v, got %v", testCase.expected, matched)
+			}
+		})
+	}
+
+	if _, err := NewOptions().WithNamespaces(sets.New("/(/")).BuildFilterFunc(); err == nil {
+		t.Errorf("Expected an error building the filter with an invalid regular expression")
+	}
+}
+
 func TestSortPodsBasedOnPriorityLowToHigh(t *testing.T) {
 	n1 := test.BuildTestNode("n1", 4000, 3000, 9, nil)
 
@@ -157,6 +203,48 @@ func TestSortPodsBasedOnPriorityLowToHigh(t *testing.T) {
 	}
 }
 
+func TestSortPodsBasedOnDeletionCost(t *testing.T) {
+	withCost := func(cost string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			test.SetPodPriority(pod, highPriority)
+			test.MakeBestEffortPod(pod)
+			pod.Annotations = map[string]string{PodDeletionCostAnnotationKey: cost}
+		}
+	}
+	p1 := test.BuildTestPod("p1", 400, 0, "n1", withCost("100"))
+	p2 := test.BuildTestPod("p2", 400, 0, "n1", withCost("-5"))
+	p3 := test.BuildTestPod("p3", 400, 0, "n1", withCost("not-a-number"))
+	p4 := test.BuildTestPod("p4", 400, 0, "n1", func(pod *v1.Pod) {
+		test.SetPodPriority(pod, highPriority)
+		test.MakeBestEffortPod(pod)
+	})
+	p5 := test.BuildTestPod("p5", 400, 0, "n1", func(pod *v1.Pod) {
+		test.SetPodPriority(pod, lowPriority)
+		pod.Annotations = map[string]string{PodDeletionCostAnnotationKey: "1000"}
+	})
+
+	podList := []*v1.Pod{p1, p3, p2, p4}
+	SortPodsBasedOnDeletionCost(podList)
+	if !reflect.DeepEqual(podNames(podList), []string{"p2", "p3", "p4", "p1"}) {
+		t.Errorf("Expected the pods sorted by deletion cost keeping the order of the same costs, got %v", podNames(podList))
+	}
+
+	// The priority ranks first, the deletion cost ranks the pods of the same priority
+	podList = []*v1.Pod{p1, p4, p5, p2}
+	SortPodsBasedOnPriorityLowToHigh(podList)
+	if !reflect.DeepEqual(podNames(podList), []string{"p5", "p2", "p4", "p1"}) {
+		t.Errorf("Expected the pods sorted by priority then deletion cost, got %v", podNames(podList))
+	}
+}
+
+func podNames(pods []*v1.Pod) []string {
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
 func TestSortPodsBasedOnAge(t *testing.T) {
 	podList := make([]*v1.Pod, 9)
 	n1 := test.BuildTestNode("n1", 4000, 3000, int64(len(podList)), nil)
@@ -240,3 +328,39 @@ func TestGroupByNodeName(t *testing.T) {
 		})
 	}
 }
+
+func TestPodGroupName(t *testing.T) {
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		expected string
+	}{
+		{
+			name:     "pod not in a gang",
+			pod:      &v1.Pod{},
+			expected: "",
+		},
+		{
+			name:     "coscheduling pod group",
+			pod:      &v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{PodGroupLabelKey: "training"}}},
+			expected: "training",
+		},
+		{
+			name:     "legacy coscheduling pod group",
+			pod:      &v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{LegacyPodGroupLabelKey: "training"}}},
+			expected: "training",
+		},
+		{
+			name:     "volcano pod group",
+			pod:      &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{VolcanoGroupNameAnnotationKey: "training"}}},
+			expected: "training",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if name := PodGroupName(tc.pod); name != tc.expected {
+				t.Errorf("Expected pod group %q, got %q", tc.expected, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	clientset "k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+	"sigs.k8s.io/descheduler/pkg/descheduler/scheme"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/yaml"
+)
+
+// PolicyBuilder constructs a descheduler policy programmatically, e.g. by an operator generating the
+// policy instead of templating the policy config file:
+//
+//	policy, err := descheduler.NewPolicy().
+//		WithProfile("default").
+//		WithPlugin(removefailedpods.PluginName, &removefailedpods.RemoveFailedPodsArgs{MinPodLifetimeSeconds: ptr.To[uint](3600)}).
+//		WithPlugin(nodeutilization.LowNodeUtilizationPluginName, nil).
+//		Build(client)
+//
+// The plugins are enabled at all the extension points they implement. The errors are collected
+// along the way and returned by Build.
+type PolicyBuilder struct {
+	policy   api.DeschedulerPolicy
+	registry pluginregistry.Registry
+	errs     []error
+}
+
+// NewPolicy returns a builder of an empty policy with the in-tree plugins registered
+func NewPolicy() *PolicyBuilder {
+	if pluginregistry.PluginRegistry == nil {
+		SetupPlugins()
+	}
+	return &PolicyBuilder{registry: pluginregistry.PluginRegistry}
+}
+
+// WithRegistry sets the registry the plugins are looked up in, e.g. with out-of-tree plugins registered
+func (b *PolicyBuilder) WithRegistry(registry pluginregistry.Registry) *PolicyBuilder {
+	b.registry = registry
+	return b
+}
+
+// WithProfile adds a profile, the plugins added next are added to the profile
+func (b *PolicyBuilder) WithProfile(name string) *PolicyBuilder {
+	b.policy.Profiles = append(b.policy.Profiles, api.DeschedulerProfile{Name: name})
+	return b
+}
+
+// WithProfileNamespaces sets the namespaces inherited by the plugins of the last profile added
+func (b *PolicyBuilder) WithProfileNamespaces(namespaces *api.Namespaces) *PolicyBuilder {
+	profile := b.lastProfile("namespaces")
+	if profile != nil {
+		profile.Namespaces = namespaces
+	}
+	return b
+}
+
+// WithPlugin adds the plugin to the last profile added and enables it at all the extension points it implements.
+// The args default to the default args of the plugin when nil.
+func (b *PolicyBuilder) WithPlugin(name string, args runtime.Object) *PolicyBuilder {
+	profile := b.lastProfile(name)
+	if profile == nil {
+		return b
+	}
+	pluginUtilities, ok := b.registry[name]
+	if !ok {
+		b.errs = append(b.errs, fmt.Errorf("in profile %s: plugin %s not registered", profile.Name, name))
+		return b
+	}
+	if args == nil && pluginUtilities.PluginArgInstance != nil {
+		args = pluginUtilities.PluginArgInstance.DeepCopyObject()
+	}
+
+	enabled := false
+	if _, ok := pluginUtilities.PluginType.(frameworktypes.DeschedulePlugin); ok {
+		profile.Plugins.Deschedule.Enabled = append(profile.Plugins.Deschedule.Enabled, name)
+		enabled = true
+	}
+	if _, ok := pluginUtilities.PluginType.(frameworktypes.BalancePlugin); ok {
+		profile.Plugins.Balance.Enabled = append(profile.Plugins.Balance.Enabled, name)
+		enabled = true
+	}
+	if _, ok := pluginUtilities.PluginType.(frameworktypes.EvictorPlugin); ok {
+		profile.Plugins.Filter.Enabled = append(profile.Plugins.Filter.Enabled, name)
+		profile.Plugins.PreEvictionFilter.Enabled = append(profile.Plugins.PreEvictionFilter.Enabled, name)
+		enabled = true
+	}
+	if !enabled {
+		b.errs = append(b.errs, fmt.Errorf("in profile %s: plugin %s implements no extension point", profile.Name, name))
+		return b
+	}
+	if args != nil {
+		profile.PluginConfigs = append(profile.PluginConfigs, api.PluginConfig{Name: name, Args: args})
+	}
+	return b
+}
+
+// WithSettings customizes the policy wide settings, e.g. the eviction limits
+func (b *PolicyBuilder) WithSettings(customize func(policy *api.DeschedulerPolicy)) *PolicyBuilder {
+	customize(&b.policy)
+	return b
+}
+
+func (b *PolicyBuilder) lastProfile(subject string) *api.DeschedulerProfile {
+	if len(b.policy.Profiles) == 0 {
+		b.errs = append(b.errs, fmt.Errorf("no profile to add %s to, a profile must be added first", subject))
+		return nil
+	}
+	return &b.policy.Profiles[len(b.policy.Profiles)-1]
+}
+
+// Build returns the policy defaulted and validated the same way as a policy loaded from the policy config file.
+// The client resolves the priority classes the priority thresholds of the DefaultEvictor refer to by name,
+// it may be nil when no priority threshold refers to a priority class by name.
+func (b *PolicyBuilder) Build(client clientset.Interface) (*api.DeschedulerPolicy, error) {
+	if len(b.errs) > 0 {
+		return nil, utilerrors.NewAggregate(b.errs)
+	}
+	policy := b.policy.DeepCopy()
+	for _, profile := range policy.Profiles {
+		for idx := range profile.PluginConfigs {
+			setDefaultsPluginConfig(&profile.PluginConfigs[idx], b.registry)
+		}
+	}
+	if err := validateDeschedulerConfiguration(*policy, b.registry); err != nil {
+		return nil, err
+	}
+	return setDefaults(*policy, b.registry, client)
+}
+
+// EncodePolicy encodes the policy into a v1alpha2 policy config file
+func EncodePolicy(policy *api.DeschedulerPolicy) ([]byte, error) {
+	in := policy.DeepCopy()
+	// The plugin args are the same in every version, they are encoded as they are
+	defaultEvictorArgs := in.DefaultEvictorArgs
+	in.DefaultEvictorArgs = nil
+	pluginArgs := make([][]runtime.Object, len(in.Profiles))
+	for i := range in.Profiles {
+		for j := range in.Profiles[i].PluginConfigs {
+			pluginArgs[i] = append(pluginArgs[i], in.Profiles[i].PluginConfigs[j].Args)
+			in.Profiles[i].PluginConfigs[j].Args = nil
+		}
+	}
+
+	out := &v1alpha2.DeschedulerPolicy{}
+	if err := scheme.Scheme.Convert(in, out, nil); err != nil {
+		return nil, fmt.Errorf("unable to convert the policy to %v: %v", v1alpha2.SchemeGroupVersion, err)
+	}
+	out.APIVersion = v1alpha2.SchemeGroupVersion.String()
+	out.Kind = "DeschedulerPolicy"
+	if defaultEvictorArgs != nil {
+		raw, err := json.Marshal(defaultEvictorArgs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode the default evictor args: %v", err)
+		}
+		out.DefaultEvictorArgs = &runtime.RawExtension{Raw: raw}
+	}
+	for i := range out.Profiles {
+		for j := range out.Profiles[i].PluginConfigs {
+			if pluginArgs[i][j] == nil {
+				continue
+			}
+			raw, err := json.Marshal(pluginArgs[i][j])
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode the args of plugin %s in profile %s: %v", out.Profiles[i].PluginConfigs[j].Name, out.Profiles[i].Name, err)
+			}
+			out.Profiles[i].PluginConfigs[j].Args = runtime.RawExtension{Raw: raw}
+		}
+	}
+	return yaml.Marshal(out)
+}
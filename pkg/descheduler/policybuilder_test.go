@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removefailedpods"
+)
+
+func TestPolicyBuilder(t *testing.T) {
+	client := fakeclientset.NewSimpleClientset()
+	SetupPlugins()
+
+	policy, err := NewPolicy().
+		WithSettings(func(policy *api.DeschedulerPolicy) {
+			policy.MaxNoOfPodsToEvictPerNode = utilptr.To[uint](5)
+		}).
+		WithProfile("default").
+		WithProfileNamespaces(&api.Namespaces{Exclude: []string{"kube-system"}}).
+		WithPlugin(removefailedpods.PluginName, &removefailedpods.RemoveFailedPodsArgs{Reasons: []string{"OutOfcpu"}}).
+		WithPlugin(removeduplicates.PluginName, nil).
+		Build(client)
+	if err != nil {
+		t.Fatalf("Unexpected error when building the policy: %v", err)
+	}
+
+	expected := &api.DeschedulerPolicy{
+		MaxNoOfPodsToEvictPerNode: utilptr.To[uint](5),
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name:       "default",
+				Namespaces: &api.Namespaces{Exclude: []string{"kube-system"}},
+				PluginConfigs: []api.PluginConfig{
+					{
+						Name: defaultevictor.PluginName,
+						Args: &defaultevictor.DefaultEvictorArgs{
+							PriorityThreshold: &api.PriorityThreshold{Value: utilptr.To[int32](2000000000)},
+						},
+					},
+					{
+						Name: removefailedpods.PluginName,
+						Args: &removefailedpods.RemoveFailedPodsArgs{
+							Reasons:               []string{"OutOfcpu"},
+							MinPodLifetimeSeconds: utilptr.To[uint](3600),
+							Namespaces:            &api.Namespaces{Exclude: []string{"kube-system"}},
+						},
+					},
+					{
+						Name: removeduplicates.PluginName,
+						Args: &removeduplicates.RemoveDuplicatesArgs{
+							Namespaces: &api.Namespaces{Exclude: []string{"kube-system"}},
+						},
+					},
+				},
+				Plugins: api.Plugins{
+					Deschedule:        api.PluginSet{Enabled: []string{removefailedpods.PluginName}},
+					Balance:           api.PluginSet{Enabled: []string{removeduplicates.PluginName}},
+					Filter:            api.PluginSet{Enabled: []string{defaultevictor.PluginName}},
+					PreEvictionFilter: api.PluginSet{Enabled: []string{defaultevictor.PluginName}},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, policy); diff != "" {
+		t.Errorf("Unexpected policy built (-want +got):\n%s", diff)
+	}
+
+	// The encoded policy decodes into the same policy
+	encoded, err := EncodePolicy(policy)
+	if err != nil {
+		t.Fatalf("Unexpected error when encoding the policy: %v", err)
+	}
+	decoded, err := decode("", encoded, client, pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unexpected error when decoding the encoded policy: %v\n%s", err, encoded)
+	}
+	if diff := cmp.Diff(policy, decoded); diff != "" {
+		t.Errorf("Unexpected policy decoded (-want +got):\n%s\n%s", diff, encoded)
+	}
+}
+
+func TestPolicyBuilderErrors(t *testing.T) {
+	SetupPlugins()
+
+	tests := []struct {
+		description string
+		builder     *PolicyBuilder
+		err         string
+	}{
+		{
+			description: "plugin without a profile",
+			builder:     NewPolicy().WithPlugin(removeduplicates.PluginName, nil),
+			err:         "no profile to add RemoveDuplicates to, a profile must be added first",
+		},
+		{
+			description: "plugin not registered",
+			builder:     NewPolicy().WithProfile("default").WithPlugin("Unknown", nil),
+			err:         "in profile default: plugin Unknown not registered",
+		},
+		{
+			description: "invalid args",
+			builder: NewPolicy().WithProfile("default").WithPlugin(nodeutilization.LowNodeUtilizationPluginName, &nodeutilization.LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{"cpu": 120},
+				TargetThresholds: api.ResourceThresholds{"cpu": 80},
+			}),
+			err: "in profile default: thresholds config is not valid: cpu threshold not in [0, 100] range",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := tc.builder.Build(nil)
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("Expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -21,15 +21,25 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/descheduler/scheme"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
@@ -51,19 +61,26 @@ func LoadPolicyConfig(policyConfigFile string, client clientset.Interface, regis
 }
 
 func decode(policyConfigFile string, policy []byte, client clientset.Interface, registry pluginregistry.Registry) (*api.DeschedulerPolicy, error) {
+	internalPolicy, err := decodeAndValidate(policyConfigFile, policy, registry)
+	if err != nil {
+		return nil, err
+	}
+	return setDefaults(*internalPolicy, registry, client)
+}
+
+// decodeAndValidate decodes and validates the policy without setting the defaults requiring a client
+func decodeAndValidate(policyConfigFile string, policy []byte, registry pluginregistry.Registry) (*api.DeschedulerPolicy, error) {
 	internalPolicy := &api.DeschedulerPolicy{}
-	var err error
 
 	decoder := scheme.Codecs.UniversalDecoder(v1alpha2.SchemeGroupVersion, api.SchemeGroupVersion)
 	if err := runtime.DecodeInto(decoder, policy, internalPolicy); err != nil {
 		return nil, fmt.Errorf("failed decoding descheduler's policy config %q: %v", policyConfigFile, err)
 	}
 
-	err = validateDeschedulerConfiguration(*internalPolicy, registry)
-	if err != nil {
+	if err := validateDeschedulerConfiguration(*internalPolicy, registry); err != nil {
 		return nil, err
 	}
-	return setDefaults(*internalPolicy, registry, client)
+	return internalPolicy, nil
 }
 
 func setDefaults(in api.DeschedulerPolicy, registry pluginregistry.Registry, client clientset.Interface) (*api.DeschedulerPolicy, error) {
@@ -77,10 +94,35 @@ func setDefaults(in api.DeschedulerPolicy, registry pluginregistry.Registry, cli
 		for _, pluginConfig := range profile.PluginConfigs {
 			setDefaultsPluginConfig(&pluginConfig, registry)
 		}
+		inheritNamespaces(profile)
+		inheritMetricsSource(profile)
 	}
 	return &in, nil
 }
 
+var namespacesType = reflect.TypeOf(&api.Namespaces{})
+
+// inheritNamespaces sets the namespaces of the profile to every plugin of the profile
+// with no namespaces configured. The plugins limited to excluded namespaces through
+// evictableNamespaces (e.g. LowNodeUtilization) inherit the excluded namespaces only.
+func inheritNamespaces(profile api.DeschedulerProfile) {
+	if profile.Namespaces == nil {
+		return
+	}
+	for _, pluginConfig := range profile.PluginConfigs {
+		args := reflect.ValueOf(pluginConfig.Args)
+		if args.Kind() != reflect.Ptr || args.IsNil() || args.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if field := args.Elem().FieldByName("Namespaces"); field.IsValid() && field.Type() == namespacesType && field.IsNil() {
+			field.Set(reflect.ValueOf(profile.Namespaces.DeepCopy()))
+		}
+		if field := args.Elem().FieldByName("EvictableNamespaces"); field.IsValid() && field.Type() == namespacesType && field.IsNil() && len(profile.Namespaces.Exclude) > 0 {
+			field.Set(reflect.ValueOf(&api.Namespaces{Exclude: append([]string{}, profile.Namespaces.Exclude...)}))
+		}
+	}
+}
+
 func setDefaultsPluginConfig(pluginConfig *api.PluginConfig, registry pluginregistry.Registry) {
 	if _, ok := registry[pluginConfig.Name]; ok {
 		pluginUtilities := registry[pluginConfig.Name]
@@ -127,6 +169,11 @@ func setDefaultEvictor(profile api.DeschedulerProfile, client clientset.Interfac
 		idx = 0
 	}
 
+	// The priority classes selected by a label selector are resolved by the plugin in every cycle
+	if priorityThreshold := defaultevictorPluginConfig.Args.(*defaultevictor.DefaultEvictorArgs).PriorityThreshold; priorityThreshold != nil && priorityThreshold.LabelSelector != nil {
+		return profile, nil
+	}
+
 	thresholdPriority, err := utils.GetPriorityValueFromPriorityThreshold(context.TODO(), client, defaultevictorPluginConfig.Args.(*defaultevictor.DefaultEvictorArgs).PriorityThreshold)
 	if err != nil {
 		klog.Error(err, "Failed to get threshold priority from args")
@@ -140,6 +187,15 @@ func setDefaultEvictor(profile api.DeschedulerProfile, client clientset.Interfac
 func validateDeschedulerConfiguration(in api.DeschedulerPolicy, registry pluginregistry.Registry) error {
 	var errorsInPolicy []error
 	for _, profile := range in.Profiles {
+		if err := utils.ValidateNamespaces(profile.Namespaces); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: %v", profile.Name, err))
+		}
+		if profile.ClientIdentity != nil && !filepath.IsAbs(profile.ClientIdentity.TokenFile) {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: clientIdentity.tokenFile must be an absolute path", profile.Name))
+		}
+		if err := validateSchedule(profile.Schedule); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: %v", profile.Name, err))
+		}
 		for _, pluginConfig := range profile.PluginConfigs {
 			if _, ok := registry[pluginConfig.Name]; !ok {
 				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: plugin %s in pluginConfig not registered", profile.Name, pluginConfig.Name))
@@ -192,5 +248,229 @@ func validateDeschedulerConfiguration(in api.DeschedulerPolicy, registry pluginr
 		}
 	}
 
+	for _, profile := range in.Profiles {
+		if err := validateProfileMetricsSource(profile, providers, in.MetricsCollector); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("in profile %s: %v", profile.Name, err))
+		}
+	}
+
+	if in.Notifications != nil {
+		for _, webhook := range in.Notifications.Webhooks {
+			if webhook.URL == "" {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("webhook notification URL is required"))
+			} else if u, err := url.Parse(webhook.URL); err != nil {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("error parsing webhook notification URL: %v", err))
+			} else if u.Scheme != "http" && u.Scheme != "https" {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("webhook notification URL's scheme is not http(s), got %q instead", u.Scheme))
+			}
+			switch webhook.Format {
+			case "", api.GenericWebhookFormat, api.SlackWebhookFormat:
+			default:
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("webhook notification format %q is not supported, expected one of %q, %q", webhook.Format, api.GenericWebhookFormat, api.SlackWebhookFormat))
+			}
+		}
+	}
+
+	if in.NodeSelector != nil {
+		if _, err := labels.Parse(*in.NodeSelector); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid nodeSelector: %v", err))
+		}
+	}
+	if in.PodWatch != nil {
+		if _, err := labels.Parse(in.PodWatch.LabelSelector); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid pod watch labelSelector: %v", err))
+		}
+		if _, err := fields.ParseSelector(in.PodWatch.FieldSelector); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid pod watch fieldSelector: %v", err))
+		}
+	}
+
+	if in.EvictionSpreading != nil {
+		if in.EvictionSpreading.TopologyKey == "" {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction spreading topologyKey is required"))
+		}
+		if in.MaxNoOfPodsToEvictTotal == nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction spreading requires maxNoOfPodsToEvictTotal to be set"))
+		}
+	}
+
+	if in.TopologyDomainKey != "" && in.MaxNoOfPodsToEvictPerTopologyDomain == nil {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("topologyDomainKey requires maxNoOfPodsToEvictPerTopologyDomain to be set"))
+	}
+
+	if maxFailures := in.MaxEvictionFailuresPerCycle; maxFailures != nil {
+		if maxFailures.Type == intstr.String {
+			percentage, err := intstr.GetScaledValueFromIntOrPercent(maxFailures, 100, true)
+			if err != nil || percentage < 0 || percentage > 100 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("maxEvictionFailuresPerCycle must be a count or a percentage between 0%% and 100%%, got %q", maxFailures.String()))
+			}
+		} else if maxFailures.IntValue() < 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("maxEvictionFailuresPerCycle must not be negative, got %v", maxFailures.IntValue()))
+		}
+	}
+
+	switch in.EvictionBackend {
+	case "", api.EvictionAPIBackend, api.EvacuationAPIBackend:
+	default:
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction backend must be one of %q or %q, got %q", api.EvictionAPIBackend, api.EvacuationAPIBackend, in.EvictionBackend))
+	}
+
+	switch in.EvictionOrder {
+	case "", api.OlderRevisionsFirstEvictionOrder:
+	default:
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction order must be %q, got %q", api.OlderRevisionsFirstEvictionOrder, in.EvictionOrder))
+	}
+
+	if in.EvictionFairness != nil {
+		switch in.EvictionFairness.By {
+		case "", api.FairnessByNamespace, api.FairnessByOwner:
+		default:
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction fairness by must be one of %q or %q, got %q", api.FairnessByNamespace, api.FairnessByOwner, in.EvictionFairness.By))
+		}
+		if in.MaxNoOfPodsToEvictTotal == nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction fairness requires maxNoOfPodsToEvictTotal to be set"))
+		}
+	}
+
+	for i, quota := range in.NamespaceDisruptionQuotas {
+		if len(quota.Namespaces) == 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("namespace disruption quota %d: namespaces are required", i))
+		} else if _, err := utils.NewNamePatterns(quota.Namespaces...); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("namespace disruption quota %d: %v", i, err))
+		}
+		if quota.Period.Duration <= 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("namespace disruption quota %d: period must be positive", i))
+		}
+	}
+
+	if _, err := evictions.NewEvictionVeto(in.EvictionVeto); err != nil {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid eviction veto: %v", err))
+	}
+
+	if drains := in.ConcurrentDrains; drains != nil {
+		if !drains.Cordoned && len(drains.NodeAnnotations) == 0 && len(drains.NodeTaints) == 0 && drains.LeaseNamespace == "" {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("concurrent drains require at least one of cordoned, nodeAnnotations, nodeTaints or leaseNamespace"))
+		}
+		for _, annotation := range drains.NodeAnnotations {
+			key, _, _ := strings.Cut(annotation, "=")
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid concurrent drains node annotation %q: %v", annotation, strings.Join(errs, ", ")))
+			}
+		}
+		for _, key := range drains.NodeTaints {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid concurrent drains node taint %q: %v", key, strings.Join(errs, ", ")))
+			}
+		}
+		if drains.LeaseNamespace != "" {
+			if errs := validation.IsDNS1123Label(drains.LeaseNamespace); len(errs) > 0 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid concurrent drains leaseNamespace %q: %v", drains.LeaseNamespace, strings.Join(errs, ", ")))
+			}
+		}
+	}
+
+	if nodeLeases := in.NodeLeases; nodeLeases != nil {
+		if errs := validation.IsDNS1123Label(nodeLeases.Namespace); len(errs) > 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid node leases namespace %q: %v", nodeLeases.Namespace, strings.Join(errs, ", ")))
+		}
+		if nodeLeases.LeaseDuration.Duration < 0 || (nodeLeases.LeaseDuration.Duration > 0 && nodeLeases.LeaseDuration.Duration < time.Second) {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("node leases leaseDuration must be at least 1s, got %v", nodeLeases.LeaseDuration.Duration))
+		}
+	}
+	if exemptions := in.DeschedulingExemptions; exemptions != nil && exemptions.MaxDuration != nil && exemptions.MaxDuration.Duration <= 0 {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("descheduling exemptions maxDuration must be positive, got %v", exemptions.MaxDuration.Duration))
+	}
+	if brake := in.ZoneOutageBrake; brake != nil {
+		if brake.TopologyKey != "" {
+			if errs := validation.IsQualifiedName(brake.TopologyKey); len(errs) > 0 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid zone outage brake topologyKey %q: %v", brake.TopologyKey, strings.Join(errs, ", ")))
+			}
+		}
+		if brake.MaxUnavailablePercentage < 0 || brake.MaxUnavailablePercentage >= 100 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("zone outage brake maxUnavailablePercentage must be in [0, 100), got %v", brake.MaxUnavailablePercentage))
+		}
+	}
+	if detection := in.ConvergenceDetection; detection != nil {
+		if detection.Cycles != nil && *detection.Cycles < 2 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("convergence detection cycles must be at least 2, got %d", *detection.Cycles))
+		}
+		if detection.MinSimilarityPercentage != nil && (*detection.MinSimilarityPercentage <= 0 || *detection.MinSimilarityPercentage > 100) {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("convergence detection minSimilarityPercentage must be in (0, 100], got %v", *detection.MinSimilarityPercentage))
+		}
+	}
+	if explanations := in.SkipExplanations; explanations != nil {
+		if _, err := metav1.LabelSelectorAsSelector(explanations.LabelSelector); err != nil {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("invalid skip explanations labelSelector: %v", err))
+		}
+		if explanations.Interval != nil && explanations.Interval.Duration <= 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("skip explanations interval must be positive, got %v", explanations.Interval.Duration))
+		}
+	}
+	if hints := in.PreferredNodeHints; hints != nil && hints.MaxNodes != nil && *hints.MaxNodes == 0 {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("preferred node hints maxNodes must be at least 1, got 0"))
+	}
+	if gangs := in.GangScheduling; gangs != nil {
+		switch gangs.Members {
+		case api.GangMembersSkip, api.GangMembersEvictTogether:
+		default:
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("gang scheduling members must be one of %q or %q, got %q", api.GangMembersSkip, api.GangMembersEvictTogether, gangs.Members))
+		}
+		if gangs.Pacing != nil && gangs.Pacing.Duration < 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("gang scheduling pacing must not be negative, got %v", gangs.Pacing.Duration))
+		}
+	}
+	if verification := in.EvictionVerification; verification != nil && verification.Timeout != nil && verification.Timeout.Duration <= 0 {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction verification timeout must be positive, got %v", verification.Timeout.Duration))
+	}
+	if verification := in.EvictionVerification; verification != nil && verification.Wait != nil && verification.Wait.Timeout != nil && verification.Wait.Timeout.Duration <= 0 {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction wait timeout must be positive, got %v", verification.Wait.Timeout.Duration))
+	}
+	if limits := in.EvictionRateLimits; limits != nil {
+		validateRateLimit := func(scope string, limit *api.EvictionRateLimit) {
+			if limit == nil {
+				return
+			}
+			if limit.EvictionsPerMinute == 0 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction rate limit per %s must allow at least one eviction per minute", scope))
+			}
+			if limit.Burst != nil && *limit.Burst == 0 {
+				errorsInPolicy = append(errorsInPolicy, fmt.Errorf("eviction rate limit per %s burst must be at least 1", scope))
+			}
+		}
+		validateRateLimit("node", limits.PerNode)
+		validateRateLimit("namespace", limits.PerNamespace)
+	}
+	if intervals := in.NamespaceEvictionIntervals; intervals != nil && intervals.MaxInterval != nil && intervals.MaxInterval.Duration <= 0 {
+		errorsInPolicy = append(errorsInPolicy, fmt.Errorf("namespace eviction intervals max interval must be positive, got %v", intervals.MaxInterval.Duration))
+	}
+	if err := validateSchedule(in.Schedule); err != nil {
+		errorsInPolicy = append(errorsInPolicy, err)
+	}
+	if pacing := in.TerminationPacing; pacing != nil {
+		if pacing.MaxTerminatingPodsPerNode != nil && *pacing.MaxTerminatingPodsPerNode == 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("max terminating pods per node must be at least 1"))
+		}
+		if pacing.MaxTerminatingPods != nil && *pacing.MaxTerminatingPods == 0 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("max terminating pods must be at least 1"))
+		}
+	}
+	for name, percentage := range in.MinClusterHeadroom {
+		if percentage < 0 || percentage > 100 {
+			errorsInPolicy = append(errorsInPolicy, fmt.Errorf("min cluster headroom of %v must be in [0, 100], got %v", name, percentage))
+		}
+	}
+
 	return utilerrors.NewAggregate(errorsInPolicy)
 }
+
+// validateSchedule checks the schedule has windows and parses them
+func validateSchedule(schedule *api.Schedule) error {
+	if schedule == nil {
+		return nil
+	}
+	if len(schedule.Windows) == 0 {
+		return fmt.Errorf("schedule must have at least one maintenance window")
+	}
+	_, err := newMaintenanceWindows(schedule)
+	return err
+}
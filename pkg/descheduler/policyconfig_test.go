@@ -20,14 +20,20 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	utilptr "k8s.io/utils/ptr"
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removefailedpods"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodshavingtoomanyrestarts"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingtopologyspreadconstraint"
@@ -122,6 +128,58 @@ profiles:
 				},
 			},
 		},
+		{
+			description: "v1alpha2 to internal with the eviction limits of a plugin",
+			policy: []byte(`apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: ProfileName
+    pluginConfig:
+    - name: "RemovePodsHavingTooManyRestarts"
+      maxPodsToEvictPerNode: 1
+      maxPodsToEvictPerCycle: 5
+      args:
+        podRestartThreshold: 100
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsHavingTooManyRestarts"
+`),
+			result: &api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name: "ProfileName",
+						PluginConfigs: []api.PluginConfig{
+							{
+								Name: defaultevictor.PluginName,
+								Args: &defaultevictor.DefaultEvictorArgs{
+									PriorityThreshold: &api.PriorityThreshold{Value: utilptr.To[int32](2000000000)},
+								},
+							},
+							{
+								Name:                   removepodshavingtoomanyrestarts.PluginName,
+								MaxPodsToEvictPerNode:  utilptr.To[uint](1),
+								MaxPodsToEvictPerCycle: utilptr.To[uint](5),
+								Args: &removepodshavingtoomanyrestarts.RemovePodsHavingTooManyRestartsArgs{
+									PodRestartThreshold: 100,
+								},
+							},
+						},
+						Plugins: api.Plugins{
+							Filter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							PreEvictionFilter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							Deschedule: api.PluginSet{
+								Enabled: []string{removepodshavingtoomanyrestarts.PluginName},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			description: "v1alpha2 to internal, validate error handling (priorityThreshold exceeding maximum)",
 			policy: []byte(`apiVersion: "descheduler/v1alpha2"
@@ -211,6 +269,51 @@ func TestValidateDeschedulerConfiguration(t *testing.T) {
 			},
 			result: fmt.Errorf("[in profile RemoveFailedPods: only one of Include/Exclude namespaces can be set, in profile RemovePodsViolatingTopologySpreadConstraint: only one of Include/Exclude namespaces can be set]"),
 		},
+		{
+			description: "profile namespaces with both include and exclude",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name: "ProfileName",
+						Plugins: api.Plugins{
+							Deschedule: api.PluginSet{Enabled: []string{removefailedpods.PluginName}},
+						},
+						PluginConfigs: []api.PluginConfig{
+							{
+								Name: removefailedpods.PluginName,
+								Args: &removefailedpods.RemoveFailedPodsArgs{},
+							},
+						},
+						Namespaces: &api.Namespaces{
+							Include: []string{"test1"},
+							Exclude: []string{"test2"},
+						},
+					},
+				},
+			},
+			result: fmt.Errorf("in profile ProfileName: only one of Include/Exclude namespaces can be set"),
+		},
+		{
+			description: "relative client identity token file error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name: "ProfileName",
+						Plugins: api.Plugins{
+							Deschedule: api.PluginSet{Enabled: []string{removefailedpods.PluginName}},
+						},
+						PluginConfigs: []api.PluginConfig{
+							{
+								Name: removefailedpods.PluginName,
+								Args: &removefailedpods.RemoveFailedPodsArgs{},
+							},
+						},
+						ClientIdentity: &api.ClientIdentity{TokenFile: "tokens/team-a"},
+					},
+				},
+			},
+			result: fmt.Errorf("in profile ProfileName: clientIdentity.tokenFile must be an absolute path"),
+		},
 		{
 			description: "Duplicit metrics providers error",
 			deschedulerPolicy: api.DeschedulerPolicy{
@@ -362,6 +465,329 @@ func TestValidateDeschedulerConfiguration(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "webhook notification without url error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Notifications: &api.Notifications{
+					Webhooks: []api.Webhook{{}},
+				},
+			},
+			result: fmt.Errorf("webhook notification URL is required"),
+		},
+		{
+			description: "webhook notification with unsupported scheme and format error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Notifications: &api.Notifications{
+					Webhooks: []api.Webhook{
+						{
+							URL:    "ftp://example.com/hook",
+							Format: "Teams",
+						},
+					},
+				},
+			},
+			result: fmt.Errorf("[webhook notification URL's scheme is not http(s), got \"ftp\" instead, webhook notification format \"Teams\" is not supported, expected one of \"Generic\", \"Slack\"]"),
+		},
+		{
+			description: "valid webhook notifications",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Notifications: &api.Notifications{
+					Webhooks: []api.Webhook{
+						{URL: "https://hooks.slack.com/services/T0/B0/X", Format: api.SlackWebhookFormat, MinEvictions: 10},
+						{URL: "http://alerts.example.com/descheduler"},
+					},
+				},
+			},
+		},
+		{
+			description: "topology domain key without the topology domain limit error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				TopologyDomainKey: "rack",
+			},
+			result: fmt.Errorf("topologyDomainKey requires maxNoOfPodsToEvictPerTopologyDomain to be set"),
+		},
+		{
+			description: "valid topology domain limit",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxNoOfPodsToEvictPerTopologyDomain: utilptr.To[uint](5),
+			},
+		},
+		{
+			description: "eviction spreading without topology key and total limit error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionSpreading: &api.EvictionSpreading{},
+			},
+			result: fmt.Errorf("[eviction spreading topologyKey is required, eviction spreading requires maxNoOfPodsToEvictTotal to be set]"),
+		},
+		{
+			description: "valid eviction spreading",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxNoOfPodsToEvictTotal: utilptr.To[uint](10),
+				EvictionSpreading:       &api.EvictionSpreading{TopologyKey: "topology.kubernetes.io/zone"},
+			},
+		},
+		{
+			description: "negative max eviction failures error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxEvictionFailuresPerCycle: utilptr.To(intstr.FromInt32(-1)),
+			},
+			result: fmt.Errorf("maxEvictionFailuresPerCycle must not be negative, got -1"),
+		},
+		{
+			description: "invalid max eviction failures percentage error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxEvictionFailuresPerCycle: utilptr.To(intstr.FromString("150%")),
+			},
+			result: fmt.Errorf("maxEvictionFailuresPerCycle must be a count or a percentage between 0%% and 100%%, got \"150%%\""),
+		},
+		{
+			description: "valid max eviction failures percentage",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxEvictionFailuresPerCycle: utilptr.To(intstr.FromString("20%")),
+			},
+		},
+		{
+			description: "unknown eviction backend error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionBackend: "Delete",
+			},
+			result: fmt.Errorf("eviction backend must be one of \"Eviction\" or \"Evacuation\", got \"Delete\""),
+		},
+		{
+			description: "valid evacuation backend",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionBackend: api.EvacuationAPIBackend,
+			},
+		},
+		{
+			description: "unknown eviction order error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionOrder: "NewerRevisionsFirst",
+			},
+			result: fmt.Errorf("eviction order must be \"OlderRevisionsFirst\", got \"NewerRevisionsFirst\""),
+		},
+		{
+			description: "valid eviction order",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionOrder: api.OlderRevisionsFirstEvictionOrder,
+			},
+		},
+		{
+			description: "eviction fairness with unknown unit and without total limit error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionFairness: &api.EvictionFairness{By: "Node"},
+			},
+			result: fmt.Errorf("[eviction fairness by must be one of \"Namespace\" or \"Owner\", got \"Node\", eviction fairness requires maxNoOfPodsToEvictTotal to be set]"),
+		},
+		{
+			description: "valid eviction fairness",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MaxNoOfPodsToEvictTotal: utilptr.To[uint](10),
+				EvictionFairness:        &api.EvictionFairness{By: api.FairnessByOwner},
+			},
+		},
+		{
+			description: "namespace disruption quotas without namespaces, period and with invalid pattern error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				NamespaceDisruptionQuotas: []api.NamespaceDisruptionQuota{
+					{MaxEvictions: 2},
+					{Namespaces: []string{"/(/"}, MaxEvictions: 2, Period: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+			result: fmt.Errorf("[namespace disruption quota 0: namespaces are required, namespace disruption quota 0: period must be positive, namespace disruption quota 1: invalid regular expression \"/(/\": error parsing regexp: missing closing ): `(`]"),
+		},
+		{
+			description: "eviction veto with non boolean expression error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionVeto: &api.EvictionVeto{
+					Validations: []api.VetoValidation{{Expression: "object.metadata.name + '-veto'"}},
+				},
+			},
+			result: fmt.Errorf("invalid eviction veto: veto validation 0: expression must evaluate to bool, got string"),
+		},
+		{
+			description: "concurrent drains without signals error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				ConcurrentDrains: &api.ConcurrentDrains{},
+			},
+			result: fmt.Errorf("concurrent drains require at least one of cordoned, nodeAnnotations, nodeTaints or leaseNamespace"),
+		},
+		{
+			description: "concurrent drains with invalid annotation and lease namespace error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				ConcurrentDrains: &api.ConcurrentDrains{
+					NodeAnnotations: []string{"example.com/draining=true", "-invalid"},
+					LeaseNamespace:  "Drains",
+				},
+			},
+			result: fmt.Errorf("[invalid concurrent drains node annotation \"-invalid\": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), invalid concurrent drains leaseNamespace \"Drains\": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')]"),
+		},
+		{
+			description: "node leases without namespace and with too short duration error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				NodeLeases: &api.NodeLeases{LeaseDuration: metav1.Duration{Duration: time.Millisecond}},
+			},
+			result: fmt.Errorf("[invalid node leases namespace \"\": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), node leases leaseDuration must be at least 1s, got 1ms]"),
+		},
+		{
+			description: "descheduling exemptions with non-positive max duration error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				DeschedulingExemptions: &api.DeschedulingExemptions{Enabled: true, MaxDuration: &metav1.Duration{}},
+			},
+			result: fmt.Errorf("descheduling exemptions maxDuration must be positive, got 0s"),
+		},
+		{
+			description: "zone outage brake with invalid topology key and percentage error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				ZoneOutageBrake: &api.ZoneOutageBrake{TopologyKey: "-zone", MaxUnavailablePercentage: 100},
+			},
+			result: fmt.Errorf("[invalid zone outage brake topologyKey \"-zone\": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), zone outage brake maxUnavailablePercentage must be in [0, 100), got 100]"),
+		},
+		{
+			description: "convergence detection with too few cycles and zero similarity error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				ConvergenceDetection: &api.ConvergenceDetection{
+					Cycles:                  utilptr.To[uint](1),
+					MinSimilarityPercentage: utilptr.To[api.Percentage](0),
+				},
+			},
+			result: fmt.Errorf("[convergence detection cycles must be at least 2, got 1, convergence detection minSimilarityPercentage must be in (0, 100], got 0]"),
+		},
+		{
+			description: "skip explanations with invalid selector and non-positive interval error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				SkipExplanations: &api.SkipExplanations{
+					LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Matches"}}},
+					Interval:      &metav1.Duration{},
+				},
+			},
+			result: fmt.Errorf("[invalid skip explanations labelSelector: \"Matches\" is not a valid label selector operator, skip explanations interval must be positive, got 0s]"),
+		},
+		{
+			description: "preferred node hints with no max nodes error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				PreferredNodeHints: &api.PreferredNodeHints{Enabled: true, MaxNodes: utilptr.To[uint](0)},
+			},
+			result: fmt.Errorf("preferred node hints maxNodes must be at least 1, got 0"),
+		},
+		{
+			description: "gang scheduling with unknown members policy and negative pacing error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				GangScheduling: &api.GangScheduling{Members: "Evict", Pacing: &metav1.Duration{Duration: -time.Second}},
+			},
+			result: fmt.Errorf("[gang scheduling members must be one of \"Skip\" or \"EvictTogether\", got \"Evict\", gang scheduling pacing must not be negative, got -1s]"),
+		},
+		{
+			description: "eviction verification with zero timeout error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionVerification: &api.EvictionVerification{Enabled: true, Timeout: &metav1.Duration{}},
+			},
+			result: fmt.Errorf("eviction verification timeout must be positive, got 0s"),
+		},
+		{
+			description: "eviction rate limit without rate error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionRateLimits: &api.EvictionRateLimits{PerNode: &api.EvictionRateLimit{}},
+			},
+			result: fmt.Errorf("eviction rate limit per node must allow at least one eviction per minute"),
+		},
+		{
+			description: "eviction rate limit without burst error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionRateLimits: &api.EvictionRateLimits{PerNamespace: &api.EvictionRateLimit{EvictionsPerMinute: 2, Burst: utilptr.To[uint](0)}},
+			},
+			result: fmt.Errorf("eviction rate limit per namespace burst must be at least 1"),
+		},
+		{
+			description: "namespace eviction intervals max interval error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				NamespaceEvictionIntervals: &api.NamespaceEvictionIntervals{Enabled: true, MaxInterval: &metav1.Duration{}},
+			},
+			result: fmt.Errorf("namespace eviction intervals max interval must be positive, got 0s"),
+		},
+		{
+			description: "schedule without windows error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Schedule: &api.Schedule{},
+			},
+			result: fmt.Errorf("schedule must have at least one maintenance window"),
+		},
+		{
+			description: "profile schedule with an invalid cron expression error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name:     "p1",
+						Schedule: &api.Schedule{Windows: []api.MaintenanceWindow{{Cron: "0 22 * *", Duration: metav1.Duration{Duration: time.Hour}}}},
+					},
+				},
+			},
+			result: fmt.Errorf("in profile p1: cron expression \"0 22 * *\" must have 5 fields, got 4"),
+		},
+		{
+			description: "zero max terminating pods per node error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				TerminationPacing: &api.TerminationPacing{MaxTerminatingPodsPerNode: utilptr.To[uint](0)},
+			},
+			result: fmt.Errorf("max terminating pods per node must be at least 1"),
+		},
+		{
+			description: "negative eviction wait timeout error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				EvictionVerification: &api.EvictionVerification{Wait: &api.EvictionWait{Timeout: &metav1.Duration{Duration: -time.Second}}},
+			},
+			result: fmt.Errorf("eviction wait timeout must be positive, got -1s"),
+		},
+		{
+			description: "min cluster headroom out of range error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				MinClusterHeadroom: api.ResourceThresholds{v1.ResourceCPU: 101},
+			},
+			result: fmt.Errorf("min cluster headroom of cpu must be in [0, 100], got 101"),
+		},
+		{
+			description: "valid node leases",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				NodeLeases: &api.NodeLeases{Namespace: "node-leases", LeaseDuration: metav1.Duration{Duration: time.Minute}},
+			},
+		},
+		{
+			description: "valid concurrent drains",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				ConcurrentDrains: &api.ConcurrentDrains{
+					Cordoned:   true,
+					NodeTaints: []string{"ToBeDeletedByClusterAutoscaler"},
+				},
+			},
+		},
+		{
+			description: "valid namespace disruption quotas",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				NamespaceDisruptionQuotas: []api.NamespaceDisruptionQuota{
+					{Namespaces: []string{"prod-*"}, MaxEvictions: 2, Period: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+		},
+		{
+			description: "invalid node selector error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				NodeSelector: utilptr.To("pool in"),
+			},
+			result: fmt.Errorf("invalid nodeSelector: unable to parse requirement: found '' expected: '('"),
+		},
+		{
+			description: "invalid pod watch selectors error",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				PodWatch: &api.PodWatch{LabelSelector: "app in", FieldSelector: "metadata.namespace"},
+			},
+			result: fmt.Errorf("[invalid pod watch labelSelector: unable to parse requirement: found '' expected: '(', invalid pod watch fieldSelector: invalid selector: 'metadata.namespace'; can't understand 'metadata.namespace']"),
+		},
+		{
+			description: "valid pod watch selectors",
+			deschedulerPolicy: api.DeschedulerPolicy{
+				PodWatch: &api.PodWatch{LabelSelector: "app in (web)", FieldSelector: "metadata.namespace!=kube-system"},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -452,6 +878,85 @@ profiles:
 				},
 			},
 		},
+		{
+			description: "shared DefaultEvictor args with a profile override",
+			policy: []byte(`apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+defaultEvictorArgs:
+  evictLocalStoragePods: true
+  nodeFit: true
+profiles:
+  - name: ProfileName
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsHavingTooManyRestarts"
+  - name: OverridingProfileName
+    pluginConfig:
+    - name: "DefaultEvictor"
+      args:
+        nodeFit: false
+    plugins:
+      deschedule:
+        enabled:
+          - "RemovePodsHavingTooManyRestarts"
+`),
+			result: &api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name: "ProfileName",
+						PluginConfigs: []api.PluginConfig{
+							{
+								Name: defaultevictor.PluginName,
+								Args: &defaultevictor.DefaultEvictorArgs{
+									EvictLocalStoragePods: true,
+									PriorityThreshold:     &api.PriorityThreshold{Value: utilptr.To[int32](2000000000)},
+									NodeFit:               true,
+								},
+							},
+						},
+						Plugins: api.Plugins{
+							Filter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							PreEvictionFilter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							Deschedule: api.PluginSet{
+								Enabled: []string{removepodshavingtoomanyrestarts.PluginName},
+							},
+						},
+					},
+					{
+						Name: "OverridingProfileName",
+						PluginConfigs: []api.PluginConfig{
+							{
+								Name: defaultevictor.PluginName,
+								Args: &defaultevictor.DefaultEvictorArgs{
+									EvictLocalStoragePods: true,
+									PriorityThreshold:     &api.PriorityThreshold{Value: utilptr.To[int32](2000000000)},
+								},
+							},
+						},
+						Plugins: api.Plugins{
+							Filter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							PreEvictionFilter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							Deschedule: api.PluginSet{
+								Enabled: []string{removepodshavingtoomanyrestarts.PluginName},
+							},
+						},
+					},
+				},
+				DefaultEvictorArgs: &defaultevictor.DefaultEvictorArgs{
+					EvictLocalStoragePods: true,
+					NodeFit:               true,
+				},
+			},
+		},
 		{
 			description: "omit default evictor extension point with their enablement",
 			policy: []byte(`apiVersion: "descheduler/v1alpha2"
@@ -510,6 +1015,74 @@ profiles:
 				},
 			},
 		},
+		{
+			description: "plugins without namespaces inherit the profile namespaces",
+			policy: []byte(`apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: ProfileName
+    namespaces:
+      exclude:
+      - kube-system
+    pluginConfig:
+    - name: "RemoveFailedPods"
+    - name: "RemoveDuplicates"
+      args:
+        namespaces:
+          include:
+          - dev
+    plugins:
+      deschedule:
+        enabled:
+          - "RemoveFailedPods"
+      balance:
+        enabled:
+          - "RemoveDuplicates"
+`),
+			result: &api.DeschedulerPolicy{
+				Profiles: []api.DeschedulerProfile{
+					{
+						Name: "ProfileName",
+						PluginConfigs: []api.PluginConfig{
+							{
+								Name: defaultevictor.PluginName,
+								Args: &defaultevictor.DefaultEvictorArgs{
+									PriorityThreshold: &api.PriorityThreshold{Value: utilptr.To[int32](2000000000)},
+								},
+							},
+							{
+								Name: removefailedpods.PluginName,
+								Args: &removefailedpods.RemoveFailedPodsArgs{
+									MinPodLifetimeSeconds: utilptr.To[uint](3600),
+									Namespaces:            &api.Namespaces{Exclude: []string{"kube-system"}},
+								},
+							},
+							{
+								Name: removeduplicates.PluginName,
+								Args: &removeduplicates.RemoveDuplicatesArgs{
+									Namespaces: &api.Namespaces{Include: []string{"dev"}},
+								},
+							},
+						},
+						Plugins: api.Plugins{
+							Filter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							PreEvictionFilter: api.PluginSet{
+								Enabled: []string{defaultevictor.PluginName},
+							},
+							Deschedule: api.PluginSet{
+								Enabled: []string{removefailedpods.PluginName},
+							},
+							Balance: api.PluginSet{
+								Enabled: []string{removeduplicates.PluginName},
+							},
+						},
+						Namespaces: &api.Namespaces{Exclude: []string{"kube-system"}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -529,3 +1102,36 @@ profiles:
 		})
 	}
 }
+
+func TestInheritNamespaces(t *testing.T) {
+	profile := api.DeschedulerProfile{
+		Name: "ProfileName",
+		PluginConfigs: []api.PluginConfig{
+			{Name: nodeutilization.LowNodeUtilizationPluginName, Args: &nodeutilization.LowNodeUtilizationArgs{}},
+			{Name: removeduplicates.PluginName, Args: &removeduplicates.RemoveDuplicatesArgs{}},
+		},
+		Namespaces: &api.Namespaces{Exclude: []string{"kube-system"}},
+	}
+	inheritNamespaces(profile)
+
+	lowNodeUtilizationArgs := profile.PluginConfigs[0].Args.(*nodeutilization.LowNodeUtilizationArgs)
+	if diff := cmp.Diff(&api.Namespaces{Exclude: []string{"kube-system"}}, lowNodeUtilizationArgs.EvictableNamespaces); diff != "" {
+		t.Errorf("Unexpected evictable namespaces (-want +got):\n%s", diff)
+	}
+	removeDuplicatesArgs := profile.PluginConfigs[1].Args.(*removeduplicates.RemoveDuplicatesArgs)
+	if diff := cmp.Diff(&api.Namespaces{Exclude: []string{"kube-system"}}, removeDuplicatesArgs.Namespaces); diff != "" {
+		t.Errorf("Unexpected namespaces (-want +got):\n%s", diff)
+	}
+	// The plugins must not share the namespaces of the profile
+	removeDuplicatesArgs.Namespaces.Exclude[0] = "changed"
+	if profile.Namespaces.Exclude[0] != "kube-system" {
+		t.Errorf("Expected the profile namespaces to be copied to the plugins")
+	}
+
+	profile.PluginConfigs[0].Args = &nodeutilization.LowNodeUtilizationArgs{}
+	profile.Namespaces = &api.Namespaces{Include: []string{"dev"}}
+	inheritNamespaces(profile)
+	if args := profile.PluginConfigs[0].Args.(*nodeutilization.LowNodeUtilizationArgs); args.EvictableNamespaces != nil {
+		t.Errorf("Expected included namespaces not to be inherited as evictable namespaces, got %v", args.EvictableNamespaces)
+	}
+}
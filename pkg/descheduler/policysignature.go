@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	clientset "k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+// policySignatureSuffix is appended to the policy config file to find its signature by default
+const policySignatureSuffix = ".sig"
+
+// readPolicySignatureKey reads a PEM encoded ECDSA, Ed25519 or RSA public key
+func readPolicySignatureKey(keyFile string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy signature key file %q: %v", keyFile, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded key found in policy signature key file %q", keyFile)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy signature key file %q: %v", keyFile, err)
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported policy signature key type %T", publicKey)
+	}
+}
+
+// verifyPolicySignature checks the signature of the policy, made over its SHA-256
+// digest for ECDSA (ASN.1) and RSA (PKCS #1 v1.5) keys and over the policy itself for Ed25519 keys
+func verifyPolicySignature(publicKey crypto.PublicKey, policy, signature []byte) bool {
+	digest := sha256.Sum256(policy)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, policy, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return false
+	}
+}
+
+// verifyEncodedPolicySignature checks the base64 encoded signature of the policy read from the source
+func verifyEncodedPolicySignature(publicKey crypto.PublicKey, source string, policy []byte, encodedSignature string) error {
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedSignature))
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("invalid signature of policy %q", source)
+	}
+	if !verifyPolicySignature(publicKey, policy, signature) {
+		return fmt.Errorf("signature verification of policy from %q failed", source)
+	}
+	return nil
+}
+
+// loadSignedPolicyConfig loads the policy config file of the server once its signature,
+// kept in the signature file, is verified with the policy signature key
+func loadSignedPolicyConfig(rs *options.DeschedulerServer, client clientset.Interface, registry pluginregistry.Registry) (*api.DeschedulerPolicy, error) {
+	if rs.PolicyConfigFile == "" {
+		return nil, fmt.Errorf("policy signature key file set without a policy config file")
+	}
+	publicKey, err := readPolicySignatureKey(rs.PolicySignatureKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := os.ReadFile(rs.PolicyConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy config file %q: %+v", rs.PolicyConfigFile, err)
+	}
+	signatureFile := rs.PolicySignatureFile
+	if signatureFile == "" {
+		signatureFile = rs.PolicyConfigFile + policySignatureSuffix
+	}
+	signature, err := os.ReadFile(signatureFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature file %q of policy config file %q: %v", signatureFile, rs.PolicyConfigFile, err)
+	}
+	if err := verifyEncodedPolicySignature(publicKey, rs.PolicyConfigFile, policy, string(signature)); err != nil {
+		return nil, err
+	}
+	return decode(rs.PolicyConfigFile, policy, client, registry)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+func TestLoadSignedPolicyConfig(t *testing.T) {
+	client := fakeclientset.NewSimpleClientset()
+	SetupPlugins()
+	policy := policyWithProfile("signed")
+	digest := sha256.Sum256(policy)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+	ed25519PublicKey, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+	// cosign sign-blob signs the SHA-256 digest with an ECDSA P-256 key
+	ecdsaSignature, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+	if err != nil {
+		t.Fatalf("Unable to sign the policy: %v", err)
+	}
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("Unable to sign the policy: %v", err)
+	}
+	ed25519Signature := ed25519.Sign(ed25519Key, policy)
+
+	tests := []struct {
+		name            string
+		publicKey       crypto.PublicKey
+		policy          []byte
+		signature       []byte
+		signatureFile   bool
+		noSignatureFile bool
+		err             string
+	}{
+		{
+			name:      "ECDSA signature",
+			publicKey: &ecdsaKey.PublicKey,
+			signature: ecdsaSignature,
+		},
+		{
+			name:      "RSA signature",
+			publicKey: &rsaKey.PublicKey,
+			signature: rsaSignature,
+		},
+		{
+			name:          "Ed25519 signature in a custom signature file",
+			publicKey:     ed25519PublicKey,
+			signature:     ed25519Signature,
+			signatureFile: true,
+		},
+		{
+			name:      "tampered policy",
+			publicKey: &ecdsaKey.PublicKey,
+			policy:    policyWithProfile("tampered"),
+			signature: ecdsaSignature,
+			err:       "signature verification of policy from",
+		},
+		{
+			name:      "signature of another key",
+			publicKey: &rsaKey.PublicKey,
+			signature: ecdsaSignature,
+			err:       "signature verification of policy from",
+		},
+		{
+			name:            "unsigned policy",
+			publicKey:       &ecdsaKey.PublicKey,
+			noSignatureFile: true,
+			err:             "failed to read signature file",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			rs, err := options.NewDeschedulerServer()
+			if err != nil {
+				t.Fatalf("Unable to initialize server: %v", err)
+			}
+			rs.PolicyConfigFile = filepath.Join(dir, "policy.yaml")
+			writtenPolicy := policy
+			if tc.policy != nil {
+				writtenPolicy = tc.policy
+			}
+			if err := os.WriteFile(rs.PolicyConfigFile, writtenPolicy, 0o600); err != nil {
+				t.Fatalf("Unable to write the policy: %v", err)
+			}
+			publicKeyDER, err := x509.MarshalPKIXPublicKey(tc.publicKey)
+			if err != nil {
+				t.Fatalf("Unable to marshal the public key: %v", err)
+			}
+			rs.PolicySignatureKeyFile = writePEM(t, "PUBLIC KEY", publicKeyDER)
+			signatureFile := rs.PolicyConfigFile + ".sig"
+			if tc.signatureFile {
+				rs.PolicySignatureFile = filepath.Join(dir, "signature")
+				signatureFile = rs.PolicySignatureFile
+			}
+			if !tc.noSignatureFile {
+				if err := os.WriteFile(signatureFile, []byte(base64.StdEncoding.EncodeToString(tc.signature)+"\n"), 0o600); err != nil {
+					t.Fatalf("Unable to write the signature: %v", err)
+				}
+			}
+
+			deschedulerPolicy, err := loadSignedPolicyConfig(rs, client, pluginregistry.PluginRegistry)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("Expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unable to load the policy: %v", err)
+			}
+			if len(deschedulerPolicy.Profiles) != 1 || deschedulerPolicy.Profiles[0].Name != "signed" {
+				t.Errorf("Expected the signed policy, got %v", deschedulerPolicy)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+const (
+	// PolicySignatureHeader carries the base64 encoded signature of the policy served through the policy URL
+	PolicySignatureHeader = "X-Descheduler-Policy-Signature"
+
+	policyURLTimeout = 30 * time.Second
+	// maxPolicySize bounds the size of the policy read from the policy URL
+	maxPolicySize = 10 << 20
+)
+
+// urlPolicySource fetches the policy from an HTTPS endpoint. The ETag of the last
+// loaded policy is sent along so an unchanged policy is neither sent nor decoded again.
+type urlPolicySource struct {
+	url       string
+	client    *http.Client
+	publicKey crypto.PublicKey
+
+	mu   sync.Mutex
+	etag string
+}
+
+// newURLPolicySource builds the policy source of the policy URL of the server
+func newURLPolicySource(rs *options.DeschedulerServer) (*urlPolicySource, error) {
+	if rs.PolicyConfigFile != "" {
+		return nil, fmt.Errorf("policy config file and policy URL can not be set together")
+	}
+	policyURL, err := url.Parse(rs.PolicyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy URL %q: %v", rs.PolicyURL, err)
+	}
+	if policyURL.Scheme != "https" {
+		return nil, fmt.Errorf("policy URL %q must use https", rs.PolicyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if rs.PolicyURLCAFile != "" {
+		caBundle, err := os.ReadFile(rs.PolicyURLCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy URL CA file %q: %v", rs.PolicyURLCAFile, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates found in policy URL CA file %q", rs.PolicyURLCAFile)
+		}
+	}
+
+	var publicKey crypto.PublicKey
+	if rs.PolicySignatureKeyFile != "" {
+		publicKey, err = readPolicySignatureKey(rs.PolicySignatureKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &urlPolicySource{
+		url: rs.PolicyURL,
+		client: &http.Client{
+			Timeout:   policyURLTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+		},
+		publicKey: publicKey,
+	}, nil
+}
+
+// fetch retrieves the policy and its ETag, nil when the policy did not change since the last load
+func (s *urlPolicySource) fetch(ctx context.Context) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	s.mu.Lock()
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+	s.mu.Unlock()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch policy from %q: %v", s.url, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return nil, "", nil
+	case http.StatusOK:
+	default:
+		return nil, "", fmt.Errorf("failed to fetch policy from %q: unexpected status %q", s.url, resp.Status)
+	}
+
+	policy, err := io.ReadAll(io.LimitReader(resp.Body, maxPolicySize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read policy from %q: %v", s.url, err)
+	}
+	if len(policy) > maxPolicySize {
+		return nil, "", fmt.Errorf("policy from %q exceeds %d bytes", s.url, maxPolicySize)
+	}
+	if s.publicKey != nil {
+		if resp.Header.Get(PolicySignatureHeader) == "" {
+			return nil, "", fmt.Errorf("policy from %q has no %s header", s.url, PolicySignatureHeader)
+		}
+		if err := verifyEncodedPolicySignature(s.publicKey, s.url, policy, resp.Header.Get(PolicySignatureHeader)); err != nil {
+			return nil, "", err
+		}
+	}
+	return policy, resp.Header.Get("ETag"), nil
+}
+
+// load fetches and decodes the policy, nil when the policy did not change since the last load.
+// The ETag is only remembered once the policy is decoded so an invalid policy is reported on every poll.
+func (s *urlPolicySource) load(ctx context.Context, client clientset.Interface, registry pluginregistry.Registry) (*api.DeschedulerPolicy, error) {
+	policy, etag, err := s.fetch(ctx)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	deschedulerPolicy, err := decode(s.url, policy, client, registry)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.etag = etag
+	s.mu.Unlock()
+	return deschedulerPolicy, nil
+}
+
+// runWithPolicyUpdates runs the descheduler with the policy and restarts it with every changed
+// policy polled from the policy source. It returns once a run ends without a policy update.
+func runWithPolicyUpdates(
+	ctx context.Context,
+	source *urlPolicySource,
+	interval time.Duration,
+	client clientset.Interface,
+	deschedulerPolicy *api.DeschedulerPolicy,
+	run func(ctx context.Context, deschedulerPolicy *api.DeschedulerPolicy) error,
+) error {
+	for {
+		runCtx, cancel := context.WithCancel(ctx)
+		var updated *api.DeschedulerPolicy
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-runCtx.Done():
+					return
+				case <-ticker.C:
+				}
+				next, err := source.load(runCtx, client, pluginregistry.PluginRegistry)
+				if err != nil {
+					if runCtx.Err() == nil {
+						klog.ErrorS(err, "Unable to update the descheduler policy, keeping the current one", "url", source.url)
+					}
+					continue
+				}
+				if next != nil {
+					updated = next
+					cancel()
+					return
+				}
+			}
+		}()
+
+		err := run(runCtx, deschedulerPolicy)
+		cancel()
+		wg.Wait()
+		if updated == nil || ctx.Err() != nil {
+			return err
+		}
+		klog.InfoS("Descheduler policy updated, restarting the descheduler", "url", source.url)
+		deschedulerPolicy = updated
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+)
+
+func policyWithProfile(profileName string) []byte {
+	return []byte(fmt.Sprintf(`apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: %s
+    pluginConfig:
+    - name: "RemoveFailedPods"
+    plugins:
+      deschedule:
+        enabled:
+          - "RemoveFailedPods"
+`, profileName))
+}
+
+// policyServer serves the policy with its ETag, signed with the private key when set
+type policyServer struct {
+	mu         sync.Mutex
+	policy     []byte
+	etag       string
+	privateKey ed25519.PrivateKey
+	requests   int
+	notChanged int
+}
+
+func (s *policyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if r.Header.Get("If-None-Match") == s.etag {
+		s.notChanged++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", s.etag)
+	if s.privateKey != nil {
+		w.Header().Set(PolicySignatureHeader, base64.StdEncoding.EncodeToString(ed25519.Sign(s.privateKey, s.policy)))
+	}
+	w.Write(s.policy)
+}
+
+func (s *policyServer) update(policy []byte, etag string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy, s.etag = policy, etag
+}
+
+func writePEM(t *testing.T, blockType string, data []byte) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), strings.ToLower(strings.ReplaceAll(blockType, " ", "-"))+".pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0o600); err != nil {
+		t.Fatalf("Unable to write %s: %v", blockType, err)
+	}
+	return file
+}
+
+func newTestPolicyServer(t *testing.T, server *policyServer) *options.DeschedulerServer {
+	t.Helper()
+	httpServer := httptest.NewTLSServer(server)
+	t.Cleanup(httpServer.Close)
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		t.Fatalf("Unable to initialize server: %v", err)
+	}
+	rs.PolicyURL = httpServer.URL
+	rs.PolicyURLCAFile = writePEM(t, "CERTIFICATE", httpServer.Certificate().Raw)
+	return rs
+}
+
+func TestURLPolicySource(t *testing.T) {
+	ctx := context.Background()
+	client := fakeclientset.NewSimpleClientset()
+	SetupPlugins()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatalf("Unable to marshal the public key: %v", err)
+	}
+	_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		privateKey ed25519.PrivateKey
+		verify     bool
+		err        string
+	}{
+		{
+			name: "unsigned policy",
+		},
+		{
+			name:       "signed policy",
+			privateKey: privateKey,
+			verify:     true,
+		},
+		{
+			name:   "missing signature",
+			verify: true,
+			err:    "has no X-Descheduler-Policy-Signature header",
+		},
+		{
+			name:       "signature of another key",
+			privateKey: otherPrivateKey,
+			verify:     true,
+			err:        "signature verification of policy from",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &policyServer{policy: policyWithProfile("first"), etag: `"1"`, privateKey: tc.privateKey}
+			rs := newTestPolicyServer(t, server)
+			if tc.verify {
+				rs.PolicySignatureKeyFile = writePEM(t, "PUBLIC KEY", publicKeyDER)
+			}
+			source, err := newURLPolicySource(rs)
+			if err != nil {
+				t.Fatalf("Unable to create the policy source: %v", err)
+			}
+
+			policy, err := source.load(ctx, client, pluginregistry.PluginRegistry)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("Expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unable to load the policy: %v", err)
+			}
+			if policy == nil || len(policy.Profiles) != 1 || policy.Profiles[0].Name != "first" {
+				t.Fatalf("Expected the policy with the first profile, got %v", policy)
+			}
+
+			// An unchanged policy is not sent again
+			policy, err = source.load(ctx, client, pluginregistry.PluginRegistry)
+			if err != nil || policy != nil {
+				t.Fatalf("Expected no policy update, got %v, %v", policy, err)
+			}
+			if server.notChanged != 1 {
+				t.Errorf("Expected the server to report the policy not modified once, got %d", server.notChanged)
+			}
+
+			server.update(policyWithProfile("second"), `"2"`)
+			policy, err = source.load(ctx, client, pluginregistry.PluginRegistry)
+			if err != nil {
+				t.Fatalf("Unable to load the policy: %v", err)
+			}
+			if policy == nil || policy.Profiles[0].Name != "second" {
+				t.Fatalf("Expected the policy with the second profile, got %v", policy)
+			}
+		})
+	}
+}
+
+func TestNewURLPolicySourceValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(rs *options.DeschedulerServer)
+		err    string
+	}{
+		{
+			name: "policy config file set too",
+			modify: func(rs *options.DeschedulerServer) {
+				rs.PolicyConfigFile = "policy.yaml"
+			},
+			err: "policy config file and policy URL can not be set together",
+		},
+		{
+			name: "plain http",
+			modify: func(rs *options.DeschedulerServer) {
+				rs.PolicyURL = "http://policies.example.com/policy.yaml"
+			},
+			err: `policy URL "http://policies.example.com/policy.yaml" must use https`,
+		},
+		{
+			name: "missing CA file",
+			modify: func(rs *options.DeschedulerServer) {
+				rs.PolicyURLCAFile = filepath.Join(t.TempDir(), "missing.pem")
+			},
+			err: "failed to read policy URL CA file",
+		},
+		{
+			name: "invalid signature key file",
+			modify: func(rs *options.DeschedulerServer) {
+				rs.PolicySignatureKeyFile = writePEM(t, "PUBLIC KEY", []byte("invalid"))
+			},
+			err: "failed to parse policy signature key file",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rs, err := options.NewDeschedulerServer()
+			if err != nil {
+				t.Fatalf("Unable to initialize server: %v", err)
+			}
+			rs.PolicyURL = "https://policies.example.com/policy.yaml"
+			tc.modify(rs)
+			if _, err := newURLPolicySource(rs); err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("Expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestRunWithPolicyUpdates(t *testing.T) {
+	ctx := context.Background()
+	client := fakeclientset.NewSimpleClientset()
+	SetupPlugins()
+
+	server := &policyServer{policy: policyWithProfile("first"), etag: `"1"`}
+	rs := newTestPolicyServer(t, server)
+	source, err := newURLPolicySource(rs)
+	if err != nil {
+		t.Fatalf("Unable to create the policy source: %v", err)
+	}
+	policy, err := source.load(ctx, client, pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to load the policy: %v", err)
+	}
+
+	var profiles []string
+	run := func(ctx context.Context, policy *api.DeschedulerPolicy) error {
+		profiles = append(profiles, policy.Profiles[0].Name)
+		if len(profiles) == 1 {
+			// The first run lasts until the policy gets updated
+			server.update(policyWithProfile("second"), `"2"`)
+			<-ctx.Done()
+		}
+		return nil
+	}
+	if err := runWithPolicyUpdates(ctx, source, 10*time.Millisecond, client, policy, run); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(profiles, ",") != "first,second" {
+		t.Errorf("Expected runs with the first and the second policy, got %v", profiles)
+	}
+}
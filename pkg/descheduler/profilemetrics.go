@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"fmt"
+
+	promapi "github.com/prometheus/client_golang/api"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsapproachingdiskpressure"
+)
+
+// inheritMetricsSource sets the metrics source of a profile consuming the Kubernetes metrics to the plugins
+// of the profile with no metrics source configured. The Prometheus source is not inherited, the plugins
+// consuming the Prometheus metrics configure their own queries.
+func inheritMetricsSource(profile api.DeschedulerProfile) {
+	if profile.MetricsSource != api.KubernetesMetrics {
+		return
+	}
+	for _, pluginConfig := range profile.PluginConfigs {
+		if args, ok := pluginConfig.Args.(*nodeutilization.LowNodeUtilizationArgs); ok && args.MetricsUtilization == nil {
+			args.MetricsUtilization = &nodeutilization.MetricsUtilization{Source: api.KubernetesMetrics}
+		}
+	}
+}
+
+// pluginMetricsSources returns the metrics sources the plugin consumes with the args
+func pluginMetricsSources(args runtime.Object) []api.MetricsSource {
+	var sources []api.MetricsSource
+	switch args := args.(type) {
+	case *nodeutilization.LowNodeUtilizationArgs:
+		if metrics := args.MetricsUtilization; metrics != nil {
+			if metrics.MetricsServer {
+				sources = append(sources, api.KubernetesMetrics)
+			} else if metrics.Source != "" {
+				sources = append(sources, metrics.Source)
+			}
+		}
+		if args.NetworkUtilization != nil || len(args.CustomResources) > 0 {
+			sources = append(sources, api.PrometheusMetrics)
+		}
+	case *nodeutilization.HighNodeUtilizationArgs:
+		if len(args.CustomResources) > 0 {
+			sources = append(sources, api.PrometheusMetrics)
+		}
+	case *removepodsapproachingdiskpressure.RemovePodsApproachingDiskPressureArgs:
+		sources = append(sources, api.PrometheusMetrics)
+	}
+	return sources
+}
+
+// validateProfileMetricsSource checks the metrics source the profile selects is configured by the policy
+// and the plugins of the profile consume no other source
+func validateProfileMetricsSource(profile api.DeschedulerProfile, providers map[api.MetricsSource]api.MetricsProvider, metricsCollector *api.MetricsCollector) error {
+	switch profile.MetricsSource {
+	case "":
+		return nil
+	case api.NoMetrics:
+	case api.KubernetesMetrics:
+		if _, ok := providers[api.KubernetesMetrics]; !ok && (metricsCollector == nil || !metricsCollector.Enabled) {
+			return fmt.Errorf("metrics source %q is not configured by the policy", profile.MetricsSource)
+		}
+	case api.PrometheusMetrics:
+		if _, ok := providers[api.PrometheusMetrics]; !ok {
+			return fmt.Errorf("metrics source %q is not configured by the policy", profile.MetricsSource)
+		}
+	default:
+		return fmt.Errorf("metrics source must be one of %q, %q or %q, got %q", api.KubernetesMetrics, api.PrometheusMetrics, api.NoMetrics, profile.MetricsSource)
+	}
+	for _, pluginConfig := range profile.PluginConfigs {
+		for _, source := range pluginMetricsSources(pluginConfig.Args) {
+			if source != profile.MetricsSource {
+				return fmt.Errorf("plugin %s consumes the %q metrics, the profile selects %q", pluginConfig.Name, source, profile.MetricsSource)
+			}
+		}
+	}
+	return nil
+}
+
+// profileMetrics returns the metrics collector and the prometheus client of the metrics source the profile
+// selects, both when the profile selects none
+func (d *descheduler) profileMetrics(profile api.DeschedulerProfile) (*metricscollector.MetricsCollector, promapi.Client) {
+	switch profile.MetricsSource {
+	case api.KubernetesMetrics:
+		return d.metricsCollector, nil
+	case api.PrometheusMetrics:
+		return nil, d.prometheusClient
+	case api.NoMetrics:
+		return nil, nil
+	}
+	return d.metricsCollector, d.prometheusClient
+}
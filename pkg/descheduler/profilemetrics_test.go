@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsapproachingdiskpressure"
+)
+
+func TestValidateProfileMetricsSource(t *testing.T) {
+	providers := map[api.MetricsSource]api.MetricsProvider{
+		api.PrometheusMetrics: {Source: api.PrometheusMetrics, Prometheus: &api.Prometheus{URL: "https://prometheus.example.com"}},
+	}
+	profile := func(source api.MetricsSource, args ...runtime.Object) api.DeschedulerProfile {
+		profile := api.DeschedulerProfile{Name: "pool", MetricsSource: source}
+		for i, arg := range args {
+			profile.PluginConfigs = append(profile.PluginConfigs, api.PluginConfig{Name: fmt.Sprintf("Plugin%d", i), Args: arg})
+		}
+		return profile
+	}
+	prometheusUtilization := &nodeutilization.LowNodeUtilizationArgs{
+		MetricsUtilization: &nodeutilization.MetricsUtilization{Source: api.PrometheusMetrics, Prometheus: &nodeutilization.Prometheus{Query: "instance:node_cpu:rate:sum"}},
+	}
+
+	tests := []struct {
+		description      string
+		profile          api.DeschedulerProfile
+		metricsCollector *api.MetricsCollector
+		result           error
+	}{
+		{
+			description: "no metrics source selected",
+			profile:     profile("", prometheusUtilization),
+		},
+		{
+			description: "prometheus source consumed by the plugins",
+			profile:     profile(api.PrometheusMetrics, prometheusUtilization, &removepodsapproachingdiskpressure.RemovePodsApproachingDiskPressureArgs{}),
+		},
+		{
+			description: "requested utilization with no metrics",
+			profile:     profile(api.NoMetrics, &nodeutilization.LowNodeUtilizationArgs{}, &nodeutilization.HighNodeUtilizationArgs{}),
+		},
+		{
+			description:      "kubernetes metrics through the deprecated metrics collector",
+			profile:          profile(api.KubernetesMetrics, &nodeutilization.LowNodeUtilizationArgs{MetricsUtilization: &nodeutilization.MetricsUtilization{MetricsServer: true}}),
+			metricsCollector: &api.MetricsCollector{Enabled: true},
+		},
+		{
+			description: "kubernetes metrics not configured by the policy",
+			profile:     profile(api.KubernetesMetrics),
+			result:      fmt.Errorf("metrics source \"KubernetesMetrics\" is not configured by the policy"),
+		},
+		{
+			description: "unknown metrics source",
+			profile:     profile("Datadog"),
+			result:      fmt.Errorf("metrics source must be one of \"KubernetesMetrics\", \"Prometheus\" or \"None\", got \"Datadog\""),
+		},
+		{
+			description: "prometheus queries of a profile with no metrics",
+			profile:     profile(api.NoMetrics, &nodeutilization.HighNodeUtilizationArgs{CustomResources: []nodeutilization.CustomResource{{Name: "gpu", Query: "gpu_util"}}}),
+			result:      fmt.Errorf("plugin Plugin0 consumes the \"Prometheus\" metrics, the profile selects \"None\""),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			err := validateProfileMetricsSource(tc.profile, providers, tc.metricsCollector)
+			if fmt.Sprint(err) != fmt.Sprint(tc.result) {
+				t.Errorf("Expected error %v, got %v", tc.result, err)
+			}
+		})
+	}
+}
+
+func TestInheritMetricsSource(t *testing.T) {
+	inheriting := &nodeutilization.LowNodeUtilizationArgs{}
+	configured := &nodeutilization.LowNodeUtilizationArgs{
+		MetricsUtilization: &nodeutilization.MetricsUtilization{Source: api.PrometheusMetrics},
+	}
+	inheritMetricsSource(api.DeschedulerProfile{
+		MetricsSource: api.KubernetesMetrics,
+		PluginConfigs: []api.PluginConfig{
+			{Name: nodeutilization.LowNodeUtilizationPluginName, Args: inheriting},
+			{Name: "Configured", Args: configured},
+		},
+	})
+	if inheriting.MetricsUtilization == nil || inheriting.MetricsUtilization.Source != api.KubernetesMetrics {
+		t.Errorf("Expected the plugin to inherit the kubernetes metrics, got %v", inheriting.MetricsUtilization)
+	}
+	if configured.MetricsUtilization.Source != api.PrometheusMetrics {
+		t.Errorf("Expected the metrics source of the plugin to be kept, got %v", configured.MetricsUtilization.Source)
+	}
+
+	notInheriting := &nodeutilization.LowNodeUtilizationArgs{}
+	inheritMetricsSource(api.DeschedulerProfile{
+		MetricsSource: api.PrometheusMetrics,
+		PluginConfigs: []api.PluginConfig{{Name: nodeutilization.LowNodeUtilizationPluginName, Args: notInheriting}},
+	})
+	if notInheriting.MetricsUtilization != nil {
+		t.Errorf("Expected the prometheus source not to be inherited, got %v", notInheriting.MetricsUtilization)
+	}
+}
+
+func TestProfileMetrics(t *testing.T) {
+	d := &descheduler{metricsCollector: &metricscollector.MetricsCollector{}}
+	tests := []struct {
+		source           api.MetricsSource
+		metricsCollector bool
+	}{
+		{source: "", metricsCollector: true},
+		{source: api.KubernetesMetrics, metricsCollector: true},
+		{source: api.PrometheusMetrics, metricsCollector: false},
+		{source: api.NoMetrics, metricsCollector: false},
+	}
+	for _, tc := range tests {
+		metricsCollector, _ := d.profileMetrics(api.DeschedulerProfile{MetricsSource: tc.source})
+		if (metricsCollector != nil) != tc.metricsCollector {
+			t.Errorf("Expected the profile selecting %q to get the metrics collector to be %v", tc.source, tc.metricsCollector)
+		}
+	}
+}
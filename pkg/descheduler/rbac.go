@@ -0,0 +1,406 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	componentbaseconfig "k8s.io/component-base/config"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	exemptionsv1alpha1 "sigs.k8s.io/descheduler/pkg/api/exemptions/v1alpha1"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+)
+
+// RBACRules are the RBAC policy rules the descheduler needs to run a policy
+type RBACRules struct {
+	// ClusterRules are granted cluster wide
+	ClusterRules []rbacv1.PolicyRule
+	// NamespaceRules are granted in the namespaces only, e.g. the access to the Prometheus auth token secret
+	NamespaceRules map[string][]rbacv1.PolicyRule
+}
+
+// RBACManifestOptions name the RBAC objects granting the rules to the descheduler service account
+type RBACManifestOptions struct {
+	// Name of the ClusterRole, the Roles and their bindings
+	Name string
+	// ServiceAccount the rules are granted to
+	ServiceAccount string
+	// Namespace of the service account
+	Namespace string
+}
+
+// PolicyRulesOptions are the settings of the descheduler the RBAC rules depend on
+type PolicyRulesOptions struct {
+	// DryRun leaves out the rules of the evictions, the node annotations and the leader election
+	DryRun bool
+	// DryRunValidation keeps the rules of the evictions in the dry run mode, the evictions are validated by the apiserver
+	DryRunValidation bool
+	// LeaderElection adds the rules of the lease when enabled
+	LeaderElection *componentbaseconfig.LeaderElectionConfiguration
+}
+
+var watchVerbs = []string{"get", "watch", "list"}
+
+// PolicyRules returns the minimal RBAC rules of the policy: the rules of the descheduler itself,
+// the rules of the enabled policy features and the rules the enabled plugins declare.
+func PolicyRules(deschedulerPolicy *api.DeschedulerPolicy, registry pluginregistry.Registry, opts PolicyRulesOptions) RBACRules {
+	cluster := newRuleSet()
+	// The resources watched by the descheduler and by the default evictor (see cachedResources)
+	cluster.add("", []string{"pods", "nodes", "namespaces"}, nil, watchVerbs...)
+	cluster.add("scheduling.k8s.io", []string{"priorityclasses"}, nil, watchVerbs...)
+	cluster.add("policy", []string{"poddisruptionbudgets"}, nil, watchVerbs...)
+	cluster.add("events.k8s.io", []string{"events"}, nil, "create", "update")
+	if !opts.DryRun && deschedulerPolicy.EvictionBackend == api.EvacuationAPIBackend {
+		cluster.add(evictions.EvacuationResource.Group, []string{evictions.EvacuationResource.Resource}, nil, "create")
+	} else if !opts.DryRun || opts.DryRunValidation {
+		cluster.add("", []string{"pods/eviction"}, nil, "create")
+	}
+
+	if !opts.DryRun && deschedulerPolicy.NodeEvictionAnnotations != nil && *deschedulerPolicy.NodeEvictionAnnotations {
+		cluster.add("", []string{"nodes"}, nil, "patch")
+	}
+	if deschedulerPolicy.DeschedulingExemptions != nil && deschedulerPolicy.DeschedulingExemptions.Enabled {
+		cluster.add(exemptionsv1alpha1.GroupName, []string{"deschedulingexemptions"}, nil, "list")
+	}
+	if deschedulerPolicy.EvictionOrder == api.OlderRevisionsFirstEvictionOrder {
+		cluster.add("apps", []string{"replicasets"}, nil, watchVerbs...)
+	}
+	if (deschedulerPolicy.MetricsCollector != nil && deschedulerPolicy.MetricsCollector.Enabled) || metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.KubernetesMetrics] != nil {
+		cluster.add("metrics.k8s.io", []string{"nodes", "pods"}, nil, "get", "list")
+	}
+
+	for _, profile := range deschedulerPolicy.Profiles {
+		for _, pluginName := range sets.List(profilePlugins(profile)) {
+			pluginUtilities, ok := registry[pluginName]
+			if !ok || pluginUtilities.PluginPolicyRules == nil {
+				continue
+			}
+			pluginConfig, _ := GetPluginConfig(pluginName, profile.PluginConfigs)
+			if pluginConfig == nil {
+				continue
+			}
+			for _, rule := range pluginUtilities.PluginPolicyRules(pluginConfig.Args) {
+				for _, group := range rule.APIGroups {
+					cluster.add(group, rule.Resources, rule.ResourceNames, rule.Verbs...)
+				}
+			}
+		}
+	}
+
+	namespaced := make(map[string]*ruleSet)
+	namespaceRules := func(namespace string) *ruleSet {
+		if _, ok := namespaced[namespace]; !ok {
+			namespaced[namespace] = newRuleSet()
+		}
+		return namespaced[namespace]
+	}
+	prometheusProvider := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.PrometheusMetrics]
+	if prometheusProvider != nil && prometheusProvider.Prometheus != nil && prometheusProvider.Prometheus.AuthToken != nil && prometheusProvider.Prometheus.AuthToken.SecretReference != nil {
+		namespaceRules(prometheusProvider.Prometheus.AuthToken.SecretReference.Namespace).add("", []string{"secrets"}, nil, watchVerbs...)
+	}
+	if deschedulerPolicy.ConcurrentDrains != nil && deschedulerPolicy.ConcurrentDrains.LeaseNamespace != "" {
+		namespaceRules(deschedulerPolicy.ConcurrentDrains.LeaseNamespace).add("coordination.k8s.io", []string{"leases"}, nil, "list")
+	}
+	if nodeLeases := deschedulerPolicy.NodeLeases; nodeLeases != nil {
+		if opts.DryRun {
+			namespaceRules(nodeLeases.Namespace).add("coordination.k8s.io", []string{"leases"}, nil, "get")
+		} else {
+			namespaceRules(nodeLeases.Namespace).add("coordination.k8s.io", []string{"leases"}, nil, "get", "create", "update")
+		}
+	}
+	if leaderElection := opts.LeaderElection; !opts.DryRun && leaderElection != nil && leaderElection.LeaderElect {
+		leases := namespaceRules(leaderElection.ResourceNamespace)
+		leases.add("coordination.k8s.io", []string{"leases"}, nil, "create")
+		leases.add("coordination.k8s.io", []string{"leases"}, []string{leaderElection.ResourceName}, "get", "update")
+	}
+
+	rules := RBACRules{ClusterRules: cluster.rules()}
+	if len(namespaced) > 0 {
+		rules.NamespaceRules = make(map[string][]rbacv1.PolicyRule, len(namespaced))
+		for namespace, set := range namespaced {
+			rules.NamespaceRules[namespace] = set.rules()
+		}
+	}
+	return rules
+}
+
+// PolicyRulesForPolicyConfig decodes the policy file and returns its RBAC rules, see PolicyRules
+func PolicyRulesForPolicyConfig(policyConfigFile string, registry pluginregistry.Registry, opts PolicyRulesOptions) (RBACRules, error) {
+	policy, err := os.ReadFile(policyConfigFile)
+	if err != nil {
+		return RBACRules{}, fmt.Errorf("failed to read policy config file %q: %+v", policyConfigFile, err)
+	}
+	internalPolicy, err := decodeAndValidate(policyConfigFile, policy, registry)
+	if err != nil {
+		return RBACRules{}, err
+	}
+	for _, profile := range internalPolicy.Profiles {
+		for idx := range profile.PluginConfigs {
+			setDefaultsPluginConfig(&profile.PluginConfigs[idx], registry)
+		}
+	}
+	return PolicyRules(internalPolicy, registry, opts), nil
+}
+
+// WriteRBACManifests writes the ClusterRole, the Roles and their bindings granting the rules as a YAML stream
+func WriteRBACManifests(out io.Writer, rules RBACRules, opts RBACManifestOptions) error {
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: opts.ServiceAccount, Namespace: opts.Namespace}}
+	objects := []interface{}{
+		&rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+			ObjectMeta: metav1.ObjectMeta{Name: opts.Name},
+			Rules:      rules.ClusterRules,
+		},
+		&rbacv1.ClusterRoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Name: opts.Name},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: opts.Name},
+			Subjects:   subjects,
+		},
+	}
+	namespaces := make([]string, 0, len(rules.NamespaceRules))
+	for namespace := range rules.NamespaceRules {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		objects = append(objects,
+			&rbacv1.Role{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+				ObjectMeta: metav1.ObjectMeta{Name: opts.Name, Namespace: namespace},
+				Rules:      rules.NamespaceRules[namespace],
+			},
+			&rbacv1.RoleBinding{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "RoleBinding"},
+				ObjectMeta: metav1.ObjectMeta{Name: opts.Name, Namespace: namespace},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: opts.Name},
+				Subjects:   subjects,
+			},
+		)
+	}
+	for _, object := range objects {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return err
+		}
+		// The creation timestamps are always null
+		data = []byte(strings.ReplaceAll(string(data), "  creationTimestamp: null\n", ""))
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPermissions warns about the permissions the policy needs the descheduler is not granted
+func checkPermissions(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy) {
+	rules := PolicyRules(deschedulerPolicy, pluginregistry.PluginRegistry, PolicyRulesOptions{DryRun: rs.DryRun, DryRunValidation: rs.DryRunValidation, LeaderElection: &rs.LeaderElection})
+	denied, err := CheckPolicyRules(ctx, rs.Client, rules)
+	if err != nil {
+		klog.ErrorS(err, "Unable to check the permissions of the descheduler")
+		return
+	}
+	if len(denied) > 0 {
+		klog.Warningf("The descheduler is not granted the permissions the policy needs, generate the minimal RBAC with the rbac subcommand: %s", strings.Join(denied, ", "))
+	}
+}
+
+// CheckPolicyRules reviews every rule with a SelfSubjectAccessReview and returns the denied permissions,
+// e.g. "list nodes" or "create pods/eviction"
+func CheckPolicyRules(ctx context.Context, client clientset.Interface, rules RBACRules) ([]string, error) {
+	var denied []string
+	check := func(namespace string, rule rbacv1.PolicyRule) error {
+		names := rule.ResourceNames
+		if len(names) == 0 {
+			names = []string{""}
+		}
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				resource, subresource, _ := strings.Cut(resource, "/")
+				for _, name := range names {
+					for _, verb := range rule.Verbs {
+						review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+							Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+								ResourceAttributes: &authorizationv1.ResourceAttributes{
+									Namespace:   namespace,
+									Verb:        verb,
+									Group:       group,
+									Resource:    resource,
+									Subresource: subresource,
+									Name:        name,
+								},
+							},
+						}, metav1.CreateOptions{})
+						if err != nil {
+							return fmt.Errorf("unable to review the access to %s: %v", resource, err)
+						}
+						if !review.Status.Allowed {
+							denied = append(denied, permission(namespace, group, resource, subresource, name, verb))
+						}
+					}
+				}
+			}
+		}
+		return nil
+	}
+	for _, rule := range rules.ClusterRules {
+		if err := check("", rule); err != nil {
+			return nil, err
+		}
+	}
+	for namespace, namespaceRules := range rules.NamespaceRules {
+		for _, rule := range namespaceRules {
+			if err := check(namespace, rule); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Strings(denied)
+	return denied, nil
+}
+
+// permission describes a permission the way kubectl auth can-i does, e.g. "list persistentvolumeclaims -n ns"
+func permission(namespace, group, resource, subresource, name, verb string) string {
+	target := resource
+	if group != "" {
+		target += "." + group
+	}
+	if subresource != "" {
+		target += "/" + subresource
+	}
+	if name != "" {
+		target += "/" + name
+	}
+	if namespace != "" {
+		return fmt.Sprintf("%s %s -n %s", verb, target, namespace)
+	}
+	return fmt.Sprintf("%s %s", verb, target)
+}
+
+// profilePlugins returns the plugins the profile builds
+func profilePlugins(profile api.DeschedulerProfile) sets.Set[string] {
+	// The DefaultEvictor plugin is always enabled, see setDefaultEvictor
+	plugins := sets.New(defaultevictor.PluginName)
+	plugins.Insert(profile.Plugins.Deschedule.Enabled...)
+	plugins.Insert(profile.Plugins.Balance.Enabled...)
+	plugins.Insert(profile.Plugins.Filter.Enabled...)
+	plugins.Insert(profile.Plugins.PreEvictionFilter.Enabled...)
+	return plugins
+}
+
+// ruleSet merges the verbs of the rules of the same resources
+type ruleSet struct {
+	verbs map[ruleKey]sets.Set[string]
+}
+
+type ruleKey struct {
+	group, resource, resourceNames string
+}
+
+func newRuleSet() *ruleSet {
+	return &ruleSet{verbs: make(map[ruleKey]sets.Set[string])}
+}
+
+func (rs *ruleSet) add(group string, resources, resourceNames []string, verbs ...string) {
+	names := strings.Join(sets.List(sets.New(resourceNames...)), ",")
+	for _, resource := range resources {
+		key := ruleKey{group: group, resource: resource, resourceNames: names}
+		if _, ok := rs.verbs[key]; !ok {
+			rs.verbs[key] = sets.New[string]()
+		}
+		rs.verbs[key].Insert(verbs...)
+	}
+}
+
+// rules returns the rules sorted by the API group, the resources of the same API group
+// with the same verbs share a rule
+func (rs *ruleSet) rules() []rbacv1.PolicyRule {
+	type ruleGroup struct {
+		group, resourceNames string
+		verbs                []string
+	}
+	groups := make(map[string]*ruleGroup)
+	resources := make(map[string][]string)
+	for key, verbs := range rs.verbs {
+		sorted := sortedVerbs(verbs)
+		id := key.group + "|" + key.resourceNames + "|" + strings.Join(sorted, ",")
+		if _, ok := groups[id]; !ok {
+			groups[id] = &ruleGroup{group: key.group, resourceNames: key.resourceNames, verbs: sorted}
+		}
+		resources[id] = append(resources[id], key.resource)
+	}
+	ids := make([]string, 0, len(groups))
+	for id := range groups {
+		sort.Strings(resources[id])
+		ids = append(ids, id)
+	}
+	// Sorted by the API group, the first resource and the resource names of the rules
+	sort.Slice(ids, func(i, j int) bool {
+		gi, gj := groups[ids[i]], groups[ids[j]]
+		if gi.group != gj.group {
+			return gi.group < gj.group
+		}
+		if resources[ids[i]][0] != resources[ids[j]][0] {
+			return resources[ids[i]][0] < resources[ids[j]][0]
+		}
+		if gi.resourceNames != gj.resourceNames {
+			return gi.resourceNames < gj.resourceNames
+		}
+		return ids[i] < ids[j]
+	})
+	rules := make([]rbacv1.PolicyRule, 0, len(ids))
+	for _, id := range ids {
+		group := groups[id]
+		rule := rbacv1.PolicyRule{APIGroups: []string{group.group}, Resources: resources[id], Verbs: group.verbs}
+		if group.resourceNames != "" {
+			rule.ResourceNames = strings.Split(group.resourceNames, ",")
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// sortedVerbs orders the verbs the way the RBAC manifests usually do, e.g. get, watch, list
+func sortedVerbs(verbs sets.Set[string]) []string {
+	order := map[string]int{"get": 0, "watch": 1, "list": 2, "create": 3, "update": 4, "patch": 5, "delete": 6}
+	sorted := sets.List(verbs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		oi, iok := order[sorted[i]]
+		oj, jok := order[sorted[j]]
+		if iok && jok {
+			return oi < oj
+		}
+		return iok && !jok
+	})
+	return sorted
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	componentbaseconfig "k8s.io/component-base/config"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+)
+
+func TestPolicyRules(t *testing.T) {
+	registry := pluginregistry.NewRegistry()
+	RegisterDefaultPlugins(registry)
+
+	policy := &api.DeschedulerPolicy{
+		NodeEvictionAnnotations: utilptr.To(true),
+		ConcurrentDrains:        &api.ConcurrentDrains{LeaseNamespace: "drains"},
+		NodeLeases:              &api.NodeLeases{Namespace: "drains"},
+		DeschedulingExemptions:  &api.DeschedulingExemptions{Enabled: true},
+		EvictionOrder:           api.OlderRevisionsFirstEvictionOrder,
+		MetricsProviders: []api.MetricsProvider{
+			{
+				Source: api.PrometheusMetrics,
+				Prometheus: &api.Prometheus{
+					URL:       "https://prometheus.example.com",
+					AuthToken: &api.AuthToken{SecretReference: &api.SecretReference{Namespace: "monitoring", Name: "token"}},
+				},
+			},
+		},
+		Profiles: []api.DeschedulerProfile{
+			{
+				Name: "default",
+				PluginConfigs: []api.PluginConfig{
+					{
+						Name: defaultevictor.PluginName,
+						Args: &defaultevictor.DefaultEvictorArgs{BatchProtection: &defaultevictor.BatchProtection{JobProgress: true}},
+					},
+				},
+			},
+		},
+	}
+	leaderElection := &componentbaseconfig.LeaderElectionConfiguration{LeaderElect: true, ResourceName: "descheduler", ResourceNamespace: "kube-system"}
+
+	tests := []struct {
+		description string
+		opts        PolicyRulesOptions
+		expected    RBACRules
+	}{
+		{
+			description: "policy features, plugins and leader election",
+			opts:        PolicyRulesOptions{LeaderElection: leaderElection},
+			expected: RBACRules{
+				ClusterRules: []rbacv1.PolicyRule{
+					{APIGroups: []string{""}, Resources: []string{"namespaces", "pods"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "watch", "list", "patch"}},
+					{APIGroups: []string{""}, Resources: []string{"pods/eviction"}, Verbs: []string{"create"}},
+					{APIGroups: []string{"apps"}, Resources: []string{"replicasets"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"batch"}, Resources: []string{"jobs"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"descheduler.x-k8s.io"}, Resources: []string{"deschedulingexemptions"}, Verbs: []string{"list"}},
+					{APIGroups: []string{"events.k8s.io"}, Resources: []string{"events"}, Verbs: []string{"create", "update"}},
+					{APIGroups: []string{"policy"}, Resources: []string{"poddisruptionbudgets"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"scheduling.k8s.io"}, Resources: []string{"priorityclasses"}, Verbs: []string{"get", "watch", "list"}},
+				},
+				NamespaceRules: map[string][]rbacv1.PolicyRule{
+					"drains": {
+						{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"get", "list", "create", "update"}},
+					},
+					"kube-system": {
+						{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"create"}},
+						{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, ResourceNames: []string{"descheduler"}, Verbs: []string{"get", "update"}},
+					},
+					"monitoring": {
+						{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "watch", "list"}},
+					},
+				},
+			},
+		},
+		{
+			description: "dry run",
+			opts:        PolicyRulesOptions{DryRun: true, LeaderElection: leaderElection},
+			expected: RBACRules{
+				ClusterRules: []rbacv1.PolicyRule{
+					{APIGroups: []string{""}, Resources: []string{"namespaces", "nodes", "pods"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"apps"}, Resources: []string{"replicasets"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"batch"}, Resources: []string{"jobs"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"descheduler.x-k8s.io"}, Resources: []string{"deschedulingexemptions"}, Verbs: []string{"list"}},
+					{APIGroups: []string{"events.k8s.io"}, Resources: []string{"events"}, Verbs: []string{"create", "update"}},
+					{APIGroups: []string{"policy"}, Resources: []string{"poddisruptionbudgets"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"scheduling.k8s.io"}, Resources: []string{"priorityclasses"}, Verbs: []string{"get", "watch", "list"}},
+				},
+				NamespaceRules: map[string][]rbacv1.PolicyRule{
+					"drains": {
+						{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"get", "list"}},
+					},
+					"monitoring": {
+						{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "watch", "list"}},
+					},
+				},
+			},
+		},
+		{
+			description: "dry run validation",
+			opts:        PolicyRulesOptions{DryRun: true, DryRunValidation: true, LeaderElection: leaderElection},
+			expected: RBACRules{
+				ClusterRules: []rbacv1.PolicyRule{
+					{APIGroups: []string{""}, Resources: []string{"namespaces", "nodes", "pods"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{""}, Resources: []string{"pods/eviction"}, Verbs: []string{"create"}},
+					{APIGroups: []string{"apps"}, Resources: []string{"replicasets"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"batch"}, Resources: []string{"jobs"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"descheduler.x-k8s.io"}, Resources: []string{"deschedulingexemptions"}, Verbs: []string{"list"}},
+					{APIGroups: []string{"events.k8s.io"}, Resources: []string{"events"}, Verbs: []string{"create", "update"}},
+					{APIGroups: []string{"policy"}, Resources: []string{"poddisruptionbudgets"}, Verbs: []string{"get", "watch", "list"}},
+					{APIGroups: []string{"scheduling.k8s.io"}, Resources: []string{"priorityclasses"}, Verbs: []string{"get", "watch", "list"}},
+				},
+				NamespaceRules: map[string][]rbacv1.PolicyRule{
+					"drains": {
+						{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"get", "list"}},
+					},
+					"monitoring": {
+						{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "watch", "list"}},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			rules := PolicyRules(policy, registry, tc.opts)
+			if diff := cmp.Diff(tc.expected, rules); diff != "" {
+				t.Errorf("Unexpected rules (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyRulesEvacuationBackend(t *testing.T) {
+	registry := pluginregistry.NewRegistry()
+	RegisterDefaultPlugins(registry)
+
+	rules := PolicyRules(&api.DeschedulerPolicy{EvictionBackend: api.EvacuationAPIBackend}, registry, PolicyRulesOptions{})
+	expected := RBACRules{
+		ClusterRules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"namespaces", "nodes", "pods"}, Verbs: []string{"get", "watch", "list"}},
+			{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"evacuations"}, Verbs: []string{"create"}},
+			{APIGroups: []string{"events.k8s.io"}, Resources: []string{"events"}, Verbs: []string{"create", "update"}},
+			{APIGroups: []string{"policy"}, Resources: []string{"poddisruptionbudgets"}, Verbs: []string{"get", "watch", "list"}},
+			{APIGroups: []string{"scheduling.k8s.io"}, Resources: []string{"priorityclasses"}, Verbs: []string{"get", "watch", "list"}},
+		},
+	}
+	if diff := cmp.Diff(expected, rules); diff != "" {
+		t.Errorf("Unexpected rules (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPolicyRulesInPlaceResize(t *testing.T) {
+	registry := pluginregistry.NewRegistry()
+	RegisterDefaultPlugins(registry)
+
+	policy := func(inPlaceResize *nodeutilization.InPlaceResize) *api.DeschedulerPolicy {
+		return &api.DeschedulerPolicy{
+			Profiles: []api.DeschedulerProfile{
+				{
+					Name: "default",
+					PluginConfigs: []api.PluginConfig{
+						{
+							Name: nodeutilization.LowNodeUtilizationPluginName,
+							Args: &nodeutilization.LowNodeUtilizationArgs{InPlaceResize: inPlaceResize},
+						},
+					},
+					Plugins: api.Plugins{
+						Balance: api.PluginSet{Enabled: []string{nodeutilization.LowNodeUtilizationPluginName}},
+					},
+				},
+			},
+		}
+	}
+	resizeRule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods/resize"}, Verbs: []string{"update", "patch"}}
+
+	tests := []struct {
+		description   string
+		inPlaceResize *nodeutilization.InPlaceResize
+		expected      bool
+	}{
+		{
+			description: "in-place resize disabled",
+		},
+		{
+			description:   "in-place resize enabled",
+			inPlaceResize: &nodeutilization.InPlaceResize{MinRequestsPercentage: 50},
+			expected:      true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			rules := PolicyRules(policy(tc.inPlaceResize), registry, PolicyRulesOptions{})
+			found := false
+			for _, rule := range rules.ClusterRules {
+				if cmp.Equal(rule, resizeRule) {
+					found = true
+				}
+			}
+			if found != tc.expected {
+				t.Errorf("Expected the pods/resize rule to be declared: %v, got rules: %v", tc.expected, rules.ClusterRules)
+			}
+		})
+	}
+}
+
+func TestWriteRBACManifests(t *testing.T) {
+	rules := RBACRules{
+		ClusterRules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+		NamespaceRules: map[string][]rbacv1.PolicyRule{
+			"monitoring": {{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteRBACManifests(&buf, rules, RBACManifestOptions{Name: "descheduler", ServiceAccount: "descheduler-sa", Namespace: "kube-system"}); err != nil {
+		t.Fatalf("Unable to write the manifests: %v", err)
+	}
+
+	var kinds []string
+	for _, document := range strings.Split(buf.String(), "---\n")[1:] {
+		for _, line := range strings.Split(document, "\n") {
+			if kind, ok := strings.CutPrefix(line, "kind: "); ok {
+				kinds = append(kinds, kind)
+			}
+		}
+	}
+	if diff := cmp.Diff([]string{"ClusterRole", "ClusterRoleBinding", "Role", "RoleBinding"}, kinds); diff != "" {
+		t.Errorf("Unexpected manifests (-want,+got):\n%s", diff)
+	}
+	if strings.Contains(buf.String(), "creationTimestamp") {
+		t.Errorf("Expected no creation timestamps in the manifests:\n%s", buf.String())
+	}
+}
+
+func TestCheckPolicyRules(t *testing.T) {
+	client := fakeclientset.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action core.Action) (bool, runtime.Object, error) {
+		review := action.(core.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = !(attributes.Verb == "create" && attributes.Subresource == "eviction") &&
+			!(attributes.Resource == "secrets" && attributes.Namespace == "monitoring" && attributes.Verb == "list")
+		return true, review, nil
+	})
+
+	rules := RBACRules{
+		ClusterRules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "watch", "list"}},
+			{APIGroups: []string{""}, Resources: []string{"pods/eviction"}, Verbs: []string{"create"}},
+		},
+		NamespaceRules: map[string][]rbacv1.PolicyRule{
+			"monitoring": {{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "watch", "list"}}},
+		},
+	}
+	denied, err := CheckPolicyRules(context.Background(), client, rules)
+	if err != nil {
+		t.Fatalf("Unable to check the rules: %v", err)
+	}
+	if diff := cmp.Diff([]string{"create pods/eviction", "list secrets -n monitoring"}, denied); diff != "" {
+		t.Errorf("Unexpected denied permissions (-want,+got):\n%s", diff)
+	}
+}
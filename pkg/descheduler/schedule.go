@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// The time zones of the schedules do not depend on the zoneinfo of the image
+	_ "time/tzdata"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// cronExpression is a parsed five-field cron expression, every field is the bit set of the values it matches
+type cronExpression struct {
+	minutes       uint64
+	hours         uint64
+	daysOfMonth   uint64
+	months        uint64
+	daysOfWeek    uint64
+	anyDayOfWeek  bool
+	anyDayOfMonth bool
+}
+
+// parseCron parses the minute, hour, day of month, month and day of week fields of a cron expression.
+// The fields are lists of values, ranges and steps, e.g. "0,30 22-23 * * 1-5" or "*/15 * * * *".
+func parseCron(expr string) (*cronExpression, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, got %d", expr, len(fields))
+	}
+	var c cronExpression
+	var err error
+	if c.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid minute of cron expression %q: %v", expr, err)
+	}
+	if c.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid hour of cron expression %q: %v", expr, err)
+	}
+	if c.daysOfMonth, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid day of month of cron expression %q: %v", expr, err)
+	}
+	if c.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid month of cron expression %q: %v", expr, err)
+	}
+	// Both 0 and 7 are Sunday
+	if c.daysOfWeek, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid day of week of cron expression %q: %v", expr, err)
+	}
+	if c.daysOfWeek&(1<<7) != 0 {
+		c.daysOfWeek |= 1
+	}
+	c.anyDayOfMonth = strings.HasPrefix(fields[2], "*")
+	c.anyDayOfWeek = strings.HasPrefix(fields[4], "*")
+	return &c, nil
+}
+
+// parseCronField returns the bit set of the values in [low, high] matched by a comma separated list
+func parseCronField(field string, low, high int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+		first, last := low, high
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if first, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("invalid value %q", from)
+			}
+			// A single value with a step, e.g. 5/10, runs until the highest value
+			if !isRange && !hasStep {
+				last = first
+			}
+			if isRange {
+				if last, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("invalid value %q", to)
+				}
+			}
+		}
+		if first < low || last > high || first > last {
+			return 0, fmt.Errorf("%q out of range [%d, %d]", part, low, high)
+		}
+		for value := first; value <= last; value += step {
+			bits |= 1 << value
+		}
+	}
+	return bits, nil
+}
+
+// matches returns true when the minute of the time matches the expression. Like in cron, a day matches
+// either field of the days when both are restricted.
+func (c *cronExpression) matches(t time.Time) bool {
+	if c.minutes&(1<<t.Minute()) == 0 || c.hours&(1<<t.Hour()) == 0 || c.months&(1<<int(t.Month())) == 0 {
+		return false
+	}
+	dayOfMonth := c.daysOfMonth&(1<<t.Day()) != 0
+	dayOfWeek := c.daysOfWeek&(1<<int(t.Weekday())) != 0
+	if c.anyDayOfMonth || c.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+type maintenanceWindow struct {
+	cron     *cronExpression
+	duration time.Duration
+}
+
+// maintenanceWindows is a parsed schedule, a nil maintenanceWindows is always open
+type maintenanceWindows struct {
+	location *time.Location
+	windows  []maintenanceWindow
+}
+
+func newMaintenanceWindows(schedule *api.Schedule) (*maintenanceWindows, error) {
+	if schedule == nil {
+		return nil, nil
+	}
+	location := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid schedule time zone %q: %v", schedule.TimeZone, err)
+		}
+	}
+	m := &maintenanceWindows{location: location}
+	for _, window := range schedule.Windows {
+		cron, err := parseCron(window.Cron)
+		if err != nil {
+			return nil, err
+		}
+		if window.Duration.Duration < time.Minute {
+			return nil, fmt.Errorf("maintenance window %q must stay open for at least a minute, got %v", window.Cron, window.Duration.Duration)
+		}
+		m.windows = append(m.windows, maintenanceWindow{cron: cron, duration: window.Duration.Duration})
+	}
+	return m, nil
+}
+
+// open returns true when any window opened within its duration before now
+func (m *maintenanceWindows) open(now time.Time) bool {
+	if m == nil {
+		return true
+	}
+	now = now.In(m.location)
+	for _, window := range m.windows {
+		for t := now.Truncate(time.Minute); now.Sub(t) < window.duration; t = t.Add(-time.Minute) {
+			if window.cron.matches(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// profileScheduled checks the profile is in a maintenance window of its own schedule, of the schedule of
+// the policy when it has none
+func (d *descheduler) profileScheduled(profile api.DeschedulerProfile, now time.Time) bool {
+	schedule := d.deschedulerPolicy.Schedule
+	if profile.Schedule != nil {
+		schedule = profile.Schedule
+	}
+	windows, err := newMaintenanceWindows(schedule)
+	if err != nil {
+		klog.ErrorS(err, "Invalid schedule, skipping the profile", "profile", profile.Name)
+		return false
+	}
+	return windows.open(now)
+}
+
+// scheduledProfiles returns the profiles in their maintenance windows
+func (d *descheduler) scheduledProfiles(now time.Time) []api.DeschedulerProfile {
+	var profiles []api.DeschedulerProfile
+	for _, profile := range d.deschedulerPolicy.Profiles {
+		if d.profileScheduled(profile, now) {
+			profiles = append(profiles, profile)
+		} else {
+			klog.V(2).InfoS("Profile outside of its maintenance windows, skipping", "profile", profile.Name)
+		}
+	}
+	return profiles
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		valid   bool
+		matches []string
+		misses  []string
+	}{
+		{
+			expr:    "* * * * *",
+			valid:   true,
+			matches: []string{"2025-03-03T10:17:00Z"},
+		},
+		{
+			expr:    "0 22 * * 1-5",
+			valid:   true,
+			matches: []string{"2025-03-03T22:00:00Z", "2025-03-07T22:00:00Z"},
+			misses:  []string{"2025-03-03T22:01:00Z", "2025-03-08T22:00:00Z"},
+		},
+		{
+			expr:    "*/15 9-17/4 * * *",
+			valid:   true,
+			matches: []string{"2025-03-03T09:45:00Z", "2025-03-03T13:00:00Z", "2025-03-03T17:30:00Z"},
+			misses:  []string{"2025-03-03T10:00:00Z", "2025-03-03T13:20:00Z"},
+		},
+		{
+			// Sunday is both 0 and 7
+			expr:    "0,30 1 * * 7",
+			valid:   true,
+			matches: []string{"2025-03-02T01:30:00Z"},
+			misses:  []string{"2025-03-03T01:30:00Z"},
+		},
+		{
+			// Either day field matches when both are restricted
+			expr:    "0 0 1 * 1",
+			valid:   true,
+			matches: []string{"2025-03-01T00:00:00Z", "2025-03-03T00:00:00Z"},
+			misses:  []string{"2025-03-02T00:00:00Z"},
+		},
+		{
+			expr:    "0 0 * 2 *",
+			valid:   true,
+			matches: []string{"2025-02-14T00:00:00Z"},
+			misses:  []string{"2025-03-14T00:00:00Z"},
+		},
+		{expr: "0 22 * *"},
+		{expr: "60 * * * *"},
+		{expr: "0 5-1 * * *"},
+		{expr: "*/0 * * * *"},
+		{expr: "0 0 0 * *"},
+		{expr: "0 0 * * MON"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			cron, err := parseCron(tc.expr)
+			if !tc.valid {
+				if err == nil {
+					t.Fatalf("Expected the expression to be invalid")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, value := range tc.matches {
+				at, _ := time.Parse(time.RFC3339, value)
+				if !cron.matches(at) {
+					t.Errorf("Expected the expression to match %v", value)
+				}
+			}
+			for _, value := range tc.misses {
+				at, _ := time.Parse(time.RFC3339, value)
+				if cron.matches(at) {
+					t.Errorf("Expected the expression not to match %v", value)
+				}
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowsOpen(t *testing.T) {
+	windows, err := newMaintenanceWindows(&api.Schedule{
+		TimeZone: "Europe/Berlin",
+		Windows: []api.MaintenanceWindow{
+			{Cron: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}},
+			{Cron: "0 0 * * 6", Duration: metav1.Duration{Duration: 48 * time.Hour}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		at   string
+		open bool
+	}{
+		// Monday 22:00 in Berlin is 21:00 UTC in the winter
+		{at: "2025-03-03T20:59:00Z", open: false},
+		{at: "2025-03-03T21:00:00Z", open: true},
+		// The window opened on Monday stays open until Tuesday 06:00 in Berlin
+		{at: "2025-03-04T04:59:59Z", open: true},
+		{at: "2025-03-04T05:00:00Z", open: false},
+		{at: "2025-03-04T12:00:00Z", open: false},
+		// The weekend window
+		{at: "2025-03-08T12:00:00Z", open: true},
+		{at: "2025-03-09T22:59:00Z", open: true},
+		// The Sunday night is not open, the weekday windows open on Monday to Friday
+		{at: "2025-03-09T23:00:00Z", open: false},
+	}
+	for _, tc := range tests {
+		at, _ := time.Parse(time.RFC3339, tc.at)
+		if open := windows.open(at); open != tc.open {
+			t.Errorf("Expected the windows to be open at %v to be %v, got %v", tc.at, tc.open, open)
+		}
+	}
+
+	var none *maintenanceWindows
+	if !none.open(time.Now()) {
+		t.Errorf("Expected no schedule to be always open")
+	}
+
+	if _, err := newMaintenanceWindows(&api.Schedule{TimeZone: "Mars/Olympus"}); err == nil {
+		t.Errorf("Expected an unknown time zone to be invalid")
+	}
+	if _, err := newMaintenanceWindows(&api.Schedule{Windows: []api.MaintenanceWindow{{Cron: "* * * * *"}}}); err == nil {
+		t.Errorf("Expected a window without a duration to be invalid")
+	}
+}
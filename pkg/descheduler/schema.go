@@ -0,0 +1,222 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"sigs.k8s.io/descheduler/pkg/api/v1alpha2"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+)
+
+// jsonSchemaDraft is the JSON Schema draft of the generated schemas,
+// draft-07 is the most widely supported one by the editors
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// JSONSchema is the subset of JSON Schema describing the policy and the plugin args
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Const                string                 `json:"const,omitempty"`
+	Minimum              *int64                 `json:"minimum,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*JSONSchema          `json:"anyOf,omitempty"`
+	AllOf                []*JSONSchema          `json:"allOf,omitempty"`
+	If                   *JSONSchema            `json:"if,omitempty"`
+	Then                 *JSONSchema            `json:"then,omitempty"`
+	Definitions          map[string]*JSONSchema `json:"definitions,omitempty"`
+}
+
+// PluginArgsSchema returns the JSON Schema of the args of the registered plugin
+func PluginArgsSchema(pluginName string, registry pluginregistry.Registry) (*JSONSchema, error) {
+	pluginUtilities, ok := registry[pluginName]
+	if !ok {
+		return nil, fmt.Errorf("plugin %q not registered, expected one of %s", pluginName, strings.Join(registeredPluginNames(registry), ", "))
+	}
+	if pluginUtilities.PluginArgInstance == nil {
+		return nil, fmt.Errorf("plugin %q has no args", pluginName)
+	}
+	schema := typeSchema(reflect.TypeOf(pluginUtilities.PluginArgInstance), map[reflect.Type]bool{})
+	schema.Schema = jsonSchemaDraft
+	schema.ID = pluginArgsSchemaID(pluginName)
+	schema.Title = fmt.Sprintf("%s args (%s)", pluginName, v1alpha2.SchemeGroupVersion)
+	return schema, nil
+}
+
+// PolicySchema returns the JSON Schema of the v1alpha2 policy with the args
+// of each pluginConfig entry checked against the schema of the named plugin
+func PolicySchema(registry pluginregistry.Registry) *JSONSchema {
+	schema := typeSchema(reflect.TypeOf(v1alpha2.DeschedulerPolicy{}), map[reflect.Type]bool{})
+	schema.Schema = jsonSchemaDraft
+	schema.ID = fmt.Sprintf("%s/DeschedulerPolicy.json", v1alpha2.SchemeGroupVersion.Version)
+	schema.Title = fmt.Sprintf("DeschedulerPolicy (%s)", v1alpha2.SchemeGroupVersion)
+	schema.Properties["apiVersion"].Const = v1alpha2.SchemeGroupVersion.String()
+	schema.Properties["kind"].Const = "DeschedulerPolicy"
+
+	names := registeredPluginNames(registry)
+	schema.Definitions = map[string]*JSONSchema{}
+	var pluginConfigs []*JSONSchema
+	for _, name := range names {
+		argsSchema, err := PluginArgsSchema(name, registry)
+		if err != nil {
+			continue
+		}
+		argsSchema.Schema = ""
+		argsSchema.ID = ""
+		schema.Definitions[name] = argsSchema
+		pluginConfigs = append(pluginConfigs, &JSONSchema{
+			If:   &JSONSchema{Properties: map[string]*JSONSchema{"name": {Const: name}}},
+			Then: &JSONSchema{Properties: map[string]*JSONSchema{"args": {Ref: "#/definitions/" + name}}},
+		})
+	}
+
+	if _, ok := schema.Definitions[defaultevictor.PluginName]; ok {
+		schema.Properties["defaultEvictorArgs"] = &JSONSchema{Ref: "#/definitions/" + defaultevictor.PluginName}
+	}
+	profile := schema.Properties["profiles"].Items
+	pluginConfig := profile.Properties["pluginConfig"].Items
+	pluginConfig.Properties["name"].Enum = names
+	pluginConfig.AllOf = pluginConfigs
+	for _, pluginSet := range profile.Properties["plugins"].Properties {
+		for _, list := range pluginSet.Properties {
+			list.Items.Enum = names
+		}
+	}
+	return schema
+}
+
+func pluginArgsSchemaID(pluginName string) string {
+	return fmt.Sprintf("%s/%s.json", v1alpha2.SchemeGroupVersion.Version, pluginName)
+}
+
+func registeredPluginNames(registry pluginregistry.Registry) []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	durationType     = reflect.TypeOf(metav1.Duration{})
+	timeType         = reflect.TypeOf(metav1.Time{})
+	quantityType     = reflect.TypeOf(resource.Quantity{})
+	intOrStringType  = reflect.TypeOf(intstr.IntOrString{})
+	rawExtensionType = reflect.TypeOf(runtime.RawExtension{})
+	typeMetaType     = reflect.TypeOf(metav1.TypeMeta{})
+)
+
+// typeSchema builds the schema of the type following the encoding/json rules,
+// types marshalled to JSON through custom methods are special cased
+func typeSchema(t reflect.Type, visiting map[reflect.Type]bool) *JSONSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case durationType:
+		return &JSONSchema{Type: "string", Format: "duration"}
+	case timeType:
+		return &JSONSchema{Type: "string", Format: "date-time"}
+	case quantityType, intOrStringType:
+		return &JSONSchema{AnyOf: []*JSONSchema{{Type: "string"}, {Type: "integer"}}}
+	case rawExtensionType:
+		return &JSONSchema{Type: "object"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var minimum int64
+		return &JSONSchema{Type: "integer", Minimum: &minimum}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &JSONSchema{Type: "string", Format: "byte"}
+		}
+		return &JSONSchema{Type: "array", Items: typeSchema(t.Elem(), visiting)}
+	case reflect.Map:
+		return &JSONSchema{Type: "object", AdditionalProperties: typeSchema(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			// recursive types are not expanded any further
+			return &JSONSchema{Type: "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		schema := &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{}}
+		addStructProperties(schema, t, visiting)
+		return schema
+	default:
+		// interfaces can hold any value
+		return &JSONSchema{}
+	}
+}
+
+func addStructProperties(schema *JSONSchema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType == typeMetaType {
+				schema.Properties["apiVersion"] = &JSONSchema{Type: "string"}
+				schema.Properties["kind"] = &JSONSchema{Type: "string"}
+				continue
+			}
+			if fieldType.Kind() == reflect.Struct {
+				addStructProperties(schema, fieldType, visiting)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = typeSchema(field.Type, visiting)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
+)
+
+func TestPluginArgsSchema(t *testing.T) {
+	SetupPlugins()
+
+	for name := range pluginregistry.PluginRegistry {
+		schema, err := PluginArgsSchema(name, pluginregistry.PluginRegistry)
+		if err != nil {
+			t.Fatalf("Unable to generate the schema of %s: %v", name, err)
+		}
+		if schema.Type != "object" || schema.Properties["kind"] == nil || schema.Properties["apiVersion"] == nil {
+			t.Errorf("Expected the schema of %s to be an object with the type meta, got %+v", name, schema)
+		}
+	}
+
+	schema, err := PluginArgsSchema(podlifetime.PluginName, pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to generate the schema: %v", err)
+	}
+	var minimum int64
+	if diff := cmp.Diff(&JSONSchema{Type: "integer", Minimum: &minimum}, schema.Properties["maxPodLifeTimeSeconds"]); diff != "" {
+		t.Errorf("Unexpected maxPodLifeTimeSeconds schema (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&JSONSchema{Type: "array", Items: &JSONSchema{Type: "string"}}, schema.Properties["states"]); diff != "" {
+		t.Errorf("Unexpected states schema (-want +got):\n%s", diff)
+	}
+
+	schema, err = PluginArgsSchema(nodeutilization.LowNodeUtilizationPluginName, pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to generate the schema: %v", err)
+	}
+	if diff := cmp.Diff(&JSONSchema{Type: "object", AdditionalProperties: &JSONSchema{Type: "number"}}, schema.Properties["thresholds"]); diff != "" {
+		t.Errorf("Unexpected thresholds schema (-want +got):\n%s", diff)
+	}
+
+	schema, err = PluginArgsSchema(defaultevictor.PluginName, pluginregistry.PluginRegistry)
+	if err != nil {
+		t.Fatalf("Unable to generate the schema: %v", err)
+	}
+	if diff := cmp.Diff(&JSONSchema{Type: "string", Format: "duration"}, schema.Properties["minPodAge"]); diff != "" {
+		t.Errorf("Unexpected minPodAge schema (-want +got):\n%s", diff)
+	}
+
+	if _, err := PluginArgsSchema("NotRegistered", pluginregistry.PluginRegistry); err == nil {
+		t.Errorf("Expected an error for a plugin not registered")
+	}
+}
+
+func TestPolicySchema(t *testing.T) {
+	SetupPlugins()
+
+	schema := PolicySchema(pluginregistry.PluginRegistry)
+	if schema.Properties["apiVersion"].Const != "descheduler/v1alpha2" || schema.Properties["kind"].Const != "DeschedulerPolicy" {
+		t.Errorf("Expected the apiVersion and kind to be fixed, got %+v and %+v", schema.Properties["apiVersion"], schema.Properties["kind"])
+	}
+	if len(schema.Definitions) != len(pluginregistry.PluginRegistry) {
+		t.Errorf("Expected a definition per plugin, got %d", len(schema.Definitions))
+	}
+	pluginConfig := schema.Properties["profiles"].Items.Properties["pluginConfig"].Items
+	if len(pluginConfig.AllOf) != len(pluginregistry.PluginRegistry) {
+		t.Errorf("Expected a pluginConfig condition per plugin, got %d", len(pluginConfig.AllOf))
+	}
+	for _, condition := range pluginConfig.AllOf {
+		name := condition.If.Properties["name"].Const
+		if ref := condition.Then.Properties["args"].Ref; ref != "#/definitions/"+name || schema.Definitions[name] == nil {
+			t.Errorf("Expected the args of %s to refer to its definition, got %q", name, ref)
+		}
+	}
+	if ref := schema.Properties["defaultEvictorArgs"].Ref; ref != "#/definitions/"+defaultevictor.PluginName {
+		t.Errorf("Expected defaultEvictorArgs to refer to the DefaultEvictor definition, got %q", ref)
+	}
+}
@@ -26,14 +26,22 @@ import (
 	componentconfigv1alpha1 "sigs.k8s.io/descheduler/pkg/apis/componentconfig/v1alpha1"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podcheckpoint"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/poddisruptioncondition"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsensitivity"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/rebalanceipcapacity"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removefailedpods"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsapproachingdiskpressure"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsexceedingpoddensity"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsfromnotreadynodes"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodshavingtoomanyrestarts"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatinginterpodantiaffinity"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodeaffinity"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodetaints"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingtopologyspreadconstraint"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodswithobsoletenodeselectors"
 )
 
 var (
@@ -45,14 +53,22 @@ func init() {
 	utilruntime.Must(api.AddToScheme(Scheme))
 	utilruntime.Must(defaultevictor.AddToScheme(Scheme))
 	utilruntime.Must(nodeutilization.AddToScheme(Scheme))
+	utilruntime.Must(podcheckpoint.AddToScheme(Scheme))
+	utilruntime.Must(poddisruptioncondition.AddToScheme(Scheme))
 	utilruntime.Must(podlifetime.AddToScheme(Scheme))
+	utilruntime.Must(podsensitivity.AddToScheme(Scheme))
+	utilruntime.Must(rebalanceipcapacity.AddToScheme(Scheme))
 	utilruntime.Must(removeduplicates.AddToScheme(Scheme))
 	utilruntime.Must(removefailedpods.AddToScheme(Scheme))
+	utilruntime.Must(removepodsapproachingdiskpressure.AddToScheme(Scheme))
+	utilruntime.Must(removepodsexceedingpoddensity.AddToScheme(Scheme))
+	utilruntime.Must(removepodsfromnotreadynodes.AddToScheme(Scheme))
 	utilruntime.Must(removepodshavingtoomanyrestarts.AddToScheme(Scheme))
 	utilruntime.Must(removepodsviolatinginterpodantiaffinity.AddToScheme(Scheme))
 	utilruntime.Must(removepodsviolatingnodeaffinity.AddToScheme(Scheme))
 	utilruntime.Must(removepodsviolatingnodetaints.AddToScheme(Scheme))
 	utilruntime.Must(removepodsviolatingtopologyspreadconstraint.AddToScheme(Scheme))
+	utilruntime.Must(removepodswithobsoletenodeselectors.AddToScheme(Scheme))
 
 	utilruntime.Must(componentconfig.AddToScheme(Scheme))
 	utilruntime.Must(componentconfigv1alpha1.AddToScheme(Scheme))
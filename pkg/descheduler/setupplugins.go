@@ -20,14 +20,22 @@ import (
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podcheckpoint"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/poddisruptioncondition"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/podlifetime"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsensitivity"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/rebalanceipcapacity"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removeduplicates"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removefailedpods"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsapproachingdiskpressure"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsexceedingpoddensity"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsfromnotreadynodes"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodshavingtoomanyrestarts"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatinginterpodantiaffinity"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodeaffinity"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingnodetaints"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodsviolatingtopologyspreadconstraint"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/removepodswithobsoletenodeselectors"
 )
 
 func SetupPlugins() {
@@ -37,14 +45,26 @@ func SetupPlugins() {
 
 func RegisterDefaultPlugins(registry pluginregistry.Registry) {
 	pluginregistry.Register(defaultevictor.PluginName, defaultevictor.New, &defaultevictor.DefaultEvictor{}, &defaultevictor.DefaultEvictorArgs{}, defaultevictor.ValidateDefaultEvictorArgs, defaultevictor.SetDefaults_DefaultEvictorArgs, registry)
+	pluginregistry.RegisterPolicyRules(defaultevictor.PluginName, defaultevictor.PolicyRules, registry)
 	pluginregistry.Register(nodeutilization.LowNodeUtilizationPluginName, nodeutilization.NewLowNodeUtilization, &nodeutilization.LowNodeUtilization{}, &nodeutilization.LowNodeUtilizationArgs{}, nodeutilization.ValidateLowNodeUtilizationArgs, nodeutilization.SetDefaults_LowNodeUtilizationArgs, registry)
+	pluginregistry.RegisterPolicyRules(nodeutilization.LowNodeUtilizationPluginName, nodeutilization.LowNodeUtilizationPolicyRules, registry)
 	pluginregistry.Register(nodeutilization.HighNodeUtilizationPluginName, nodeutilization.NewHighNodeUtilization, &nodeutilization.HighNodeUtilization{}, &nodeutilization.HighNodeUtilizationArgs{}, nodeutilization.ValidateHighNodeUtilizationArgs, nodeutilization.SetDefaults_HighNodeUtilizationArgs, registry)
+	pluginregistry.Register(podcheckpoint.PluginName, podcheckpoint.New, &podcheckpoint.PodCheckpoint{}, &podcheckpoint.PodCheckpointArgs{}, podcheckpoint.ValidatePodCheckpointArgs, podcheckpoint.SetDefaults_PodCheckpointArgs, registry)
+	pluginregistry.Register(poddisruptioncondition.PluginName, poddisruptioncondition.New, &poddisruptioncondition.PodDisruptionCondition{}, &poddisruptioncondition.PodDisruptionConditionArgs{}, poddisruptioncondition.ValidatePodDisruptionConditionArgs, poddisruptioncondition.SetDefaults_PodDisruptionConditionArgs, registry)
+	pluginregistry.RegisterPolicyRules(poddisruptioncondition.PluginName, poddisruptioncondition.PolicyRules, registry)
 	pluginregistry.Register(podlifetime.PluginName, podlifetime.New, &podlifetime.PodLifeTime{}, &podlifetime.PodLifeTimeArgs{}, podlifetime.ValidatePodLifeTimeArgs, podlifetime.SetDefaults_PodLifeTimeArgs, registry)
+	pluginregistry.Register(podsensitivity.PluginName, podsensitivity.New, &podsensitivity.PodSensitivity{}, &podsensitivity.PodSensitivityArgs{}, podsensitivity.ValidatePodSensitivityArgs, podsensitivity.SetDefaults_PodSensitivityArgs, registry)
+	pluginregistry.Register(rebalanceipcapacity.PluginName, rebalanceipcapacity.New, &rebalanceipcapacity.RebalanceIPCapacity{}, &rebalanceipcapacity.RebalanceIPCapacityArgs{}, rebalanceipcapacity.ValidateRebalanceIPCapacityArgs, rebalanceipcapacity.SetDefaults_RebalanceIPCapacityArgs, registry)
 	pluginregistry.Register(removeduplicates.PluginName, removeduplicates.New, &removeduplicates.RemoveDuplicates{}, &removeduplicates.RemoveDuplicatesArgs{}, removeduplicates.ValidateRemoveDuplicatesArgs, removeduplicates.SetDefaults_RemoveDuplicatesArgs, registry)
 	pluginregistry.Register(removefailedpods.PluginName, removefailedpods.New, &removefailedpods.RemoveFailedPods{}, &removefailedpods.RemoveFailedPodsArgs{}, removefailedpods.ValidateRemoveFailedPodsArgs, removefailedpods.SetDefaults_RemoveFailedPodsArgs, registry)
+	pluginregistry.Register(removepodsapproachingdiskpressure.PluginName, removepodsapproachingdiskpressure.New, &removepodsapproachingdiskpressure.RemovePodsApproachingDiskPressure{}, &removepodsapproachingdiskpressure.RemovePodsApproachingDiskPressureArgs{}, removepodsapproachingdiskpressure.ValidateRemovePodsApproachingDiskPressureArgs, removepodsapproachingdiskpressure.SetDefaults_RemovePodsApproachingDiskPressureArgs, registry)
+	pluginregistry.Register(removepodsexceedingpoddensity.PluginName, removepodsexceedingpoddensity.New, &removepodsexceedingpoddensity.RemovePodsExceedingPodDensity{}, &removepodsexceedingpoddensity.RemovePodsExceedingPodDensityArgs{}, removepodsexceedingpoddensity.ValidateRemovePodsExceedingPodDensityArgs, removepodsexceedingpoddensity.SetDefaults_RemovePodsExceedingPodDensityArgs, registry)
+	pluginregistry.Register(removepodsfromnotreadynodes.PluginName, removepodsfromnotreadynodes.New, &removepodsfromnotreadynodes.RemovePodsFromNotReadyNodes{}, &removepodsfromnotreadynodes.RemovePodsFromNotReadyNodesArgs{}, removepodsfromnotreadynodes.ValidateRemovePodsFromNotReadyNodesArgs, removepodsfromnotreadynodes.SetDefaults_RemovePodsFromNotReadyNodesArgs, registry)
+	pluginregistry.RegisterPolicyRules(removepodsfromnotreadynodes.PluginName, removepodsfromnotreadynodes.PolicyRules, registry)
 	pluginregistry.Register(removepodshavingtoomanyrestarts.PluginName, removepodshavingtoomanyrestarts.New, &removepodshavingtoomanyrestarts.RemovePodsHavingTooManyRestarts{}, &removepodshavingtoomanyrestarts.RemovePodsHavingTooManyRestartsArgs{}, removepodshavingtoomanyrestarts.ValidateRemovePodsHavingTooManyRestartsArgs, removepodshavingtoomanyrestarts.SetDefaults_RemovePodsHavingTooManyRestartsArgs, registry)
 	pluginregistry.Register(removepodsviolatinginterpodantiaffinity.PluginName, removepodsviolatinginterpodantiaffinity.New, &removepodsviolatinginterpodantiaffinity.RemovePodsViolatingInterPodAntiAffinity{}, &removepodsviolatinginterpodantiaffinity.RemovePodsViolatingInterPodAntiAffinityArgs{}, removepodsviolatinginterpodantiaffinity.ValidateRemovePodsViolatingInterPodAntiAffinityArgs, removepodsviolatinginterpodantiaffinity.SetDefaults_RemovePodsViolatingInterPodAntiAffinityArgs, registry)
 	pluginregistry.Register(removepodsviolatingnodeaffinity.PluginName, removepodsviolatingnodeaffinity.New, &removepodsviolatingnodeaffinity.RemovePodsViolatingNodeAffinity{}, &removepodsviolatingnodeaffinity.RemovePodsViolatingNodeAffinityArgs{}, removepodsviolatingnodeaffinity.ValidateRemovePodsViolatingNodeAffinityArgs, removepodsviolatingnodeaffinity.SetDefaults_RemovePodsViolatingNodeAffinityArgs, registry)
 	pluginregistry.Register(removepodsviolatingnodetaints.PluginName, removepodsviolatingnodetaints.New, &removepodsviolatingnodetaints.RemovePodsViolatingNodeTaints{}, &removepodsviolatingnodetaints.RemovePodsViolatingNodeTaintsArgs{}, removepodsviolatingnodetaints.ValidateRemovePodsViolatingNodeTaintsArgs, removepodsviolatingnodetaints.SetDefaults_RemovePodsViolatingNodeTaintsArgs, registry)
 	pluginregistry.Register(removepodsviolatingtopologyspreadconstraint.PluginName, removepodsviolatingtopologyspreadconstraint.New, &removepodsviolatingtopologyspreadconstraint.RemovePodsViolatingTopologySpreadConstraint{}, &removepodsviolatingtopologyspreadconstraint.RemovePodsViolatingTopologySpreadConstraintArgs{}, removepodsviolatingtopologyspreadconstraint.ValidateRemovePodsViolatingTopologySpreadConstraintArgs, removepodsviolatingtopologyspreadconstraint.SetDefaults_RemovePodsViolatingTopologySpreadConstraintArgs, registry)
+	pluginregistry.Register(removepodswithobsoletenodeselectors.PluginName, removepodswithobsoletenodeselectors.New, &removepodswithobsoletenodeselectors.RemovePodsWithObsoleteNodeSelectors{}, &removepodswithobsoletenodeselectors.RemovePodsWithObsoleteNodeSelectorsArgs{}, removepodswithobsoletenodeselectors.ValidateRemovePodsWithObsoleteNodeSelectorsArgs, removepodswithobsoletenodeselectors.SetDefaults_RemovePodsWithObsoleteNodeSelectorsArgs, registry)
 }
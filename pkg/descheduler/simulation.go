@@ -0,0 +1,288 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// SimulatedEviction describes a pod evicted in a simulated descheduling cycle
+type SimulatedEviction struct {
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Node      string `json:"node"`
+	Profile   string `json:"profile"`
+	Plugin    string `json:"plugin"`
+}
+
+// SimulationDiff compares the would-be evictions of two policies simulated against the same cluster snapshot
+type SimulationDiff struct {
+	// BaseEvicted and ComparedEvicted are the numbers of pods evicted under the respective policy
+	BaseEvicted     int `json:"baseEvicted"`
+	ComparedEvicted int `json:"comparedEvicted"`
+	// Removed lists the pods evicted only under the base policy
+	Removed []SimulatedEviction `json:"removed"`
+	// Added lists the pods evicted only under the compared policy
+	Added []SimulatedEviction `json:"added"`
+	// Unchanged is the number of pods evicted under both policies
+	Unchanged int `json:"unchanged"`
+}
+
+type evictionRecorder struct {
+	mu        sync.Mutex
+	evictions []SimulatedEviction
+}
+
+func (er *evictionRecorder) observer() evictions.EvictionObserver {
+	return func(pod *v1.Pod, opts evictions.EvictOptions) {
+		er.mu.Lock()
+		defer er.mu.Unlock()
+		er.evictions = append(er.evictions, SimulatedEviction{
+			Namespace: pod.Namespace,
+			Pod:       pod.Name,
+			Node:      pod.Spec.NodeName,
+			Profile:   opts.ProfileName,
+			Plugin:    opts.StrategyName,
+		})
+	}
+}
+
+// DiffSimulations simulates a single descheduling cycle of the configured policy and of the policy
+// in comparedPolicyFile against the same snapshot of the cluster and reports the pods evicted under
+// only one of the policies. The eviction limits of the policies are respected. The snapshot holds
+// the objects the descheduler caches in the dry run mode, the metrics are read from the live providers.
+// No object in the cluster is modified.
+func DiffSimulations(ctx context.Context, rs *options.DeschedulerServer, comparedPolicyFile string) (*SimulationDiff, error) {
+	if err := setupClient(rs); err != nil {
+		return nil, err
+	}
+
+	var policies []*api.DeschedulerPolicy
+	for _, policyFile := range []string{rs.PolicyConfigFile, comparedPolicyFile} {
+		deschedulerPolicy, err := LoadPolicyConfig(policyFile, rs.Client, pluginregistry.PluginRegistry)
+		if err != nil {
+			return nil, err
+		}
+		if deschedulerPolicy == nil {
+			return nil, fmt.Errorf("deschedulerPolicy is nil")
+		}
+		policies = append(policies, deschedulerPolicy)
+	}
+
+	evictionPolicyGroupVersion, err := eutils.SupportEviction(rs.Client)
+	if err != nil || len(evictionPolicyGroupVersion) == 0 {
+		return nil, err
+	}
+
+	for _, deschedulerPolicy := range policies {
+		if err := setupMetricsClient(rs, deschedulerPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	return diffSimulations(ctx, rs, policies[0], policies[1], evictionPolicyGroupVersion)
+}
+
+func diffSimulations(ctx context.Context, rs *options.DeschedulerServer, basePolicy, comparedPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string) (*SimulationDiff, error) {
+	liveClient := rs.Client
+	snapshot, err := snapshotCluster(ctx, liveClient)
+	if err != nil {
+		return nil, err
+	}
+	rs.Client = snapshot
+	defer func() {
+		rs.Client = liveClient
+	}()
+
+	var results [][]SimulatedEviction
+	for _, deschedulerPolicy := range []*api.DeschedulerPolicy{basePolicy, comparedPolicy} {
+		recorder := &evictionRecorder{}
+		if err := simulateCycle(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion, liveClient, recorder.observer()); err != nil {
+			return nil, err
+		}
+		results = append(results, recorder.evictions)
+	}
+
+	return diffEvictions(results[0], results[1]), nil
+}
+
+// diffEvictions compares the evictions by the evicted pod. The evictions
+// evicting the same pod through different plugins are considered unchanged.
+func diffEvictions(base, compared []SimulatedEviction) *SimulationDiff {
+	key := func(eviction SimulatedEviction) string {
+		return eviction.Namespace + "/" + eviction.Pod
+	}
+	baseKeys := make(map[string]bool, len(base))
+	for _, eviction := range base {
+		baseKeys[key(eviction)] = true
+	}
+	comparedKeys := make(map[string]bool, len(compared))
+	for _, eviction := range compared {
+		comparedKeys[key(eviction)] = true
+	}
+
+	diff := &SimulationDiff{BaseEvicted: len(base), ComparedEvicted: len(compared)}
+	for _, eviction := range base {
+		if comparedKeys[key(eviction)] {
+			diff.Unchanged++
+		} else {
+			diff.Removed = append(diff.Removed, eviction)
+		}
+	}
+	for _, eviction := range compared {
+		if !baseKeys[key(eviction)] {
+			diff.Added = append(diff.Added, eviction)
+		}
+	}
+	sortEvictions(diff.Removed)
+	sortEvictions(diff.Added)
+	return diff
+}
+
+func sortEvictions(evictions []SimulatedEviction) {
+	sort.Slice(evictions, func(i, j int) bool {
+		if evictions[i].Namespace != evictions[j].Namespace {
+			return evictions[i].Namespace < evictions[j].Namespace
+		}
+		return evictions[i].Pod < evictions[j].Pod
+	})
+}
+
+// snapshotCluster copies the objects the descheduler caches in the dry run mode to an in-memory client
+func snapshotCluster(ctx context.Context, client clientset.Interface) (*fakeclientset.Clientset, error) {
+	objects, err := cachedObjects(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := fakeclientset.NewSimpleClientset()
+	for _, object := range objects {
+		if err := snapshot.Tracker().Add(object); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// cachedObjects lists the objects the descheduler caches in the dry run mode
+func cachedObjects(ctx context.Context, client clientset.Interface) ([]runtime.Object, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithTransform(trimManagedFields))
+	ir := newInformerResources(sharedInformerFactory)
+	if err := ir.Uses(cachedResources...); err != nil {
+		return nil, err
+	}
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	var objects []runtime.Object
+	for _, resource := range cachedResources {
+		list, err := ir.resourceToInformer[resource].Lister().List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s: %w", resource, err)
+		}
+		objects = append(objects, list...)
+	}
+	return objects, nil
+}
+
+// simulateCycle runs a single descheduling cycle of the policy in the dry run mode and reports
+// every simulated eviction to the observer. The secret holding the Prometheus authentication
+// token is read through secretsClient. No notification is sent and no node is annotated.
+func simulateCycle(ctx context.Context, rs *options.DeschedulerServer, deschedulerPolicy *api.DeschedulerPolicy, evictionPolicyGroupVersion string, secretsClient clientset.Interface, observer evictions.EvictionObserver) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Never evict anything for real
+	rs.DryRun = true
+	deschedulerPolicy.NodeEvictionAnnotations = nil
+	deschedulerPolicy.Notifications = nil
+	if rs.RandomSeed != nil {
+		deschedulerPolicy.RandomSeed = rs.RandomSeed
+	}
+
+	sharedInformerFactory := informers.NewSharedInformerFactoryWithOptions(rs.Client, 0, informers.WithTransform(trimManagedFields))
+	var namespacedSharedInformerFactory informers.SharedInformerFactory
+	prometheusProvider := metricsProviderListToMap(deschedulerPolicy.MetricsProviders)[api.PrometheusMetrics]
+	if prometheusProvider != nil && prometheusProvider.Prometheus != nil && prometheusProvider.Prometheus.AuthToken != nil {
+		namespacedSharedInformerFactory = informers.NewSharedInformerFactoryWithOptions(secretsClient, 0, informers.WithTransform(trimManagedFields), informers.WithNamespace(prometheusProvider.Prometheus.AuthToken.SecretReference.Namespace))
+	}
+
+	eventBroadcaster, eventRecorder := utils.GetRecorderAndBroadcaster(ctx, fakeclientset.NewSimpleClientset())
+	defer eventBroadcaster.Shutdown()
+
+	descheduler, err := newDescheduler(ctx, rs, deschedulerPolicy, evictionPolicyGroupVersion, eventRecorder, sharedInformerFactory, namespacedSharedInformerFactory)
+	if err != nil {
+		return err
+	}
+	descheduler.podEvictor.AddEvictionObserver(observer)
+
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+	if namespacedSharedInformerFactory != nil {
+		namespacedSharedInformerFactory.Start(ctx.Done())
+		namespacedSharedInformerFactory.WaitForCacheSync(ctx.Done())
+		if err := descheduler.sync(); err != nil {
+			return err
+		}
+	} else if prometheusProvider != nil && prometheusProvider.Prometheus != nil && prometheusProvider.Prometheus.URL != "" {
+		if err := descheduler.reconcileInClusterSAToken(); err != nil {
+			return err
+		}
+	}
+
+	if descheduler.metricsCollector != nil {
+		go descheduler.metricsCollector.Run(ctx)
+		if err := wait.PollUntilContextTimeout(ctx, time.Second, time.Minute, true, func(context.Context) (done bool, err error) {
+			return descheduler.metricsCollector.HasSynced(), nil
+		}); err != nil {
+			return fmt.Errorf("unable to wait for metrics collector to sync: %v", err)
+		}
+	}
+
+	var nodeSelector string
+	if deschedulerPolicy.NodeSelector != nil {
+		nodeSelector = *deschedulerPolicy.NodeSelector
+	}
+	nodes, err := nodeutil.ReadyNodes(ctx, rs.Client, sharedInformerFactory.Core().V1().Nodes().Lister(), nodeSelector)
+	if err != nil {
+		return err
+	}
+
+	return descheduler.runDeschedulerLoop(ctx, nodes)
+}
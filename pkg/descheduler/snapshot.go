@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler/source"
+)
+
+// ExportSnapshot writes the objects the descheduler caches in the dry run mode (nodes, pods,
+// namespaces, priority classes and pod disruption budgets) together with the node and pod metrics
+// to out as a YAML list. The metrics are exported only when includeMetrics is set.
+// The Prometheus metrics are not part of the snapshot. The snapshot is read through source.NewFileSource.
+func ExportSnapshot(ctx context.Context, rs *options.DeschedulerServer, out io.Writer, includeMetrics bool) error {
+	if err := setupClient(rs); err != nil {
+		return err
+	}
+
+	objects, err := cachedObjects(ctx, rs.Client)
+	if err != nil {
+		return err
+	}
+
+	if includeMetrics {
+		if rs.MetricsClient == nil {
+			metricsClient, err := objectSource(rs).MetricsClient()
+			if err != nil {
+				return err
+			}
+			rs.MetricsClient = metricsClient
+		}
+		nodeMetrics, err := rs.MetricsClient.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Unable to list node metrics, the snapshot holds no utilization")
+		} else {
+			for idx := range nodeMetrics.Items {
+				objects = append(objects, &nodeMetrics.Items[idx])
+			}
+			podMetrics, err := rs.MetricsClient.MetricsV1beta1().PodMetricses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("unable to list pod metrics: %v", err)
+			}
+			for idx := range podMetrics.Items {
+				objects = append(objects, &podMetrics.Items[idx])
+			}
+		}
+	}
+
+	return source.EncodeObjects(out, objects)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+)
+
+// softEvictionConfigs returns the soft evictions configured by the DefaultEvictor of every profile
+func softEvictionConfigs(deschedulerPolicy *api.DeschedulerPolicy) []*defaultevictor.SoftEviction {
+	var configs []*defaultevictor.SoftEviction
+	for _, profile := range deschedulerPolicy.Profiles {
+		pluginConfig, _ := GetPluginConfig(defaultevictor.PluginName, profile.PluginConfigs)
+		if pluginConfig == nil {
+			continue
+		}
+		if args, ok := pluginConfig.Args.(*defaultevictor.DefaultEvictorArgs); ok && args.SoftEviction != nil {
+			configs = append(configs, args.SoftEviction)
+		}
+	}
+	return configs
+}
+
+// cleanupSoftEvictionMarks removes the stale marks of the soft evictions of all the profiles, scheduled or not,
+// so no downstream controller acts on an expired request. The dry runs leave no marks in the cluster.
+func (d *descheduler) cleanupSoftEvictionMarks(ctx context.Context) {
+	configs := softEvictionConfigs(d.deschedulerPolicy)
+	if len(configs) == 0 || d.rs.DryRun {
+		return
+	}
+	pods, err := d.sharedInformerFactory.Core().V1().Pods().Lister().List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the pods to remove the stale soft eviction marks")
+		return
+	}
+	nodes, err := d.sharedInformerFactory.Core().V1().Nodes().Lister().List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the nodes to remove the stale soft eviction marks")
+		return
+	}
+	if err := defaultevictor.CleanupSoftEvictionMarks(ctx, d.rs.Client, pods, nodes, configs, time.Now()); err != nil {
+		klog.ErrorS(err, "Unable to remove the stale soft eviction marks")
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	scheme = runtime.NewScheme()
+	codecs = serializer.NewCodecFactory(scheme)
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(metricsv1beta1.AddToScheme(scheme))
+}
+
+// NewFileSource returns a source serving the objects of a file written by EncodeObjects
+func NewFileSource(file string) (ObjectSource, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file %q: %v", file, err)
+	}
+	objects, err := DecodeObjects(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode file %q: %v", file, err)
+	}
+	return NewObjectsSource(file, objects...), nil
+}
+
+// EncodeObjects writes the objects to out as a YAML list
+func EncodeObjects(out io.Writer, objects []runtime.Object) error {
+	encoder := codecs.LegacyCodec(v1.SchemeGroupVersion, policyv1.SchemeGroupVersion, schedulingv1.SchemeGroupVersion, metricsv1beta1.SchemeGroupVersion)
+	list := &v1.List{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"}}
+	for _, object := range objects {
+		raw, err := runtime.Encode(encoder, object)
+		if err != nil {
+			return fmt.Errorf("unable to encode object: %v", err)
+		}
+		list.Items = append(list.Items, runtime.RawExtension{Raw: raw})
+	}
+
+	data, err := yaml.Marshal(list)
+	if err != nil {
+		return fmt.Errorf("unable to encode objects: %v", err)
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// DecodeObjects decodes the objects of a YAML or JSON list
+func DecodeObjects(data []byte) ([]runtime.Object, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := codecs.UniversalDeserializer()
+	obj, err := runtime.Decode(decoder, jsonData)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.(*v1.List)
+	if !ok {
+		return nil, fmt.Errorf("expected a List, got %T", obj)
+	}
+	var objects []runtime.Object
+	for _, item := range list.Items {
+		object, err := runtime.Decode(decoder, item.Raw)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
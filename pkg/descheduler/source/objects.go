@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clientset "k8s.io/client-go/kubernetes"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
+	fakemetricsclient "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+)
+
+var (
+	nodeMetricsResource = metricsv1beta1.SchemeGroupVersion.WithResource("nodes")
+	podMetricsResource  = metricsv1beta1.SchemeGroupVersion.WithResource("pods")
+)
+
+type objectsSource struct {
+	name    string
+	objects []runtime.Object
+
+	once          sync.Once
+	client        *fakeclientset.Clientset
+	metricsClient *fakemetricsclient.Clientset
+	err           error
+}
+
+// NewObjectsSource returns a source serving the given objects from memory. The node and pod
+// metrics (metrics.k8s.io/v1beta1 NodeMetrics and PodMetrics) are served by the metrics client.
+func NewObjectsSource(name string, objects ...runtime.Object) ObjectSource {
+	return &objectsSource{name: name, objects: objects}
+}
+
+func (s *objectsSource) Name() string {
+	return s.name
+}
+
+func (s *objectsSource) Client() (clientset.Interface, error) {
+	s.once.Do(s.load)
+	return s.client, s.err
+}
+
+func (s *objectsSource) MetricsClient() (metricsclient.Interface, error) {
+	s.once.Do(s.load)
+	return s.metricsClient, s.err
+}
+
+func (s *objectsSource) load() {
+	s.client = fakeclientset.NewSimpleClientset()
+	// Let eutils.SupportEviction discover the eviction API
+	s.client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: v1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{{Name: eutils.EvictionSubresource, Kind: eutils.EvictionKind}},
+		},
+		{
+			GroupVersion: policyv1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{{Name: "poddisruptionbudgets", Kind: "PodDisruptionBudget"}},
+		},
+	}
+	s.metricsClient = fakemetricsclient.NewSimpleClientset()
+
+	for _, object := range s.objects {
+		var err error
+		switch o := object.(type) {
+		case *metricsv1beta1.NodeMetrics:
+			err = s.metricsClient.Tracker().Create(nodeMetricsResource, o, "")
+		case *metricsv1beta1.PodMetrics:
+			err = s.metricsClient.Tracker().Create(podMetricsResource, o, o.Namespace)
+		default:
+			err = s.client.Tracker().Add(o)
+		}
+		if err != nil {
+			s.err = fmt.Errorf("unable to load %s object: %v", s.name, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	clientset "k8s.io/client-go/kubernetes"
+	componentbaseconfig "k8s.io/component-base/config"
+	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/client"
+)
+
+// ObjectSource provides the clients the descheduler acquires the nodes, pods and the other objects through.
+// The informers, the evictor and the plugins run the same code paths whether the objects come from
+// a live cluster, a snapshot file or an alternative inventory converted to objects.
+type ObjectSource interface {
+	// Name identifies the source in logs
+	Name() string
+	// Client returns the client serving the objects
+	Client() (clientset.Interface, error)
+	// MetricsClient returns the client serving the node and pod metrics
+	MetricsClient() (metricsclient.Interface, error)
+}
+
+type clusterSource struct {
+	clientConnection componentbaseconfig.ClientConnectionConfiguration
+}
+
+// NewClusterSource returns a source reading the objects from the live cluster
+func NewClusterSource(clientConnection componentbaseconfig.ClientConnectionConfiguration) ObjectSource {
+	return &clusterSource{clientConnection: clientConnection}
+}
+
+func (s *clusterSource) Name() string {
+	return "cluster"
+}
+
+func (s *clusterSource) Client() (clientset.Interface, error) {
+	return client.CreateClient(s.clientConnection, "descheduler")
+}
+
+func (s *clusterSource) MetricsClient() (metricsclient.Interface, error) {
+	return client.CreateMetricsClient(s.clientConnection, "descheduler")
+}
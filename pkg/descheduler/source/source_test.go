@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	eutils "sigs.k8s.io/descheduler/pkg/descheduler/evictions/utils"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestFileSource(t *testing.T) {
+	ctx := context.Background()
+	objects := []runtime.Object{
+		test.BuildTestNode("n1", 2000, 3000, 10, nil),
+		test.BuildTestPod("p1", 100, 0, "n1", nil),
+		&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "pdb", Namespace: "default"}},
+		test.BuildNodeMetrics("n1", 1200, 0),
+		test.BuildPodMetrics("p1", 400, 0),
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeObjects(&buf, objects); err != nil {
+		t.Fatalf("Unable to encode the objects: %v", err)
+	}
+	file := filepath.Join(t.TempDir(), "objects.yaml")
+	if err := os.WriteFile(file, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("Unable to write the file: %v", err)
+	}
+
+	src, err := NewFileSource(file)
+	if err != nil {
+		t.Fatalf("Unable to create the file source: %v", err)
+	}
+	client, err := src.Client()
+	if err != nil {
+		t.Fatalf("Unable to create the client: %v", err)
+	}
+	if _, err := client.CoreV1().Nodes().Get(ctx, "n1", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected node n1 to be served: %v", err)
+	}
+	if _, err := client.CoreV1().Pods("default").Get(ctx, "p1", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected pod p1 to be served: %v", err)
+	}
+	if _, err := client.PolicyV1().PodDisruptionBudgets("default").Get(ctx, "pdb", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected the pod disruption budget to be served: %v", err)
+	}
+	if groupVersion, err := eutils.SupportEviction(client); err != nil || groupVersion != policyv1.SchemeGroupVersion.String() {
+		t.Errorf("Expected the eviction API to be discovered, got %q (%v)", groupVersion, err)
+	}
+
+	metricsClient, err := src.MetricsClient()
+	if err != nil {
+		t.Fatalf("Unable to create the metrics client: %v", err)
+	}
+	nodeMetrics, err := metricsClient.MetricsV1beta1().NodeMetricses().Get(ctx, "n1", metav1.GetOptions{})
+	if err != nil || nodeMetrics.Usage.Cpu().MilliValue() != 1200 {
+		t.Errorf("Expected node metrics of n1 to be served, got %v (%v)", nodeMetrics, err)
+	}
+	if _, err := metricsClient.MetricsV1beta1().PodMetricses("default").Get(ctx, "p1", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected pod metrics of p1 to be served: %v", err)
+	}
+}
+
+func TestDecodeObjectsNotAList(t *testing.T) {
+	if _, err := DecodeObjects([]byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: p1\n")); err == nil {
+		t.Errorf("Expected an error decoding a single object")
+	}
+}
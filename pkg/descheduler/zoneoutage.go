@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+)
+
+// DefaultZoneOutageTopologyKey is the node label identifying the zones when the zone outage brake sets none
+const DefaultZoneOutageTopologyKey = v1.LabelTopologyZone
+
+// zonesInOutage returns the zones with a larger share of the unavailable nodes than allowed by the brake.
+// The nodes without the topology label are not part of any zone.
+func zonesInOutage(nodes []*v1.Node, brake *api.ZoneOutageBrake) sets.Set[string] {
+	topologyKey := brake.TopologyKey
+	if topologyKey == "" {
+		topologyKey = DefaultZoneOutageTopologyKey
+	}
+
+	total := make(map[string]int)
+	unavailable := make(map[string]int)
+	for _, node := range nodes {
+		zone, ok := node.Labels[topologyKey]
+		if !ok {
+			continue
+		}
+		total[zone]++
+		if !nodeutil.IsReady(node) || nodeutil.IsNodeUnschedulable(node) {
+			unavailable[zone]++
+		}
+	}
+
+	inOutage := sets.New[string]()
+	for zone, count := range total {
+		if float64(unavailable[zone])*100 > float64(brake.MaxUnavailablePercentage)*float64(count) {
+			klog.V(1).InfoS("Zone outage detected", "zone", zone, "unavailableNodes", unavailable[zone], "nodes", count)
+			inOutage.Insert(zone)
+		}
+	}
+	return inOutage
+}
+
+// balanceSuspended checks all the scoped nodes for the zone outages, the ready nodes the plugins get would hide them
+func (d *descheduler) balanceSuspended() bool {
+	metrics.ZoneOutage.Reset()
+	brake := d.deschedulerPolicy.ZoneOutageBrake
+	if brake == nil {
+		return false
+	}
+	nodes, err := d.sharedInformerFactory.Core().V1().Nodes().Lister().List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Unable to list the nodes, suspending the balance plugins")
+		return true
+	}
+	zones := zonesInOutage(nodes, brake)
+	for zone := range zones {
+		metrics.ZoneOutage.WithLabelValues(zone).Set(1)
+	}
+	if zones.Len() > 0 {
+		klog.InfoS("Suspending the balance plugins during the zone outage", "zones", sets.List(zones))
+		return true
+	}
+	return false
+}
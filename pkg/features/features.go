@@ -25,6 +25,13 @@ const (
 	// Enable evictions in background so users can create their own eviction policies
 	// as an alternative to immediate evictions.
 	EvictionsInBackground featuregate.Feature = "EvictionsInBackground"
+
+	// alpha: v1.33
+	//
+	// Recognize nodes simulated by kwok so the descheduler can be validated against
+	// kwok clusters at scale. No metrics are collected for the simulated nodes
+	// and their actual utilization is computed from the pod requests instead.
+	SimulatedNodes featuregate.Feature = "SimulatedNodes"
 )
 
 func init() {
@@ -39,6 +46,8 @@ func init() {
 // when adding or removing one entry.
 var defaultDeschedulerFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	EvictionsInBackground: {Default: false, PreRelease: featuregate.Alpha},
+
+	SimulatedNodes: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // DefaultMutableFeatureGate is a mutable version of DefaultFeatureGate.
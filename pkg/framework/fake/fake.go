@@ -2,11 +2,15 @@ package fake
 
 import (
 	"context"
+	"math/rand"
+	"sync"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
+	componentbasemetrics "k8s.io/component-base/metrics"
 
+	"sigs.k8s.io/descheduler/metrics"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
@@ -23,6 +27,11 @@ type HandleImpl struct {
 	PodEvictorImpl                *evictions.PodEvictor
 	MetricsCollectorImpl          *metricscollector.MetricsCollector
 	PrometheusClientImpl          promapi.Client
+	// RandImpl is the source of the randomized choices, seeded with 0 when not set
+	RandImpl *rand.Rand
+
+	evictableCapacityOnce sync.Once
+	evictableCapacity     *evictions.EvictableCapacityCache
 }
 
 var _ frameworktypes.Handle = &HandleImpl{}
@@ -35,7 +44,10 @@ func (hi *HandleImpl) PrometheusClient() promapi.Client {
 	return hi.PrometheusClientImpl
 }
 
-func (hi *HandleImpl) MetricsCollector() *metricscollector.MetricsCollector {
+func (hi *HandleImpl) MetricsCollector() frameworktypes.MetricsCollector {
+	if hi.MetricsCollectorImpl == nil {
+		return nil
+	}
 	return hi.MetricsCollectorImpl
 }
 
@@ -51,6 +63,38 @@ func (hi *HandleImpl) Evictor() frameworktypes.Evictor {
 	return hi
 }
 
+func (hi *HandleImpl) RecentEvictions() frameworktypes.RecentEvictions {
+	var recentEvictions *evictions.RecentEvictions
+	if hi.PodEvictorImpl != nil {
+		recentEvictions = hi.PodEvictorImpl.RecentEvictions()
+	}
+	return recentEvictions
+}
+
+func (hi *HandleImpl) EvictableCapacity(node *v1.Node) (*frameworktypes.NodeEvictableCapacity, error) {
+	hi.evictableCapacityOnce.Do(func() {
+		hi.evictableCapacity = evictions.NewEvictableCapacityCache(hi.GetPodsAssignedToNodeFuncImpl, hi)
+	})
+	return hi.evictableCapacity.Get(node)
+}
+
+func (hi *HandleImpl) RegisterMetric(metric componentbasemetrics.Registerable) error {
+	return metrics.RegisterPluginMetric(metric)
+}
+
+func (hi *HandleImpl) Rand() *rand.Rand {
+	if hi.RandImpl == nil {
+		hi.RandImpl = rand.New(rand.NewSource(0))
+	}
+	return hi.RandImpl
+}
+
+func (hi *HandleImpl) OrderPodsForEviction(pods []*v1.Pod) {
+	if hi.PodEvictorImpl != nil {
+		hi.PodEvictorImpl.OrderPodsForEviction(pods)
+	}
+}
+
 func (hi *HandleImpl) Filter(pod *v1.Pod) bool {
 	return hi.EvictorFilterImpl.Filter(pod)
 }
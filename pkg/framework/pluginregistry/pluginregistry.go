@@ -17,6 +17,7 @@ limitations under the License.
 package pluginregistry
 
 import (
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
@@ -33,6 +34,9 @@ type PluginUtilities struct {
 	PluginArgInstance  runtime.Object
 	PluginArgValidator PluginArgValidator
 	PluginArgDefaulter PluginArgDefaulter
+	// PluginPolicyRules declares the RBAC policy rules the plugin needs on top of the rules
+	// of the descheduler itself, nil when the plugin needs none
+	PluginPolicyRules PluginPolicyRules
 }
 
 type PluginBuilder = func(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error)
@@ -40,6 +44,7 @@ type PluginBuilder = func(args runtime.Object, handle frameworktypes.Handle) (fr
 type (
 	PluginArgValidator = func(args runtime.Object) error
 	PluginArgDefaulter = func(args runtime.Object)
+	PluginPolicyRules  = func(args runtime.Object) []rbacv1.PolicyRule
 )
 
 type Registry map[string]PluginUtilities
@@ -69,3 +74,14 @@ func Register(
 		}
 	}
 }
+
+// RegisterPolicyRules declares the RBAC policy rules of a registered plugin
+func RegisterPolicyRules(name string, policyRules PluginPolicyRules, registry Registry) {
+	pluginUtilities, ok := registry[name]
+	if !ok {
+		klog.V(10).InfoS("Plugin not registered, ignoring its policy rules", "plugin", name)
+		return
+	}
+	pluginUtilities.PluginPolicyRules = policyRules
+	registry[name] = pluginUtilities
+}
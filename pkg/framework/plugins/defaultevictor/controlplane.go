@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// controlPlaneNodeLabel is the node role label of the control-plane nodes
+	controlPlaneNodeLabel = "node-role.kubernetes.io/control-plane"
+	// defaultControlPlaneNamespace is the only control-plane namespace unless controlPlaneNamespaces is set
+	defaultControlPlaneNamespace = "kube-system"
+	// serviceAccountNamespaceFile holds the namespace of the pod the descheduler runs in
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// selfPod returns the namespace and the name of the pod the descheduler runs in.
+// The POD_NAMESPACE and POD_NAME environment variables exposed through the downward API
+// take precedence over the namespace of the service account and the hostname.
+// The namespace is empty when the descheduler does not run in a pod.
+func selfPod() (string, string) {
+	namespace := os.Getenv("POD_NAMESPACE")
+	if namespace == "" {
+		if data, err := os.ReadFile(serviceAccountNamespaceFile); err == nil {
+			namespace = strings.TrimSpace(string(data))
+		}
+	}
+	name := os.Getenv("POD_NAME")
+	if name == "" {
+		name, _ = os.Hostname()
+	}
+	return namespace, name
+}
+
+// newControlPlaneConstraint never evicts the descheduler's own pod, the pods in the
+// control-plane namespaces and the pods assigned to the control-plane nodes
+func newControlPlaneConstraint(namespaces []string, nodeLister listersv1.NodeLister) constraint {
+	controlPlaneNamespaces := sets.New(namespaces...)
+	if controlPlaneNamespaces.Len() == 0 {
+		controlPlaneNamespaces.Insert(defaultControlPlaneNamespace)
+	}
+	selfNamespace, selfName := selfPod()
+
+	return func(pod *v1.Pod) error {
+		if pod.Name == selfName && (selfNamespace == "" || pod.Namespace == selfNamespace) {
+			return fmt.Errorf("pod is the descheduler's own pod")
+		}
+		if controlPlaneNamespaces.Has(pod.Namespace) {
+			return fmt.Errorf("pod is in the control-plane namespace %q", pod.Namespace)
+		}
+		if _, ok := pod.Spec.NodeSelector[controlPlaneNodeLabel]; ok {
+			return fmt.Errorf("pod is assigned to the control-plane nodes")
+		}
+		if pod.Spec.NodeName == "" {
+			return nil
+		}
+		node, err := nodeLister.Get(pod.Spec.NodeName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("unable to get the node of the pod: %w", err)
+		}
+		if _, ok := node.Labels[controlPlaneNodeLabel]; ok {
+			return fmt.Errorf("pod is running on the control-plane node %q", node.Name)
+		}
+		return nil
+	}
+}
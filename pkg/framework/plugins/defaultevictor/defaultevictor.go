@@ -18,15 +18,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
@@ -51,8 +59,28 @@ type DefaultEvictor struct {
 	args        *DefaultEvictorArgs
 	constraints []constraint
 	handle      frameworktypes.Handle
+	overrides   map[string]PluginOverride
+	extender    *nodeFitExtender
+	// schedulerNodeFit holds the node fit configuration and the extender per scheduler name
+	schedulerNodeFit map[string]schedulerNodeFit
+	pdbPacing        *pdbPacing
+	pdbPreCheck      *pdbPreCheck
+	softEviction     *softEviction
+	localStoragePods *localStoragePods
 }
 
+type schedulerNodeFit struct {
+	SchedulerNodeFit
+	extender *nodeFitExtender
+}
+
+var (
+	_ frameworktypes.ReportingEvictorPlugin    = &DefaultEvictor{}
+	_ frameworktypes.PluginFilterEvictorPlugin = &DefaultEvictor{}
+	_ frameworktypes.SoftEvictorPlugin         = &DefaultEvictor{}
+	_ frameworktypes.CycleEvictorPlugin        = &DefaultEvictor{}
+)
+
 // IsPodEvictableBasedOnPriority checks if the given pod is evictable based on priority resolved from pod Spec.
 func IsPodEvictableBasedOnPriority(pod *v1.Pod, priority int32) bool {
 	return pod.Spec.Priority == nil || *pod.Spec.Priority < priority
@@ -73,29 +101,39 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 	}
 
 	ev := &DefaultEvictor{
-		handle: handle,
-		args:   defaultEvictorArgs,
+		handle:    handle,
+		args:      defaultEvictorArgs,
+		overrides: make(map[string]PluginOverride),
+	}
+	for _, override := range defaultEvictorArgs.PluginOverrides {
+		ev.overrides[override.Name] = override
+	}
+	if defaultEvictorArgs.NodeFitExtender != nil {
+		ev.extender = newNodeFitExtender(defaultEvictorArgs.NodeFitExtender)
+	}
+	for _, nodeFit := range defaultEvictorArgs.SchedulerNodeFit {
+		if ev.schedulerNodeFit == nil {
+			ev.schedulerNodeFit = make(map[string]schedulerNodeFit)
+		}
+		config := schedulerNodeFit{SchedulerNodeFit: nodeFit}
+		if nodeFit.Mode == NodeFitExtenderMode && nodeFit.Extender != nil {
+			config.extender = newNodeFitExtender(nodeFit.Extender)
+		}
+		ev.schedulerNodeFit[nodeFit.SchedulerName] = config
 	}
 
-	if defaultEvictorArgs.EvictFailedBarePods {
-		klog.V(1).InfoS("Warning: EvictFailedBarePods is set to True. This could cause eviction of pods without ownerReferences.")
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
-			ownerRefList := podutil.OwnerRef(pod)
-			// Enable evictFailedBarePods to evict bare pods in failed phase
-			if len(ownerRefList) == 0 && pod.Status.Phase != v1.PodFailed {
-				return fmt.Errorf("pod does not have any ownerRefs and is not in failed phase")
-			}
-			return nil
-		})
-	} else {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
-			ownerRefList := podutil.OwnerRef(pod)
-			if len(ownerRefList) == 0 {
-				return fmt.Errorf("pod does not have any ownerRefs")
-			}
-			return nil
-		})
+	if defaultEvictorArgs.PDBPacing != nil {
+		ev.pdbPacing = newPDBPacing(defaultEvictorArgs.PDBPacing, handle.SharedInformerFactory().Policy().V1().PodDisruptionBudgets().Lister())
+	}
+	if defaultEvictorArgs.PDBPreCheck {
+		ev.pdbPreCheck = newPDBPreCheck(handle.SharedInformerFactory().Policy().V1().PodDisruptionBudgets().Lister())
+	}
+
+	barePodsConstraint, err := newBarePodsConstraint(defaultEvictorArgs)
+	if err != nil {
+		return nil, err
 	}
+	ev.constraints = append(ev.constraints, barePodsConstraint)
 	if !defaultEvictorArgs.EvictSystemCriticalPods {
 		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
 			if utils.IsCriticalPriorityPod(pod) {
@@ -104,7 +142,24 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 			return nil
 		})
 
-		if defaultEvictorArgs.PriorityThreshold != nil && (defaultEvictorArgs.PriorityThreshold.Value != nil || len(defaultEvictorArgs.PriorityThreshold.Name) > 0) {
+		if defaultEvictorArgs.PriorityThreshold != nil && defaultEvictorArgs.PriorityThreshold.LabelSelector != nil {
+			priorityClassSelector, err := metav1.LabelSelectorAsSelector(defaultEvictorArgs.PriorityThreshold.LabelSelector)
+			if err != nil {
+				return nil, fmt.Errorf("could not get selector from priority threshold label selector")
+			}
+			// the threshold is resolved for every pod as the priority classes come and go
+			priorityClassLister := handle.SharedInformerFactory().Scheduling().V1().PriorityClasses().Lister()
+			ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
+				thresholdPriority, err := utils.GetPriorityValueFromPriorityClassSelector(priorityClassLister, priorityClassSelector)
+				if err != nil {
+					return fmt.Errorf("unable to get priority threshold: %w", err)
+				}
+				if IsPodEvictableBasedOnPriority(pod, thresholdPriority) {
+					return nil
+				}
+				return fmt.Errorf("pod has higher priority than the priority classes selected by the threshold label selector")
+			})
+		} else if defaultEvictorArgs.PriorityThreshold != nil && (defaultEvictorArgs.PriorityThreshold.Value != nil || len(defaultEvictorArgs.PriorityThreshold.Name) > 0) {
 			thresholdPriority, err := utils.GetPriorityValueFromPriorityThreshold(context.TODO(), handle.ClientSet(), defaultEvictorArgs.PriorityThreshold)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get priority threshold: %v", err)
@@ -119,7 +174,10 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 	} else {
 		klog.V(1).InfoS("Warning: EvictSystemCriticalPods is set to True. This could cause eviction of Kubernetes system pods.")
 	}
-	if !defaultEvictorArgs.EvictLocalStoragePods {
+	if defaultEvictorArgs.LocalStoragePods != nil {
+		ev.localStoragePods = newLocalStoragePods(defaultEvictorArgs.LocalStoragePods, handle.ClientSet())
+		ev.constraints = append(ev.constraints, ev.localStoragePods.constraint)
+	} else if !defaultEvictorArgs.EvictLocalStoragePods {
 		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
 			if utils.IsPodWithLocalStorage(pod) {
 				return fmt.Errorf("pod has local storage and descheduler is not configured with evictLocalStoragePods")
@@ -127,16 +185,9 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 			return nil
 		})
 	}
-	if !defaultEvictorArgs.EvictDaemonSetPods {
-		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
-			ownerRefList := podutil.OwnerRef(pod)
-			if utils.IsDaemonsetPod(ownerRefList) {
-				return fmt.Errorf("pod is related to daemonset and descheduler is not configured with evictDaemonSetPods")
-			}
-			return nil
-		})
-	}
-	if defaultEvictorArgs.IgnorePvcPods {
+	if defaultEvictorArgs.IgnorePvcPods && defaultEvictorArgs.PvcPods != nil {
+		ev.constraints = append(ev.constraints, newPvcPodsConstraint(defaultEvictorArgs.PvcPods, handle))
+	} else if defaultEvictorArgs.IgnorePvcPods {
 		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
 			if utils.IsPodWithPVC(pod) {
 				return fmt.Errorf("pod has a PVC and descheduler is configured to ignore PVC pods")
@@ -196,6 +247,19 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 		})
 	}
 
+	if defaultEvictorArgs.BatchProtection != nil {
+		ev.constraints = append(ev.constraints, newBatchProtectionConstraint(defaultEvictorArgs.BatchProtection, handle))
+	}
+
+	if defaultEvictorArgs.IgnoreControlPlanePods {
+		ev.constraints = append(ev.constraints, newControlPlaneConstraint(defaultEvictorArgs.ControlPlaneNamespaces, handle.SharedInformerFactory().Core().V1().Nodes().Lister()))
+	}
+
+	if defaultEvictorArgs.SoftEviction != nil {
+		ev.softEviction = newSoftEviction(defaultEvictorArgs.SoftEviction)
+		ev.constraints = append(ev.constraints, ev.softEviction.constraint)
+	}
+
 	if defaultEvictorArgs.IgnorePodsWithoutPDB {
 		ev.constraints = append(ev.constraints, func(pod *v1.Pod) error {
 			hasPdb, err := utils.IsPodCoveredByPDB(pod, handle.SharedInformerFactory().Policy().V1().PodDisruptionBudgets().Lister())
@@ -218,36 +282,353 @@ func (d *DefaultEvictor) Name() string {
 }
 
 func (d *DefaultEvictor) PreEvictionFilter(pod *v1.Pod) bool {
+	return d.preEvictionCheck(pod) == nil
+}
+
+// PreEvictionFilterReasons explains why PreEvictionFilter rejects the pod
+func (d *DefaultEvictor) PreEvictionFilterReasons(pod *v1.Pod) []string {
+	if err := d.preEvictionCheck(pod); err != nil {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
+// preEvictionCheck checks the pod fits on any other node when the node fit is enabled
+func (d *DefaultEvictor) preEvictionCheck(pod *v1.Pod) error {
 	if d.args.NodeFit {
+		extender, ignorable := d.extender, d.args.NodeFitExtender != nil && d.args.NodeFitExtender.Ignorable
+		if nodeFit, ok := d.schedulerNodeFit[podSchedulerName(pod)]; ok {
+			switch nodeFit.Mode {
+			case NodeFitAssumeFeasible:
+				return nil
+			case NodeFitAssumeInfeasible:
+				klog.InfoS("pod is assumed not to fit on any other node by the node fit configuration of its scheduler", "pod", klog.KObj(pod), "schedulerName", podSchedulerName(pod))
+				return fmt.Errorf("pod is assumed not to fit on any other node by the node fit configuration of its scheduler")
+			case NodeFitExtenderMode:
+				extender, ignorable = nodeFit.extender, nodeFit.Extender.Ignorable
+			case NodeFitDefault:
+				extender = nil
+			}
+		}
 		nodes, err := nodeutil.ReadyNodes(context.TODO(), d.handle.ClientSet(), d.handle.SharedInformerFactory().Core().V1().Nodes().Lister(), d.args.NodeSelector)
 		if err != nil {
 			klog.ErrorS(err, "unable to list ready nodes", "pod", klog.KObj(pod))
-			return false
+			return fmt.Errorf("unable to list ready nodes")
+		}
+		if extender != nil {
+			if !d.podFitsAnyOtherNodeWithExtender(pod, nodes, extender, ignorable) {
+				return fmt.Errorf("pod does not fit on any other node according to the scheduler extender")
+			}
+			return nil
 		}
 		if !nodeutil.PodFitsAnyOtherNode(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes) {
 			klog.InfoS("pod does not fit on any other node because of nodeSelector(s), Taint(s), or nodes marked as unschedulable", "pod", klog.KObj(pod))
-			return false
+			return fmt.Errorf("pod does not fit on any other node")
 		}
-		return true
+	}
+	return nil
+}
+
+// podSchedulerName returns the name of the scheduler of the pod
+func podSchedulerName(pod *v1.Pod) string {
+	if pod.Spec.SchedulerName == "" {
+		return v1.DefaultSchedulerName
+	}
+	return pod.Spec.SchedulerName
+}
+
+// podFitsAnyOtherNodeWithExtender filters the nodes the pod fits by the scheduler extender
+func (d *DefaultEvictor) podFitsAnyOtherNodeWithExtender(pod *v1.Pod, nodes []*v1.Node, extender *nodeFitExtender, ignorable bool) bool {
+	fittingNodes := nodeutil.PodFittingOtherNodes(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes)
+	if len(fittingNodes) == 0 {
+		klog.InfoS("pod does not fit on any other node because of nodeSelector(s), Taint(s), or nodes marked as unschedulable", "pod", klog.KObj(pod))
+		return false
+	}
+	nodeNames, err := extender.filter(pod, fittingNodes)
+	if err != nil {
+		if ignorable {
+			klog.V(2).InfoS("Ignoring the failure of the node fit extender", "pod", klog.KObj(pod), "err", err)
+			return true
+		}
+		klog.ErrorS(err, "unable to filter the nodes by the node fit extender", "pod", klog.KObj(pod))
+		return false
+	}
+	if len(nodeNames) == 0 {
+		klog.InfoS("pod does not fit on any other node according to the node fit extender", "pod", klog.KObj(pod))
+		return false
 	}
 	return true
 }
 
 func (d *DefaultEvictor) Filter(pod *v1.Pod) bool {
-	checkErrs := []error{}
+	if HaveEvictAnnotation(pod) {
+		return true
+	}
+
+	checkErrs := append(d.nodeBoundChecks(pod, PluginOverride{}), d.checks(pod)...)
+	if len(checkErrs) > 0 {
+		klog.V(4).InfoS("Pod fails the following checks", "pod", klog.KObj(pod), "checks", utilerrors.NewAggregate(checkErrs).Error())
+		return false
+	}
+
+	return true
+}
 
+// FilterReasons explains why Filter rejects the pod
+func (d *DefaultEvictor) FilterReasons(pod *v1.Pod) []string {
+	if HaveEvictAnnotation(pod) {
+		return nil
+	}
+	var reasons []string
+	for _, err := range append(d.nodeBoundChecks(pod, PluginOverride{}), d.checks(pod)...) {
+		reasons = append(reasons, err.Error())
+	}
+	if d.pdbPreCheck != nil {
+		if err := d.pdbPreCheck.check(pod); err != nil {
+			reasons = append(reasons, err.Error())
+		}
+	}
+	return reasons
+}
+
+// FilterForPlugin checks the PodDisruptionBudgets covering the pod allow the eviction under the PDB pacing
+// and the PDB pre-check. The plugin name is not taken into account, both apply to all the plugins.
+func (d *DefaultEvictor) FilterForPlugin(pluginName string, pod *v1.Pod) bool {
 	if HaveEvictAnnotation(pod) {
 		return true
 	}
+	if d.pdbPacing != nil {
+		if err := d.pdbPacing.check(pod); err != nil {
+			klog.V(4).InfoS("Pod fails the PDB pacing", "pod", klog.KObj(pod), "err", err)
+			return false
+		}
+	}
+	if d.pdbPreCheck != nil {
+		if err := d.pdbPreCheck.check(pod); err != nil {
+			klog.V(4).InfoS("Pod fails the PDB pre-check", "pod", klog.KObj(pod), "err", err)
+			return false
+		}
+	}
+	return true
+}
+
+// PodEvicted counts the evictions of the pods covered by every PodDisruptionBudget under the PDB pacing
+// and the PDB pre-check
+func (d *DefaultEvictor) PodEvicted(pluginName string, pod *v1.Pod) {
+	if d.pdbPacing != nil {
+		d.pdbPacing.podEvicted(pod)
+	}
+	if d.pdbPreCheck != nil {
+		d.pdbPreCheck.podEvicted(pod)
+	}
+}
+
+// StartCycle drops the emptyDir usage measured in the previous cycle and measures the usage within the new cycle
+func (d *DefaultEvictor) StartCycle(ctx context.Context) {
+	if d.localStoragePods != nil {
+		d.localStoragePods.startCycle(ctx)
+	}
+}
+
+// newPvcPodsConstraint ignores the pods with any PVC matching the policy
+func newPvcPodsConstraint(policy *PvcPodsPolicy, handle frameworktypes.Handle) constraint {
+	ignoredStorageClasses := sets.New(policy.IgnoredStorageClasses...)
+	evictableStorageClasses := sets.New(policy.EvictableStorageClasses...)
+	pvcLister := handle.SharedInformerFactory().Core().V1().PersistentVolumeClaims().Lister()
+	var volumeAttachmentLister storagelisters.VolumeAttachmentLister
+	if policy.AttachedReadWriteOnceOnly {
+		volumeAttachmentLister = handle.SharedInformerFactory().Storage().V1().VolumeAttachments().Lister()
+	}
+
+	return func(pod *v1.Pod) error {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			pvc, err := pvcLister.PersistentVolumeClaims(pod.Namespace).Get(volume.PersistentVolumeClaim.ClaimName)
+			if err != nil {
+				return fmt.Errorf("unable to get PVC %q of the pod: %w", volume.PersistentVolumeClaim.ClaimName, err)
+			}
+			storageClass := ptr.Deref(pvc.Spec.StorageClassName, "")
+			if ignoredStorageClasses.Len() > 0 && !ignoredStorageClasses.Has(storageClass) || evictableStorageClasses.Has(storageClass) {
+				continue
+			}
+			if policy.AttachedReadWriteOnceOnly {
+				attached, err := isAttachedReadWriteOnce(pvc, volumeAttachmentLister)
+				if err != nil {
+					return fmt.Errorf("unable to check if PVC %q of the pod is attached: %w", pvc.Name, err)
+				}
+				if !attached {
+					continue
+				}
+			}
+			return fmt.Errorf("pod has a PVC of storage class %q and descheduler is configured to ignore PVC pods", storageClass)
+		}
+		return nil
+	}
+}
+
+// isAttachedReadWriteOnce returns true if the PVC is ReadWriteOnce(Pod) and bound to a volume attached to a node
+func isAttachedReadWriteOnce(pvc *v1.PersistentVolumeClaim, lister storagelisters.VolumeAttachmentLister) (bool, error) {
+	readWriteOnce := false
+	for _, mode := range pvc.Spec.AccessModes {
+		if mode == v1.ReadWriteOnce || mode == v1.ReadWriteOncePod {
+			readWriteOnce = true
+		}
+	}
+	if !readWriteOnce || pvc.Status.Phase != v1.ClaimBound || pvc.Spec.VolumeName == "" {
+		return false, nil
+	}
+	volumeAttachments, err := lister.List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for _, volumeAttachment := range volumeAttachments {
+		if isVolumeAttached(volumeAttachment, pvc.Spec.VolumeName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isVolumeAttached(volumeAttachment *storagev1.VolumeAttachment, volumeName string) bool {
+	source := volumeAttachment.Spec.Source.PersistentVolumeName
+	return source != nil && *source == volumeName && volumeAttachment.Status.Attached
+}
+
+// newBatchProtectionConstraint protects the batch pods with enough progress or runtime
+func newBatchProtectionConstraint(protection *BatchProtection, handle frameworktypes.Handle) constraint {
+	var jobLister batchlisters.JobLister
+	if protection.JobProgress {
+		jobLister = handle.SharedInformerFactory().Batch().V1().Jobs().Lister()
+	}
+
+	return func(pod *v1.Pod) error {
+		job := jobOwnerRef(pod)
+		if protection.MinRuntime != nil && job != nil && pod.Status.StartTime != nil {
+			if elapsed := time.Since(pod.Status.StartTime.Time); elapsed >= protection.MinRuntime.Duration {
+				return fmt.Errorf("pod of Job %q has been running for %s, at least the batch protection minRuntime of %s", job.Name, elapsed.Round(time.Second), protection.MinRuntime.Duration)
+			}
+		}
+		if protection.MinProgressPercentage == nil {
+			return nil
+		}
+		minProgress := float64(*protection.MinProgressPercentage)
+		if value, ok := pod.Annotations[protection.ProgressAnnotation]; ok && protection.ProgressAnnotation != "" {
+			progress, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), "%"), 64)
+			if err != nil {
+				klog.V(4).InfoS("Unable to parse the progress annotation of the pod", "pod", klog.KObj(pod), "annotation", protection.ProgressAnnotation, "value", value)
+			} else if progress >= minProgress {
+				return fmt.Errorf("pod progress of %v%% is at least the batch protection minProgressPercentage of %v%%", progress, minProgress)
+			}
+		}
+		if protection.JobProgress && job != nil {
+			jobObj, err := jobLister.Jobs(pod.Namespace).Get(job.Name)
+			if err != nil {
+				return fmt.Errorf("unable to get Job %q of the pod: %w", job.Name, err)
+			}
+			if completions := ptr.Deref(jobObj.Spec.Completions, 0); completions > 0 {
+				if progress := float64(jobObj.Status.Succeeded) * 100 / float64(completions); progress >= minProgress {
+					return fmt.Errorf("owner Job %q progress of %v%% is at least the batch protection minProgressPercentage of %v%%", job.Name, progress, minProgress)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// jobOwnerRef returns the Job owner reference of the pod, if any
+func jobOwnerRef(pod *v1.Pod) *metav1.OwnerReference {
+	for idx, ownerRef := range pod.OwnerReferences {
+		if ownerRef.Kind == "Job" && ownerRef.APIVersion == batchv1.SchemeGroupVersion.String() {
+			return &pod.OwnerReferences[idx]
+		}
+	}
+	return nil
+}
+
+// newBarePodsConstraint builds the constraint for pods without owner references
+// from either the barePods policy or the evictFailedBarePods argument
+func newBarePodsConstraint(args *DefaultEvictorArgs) (constraint, error) {
+	policy := BarePodsPolicy{Mode: BarePodsEvictionNever}
+	if args.BarePods != nil {
+		policy = *args.BarePods
+	} else if args.EvictFailedBarePods {
+		policy.Mode = BarePodsEvictionFailedOnly
+	}
+
+	if policy.Mode == BarePodsEvictionNever {
+		return func(pod *v1.Pod) error {
+			if len(podutil.OwnerRef(pod)) == 0 {
+				return fmt.Errorf("pod does not have any ownerRefs")
+			}
+			return nil
+		}, nil
+	}
+
+	klog.V(1).InfoS("Warning: eviction of bare pods is enabled. This could cause eviction of pods without ownerReferences.", "mode", policy.Mode)
+	includedNamespaces, excludedNamespaces, err := utils.NewNamespacesPatterns(policy.Namespaces)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile bare pods namespaces: %v", err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("could not get selector from bare pods label selector")
+	}
 
-	if utils.IsMirrorPod(pod) {
+	return func(pod *v1.Pod) error {
+		if len(podutil.OwnerRef(pod)) > 0 {
+			return nil
+		}
+		if includedNamespaces.Len() > 0 && !includedNamespaces.Has(pod.Namespace) || excludedNamespaces.Has(pod.Namespace) {
+			return fmt.Errorf("pod does not have any ownerRefs and its namespace does not match the bare pods policy")
+		}
+		if policy.LabelSelector != nil && !selector.Matches(labels.Set(pod.Labels)) {
+			return fmt.Errorf("pod does not have any ownerRefs and its labels do not match the bare pods policy")
+		}
+		// Enable evictFailedBarePods to evict bare pods in failed phase
+		if policy.Mode == BarePodsEvictionFailedOnly && pod.Status.Phase != v1.PodFailed {
+			return fmt.Errorf("pod does not have any ownerRefs and is not in failed phase")
+		}
+		return nil
+	}, nil
+}
+
+// ReportOnly checks whether the pod fails the Filter only because it is bound to its node
+// and the plugin is configured to report such pods
+func (d *DefaultEvictor) ReportOnly(pluginName string, pod *v1.Pod) bool {
+	override, ok := d.overrides[pluginName]
+	if !ok || HaveEvictAnnotation(pod) {
+		return false
+	}
+	if len(d.nodeBoundChecks(pod, PluginOverride{})) == 0 {
+		return false
+	}
+	return len(d.nodeBoundChecks(pod, override)) == 0 && len(d.checks(pod)) == 0
+}
+
+// nodeBoundChecks checks whether the pod is bound to its node, skipping the pods reported by the override
+func (d *DefaultEvictor) nodeBoundChecks(pod *v1.Pod, override PluginOverride) []error {
+	checkErrs := []error{}
+
+	if utils.IsMirrorPod(pod) && !override.ReportMirrorPods {
 		checkErrs = append(checkErrs, fmt.Errorf("pod is a mirror pod"))
 	}
 
-	if utils.IsStaticPod(pod) {
+	if utils.IsStaticPod(pod) && !override.ReportStaticPods {
 		checkErrs = append(checkErrs, fmt.Errorf("pod is a static pod"))
 	}
 
+	if !d.args.EvictDaemonSetPods && utils.IsDaemonsetPod(podutil.OwnerRef(pod)) && !override.ReportDaemonSetPods {
+		checkErrs = append(checkErrs, fmt.Errorf("pod is related to daemonset and descheduler is not configured with evictDaemonSetPods"))
+	}
+
+	return checkErrs
+}
+
+func (d *DefaultEvictor) checks(pod *v1.Pod) []error {
+	checkErrs := []error{}
+
 	if utils.IsPodTerminating(pod) {
 		checkErrs = append(checkErrs, fmt.Errorf("pod is terminating"))
 	}
@@ -258,12 +639,7 @@ func (d *DefaultEvictor) Filter(pod *v1.Pod) bool {
 		}
 	}
 
-	if len(checkErrs) > 0 {
-		klog.V(4).InfoS("Pod fails the following checks", "pod", klog.KObj(pod), "checks", utilerrors.NewAggregate(checkErrs).Error())
-		return false
-	}
-
-	return true
+	return checkErrs
 }
 
 func getPodIndexerByOwnerRefs(indexName string, handle frameworktypes.Handle) (cache.Indexer, error) {
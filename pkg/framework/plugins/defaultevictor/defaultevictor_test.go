@@ -21,13 +21,17 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/descheduler/pkg/api"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
@@ -42,6 +46,7 @@ type testCase struct {
 	nodes                   []*v1.Node
 	pdbs                    []*policyv1.PodDisruptionBudget
 	evictFailedBarePods     bool
+	barePods                *BarePodsPolicy
 	evictLocalStoragePods   bool
 	evictSystemCriticalPods bool
 	ignorePvcPods           bool
@@ -365,6 +370,67 @@ func TestDefaultEvictorFilter(t *testing.T) {
 			},
 			evictFailedBarePods: true,
 			result:              true,
+		}, {
+			description: "Normal pod eviction with no ownerRefs and bare pods never evicted",
+			pods:        []*v1.Pod{test.BuildTestPod("bare_pod_never", 400, 0, n1.Name, nil)},
+			barePods:    &BarePodsPolicy{Mode: BarePodsEvictionNever},
+			result:      false,
+		}, {
+			description: "Normal pod eviction with no ownerRefs and bare pods evicted in failed phase only",
+			pods:        []*v1.Pod{test.BuildTestPod("bare_pod_failed_only", 400, 0, n1.Name, nil)},
+			barePods:    &BarePodsPolicy{Mode: BarePodsEvictionFailedOnly},
+			result:      false,
+		}, {
+			description: "Normal pod eviction with no ownerRefs and bare pods always evicted",
+			pods:        []*v1.Pod{test.BuildTestPod("bare_pod_always", 400, 0, n1.Name, nil)},
+			barePods:    &BarePodsPolicy{Mode: BarePodsEvictionAlways},
+			result:      true,
+		}, {
+			description: "Normal pod eviction with no ownerRefs in an included namespace and bare pods always evicted",
+			pods: []*v1.Pod{
+				test.BuildTestPod("bare_pod_ci", 400, 0, n1.Name, func(pod *v1.Pod) {
+					pod.Namespace = "ci"
+				}),
+			},
+			barePods: &BarePodsPolicy{Mode: BarePodsEvictionAlways, Namespaces: &api.Namespaces{Include: []string{"ci"}}},
+			result:   true,
+		}, {
+			description: "Normal pod eviction with no ownerRefs outside of the included namespaces and bare pods always evicted",
+			pods:        []*v1.Pod{test.BuildTestPod("bare_pod_not_ci", 400, 0, n1.Name, nil)},
+			barePods:    &BarePodsPolicy{Mode: BarePodsEvictionAlways, Namespaces: &api.Namespaces{Include: []string{"ci"}}},
+			result:      false,
+		}, {
+			description: "Normal pod eviction with no ownerRefs in an excluded namespace and bare pods always evicted",
+			pods: []*v1.Pod{
+				test.BuildTestPod("bare_pod_excluded", 400, 0, n1.Name, func(pod *v1.Pod) {
+					pod.Namespace = "kube-system"
+				}),
+			},
+			barePods: &BarePodsPolicy{Mode: BarePodsEvictionAlways, Namespaces: &api.Namespaces{Exclude: []string{"kube-system"}}},
+			result:   false,
+		}, {
+			description: "Normal pod eviction with no ownerRefs matching the label selector and bare pods always evicted",
+			pods: []*v1.Pod{
+				test.BuildTestPod("bare_pod_labeled", 400, 0, n1.Name, func(pod *v1.Pod) {
+					pod.Labels = map[string]string{"ci-job": "build"}
+				}),
+			},
+			barePods: &BarePodsPolicy{Mode: BarePodsEvictionAlways, LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "ci-job", Operator: metav1.LabelSelectorOpExists}}}},
+			result:   true,
+		}, {
+			description: "Normal pod eviction with no ownerRefs not matching the label selector and bare pods always evicted",
+			pods:        []*v1.Pod{test.BuildTestPod("bare_pod_unlabeled", 400, 0, n1.Name, nil)},
+			barePods:    &BarePodsPolicy{Mode: BarePodsEvictionAlways, LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "ci-job", Operator: metav1.LabelSelectorOpExists}}}},
+			result:      false,
+		}, {
+			description: "Normal pod eviction with normal ownerRefs not matching the bare pods label selector",
+			pods: []*v1.Pod{
+				test.BuildTestPod("owned_pod_unlabeled", 400, 0, n1.Name, func(pod *v1.Pod) {
+					pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+				}),
+			},
+			barePods: &BarePodsPolicy{Mode: BarePodsEvictionAlways, LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "ci-job", Operator: metav1.LabelSelectorOpExists}}}},
+			result:   true,
 		}, {
 			description: "Normal pod eviction with normal ownerRefs",
 			pods: []*v1.Pod{
@@ -823,6 +889,487 @@ func TestDefaultEvictorFilter(t *testing.T) {
 	}
 }
 
+func TestDefaultEvictorReportOnly(t *testing.T) {
+	n1 := test.BuildTestNode("node1", 1000, 2000, 13, nil)
+	daemonSetPod := test.BuildTestPod("p1", 400, 0, n1.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetDaemonSetOwnerRefList()
+	})
+	mirrorPod := test.BuildTestPod("p2", 400, 0, n1.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+		pod.Annotations = test.GetMirrorPodAnnotation()
+	})
+	terminatingDaemonSetPod := test.BuildTestPod("p3", 400, 0, n1.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetDaemonSetOwnerRefList()
+		pod.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	})
+	normalPod := test.BuildTestPod("p4", 400, 0, n1.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+	})
+
+	testCases := []struct {
+		description string
+		pluginName  string
+		overrides   []PluginOverride
+		pod         *v1.Pod
+		result      bool
+	}{
+		{
+			description: "daemonset pod reported by the overridden plugin",
+			pluginName:  "NodeDrain",
+			overrides:   []PluginOverride{{Name: "NodeDrain", ReportDaemonSetPods: true}},
+			pod:         daemonSetPod,
+			result:      true,
+		},
+		{
+			description: "daemonset pod not reported by other plugins",
+			pluginName:  "RemoveDuplicates",
+			overrides:   []PluginOverride{{Name: "NodeDrain", ReportDaemonSetPods: true}},
+			pod:         daemonSetPod,
+			result:      false,
+		},
+		{
+			description: "mirror pod not reported when only daemonset pods are reported",
+			pluginName:  "NodeDrain",
+			overrides:   []PluginOverride{{Name: "NodeDrain", ReportDaemonSetPods: true}},
+			pod:         mirrorPod,
+			result:      false,
+		},
+		{
+			description: "mirror pod reported by the overridden plugin",
+			pluginName:  "NodeDrain",
+			overrides:   []PluginOverride{{Name: "NodeDrain", ReportMirrorPods: true}},
+			pod:         mirrorPod,
+			result:      true,
+		},
+		{
+			description: "terminating daemonset pod not reported",
+			pluginName:  "NodeDrain",
+			overrides:   []PluginOverride{{Name: "NodeDrain", ReportDaemonSetPods: true}},
+			pod:         terminatingDaemonSetPod,
+			result:      false,
+		},
+		{
+			description: "evictable pod not reported",
+			pluginName:  "NodeDrain",
+			overrides:   []PluginOverride{{Name: "NodeDrain", ReportDaemonSetPods: true, ReportMirrorPods: true, ReportStaticPods: true}},
+			pod:         normalPod,
+			result:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				PriorityThreshold: &api.PriorityThreshold{},
+				PluginOverrides:   tc.overrides,
+			}, &frameworkfake.HandleImpl{})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			defaultEvictor := evictorPlugin.(*DefaultEvictor)
+
+			if defaultEvictor.Filter(tc.pod) && tc.pod != normalPod {
+				t.Errorf("Filter should not pass pod %s", tc.pod.Name)
+			}
+			if result := defaultEvictor.ReportOnly(tc.pluginName, tc.pod); result != tc.result {
+				t.Errorf("ReportOnly should return for pod %s %t, but it returns %t", tc.pod.Name, tc.result, result)
+			}
+		})
+	}
+}
+
+func TestDefaultEvictorPriorityClassSelector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	n1 := test.BuildTestNode("node1", 1000, 2000, 13, nil)
+	buildPriorityClass := func(name string, value int32, tier string) *schedulingv1.PriorityClass {
+		priorityClass := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: name}, Value: value}
+		if tier != "" {
+			priorityClass.Labels = map[string]string{"tier": tier}
+		}
+		return priorityClass
+	}
+	buildPod := func(name string, priority int32) *v1.Pod {
+		return test.BuildTestPod(name, 400, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+			pod.Spec.Priority = &priority
+		})
+	}
+
+	fakeClient := fake.NewSimpleClientset(
+		n1,
+		buildPriorityClass("protected-high", 1000, "protected"),
+		buildPriorityClass("protected-low", 500, "protected"),
+		buildPriorityClass("batch", 100, ""),
+	)
+	sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+	priorityClassInformer := sharedInformerFactory.Scheduling().V1().PriorityClasses().Informer()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	evictorPlugin, err := New(&DefaultEvictorArgs{
+		PriorityThreshold: &api.PriorityThreshold{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "protected"}},
+		},
+	}, &frameworkfake.HandleImpl{
+		ClientsetImpl:             fakeClient,
+		SharedInformerFactoryImpl: sharedInformerFactory,
+	})
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+	filter := evictorPlugin.(frameworktypes.EvictorPlugin).Filter
+
+	if !filter(buildPod("p1", 400)) {
+		t.Errorf("Expected a pod below the lowest selected priority class to be evictable")
+	}
+	if filter(buildPod("p2", 500)) {
+		t.Errorf("Expected a pod of the lowest selected priority class not to be evictable")
+	}
+
+	// A newly created priority class lowers the threshold without updating the policy
+	if err := priorityClassInformer.GetStore().Add(buildPriorityClass("protected-new", 300, "protected")); err != nil {
+		t.Fatalf("Unable to add the priority class: %v", err)
+	}
+	if filter(buildPod("p3", 400)) {
+		t.Errorf("Expected the threshold to follow the newly created priority class")
+	}
+}
+
+func TestDefaultEvictorPvcPodsPolicy(t *testing.T) {
+	n1 := test.BuildTestNode("node1", 1000, 2000, 13, nil)
+	buildPvc := func(name, storageClass string, accessMode v1.PersistentVolumeAccessMode) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1.PersistentVolumeClaimSpec{
+				StorageClassName: &storageClass,
+				AccessModes:      []v1.PersistentVolumeAccessMode{accessMode},
+				VolumeName:       "pv-" + name,
+			},
+			Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+		}
+	}
+	buildPod := func(name, claimName string) *v1.Pod {
+		return test.BuildTestPod(name, 400, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+			pod.Spec.Volumes = []v1.Volume{
+				{
+					Name: "pvc", VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+					},
+				},
+			}
+		})
+	}
+	attachedVolume := "pv-attached"
+	volumeAttachment := &storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "attachment"},
+		Spec: storagev1.VolumeAttachmentSpec{
+			NodeName: n1.Name,
+			Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &attachedVolume},
+		},
+		Status: storagev1.VolumeAttachmentStatus{Attached: true},
+	}
+
+	testCases := []struct {
+		description string
+		policy      *PvcPodsPolicy
+		pod         *v1.Pod
+		result      bool
+	}{
+		{
+			description: "ignored storage class, pod is ignored",
+			policy:      &PvcPodsPolicy{IgnoredStorageClasses: []string{"local"}},
+			pod:         buildPod("p1", "local"),
+			result:      false,
+		},
+		{
+			description: "storage class not in the ignored storage classes, evicts",
+			policy:      &PvcPodsPolicy{IgnoredStorageClasses: []string{"local"}},
+			pod:         buildPod("p2", "network"),
+			result:      true,
+		},
+		{
+			description: "evictable storage class, evicts",
+			policy:      &PvcPodsPolicy{EvictableStorageClasses: []string{"network"}},
+			pod:         buildPod("p3", "network"),
+			result:      true,
+		},
+		{
+			description: "storage class not in the evictable storage classes, pod is ignored",
+			policy:      &PvcPodsPolicy{EvictableStorageClasses: []string{"network"}},
+			pod:         buildPod("p4", "local"),
+			result:      false,
+		},
+		{
+			description: "attached ReadWriteOnce PVC, pod is ignored",
+			policy:      &PvcPodsPolicy{AttachedReadWriteOnceOnly: true},
+			pod:         buildPod("p5", "attached"),
+			result:      false,
+		},
+		{
+			description: "detached ReadWriteOnce PVC, evicts",
+			policy:      &PvcPodsPolicy{AttachedReadWriteOnceOnly: true},
+			pod:         buildPod("p6", "local"),
+			result:      true,
+		},
+		{
+			description: "ReadWriteMany PVC, evicts",
+			policy:      &PvcPodsPolicy{AttachedReadWriteOnceOnly: true},
+			pod:         buildPod("p7", "shared"),
+			result:      true,
+		},
+		{
+			description: "missing PVC, pod is ignored",
+			policy:      &PvcPodsPolicy{},
+			pod:         buildPod("p8", "missing"),
+			result:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			fakeClient := fake.NewSimpleClientset(
+				n1,
+				buildPvc("local", "local", v1.ReadWriteOnce),
+				buildPvc("network", "network", v1.ReadWriteOnce),
+				buildPvc("shared", "network", v1.ReadWriteMany),
+				buildPvc("attached", "local", v1.ReadWriteOnce),
+				volumeAttachment,
+			)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				IgnorePvcPods: true,
+				PvcPods:       tc.policy,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:             fakeClient,
+				SharedInformerFactoryImpl: sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			result := evictorPlugin.(frameworktypes.EvictorPlugin).Filter(tc.pod)
+			if result != tc.result {
+				t.Errorf("Filter should return for pod %s %t, but it returns %t", tc.pod.Name, tc.result, result)
+			}
+		})
+	}
+}
+
+func TestDefaultEvictorBatchProtection(t *testing.T) {
+	n1 := test.BuildTestNode("node1", 1000, 2000, 13, nil)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default"},
+		Spec:       batchv1.JobSpec{Completions: ptr.To[int32](10)},
+		Status:     batchv1.JobStatus{Succeeded: 9},
+	}
+	buildPod := func(name string, runtime time.Duration, apply func(pod *v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 400, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = []metav1.OwnerReference{
+				{APIVersion: "batch/v1", Kind: "Job", Name: job.Name},
+			}
+			pod.Status.StartTime = &metav1.Time{Time: time.Now().Add(-runtime)}
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+	withProgress := func(progress string) func(pod *v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Annotations = map[string]string{"example.com/progress": progress}
+		}
+	}
+
+	testCases := []struct {
+		description string
+		protection  *BatchProtection
+		pod         *v1.Pod
+		result      bool
+	}{
+		{
+			description: "progress annotation over the threshold, pod is protected",
+			protection:  &BatchProtection{ProgressAnnotation: "example.com/progress", MinProgressPercentage: ptr.To[int32](80)},
+			pod:         buildPod("p1", time.Minute, withProgress("85%")),
+			result:      false,
+		},
+		{
+			description: "progress annotation under the threshold, evicts",
+			protection:  &BatchProtection{ProgressAnnotation: "example.com/progress", MinProgressPercentage: ptr.To[int32](80)},
+			pod:         buildPod("p2", time.Minute, withProgress("40")),
+			result:      true,
+		},
+		{
+			description: "invalid progress annotation, evicts",
+			protection:  &BatchProtection{ProgressAnnotation: "example.com/progress", MinProgressPercentage: ptr.To[int32](80)},
+			pod:         buildPod("p3", time.Minute, withProgress("almost done")),
+			result:      true,
+		},
+		{
+			description: "Job progress over the threshold, pod is protected",
+			protection:  &BatchProtection{JobProgress: true, MinProgressPercentage: ptr.To[int32](90)},
+			pod:         buildPod("p4", time.Minute, nil),
+			result:      false,
+		},
+		{
+			description: "Job progress under the threshold, evicts",
+			protection:  &BatchProtection{JobProgress: true, MinProgressPercentage: ptr.To[int32](95)},
+			pod:         buildPod("p5", time.Minute, nil),
+			result:      true,
+		},
+		{
+			description: "Job pod running longer than minRuntime, pod is protected",
+			protection:  &BatchProtection{MinRuntime: &metav1.Duration{Duration: time.Hour}},
+			pod:         buildPod("p6", 2*time.Hour, nil),
+			result:      false,
+		},
+		{
+			description: "Job pod running shorter than minRuntime, evicts",
+			protection:  &BatchProtection{MinRuntime: &metav1.Duration{Duration: time.Hour}},
+			pod:         buildPod("p7", time.Minute, nil),
+			result:      true,
+		},
+		{
+			description: "pod not owned by a Job running longer than minRuntime, evicts",
+			protection:  &BatchProtection{MinRuntime: &metav1.Duration{Duration: time.Hour}},
+			pod: buildPod("p8", 2*time.Hour, func(pod *v1.Pod) {
+				pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+			}),
+			result: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			fakeClient := fake.NewSimpleClientset(n1, job)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				BatchProtection: tc.protection,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:             fakeClient,
+				SharedInformerFactoryImpl: sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			result := evictorPlugin.(frameworktypes.EvictorPlugin).Filter(tc.pod)
+			if result != tc.result {
+				t.Errorf("Filter should return for pod %s %t, but it returns %t", tc.pod.Name, tc.result, result)
+			}
+		})
+	}
+}
+
+func TestDefaultEvictorIgnoreControlPlanePods(t *testing.T) {
+	t.Setenv("POD_NAMESPACE", "descheduler")
+	t.Setenv("POD_NAME", "descheduler-5d9f8c7b6-x2k4p")
+
+	n1 := test.BuildTestNode("node1", 1000, 2000, 13, nil)
+	n2 := test.BuildTestNode("node2", 1000, 2000, 13, func(node *v1.Node) {
+		node.Labels["node-role.kubernetes.io/control-plane"] = ""
+	})
+	buildPod := func(name, namespace, nodeName string, apply func(pod *v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 400, 0, nodeName, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			pod.Namespace = namespace
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+
+	testCases := []struct {
+		description string
+		namespaces  []string
+		pod         *v1.Pod
+		result      bool
+	}{
+		{
+			description: "descheduler's own pod, pod is protected",
+			pod:         buildPod("descheduler-5d9f8c7b6-x2k4p", "descheduler", n1.Name, nil),
+			result:      false,
+		},
+		{
+			description: "pod of the descheduler's name in another namespace, evicts",
+			pod:         buildPod("descheduler-5d9f8c7b6-x2k4p", "default", n1.Name, nil),
+			result:      true,
+		},
+		{
+			description: "pod in the default control-plane namespace, pod is protected",
+			pod:         buildPod("p1", "kube-system", n1.Name, nil),
+			result:      false,
+		},
+		{
+			description: "pod in a configured control-plane namespace, pod is protected",
+			namespaces:  []string{"openshift-etcd"},
+			pod:         buildPod("p2", "openshift-etcd", n1.Name, nil),
+			result:      false,
+		},
+		{
+			description: "pod in kube-system with other control-plane namespaces configured, evicts",
+			namespaces:  []string{"openshift-etcd"},
+			pod:         buildPod("p3", "kube-system", n1.Name, nil),
+			result:      true,
+		},
+		{
+			description: "pod running on a control-plane node, pod is protected",
+			pod:         buildPod("p4", "default", n2.Name, nil),
+			result:      false,
+		},
+		{
+			description: "pod selecting the control-plane nodes, pod is protected",
+			pod: buildPod("p5", "default", n1.Name, func(pod *v1.Pod) {
+				pod.Spec.NodeSelector = map[string]string{"node-role.kubernetes.io/control-plane": ""}
+			}),
+			result: false,
+		},
+		{
+			description: "workload pod running on a worker node, evicts",
+			pod:         buildPod("p6", "default", n1.Name, nil),
+			result:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			fakeClient := fake.NewSimpleClientset(n1, n2)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				IgnoreControlPlanePods: true,
+				ControlPlaneNamespaces: tc.namespaces,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:             fakeClient,
+				SharedInformerFactoryImpl: sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			result := evictorPlugin.(frameworktypes.EvictorPlugin).Filter(tc.pod)
+			if result != tc.result {
+				t.Errorf("Filter should return for pod %s %t, but it returns %t", tc.pod.Name, tc.result, result)
+			}
+		})
+	}
+}
+
 func TestReinitialization(t *testing.T) {
 	n1 := test.BuildTestNode("node1", 1000, 2000, 13, nil)
 	ownerRefUUID := uuid.NewUUID()
@@ -897,6 +1444,7 @@ func initializePlugin(ctx context.Context, test testCase) (frameworktypes.Plugin
 		EvictSystemCriticalPods: test.evictSystemCriticalPods,
 		IgnorePvcPods:           test.ignorePvcPods,
 		EvictFailedBarePods:     test.evictFailedBarePods,
+		BarePods:                test.barePods,
 		PriorityThreshold: &api.PriorityThreshold{
 			Value: test.priorityThreshold,
 		},
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultNodeFitExtenderFilterVerb = "filter"
+	defaultNodeFitExtenderTimeout    = 5 * time.Second
+)
+
+// extenderArgs mirrors the ExtenderArgs of k8s.io/kube-scheduler/extender/v1
+type extenderArgs struct {
+	Pod       *v1.Pod
+	Nodes     *v1.NodeList
+	NodeNames *[]string
+}
+
+// extenderFilterResult mirrors the ExtenderFilterResult of k8s.io/kube-scheduler/extender/v1
+type extenderFilterResult struct {
+	Nodes                      *v1.NodeList
+	NodeNames                  *[]string
+	FailedNodes                map[string]string
+	FailedAndUnresolvableNodes map[string]string
+	Error                      string
+}
+
+type nodeFitExtender struct {
+	url              string
+	nodeCacheCapable bool
+	client           *http.Client
+}
+
+func newNodeFitExtender(config *NodeFitExtender) *nodeFitExtender {
+	verb := config.FilterVerb
+	if verb == "" {
+		verb = defaultNodeFitExtenderFilterVerb
+	}
+	timeout := defaultNodeFitExtenderTimeout
+	if config.Timeout != nil {
+		timeout = config.Timeout.Duration
+	}
+	return &nodeFitExtender{
+		url:              strings.TrimRight(config.URLPrefix, "/") + "/" + verb,
+		nodeCacheCapable: config.NodeCacheCapable,
+		client:           &http.Client{Timeout: timeout},
+	}
+}
+
+// filter returns the names of the nodes the extender lets the pod be scheduled to
+func (e *nodeFitExtender) filter(pod *v1.Pod, nodes []*v1.Node) ([]string, error) {
+	args := &extenderArgs{Pod: pod}
+	if e.nodeCacheCapable {
+		nodeNames := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			nodeNames = append(nodeNames, node.Name)
+		}
+		args.NodeNames = &nodeNames
+	} else {
+		nodeList := &v1.NodeList{}
+		for _, node := range nodes {
+			nodeList.Items = append(nodeList.Items, *node)
+		}
+		args.Nodes = nodeList
+	}
+
+	body, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("extender at URL %v responded with code %v", e.url, resp.StatusCode)
+	}
+
+	result := &extenderFilterResult{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("extender error: %v", result.Error)
+	}
+
+	var nodeNames []string
+	if result.NodeNames != nil {
+		nodeNames = *result.NodeNames
+	} else if result.Nodes != nil {
+		for _, node := range result.Nodes.Items {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+	return nodeNames, nil
+}
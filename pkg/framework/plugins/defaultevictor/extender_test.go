@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestDefaultEvictorNodeFitExtender(t *testing.T) {
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 2000, 3000, 10, nil)
+	pod := test.BuildTestPod("p1", 100, 0, n1.Name, test.SetNormalOwnerRef)
+
+	testCases := []struct {
+		description      string
+		feasibleNodes    []string
+		statusCode       int
+		nodeCacheCapable bool
+		ignorable        bool
+		result           bool
+	}{
+		{
+			description:   "extender lets the pod be scheduled to another node",
+			feasibleNodes: []string{"n3"},
+			statusCode:    http.StatusOK,
+			result:        true,
+		},
+		{
+			description:      "node cache capable extender lets the pod be scheduled to another node",
+			feasibleNodes:    []string{"n2"},
+			statusCode:       http.StatusOK,
+			nodeCacheCapable: true,
+			result:           true,
+		},
+		{
+			description:   "extender rejects all the other nodes",
+			feasibleNodes: []string{"n1"},
+			statusCode:    http.StatusOK,
+			result:        false,
+		},
+		{
+			description: "extender fails",
+			statusCode:  http.StatusInternalServerError,
+			result:      false,
+		},
+		{
+			description: "ignorable extender fails",
+			statusCode:  http.StatusInternalServerError,
+			ignorable:   true,
+			result:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/scheduler/filter" {
+					t.Errorf("Unexpected path %q", r.URL.Path)
+				}
+				if tc.statusCode != http.StatusOK {
+					w.WriteHeader(tc.statusCode)
+					return
+				}
+				args := &extenderArgs{}
+				if err := json.NewDecoder(r.Body).Decode(args); err != nil {
+					t.Errorf("Unable to decode the extender args: %v", err)
+				}
+				var candidates []string
+				if tc.nodeCacheCapable {
+					if args.NodeNames == nil || args.Nodes != nil {
+						t.Errorf("Expected the node names only to be sent")
+						return
+					}
+					candidates = *args.NodeNames
+				} else {
+					for _, node := range args.Nodes.Items {
+						candidates = append(candidates, node.Name)
+					}
+				}
+				if sets.New(candidates...).Has(n1.Name) {
+					t.Errorf("Expected the node of the pod not to be sent, got %v", candidates)
+				}
+				nodeNames := sets.List(sets.New(candidates...).Intersection(sets.New(tc.feasibleNodes...)))
+				json.NewEncoder(w).Encode(&extenderFilterResult{NodeNames: &nodeNames})
+			}))
+			defer server.Close()
+
+			fakeClient := fake.NewSimpleClientset(n1, n2, n3, pod)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			_ = sharedInformerFactory.Core().V1().Nodes().Lister()
+			getPodsAssignedToNode, err := podutil.BuildGetPodsAssignedToNodeFunc(sharedInformerFactory.Core().V1().Pods().Informer())
+			if err != nil {
+				t.Fatalf("Build get pods assigned to node function error: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				NodeFit: true,
+				NodeFitExtender: &NodeFitExtender{
+					URLPrefix:        server.URL + "/scheduler",
+					NodeCacheCapable: tc.nodeCacheCapable,
+					Ignorable:        tc.ignorable,
+				},
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:                 fakeClient,
+				GetPodsAssignedToNodeFuncImpl: getPodsAssignedToNode,
+				SharedInformerFactoryImpl:     sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			result := evictorPlugin.(frameworktypes.EvictorPlugin).PreEvictionFilter(pod)
+			if result != tc.result {
+				t.Errorf("PreEvictionFilter should return %t, but it returns %t", tc.result, result)
+			}
+		})
+	}
+}
+
+func TestDefaultEvictorSchedulerNodeFit(t *testing.T) {
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&extenderFilterResult{NodeNames: &[]string{}})
+	}))
+	defer server.Close()
+
+	schedulerNodeFit := []SchedulerNodeFit{
+		{SchedulerName: "volcano", Mode: NodeFitAssumeFeasible},
+		{SchedulerName: "batch-scheduler", Mode: NodeFitAssumeInfeasible},
+		{SchedulerName: "gpu-scheduler", Mode: NodeFitExtenderMode, Extender: &NodeFitExtender{URLPrefix: server.URL}},
+		{SchedulerName: "custom-scheduler", Mode: NodeFitDefault},
+	}
+
+	testCases := []struct {
+		description   string
+		schedulerName string
+		nodeSelector  map[string]string
+		result        bool
+	}{
+		{
+			description: "pod of the default scheduler fits another node",
+			result:      true,
+		},
+		{
+			description:   "pod of a scheduler without configuration fits another node",
+			schedulerName: "other-scheduler",
+			result:        true,
+		},
+		{
+			description:   "pod of a scheduler assumed feasible fits no other node",
+			schedulerName: "volcano",
+			nodeSelector:  map[string]string{"gpu": "true"},
+			result:        true,
+		},
+		{
+			description:   "pod of a scheduler assumed infeasible fits another node",
+			schedulerName: "batch-scheduler",
+			result:        false,
+		},
+		{
+			description:   "extender of the scheduler rejects all the nodes",
+			schedulerName: "gpu-scheduler",
+			result:        false,
+		},
+		{
+			description:   "pod of a scheduler with the default mode fits no other node",
+			schedulerName: "custom-scheduler",
+			nodeSelector:  map[string]string{"gpu": "true"},
+			result:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			pod := test.BuildTestPod("p1", 100, 0, n1.Name, func(pod *v1.Pod) {
+				test.SetNormalOwnerRef(pod)
+				pod.Spec.SchedulerName = tc.schedulerName
+				pod.Spec.NodeSelector = tc.nodeSelector
+			})
+
+			fakeClient := fake.NewSimpleClientset(n1, n2, pod)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			_ = sharedInformerFactory.Core().V1().Nodes().Lister()
+			getPodsAssignedToNode, err := podutil.BuildGetPodsAssignedToNodeFunc(sharedInformerFactory.Core().V1().Pods().Informer())
+			if err != nil {
+				t.Fatalf("Build get pods assigned to node function error: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				NodeFit:          true,
+				SchedulerNodeFit: schedulerNodeFit,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:                 fakeClient,
+				GetPodsAssignedToNodeFuncImpl: getPodsAssignedToNode,
+				SharedInformerFactoryImpl:     sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			result := evictorPlugin.(frameworktypes.EvictorPlugin).PreEvictionFilter(pod)
+			if result != tc.result {
+				t.Errorf("PreEvictionFilter should return %t, but it returns %t", tc.result, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// statsSummaryTimeout bounds the time the kubelet of a node is given to report its stats summary
+const statsSummaryTimeout = 5 * time.Second
+
+// kubeletSummary holds the part of the kubelet stats summary the usage of the emptyDir volumes is read from
+type kubeletSummary struct {
+	Pods []struct {
+		PodRef struct {
+			UID types.UID `json:"uid"`
+		} `json:"podRef"`
+		Volumes []struct {
+			Name      string  `json:"name"`
+			UsedBytes *uint64 `json:"usedBytes"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// localStoragePods ignores the pods with hostPath volumes or emptyDir volumes larger than the threshold
+type localStoragePods struct {
+	threshold    resource.Quantity
+	measureUsage bool
+	summary      func(ctx context.Context, node string) ([]byte, error)
+	mu           sync.Mutex
+	// ctx is the context of the descheduling cycle the usage is measured in
+	ctx         context.Context
+	usageByNode map[string]map[types.UID]map[string]int64
+}
+
+func newLocalStoragePods(policy *LocalStoragePodsPolicy, client clientset.Interface) *localStoragePods {
+	return &localStoragePods{
+		threshold:    *policy.EmptyDirSizeThreshold,
+		measureUsage: policy.MeasureEmptyDirUsage,
+		summary: func(ctx context.Context, node string) ([]byte, error) {
+			return client.CoreV1().RESTClient().Get().AbsPath("/api/v1/nodes", node, "proxy", "stats", "summary").DoRaw(ctx)
+		},
+		ctx:         context.Background(),
+		usageByNode: make(map[string]map[types.UID]map[string]int64),
+	}
+}
+
+// startCycle drops the usage measured in the previous cycle, the usage is measured again within the new cycle
+func (l *localStoragePods) startCycle(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ctx = ctx
+	l.usageByNode = make(map[string]map[types.UID]map[string]int64)
+}
+
+// constraint refuses the pods with hostPath volumes and the pods with emptyDir volumes larger than the threshold
+func (l *localStoragePods) constraint(pod *v1.Pod) error {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath != nil {
+			return fmt.Errorf("pod has a hostPath volume and descheduler is not configured with evictLocalStoragePods")
+		}
+		if volume.EmptyDir == nil {
+			continue
+		}
+		size, ok := l.emptyDirSize(pod, volume)
+		if !ok {
+			return fmt.Errorf("pod has an emptyDir volume %q without a size limit and descheduler is not configured with evictLocalStoragePods", volume.Name)
+		}
+		if size.Cmp(l.threshold) > 0 {
+			return fmt.Errorf("pod has an emptyDir volume %q of %v over the %v threshold and descheduler is not configured with evictLocalStoragePods", volume.Name, size.String(), l.threshold.String())
+		}
+	}
+	return nil
+}
+
+// emptyDirSize returns the measured usage of the emptyDir volume when measured, its size limit otherwise
+func (l *localStoragePods) emptyDirSize(pod *v1.Pod, volume v1.Volume) (resource.Quantity, bool) {
+	if l.measureUsage && pod.Spec.NodeName != "" {
+		if used, ok := l.usage(pod.Spec.NodeName)[pod.UID][volume.Name]; ok {
+			return *resource.NewQuantity(used, resource.BinarySI), true
+		}
+	}
+	if volume.EmptyDir.SizeLimit == nil {
+		return resource.Quantity{}, false
+	}
+	return *volume.EmptyDir.SizeLimit, true
+}
+
+// usage returns the usage of the emptyDir volumes of the pods on the node, the stats summary of every node
+// is fetched once per cycle from the kubelet. Nodes failing to report their stats in time report no usage.
+func (l *localStoragePods) usage(node string) map[types.UID]map[string]int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if usage, ok := l.usageByNode[node]; ok {
+		return usage
+	}
+	usage := make(map[types.UID]map[string]int64)
+	l.usageByNode[node] = usage
+
+	ctx, cancel := context.WithTimeout(l.ctx, statsSummaryTimeout)
+	defer cancel()
+	raw, err := l.summary(ctx, node)
+	if err != nil {
+		klog.ErrorS(err, "Unable to get the stats summary of the node, sizing the emptyDir volumes by their size limit", "node", node)
+		return usage
+	}
+	summary := kubeletSummary{}
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		klog.ErrorS(err, "Unable to decode the stats summary of the node, sizing the emptyDir volumes by their size limit", "node", node)
+		return usage
+	}
+	for _, pod := range summary.Pods {
+		volumes := make(map[string]int64)
+		for _, volume := range pod.Volumes {
+			if volume.UsedBytes != nil {
+				volumes[volume.Name] = int64(*volume.UsedBytes)
+			}
+		}
+		usage[pod.PodRef.UID] = volumes
+	}
+	return usage
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestLocalStoragePods(t *testing.T) {
+	withVolumes := func(volumes ...v1.Volume) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Spec.Volumes = volumes
+		}
+	}
+	emptyDir := func(name, sizeLimit string) v1.Volume {
+		volume := v1.Volume{Name: name, VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}
+		if sizeLimit != "" {
+			quantity := resource.MustParse(sizeLimit)
+			volume.EmptyDir.SizeLimit = &quantity
+		}
+		return volume
+	}
+	hostPath := v1.Volume{Name: "host", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/log"}}}
+	summary := `{"pods": [{"podRef": {"uid": "measured"}, "volume": [{"name": "cache", "usedBytes": 1048576}, {"name": "data"}]}]}`
+
+	testCases := []struct {
+		description  string
+		measureUsage bool
+		summaryErr   error
+		uid          types.UID
+		podApply     func(*v1.Pod)
+		evictable    bool
+	}{
+		{
+			description: "no local storage",
+			evictable:   true,
+		},
+		{
+			description: "hostPath volume",
+			podApply:    withVolumes(hostPath),
+		},
+		{
+			description: "emptyDir size limit below the threshold",
+			podApply:    withVolumes(emptyDir("cache", "64Mi")),
+			evictable:   true,
+		},
+		{
+			description: "emptyDir size limit equal to the threshold",
+			podApply:    withVolumes(emptyDir("cache", "128Mi")),
+			evictable:   true,
+		},
+		{
+			description: "one emptyDir size limit over the threshold",
+			podApply:    withVolumes(emptyDir("cache", "64Mi"), emptyDir("data", "1Gi")),
+		},
+		{
+			description: "emptyDir without a size limit",
+			podApply:    withVolumes(emptyDir("cache", "")),
+		},
+		{
+			description:  "measured usage below the threshold, size limit over the threshold",
+			measureUsage: true,
+			uid:          "measured",
+			podApply:     withVolumes(emptyDir("cache", "1Gi")),
+			evictable:    true,
+		},
+		{
+			description:  "measured usage without a size limit",
+			measureUsage: true,
+			uid:          "measured",
+			podApply:     withVolumes(emptyDir("cache", "")),
+			evictable:    true,
+		},
+		{
+			description:  "usage not measured, sized by the size limit",
+			measureUsage: true,
+			uid:          "measured",
+			podApply:     withVolumes(emptyDir("data", "1Gi")),
+		},
+		{
+			description:  "pod not reported, sized by the size limit",
+			measureUsage: true,
+			podApply:     withVolumes(emptyDir("cache", "64Mi")),
+			evictable:    true,
+		},
+		{
+			description:  "stats summary unavailable, sized by the size limit",
+			measureUsage: true,
+			summaryErr:   fmt.Errorf("kubelet unavailable"),
+			uid:          "measured",
+			podApply:     withVolumes(emptyDir("cache", "")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			pod := test.BuildTestPod("p1", 100, 0, "n1", tc.podApply)
+			if tc.uid != "" {
+				pod.UID = tc.uid
+			}
+			threshold := resource.MustParse("128Mi")
+			l := newLocalStoragePods(&LocalStoragePodsPolicy{EmptyDirSizeThreshold: &threshold, MeasureEmptyDirUsage: tc.measureUsage}, nil)
+			summaries := 0
+			l.summary = func(ctx context.Context, node string) ([]byte, error) {
+				summaries++
+				return []byte(summary), tc.summaryErr
+			}
+
+			for i := 0; i < 2; i++ {
+				if err := l.constraint(pod); (err == nil) != tc.evictable {
+					t.Errorf("Expected the pod evictable to be %v, got %v", tc.evictable, err)
+				}
+			}
+			if tc.measureUsage && summaries != 1 {
+				t.Errorf("Expected the stats summary of the node fetched once, got %v", summaries)
+			}
+		})
+	}
+}
+
+func TestLocalStoragePodsCycle(t *testing.T) {
+	type cycleKey struct{}
+	pod := test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) {
+		pod.Spec.Volumes = []v1.Volume{{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+	})
+	threshold := resource.MustParse("128Mi")
+	l := newLocalStoragePods(&LocalStoragePodsPolicy{EmptyDirSizeThreshold: &threshold, MeasureEmptyDirUsage: true}, nil)
+	var cycles []any
+	l.summary = func(ctx context.Context, node string) ([]byte, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Errorf("Expected the stats summary to be fetched with a timeout")
+		}
+		cycles = append(cycles, ctx.Value(cycleKey{}))
+		return []byte(`{"pods": []}`), nil
+	}
+
+	for cycle := 1; cycle <= 2; cycle++ {
+		l.startCycle(context.WithValue(context.Background(), cycleKey{}, cycle))
+		for i := 0; i < 2; i++ {
+			_ = l.constraint(pod)
+		}
+	}
+	if diff := cmp.Diff([]any{1, 2}, cycles); diff != "" {
+		t.Errorf("Expected the stats summary fetched once per cycle with the context of the cycle (-want,+got):\n%s", diff)
+	}
+}
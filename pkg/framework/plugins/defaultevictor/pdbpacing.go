@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	policylisters "k8s.io/client-go/listers/policy/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const defaultPDBPacingMaxEvictionsPerCycle = 1
+
+// pdbPacing spreads the evictions of the pods covered by a PodDisruptionBudget over time
+type pdbPacing struct {
+	maxEvictionsPerCycle uint
+	lister               policylisters.PodDisruptionBudgetLister
+
+	mu sync.Mutex
+	// evicted counts the evictions per PodDisruptionBudget in the current cycle,
+	// the status of the PodDisruptionBudgets is not updated right away
+	evicted map[types.UID]uint
+}
+
+func newPDBPacing(config *PDBPacing, lister policylisters.PodDisruptionBudgetLister) *pdbPacing {
+	return &pdbPacing{
+		maxEvictionsPerCycle: ptr.Deref(config.MaxEvictionsPerCycle, defaultPDBPacingMaxEvictionsPerCycle),
+		lister:               lister,
+		evicted:              make(map[types.UID]uint),
+	}
+}
+
+// check returns an error unless all the PodDisruptionBudgets covering the pod recovered
+// from the previous disruptions and allow another eviction in the current cycle
+func (p *pdbPacing) check(pod *v1.Pod) error {
+	pdbs, err := utils.GetPodPDBs(pod, p.lister)
+	if err != nil {
+		return fmt.Errorf("unable to list PodDisruptionBudgets of the pod: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pdb := range pdbs {
+		if p.evicted[pdb.UID] >= p.maxEvictionsPerCycle {
+			return fmt.Errorf("PodDisruptionBudget %q reached the maximum of %d evictions per cycle", pdb.Name, p.maxEvictionsPerCycle)
+		}
+		if len(pdb.Status.DisruptedPods) > 0 {
+			return fmt.Errorf("PodDisruptionBudget %q has %d pending disruptions", pdb.Name, len(pdb.Status.DisruptedPods))
+		}
+		unhealthy := pdb.Status.ExpectedPods - pdb.Status.CurrentHealthy
+		if !utils.IsPodReady(pod) {
+			// the eviction of an unhealthy pod does not disrupt the healthy pods
+			unhealthy--
+		}
+		if unhealthy > 0 {
+			return fmt.Errorf("PodDisruptionBudget %q has not recovered from the previous disruptions yet, %d other pods are unhealthy", pdb.Name, unhealthy)
+		}
+	}
+	return nil
+}
+
+// podEvicted counts the eviction of the pod for all the PodDisruptionBudgets covering the pod
+func (p *pdbPacing) podEvicted(pod *v1.Pod) {
+	pdbs, err := utils.GetPodPDBs(pod, p.lister)
+	if err != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pdb := range pdbs {
+		p.evicted[pdb.UID]++
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestDefaultEvictorPDBPacing(t *testing.T) {
+	buildPod := func(name string, ready bool) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, "n1", func(pod *v1.Pod) {
+			test.SetNormalOwnerRef(pod)
+			pod.Labels = map[string]string{"app": "stream"}
+			status := v1.ConditionFalse
+			if ready {
+				status = v1.ConditionTrue
+			}
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: status}}
+		})
+	}
+	buildPDB := func(expected, healthy int32, disruptedPods ...string) *policyv1.PodDisruptionBudget {
+		pdb := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "pdb", Namespace: "default", UID: "pdb"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "stream"}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{
+				ExpectedPods:       expected,
+				CurrentHealthy:     healthy,
+				DisruptionsAllowed: 1,
+				DisruptedPods:      map[string]metav1.Time{},
+			},
+		}
+		for _, pod := range disruptedPods {
+			pdb.Status.DisruptedPods[pod] = metav1.Now()
+		}
+		return pdb
+	}
+
+	testCases := []struct {
+		description string
+		pacing      *PDBPacing
+		pdb         *policyv1.PodDisruptionBudget
+		pod         *v1.Pod
+		// evicted lists the pods evicted in the cycle before the pod is checked
+		evicted []*v1.Pod
+		result  bool
+	}{
+		{
+			description: "recovered PDB, evicts",
+			pacing:      &PDBPacing{},
+			pdb:         buildPDB(3, 3),
+			pod:         buildPod("p1", true),
+			result:      true,
+		},
+		{
+			description: "PDB with unhealthy pods, pod is not evicted",
+			pacing:      &PDBPacing{},
+			pdb:         buildPDB(3, 2),
+			pod:         buildPod("p1", true),
+			result:      false,
+		},
+		{
+			description: "PDB with the pod as the only unhealthy pod, evicts",
+			pacing:      &PDBPacing{},
+			pdb:         buildPDB(3, 2),
+			pod:         buildPod("p1", false),
+			result:      true,
+		},
+		{
+			description: "PDB with pending disruptions, pod is not evicted",
+			pacing:      &PDBPacing{},
+			pdb:         buildPDB(3, 3, "p0"),
+			pod:         buildPod("p1", true),
+			result:      false,
+		},
+		{
+			description: "PDB reached the evictions per cycle, pod is not evicted",
+			pacing:      &PDBPacing{},
+			pdb:         buildPDB(3, 3),
+			pod:         buildPod("p1", true),
+			evicted:     []*v1.Pod{buildPod("p2", true)},
+			result:      false,
+		},
+		{
+			description: "PDB below the evictions per cycle, evicts",
+			pacing:      &PDBPacing{MaxEvictionsPerCycle: ptr.To[uint](2)},
+			pdb:         buildPDB(3, 3),
+			pod:         buildPod("p1", true),
+			evicted:     []*v1.Pod{buildPod("p2", true)},
+			result:      true,
+		},
+		{
+			description: "no PDB pacing, evicts",
+			pdb:         buildPDB(3, 1),
+			pod:         buildPod("p1", true),
+			result:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			fakeClient := fake.NewSimpleClientset(tc.pdb)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				PDBPacing: tc.pacing,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:             fakeClient,
+				SharedInformerFactoryImpl: sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			plugin := evictorPlugin.(frameworktypes.PluginFilterEvictorPlugin)
+			for _, pod := range tc.evicted {
+				plugin.PodEvicted("PodLifeTime", pod)
+			}
+			result := plugin.FilterForPlugin("PodLifeTime", tc.pod)
+			if result != tc.result {
+				t.Errorf("FilterForPlugin should return %t, but it returns %t", tc.result, result)
+			}
+		})
+	}
+}
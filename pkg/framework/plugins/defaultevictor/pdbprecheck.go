@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/types"
+	policylisters "k8s.io/client-go/listers/policy/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+var (
+	// errBlockedByPDB is the skip reason of the pods the eviction API would refuse to evict,
+	// the PodDisruptionBudget is logged and not part of the reason
+	errBlockedByPDB = errors.New("blocked by PodDisruptionBudget, no disruption allowed")
+	errMultiplePDBs = errors.New("blocked by PodDisruptionBudget, pod is covered by more than one PodDisruptionBudget")
+)
+
+// pdbPreCheck refuses the pods the eviction API would refuse to evict because of their
+// PodDisruptionBudgets instead of requesting the evictions
+type pdbPreCheck struct {
+	lister policylisters.PodDisruptionBudgetLister
+
+	mu sync.Mutex
+	// evicted counts the evictions of healthy pods per PodDisruptionBudget in the current cycle,
+	// the disruptionsAllowed of the PodDisruptionBudgets is not updated right away
+	evicted map[types.UID]int32
+}
+
+func newPDBPreCheck(lister policylisters.PodDisruptionBudgetLister) *pdbPreCheck {
+	return &pdbPreCheck{
+		lister:  lister,
+		evicted: make(map[types.UID]int32),
+	}
+}
+
+// check returns an error when the PodDisruptionBudgets covering the pod do not allow another disruption,
+// counting the evictions of the current cycle against the disruptions allowed
+func (p *pdbPreCheck) check(pod *v1.Pod) error {
+	pdbs, err := utils.GetPodPDBs(pod, p.lister)
+	if err != nil {
+		return fmt.Errorf("unable to list PodDisruptionBudgets of the pod: %w", err)
+	}
+	if len(pdbs) == 0 {
+		return nil
+	}
+	if len(pdbs) > 1 {
+		// the eviction API does not support pods covered by several PodDisruptionBudgets
+		klog.V(3).InfoS("Pod blocked by its PodDisruptionBudgets", "pod", klog.KObj(pod), "pdbs", len(pdbs))
+		return errMultiplePDBs
+	}
+
+	pdb := pdbs[0]
+	if !utils.IsPodReady(pod) && unhealthyPodEvictable(pdb) {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if allowed := pdb.Status.DisruptionsAllowed - p.evicted[pdb.UID]; allowed <= 0 {
+		klog.V(3).InfoS("Pod blocked by PodDisruptionBudget", "pod", klog.KObj(pod), "pdb", klog.KObj(pdb), "disruptionsAllowed", pdb.Status.DisruptionsAllowed, "evicted", p.evicted[pdb.UID])
+		return errBlockedByPDB
+	}
+	return nil
+}
+
+// podEvicted counts the eviction of the pod against its PodDisruptionBudget, the evictions
+// of the unhealthy pods do not use up the disruptions allowed
+func (p *pdbPreCheck) podEvicted(pod *v1.Pod) {
+	if !utils.IsPodReady(pod) {
+		return
+	}
+	pdbs, err := utils.GetPodPDBs(pod, p.lister)
+	if err != nil || len(pdbs) != 1 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evicted[pdbs[0].UID]++
+}
+
+// unhealthyPodEvictable tells whether the eviction API evicts an unhealthy pod covered by the
+// PodDisruptionBudget whatever disruptions it allows
+func unhealthyPodEvictable(pdb *policyv1.PodDisruptionBudget) bool {
+	if pdb.Spec.UnhealthyPodEvictionPolicy != nil && *pdb.Spec.UnhealthyPodEvictionPolicy == policyv1.AlwaysAllow {
+		return true
+	}
+	return pdb.Status.CurrentHealthy >= pdb.Status.DesiredHealthy
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestDefaultEvictorPDBPreCheck(t *testing.T) {
+	buildPod := func(name string, ready bool) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, "n1", func(pod *v1.Pod) {
+			test.SetNormalOwnerRef(pod)
+			pod.Labels = map[string]string{"app": "stream"}
+			status := v1.ConditionFalse
+			if ready {
+				status = v1.ConditionTrue
+			}
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: status}}
+		})
+	}
+	buildPDB := func(name string, allowed, healthy, desired int32, apply func(*policyv1.PodDisruptionBudget)) *policyv1.PodDisruptionBudget {
+		pdb := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name)},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "stream"}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{
+				DisruptionsAllowed: allowed,
+				CurrentHealthy:     healthy,
+				DesiredHealthy:     desired,
+				ExpectedPods:       3,
+			},
+		}
+		if apply != nil {
+			apply(pdb)
+		}
+		return pdb
+	}
+
+	testCases := []struct {
+		description string
+		preCheck    bool
+		pdbs        []*policyv1.PodDisruptionBudget
+		pod         *v1.Pod
+		// evicted lists the pods evicted in the cycle before the pod is checked
+		evicted []*v1.Pod
+		result  bool
+		reasons []string
+	}{
+		{
+			description: "PDB allowing a disruption, evicts",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 1, 3, 2, nil)},
+			pod:         buildPod("p1", true),
+			result:      true,
+		},
+		{
+			description: "PDB allowing no disruption, pod is not evicted",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 0, 2, 2, nil)},
+			pod:         buildPod("p1", true),
+			result:      false,
+			reasons:     []string{"blocked by PodDisruptionBudget, no disruption allowed"},
+		},
+		{
+			description: "disruption used up by an eviction of the cycle, pod is not evicted",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 1, 3, 2, nil)},
+			pod:         buildPod("p1", true),
+			evicted:     []*v1.Pod{buildPod("p2", true)},
+			result:      false,
+			reasons:     []string{"blocked by PodDisruptionBudget, no disruption allowed"},
+		},
+		{
+			description: "eviction of an unhealthy pod in the cycle does not use up the disruption, evicts",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 1, 3, 2, nil)},
+			pod:         buildPod("p1", true),
+			evicted:     []*v1.Pod{buildPod("p2", false)},
+			result:      true,
+		},
+		{
+			description: "unhealthy pod of a PDB with a healthy budget, evicts",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 0, 2, 2, nil)},
+			pod:         buildPod("p1", false),
+			result:      true,
+		},
+		{
+			description: "unhealthy pod of a PDB always allowing the eviction of unhealthy pods, evicts",
+			preCheck:    true,
+			pdbs: []*policyv1.PodDisruptionBudget{buildPDB("pdb", 0, 1, 2, func(pdb *policyv1.PodDisruptionBudget) {
+				pdb.Spec.UnhealthyPodEvictionPolicy = ptr.To(policyv1.AlwaysAllow)
+			})},
+			pod:    buildPod("p1", false),
+			result: true,
+		},
+		{
+			description: "unhealthy pod of a PDB with an unhealthy budget, pod is not evicted",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 0, 1, 2, nil)},
+			pod:         buildPod("p1", false),
+			result:      false,
+			reasons:     []string{"blocked by PodDisruptionBudget, no disruption allowed"},
+		},
+		{
+			description: "pod covered by two PDBs, pod is not evicted",
+			preCheck:    true,
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb1", 1, 3, 2, nil), buildPDB("pdb2", 1, 3, 2, nil)},
+			pod:         buildPod("p1", true),
+			result:      false,
+			reasons:     []string{"blocked by PodDisruptionBudget, pod is covered by more than one PodDisruptionBudget"},
+		},
+		{
+			description: "no PDB pre-check, evicts",
+			pdbs:        []*policyv1.PodDisruptionBudget{buildPDB("pdb", 0, 2, 2, nil)},
+			pod:         buildPod("p1", true),
+			result:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			fakeClient := fake.NewSimpleClientset()
+			for _, pdb := range tc.pdbs {
+				if _, err := fakeClient.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create(ctx, pdb, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("Unable to create the PDB: %v", err)
+				}
+			}
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				PDBPreCheck: tc.preCheck,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:             fakeClient,
+				SharedInformerFactoryImpl: sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			plugin := evictorPlugin.(frameworktypes.PluginFilterEvictorPlugin)
+			for _, pod := range tc.evicted {
+				plugin.PodEvicted("PodLifeTime", pod)
+			}
+			result := plugin.FilterForPlugin("PodLifeTime", tc.pod)
+			if result != tc.result {
+				t.Errorf("FilterForPlugin should return %t, but it returns %t", tc.result, result)
+			}
+			if reasons := evictorPlugin.(frameworktypes.ExplainingEvictorPlugin).FilterReasons(tc.pod); !reflect.DeepEqual(reasons, tc.reasons) {
+				t.Errorf("Expected the reasons %v, got %v", tc.reasons, reasons)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PolicyRules declares the RBAC policy rules of the listers, the kubelet stats and the soft evictions the plugin uses only when configured so
+func PolicyRules(args runtime.Object) []rbacv1.PolicyRule {
+	defaultEvictorArgs, ok := args.(*DefaultEvictorArgs)
+	if !ok {
+		return nil
+	}
+	watch := []string{"get", "watch", "list"}
+	var rules []rbacv1.PolicyRule
+	if defaultEvictorArgs.IgnorePvcPods && defaultEvictorArgs.PvcPods != nil {
+		rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"persistentvolumeclaims"}, Verbs: watch})
+		if defaultEvictorArgs.PvcPods.AttachedReadWriteOnceOnly {
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{"storage.k8s.io"}, Resources: []string{"volumeattachments"}, Verbs: watch})
+		}
+	}
+	if defaultEvictorArgs.LocalStoragePods != nil && defaultEvictorArgs.LocalStoragePods.MeasureEmptyDirUsage {
+		rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"nodes/proxy"}, Verbs: []string{"get"}})
+	}
+	if defaultEvictorArgs.BatchProtection != nil && defaultEvictorArgs.BatchProtection.JobProgress {
+		rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{"batch"}, Resources: []string{"jobs"}, Verbs: watch})
+	}
+	if softEviction := defaultEvictorArgs.SoftEviction; softEviction != nil {
+		rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"patch"}})
+		if softEviction.Mode == SoftEvictionTaint {
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "update"}})
+		}
+	}
+	return rules
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// RequestEvictAnnotationKey is set to the time of the request on the pods the disruption is requested for,
+// and on the nodes tainted in the Taint soft eviction mode
+const RequestEvictAnnotationKey = "descheduler.alpha.kubernetes.io/request-evict"
+
+// DefaultSoftEvictionTTL is the time after which a request of the disruption expires unless configured
+const DefaultSoftEvictionTTL = time.Hour
+
+// softEviction requests the disruption of the pods instead of evicting them
+type softEviction struct {
+	mode  SoftEvictionMode
+	taint v1.Taint
+	ttl   time.Duration
+}
+
+func newSoftEviction(config *SoftEviction) *softEviction {
+	taint := v1.Taint{Key: RequestEvictAnnotationKey, Effect: v1.TaintEffectNoSchedule}
+	if config.Taint != nil {
+		taint = *config.Taint
+	}
+	ttl := DefaultSoftEvictionTTL
+	if config.TTL != nil {
+		ttl = config.TTL.Duration
+	}
+	return &softEviction{
+		mode:  config.Mode,
+		taint: taint,
+		ttl:   ttl,
+	}
+}
+
+// RequestedAt returns the time of the request of the disruption recorded in the annotations of a pod
+// or a node, the zero time for a malformed record. False when no disruption is requested.
+func RequestedAt(annotations map[string]string) (time.Time, bool) {
+	value, ok := annotations[RequestEvictAnnotationKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	requestedAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, true
+	}
+	return requestedAt, true
+}
+
+// constraint refuses the pods the disruption is already requested for until the request expires
+func (s *softEviction) constraint(pod *v1.Pod) error {
+	if requestedAt, ok := RequestedAt(pod.Annotations); ok && time.Since(requestedAt) < s.ttl {
+		return fmt.Errorf("disruption of the pod already requested")
+	}
+	return nil
+}
+
+// request requests the disruption of the pod with the client of the eviction, the node of the pod is tainted
+// first in the Taint mode so a pod is never marked without its node tainted
+func (s *softEviction) request(ctx context.Context, client clientset.Interface, pod *v1.Pod) error {
+	requestedAt := time.Now().UTC().Format(time.RFC3339)
+	if s.mode == SoftEvictionTaint {
+		if err := s.taintNode(ctx, client, pod, requestedAt); err != nil {
+			return err
+		}
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{RequestEvictAnnotationKey: requestedAt},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := client.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to annotate the pod: %v", err)
+	}
+	klog.V(3).InfoS("Requested the disruption of the pod", "pod", klog.KObj(pod), "annotation", RequestEvictAnnotationKey)
+	return nil
+}
+
+// taintNode taints the node of the pod and records the time of the request in the node annotation, which marks
+// the taint as applied by the soft eviction. A taint applied by anyone else is left untouched.
+func (s *softEviction) taintNode(ctx context.Context, client clientset.Interface, pod *v1.Pod, requestedAt string) error {
+	if pod.Spec.NodeName == "" {
+		return fmt.Errorf("pod is not assigned to a node to taint")
+	}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := client.CoreV1().Nodes().Get(ctx, pod.Spec.NodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		_, owned := node.Annotations[RequestEvictAnnotationKey]
+		tainted := hasTaint(node, s.taint)
+		if tainted && !owned {
+			return nil
+		}
+		if !tainted {
+			node.Spec.Taints = append(node.Spec.Taints, s.taint)
+		}
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[RequestEvictAnnotationKey] = requestedAt
+		_, err = client.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to taint the node %s: %v", pod.Spec.NodeName, err)
+	}
+	klog.V(3).InfoS("Tainted the node of the pod the disruption is requested for", "pod", klog.KObj(pod), "node", pod.Spec.NodeName, "taint", s.taint.Key)
+	return nil
+}
+
+// hasTaint checks the node carries a taint of the key and the effect of the given taint
+func hasTaint(node *v1.Node, taint v1.Taint) bool {
+	for _, nodeTaint := range node.Spec.Taints {
+		if nodeTaint.Key == taint.Key && nodeTaint.Effect == taint.Effect {
+			return true
+		}
+	}
+	return false
+}
+
+// SoftEvict requests the disruption of the pod when the soft eviction is configured
+func (d *DefaultEvictor) SoftEvict(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error) {
+	if d.softEviction == nil {
+		return false, nil
+	}
+	if err := d.softEviction.request(ctx, client, pod); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CleanupSoftEvictionMarks removes the stale marks of the soft evictions configured by the profiles: the annotations
+// of the pods whose requests expired, and the taints with the node annotations of the nodes none of whose pods carries
+// a request in force any longer, e.g. once the requested pods are gone. The requests expire after the longest ttl.
+func CleanupSoftEvictionMarks(ctx context.Context, client clientset.Interface, pods []*v1.Pod, nodes []*v1.Node, configs []*SoftEviction, now time.Time) error {
+	if len(configs) == 0 {
+		return nil
+	}
+	var ttl time.Duration
+	// The default taint is removed whatever the mode, a profile may have switched from the Taint mode
+	taints := []v1.Taint{{Key: RequestEvictAnnotationKey, Effect: v1.TaintEffectNoSchedule}}
+	for _, config := range configs {
+		softEviction := newSoftEviction(config)
+		ttl = max(ttl, softEviction.ttl)
+		if softEviction.mode == SoftEvictionTaint {
+			taints = append(taints, softEviction.taint)
+		}
+	}
+
+	var errs []error
+	requestedNodes := sets.New[string]()
+	for _, pod := range pods {
+		requestedAt, ok := RequestedAt(pod.Annotations)
+		if !ok {
+			continue
+		}
+		if now.Sub(requestedAt) >= ttl {
+			if err := removePodMark(ctx, client, pod); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if pod.DeletionTimestamp == nil && pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed {
+			requestedNodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	for _, node := range nodes {
+		if _, owned := node.Annotations[RequestEvictAnnotationKey]; !owned || requestedNodes.Has(node.Name) {
+			continue
+		}
+		if err := removeNodeMark(ctx, client, node.Name, taints); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func removePodMark(ctx context.Context, client clientset.Interface, pod *v1.Pod) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{RequestEvictAnnotationKey: nil},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := client.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to remove the expired request of the disruption of the pod %s: %v", klog.KObj(pod), err)
+	}
+	klog.V(3).InfoS("Removed the expired request of the disruption of the pod", "pod", klog.KObj(pod))
+	return nil
+}
+
+func removeNodeMark(ctx context.Context, client clientset.Interface, nodeName string, taints []v1.Taint) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, owned := node.Annotations[RequestEvictAnnotationKey]; !owned {
+			return nil
+		}
+		delete(node.Annotations, RequestEvictAnnotationKey)
+		var nodeTaints []v1.Taint
+		for _, nodeTaint := range node.Spec.Taints {
+			stale := false
+			for _, taint := range taints {
+				if nodeTaint.Key == taint.Key && nodeTaint.Effect == taint.Effect {
+					stale = true
+					break
+				}
+			}
+			if !stale {
+				nodeTaints = append(nodeTaints, nodeTaint)
+			}
+		}
+		node.Spec.Taints = nodeTaints
+		_, err = client.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to remove the stale soft eviction taint of the node %s: %v", nodeName, err)
+	}
+	klog.V(3).InfoS("Removed the soft eviction taint of the node, no disruption of its pods is requested any longer", "node", nodeName)
+	return nil
+}
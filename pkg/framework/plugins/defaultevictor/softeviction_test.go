@@ -0,0 +1,343 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultevictor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestDefaultEvictorSoftEviction(t *testing.T) {
+	requestedTaint := v1.Taint{Key: RequestEvictAnnotationKey, Effect: v1.TaintEffectNoSchedule}
+	drainTaint := v1.Taint{Key: "example.com/drain", Effect: v1.TaintEffectPreferNoSchedule}
+	recently := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	longAgo := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+
+	testCases := []struct {
+		description     string
+		softEviction    *SoftEviction
+		podApply        func(*v1.Pod)
+		nodeApply       func(*v1.Node)
+		filter          bool
+		requested       bool
+		expectTaints    []v1.Taint
+		expectNodeOwned bool
+	}{
+		{
+			description: "no soft eviction, evicts through the Eviction API",
+			filter:      true,
+		},
+		{
+			description:  "annotation requested",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation},
+			filter:       true,
+			requested:    true,
+		},
+		{
+			description:  "annotation already requested, pod is not evicted again",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation},
+			podApply: func(pod *v1.Pod) {
+				pod.Annotations = map[string]string{RequestEvictAnnotationKey: recently}
+			},
+			filter: false,
+		},
+		{
+			description:  "annotation request expired, requested again",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation},
+			podApply: func(pod *v1.Pod) {
+				pod.Annotations = map[string]string{RequestEvictAnnotationKey: longAgo}
+			},
+			filter:    true,
+			requested: true,
+		},
+		{
+			description:  "annotation request within the configured ttl",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation, TTL: &metav1.Duration{Duration: 3 * time.Hour}},
+			podApply: func(pod *v1.Pod) {
+				pod.Annotations = map[string]string{RequestEvictAnnotationKey: longAgo}
+			},
+			filter: false,
+		},
+		{
+			description:     "default taint applied to the node",
+			softEviction:    &SoftEviction{Mode: SoftEvictionTaint},
+			filter:          true,
+			requested:       true,
+			expectTaints:    []v1.Taint{requestedTaint},
+			expectNodeOwned: true,
+		},
+		{
+			description:     "configured taint applied to the node",
+			softEviction:    &SoftEviction{Mode: SoftEvictionTaint, Taint: &drainTaint},
+			filter:          true,
+			requested:       true,
+			expectTaints:    []v1.Taint{drainTaint},
+			expectNodeOwned: true,
+		},
+		{
+			description:  "node already tainted for another pod, the other pods of the node are not refused",
+			softEviction: &SoftEviction{Mode: SoftEvictionTaint},
+			nodeApply: func(node *v1.Node) {
+				node.Spec.Taints = []v1.Taint{requestedTaint}
+				node.Annotations = map[string]string{RequestEvictAnnotationKey: longAgo}
+			},
+			filter:          true,
+			requested:       true,
+			expectTaints:    []v1.Taint{requestedTaint},
+			expectNodeOwned: true,
+		},
+		{
+			description:  "node tainted by someone else, the taint is left untouched",
+			softEviction: &SoftEviction{Mode: SoftEvictionTaint, Taint: &drainTaint},
+			nodeApply: func(node *v1.Node) {
+				node.Spec.Taints = []v1.Taint{drainTaint}
+			},
+			filter:       true,
+			requested:    true,
+			expectTaints: []v1.Taint{drainTaint},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			node := test.BuildTestNode("n1", 2000, 3000, 10, tc.nodeApply)
+			pod := test.BuildTestPod("p1", 100, 0, node.Name, func(pod *v1.Pod) {
+				test.SetNormalOwnerRef(pod)
+				if tc.podApply != nil {
+					tc.podApply(pod)
+				}
+			})
+			fakeClient := fake.NewSimpleClientset(node, pod)
+			sharedInformerFactory := informers.NewSharedInformerFactory(fakeClient, 0)
+			evictorPlugin, err := New(&DefaultEvictorArgs{
+				SoftEviction: tc.softEviction,
+			}, &frameworkfake.HandleImpl{
+				ClientsetImpl:             fakeClient,
+				SharedInformerFactoryImpl: sharedInformerFactory,
+			})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			sharedInformerFactory.Start(ctx.Done())
+			sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+			if got := evictorPlugin.(frameworktypes.EvictorPlugin).Filter(pod); got != tc.filter {
+				t.Fatalf("Expected the filter to return %v, got %v", tc.filter, got)
+			}
+			if !tc.filter {
+				return
+			}
+
+			// The disruption is requested with the client of the eviction, not the client of the handle
+			evictionClient := fake.NewSimpleClientset(node, pod)
+			requested, err := evictorPlugin.(frameworktypes.SoftEvictorPlugin).SoftEvict(ctx, evictionClient, pod)
+			if err != nil {
+				t.Fatalf("Unexpected error when requesting the disruption of the pod: %v", err)
+			}
+			if requested != tc.requested {
+				t.Fatalf("Expected the disruption requested to be %v, got %v", tc.requested, requested)
+			}
+			for _, action := range fakeClient.Actions() {
+				if action.GetVerb() != "list" && action.GetVerb() != "watch" {
+					t.Errorf("Expected no requests of the disruption through the client of the handle, got %v", action)
+				}
+			}
+
+			updatedPod, err := evictionClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unable to get the pod: %v", err)
+			}
+			requestedAt, annotated := RequestedAt(updatedPod.Annotations)
+			if annotated != tc.requested {
+				t.Errorf("Expected the pod annotated to be %v, got %v", tc.requested, annotated)
+			}
+			if tc.requested && time.Since(requestedAt) > time.Minute {
+				t.Errorf("Expected the pod annotated with the time of the request, got %v", requestedAt)
+			}
+
+			updatedNode, err := evictionClient.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unable to get the node: %v", err)
+			}
+			if len(updatedNode.Spec.Taints) != len(tc.expectTaints) {
+				t.Fatalf("Expected the node taints %v, got %v", tc.expectTaints, updatedNode.Spec.Taints)
+			}
+			for i, taint := range tc.expectTaints {
+				got := updatedNode.Spec.Taints[i]
+				if got.Key != taint.Key || got.Effect != taint.Effect {
+					t.Errorf("Expected the node taint %v, got %v", taint, got)
+				}
+			}
+			if nodeRequestedAt, owned := RequestedAt(updatedNode.Annotations); owned != tc.expectNodeOwned || (owned && time.Since(nodeRequestedAt) > time.Minute) {
+				t.Errorf("Expected the node annotated with the time of the request to be %v, got %v", tc.expectNodeOwned, updatedNode.Annotations)
+			}
+		})
+	}
+}
+
+func TestValidateSoftEviction(t *testing.T) {
+	testCases := []struct {
+		description  string
+		softEviction *SoftEviction
+		valid        bool
+	}{
+		{
+			description:  "annotation",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation, TTL: &metav1.Duration{Duration: time.Minute}},
+			valid:        true,
+		},
+		{
+			description:  "annotation with a taint",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation, Taint: &v1.Taint{Key: "example.com/drain", Effect: v1.TaintEffectNoSchedule}},
+		},
+		{
+			description:  "taint",
+			softEviction: &SoftEviction{Mode: SoftEvictionTaint, Taint: &v1.Taint{Key: "example.com/drain", Effect: v1.TaintEffectPreferNoSchedule}},
+			valid:        true,
+		},
+		{
+			description:  "NoExecute taint evicting every pod of the node",
+			softEviction: &SoftEviction{Mode: SoftEvictionTaint, Taint: &v1.Taint{Key: "example.com/drain", Effect: v1.TaintEffectNoExecute}},
+		},
+		{
+			description:  "invalid taint key",
+			softEviction: &SoftEviction{Mode: SoftEvictionTaint, Taint: &v1.Taint{Key: "drain?", Effect: v1.TaintEffectNoSchedule}},
+		},
+		{
+			description:  "non-positive ttl",
+			softEviction: &SoftEviction{Mode: SoftEvictionAnnotation, TTL: &metav1.Duration{}},
+		},
+		{
+			description:  "unknown mode",
+			softEviction: &SoftEviction{Mode: "Delete"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := validateSoftEviction(tc.softEviction)
+			if (err == nil) != tc.valid {
+				t.Errorf("Expected the soft eviction valid to be %v, got %v", tc.valid, err)
+			}
+		})
+	}
+}
+
+func TestCleanupSoftEvictionMarks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	recently := now.Add(-time.Minute).UTC().Format(time.RFC3339)
+	longAgo := now.Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	requestedTaint := v1.Taint{Key: RequestEvictAnnotationKey, Effect: v1.TaintEffectNoSchedule}
+	drainTaint := v1.Taint{Key: "example.com/drain", Effect: v1.TaintEffectPreferNoSchedule}
+	otherTaint := v1.Taint{Key: "example.com/other", Effect: v1.TaintEffectNoSchedule}
+
+	node := func(name string, owned bool, taints ...v1.Taint) *v1.Node {
+		return test.BuildTestNode(name, 2000, 3000, 10, func(node *v1.Node) {
+			node.Spec.Taints = taints
+			if owned {
+				node.Annotations = map[string]string{RequestEvictAnnotationKey: longAgo}
+			}
+		})
+	}
+	pod := func(name, nodeName, requestedAt string, apply func(*v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, nodeName, func(pod *v1.Pod) {
+			if requestedAt != "" {
+				pod.Annotations = map[string]string{RequestEvictAnnotationKey: requestedAt}
+			}
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+	nodes := []*v1.Node{
+		// n1 still runs a pod the disruption is requested for
+		node("n1", true, requestedTaint),
+		// the requested pod of n2 is gone
+		node("n2", true, drainTaint, otherTaint),
+		// the requested pod of n3 is terminating
+		node("n3", true, requestedTaint),
+		// n4 was tainted by someone else
+		node("n4", false, drainTaint),
+	}
+	pods := []*v1.Pod{
+		pod("p1", "n1", recently, nil),
+		pod("p2", "n2", longAgo, nil),
+		pod("p3", "n3", recently, func(pod *v1.Pod) { pod.Status.Phase = v1.PodSucceeded }),
+		pod("p4", "n4", "", nil),
+	}
+	var objects []runtime.Object
+	for _, node := range nodes {
+		objects = append(objects, node)
+	}
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objects...)
+
+	configs := []*SoftEviction{{Mode: SoftEvictionAnnotation}, {Mode: SoftEvictionTaint, Taint: &drainTaint}}
+	if err := CleanupSoftEvictionMarks(ctx, fakeClient, pods, nodes, configs, now); err != nil {
+		t.Fatalf("Unexpected error when removing the stale marks: %v", err)
+	}
+
+	expectedPodMarks := map[string]bool{"p1": true, "p2": false, "p3": true, "p4": false}
+	for name, expected := range expectedPodMarks {
+		updatedPod, err := fakeClient.CoreV1().Pods("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unable to get the pod: %v", err)
+		}
+		if _, marked := RequestedAt(updatedPod.Annotations); marked != expected {
+			t.Errorf("Expected the pod %s marked to be %v, got %v", name, expected, marked)
+		}
+	}
+
+	expectedNodes := map[string]struct {
+		owned  bool
+		taints []v1.Taint
+	}{
+		"n1": {owned: true, taints: []v1.Taint{requestedTaint}},
+		"n2": {taints: []v1.Taint{otherTaint}},
+		"n3": {},
+		"n4": {taints: []v1.Taint{drainTaint}},
+	}
+	for name, expected := range expectedNodes {
+		updatedNode, err := fakeClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unable to get the node: %v", err)
+		}
+		if _, owned := RequestedAt(updatedNode.Annotations); owned != expected.owned {
+			t.Errorf("Expected the node %s annotated to be %v, got %v", name, expected.owned, owned)
+		}
+		if !reflect.DeepEqual(updatedNode.Spec.Taints, expected.taints) {
+			t.Errorf("Expected the node %s taints %v, got %v", name, expected.taints, updatedNode.Spec.Taints)
+		}
+	}
+}
@@ -14,6 +14,8 @@ limitations under the License.
 package defaultevictor
 
 import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/descheduler/pkg/api"
 )
@@ -37,4 +39,194 @@ type DefaultEvictorArgs struct {
 	MinReplicas             uint                   `json:"minReplicas,omitempty"`
 	MinPodAge               *metav1.Duration       `json:"minPodAge,omitempty"`
 	IgnorePodsWithoutPDB    bool                   `json:"ignorePodsWithoutPDB,omitempty"`
+	// PluginOverrides relax the filtering of pods bound to their nodes per plugin
+	PluginOverrides []PluginOverride `json:"pluginOverrides,omitempty"`
+	// BarePods configures the eviction of pods without owner references.
+	// Only one of evictFailedBarePods and barePods can be set.
+	BarePods *BarePodsPolicy `json:"barePods,omitempty"`
+	// PvcPods limits the pods ignored through ignorePvcPods to the pods with matching PVCs.
+	// It can be set only together with ignorePvcPods.
+	PvcPods *PvcPodsPolicy `json:"pvcPods,omitempty"`
+	// LocalStoragePods limits the pods with local storage ignored while evictLocalStoragePods is not set
+	// to the pods with large emptyDir volumes. It can be set only while evictLocalStoragePods is not set.
+	LocalStoragePods *LocalStoragePodsPolicy `json:"localStoragePods,omitempty"`
+	// NodeFitExtender consults a scheduler extender about the nodes the pod fits.
+	// It can be set only together with nodeFit.
+	NodeFitExtender *NodeFitExtender `json:"nodeFitExtender,omitempty"`
+	// SchedulerNodeFit configures the node fit check of the pods scheduled by the given schedulers.
+	// It can be set only together with nodeFit.
+	SchedulerNodeFit []SchedulerNodeFit `json:"schedulerNodeFit,omitempty"`
+	// BatchProtection protects the batch pods close to completion from eviction
+	BatchProtection *BatchProtection `json:"batchProtection,omitempty"`
+	// PDBPacing spreads the evictions of the pods covered by a PodDisruptionBudget over time
+	PDBPacing *PDBPacing `json:"pdbPacing,omitempty"`
+	// PDBPreCheck refuses the pods covered by a PodDisruptionBudget allowing no more disruptions before
+	// requesting the evictions, counting the evictions of the cycle against the disruptions allowed
+	PDBPreCheck bool `json:"pdbPreCheck,omitempty"`
+	// IgnoreControlPlanePods never evicts the descheduler's own pod, the pods in the controlPlaneNamespaces
+	// and the pods assigned to the nodes with the node-role.kubernetes.io/control-plane label
+	IgnoreControlPlanePods bool `json:"ignoreControlPlanePods,omitempty"`
+	// ControlPlaneNamespaces holds the namespaces of the control-plane components. Defaults to kube-system.
+	// It can be set only together with ignoreControlPlanePods.
+	ControlPlaneNamespaces []string `json:"controlPlaneNamespaces,omitempty"`
+	// SoftEviction requests the disruption of the pods from an external controller or the owners of the
+	// workloads instead of evicting the pods through the Eviction API
+	SoftEviction *SoftEviction `json:"softEviction,omitempty"`
+}
+
+// SoftEvictionMode sets how the disruption of a pod is requested
+type SoftEvictionMode string
+
+const (
+	// SoftEvictionAnnotation annotates the pod with the descheduler.alpha.kubernetes.io/request-evict annotation
+	// set to the time of the request
+	SoftEvictionAnnotation SoftEvictionMode = "Annotation"
+	// SoftEvictionTaint applies the taint to the node of the pod, the pod is annotated as in the Annotation mode
+	SoftEvictionTaint SoftEvictionMode = "Taint"
+)
+
+// +k8s:deepcopy-gen=true
+
+// SoftEviction requests the disruption of the pods instead of evicting them, the pods the disruption
+// is already requested for are not evicted again until the request expires
+type SoftEviction struct {
+	Mode SoftEvictionMode `json:"mode"`
+	// Taint applied to the node of the pod in the Taint mode. Defaults to the
+	// descheduler.alpha.kubernetes.io/request-evict key with the NoSchedule effect.
+	Taint *v1.Taint `json:"taint,omitempty"`
+	// TTL after which a request of the disruption expires and the pod can be processed again. Defaults to 1h.
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// PDBPacing spreads the evictions of the pods covered by a PodDisruptionBudget over time instead of
+// using up the whole disruption budget at once. A pod is evicted only once the PodDisruptionBudgets covering
+// the pod recovered from the previous disruptions, i.e. no disruption is pending and all the other pods are healthy.
+type PDBPacing struct {
+	// MaxEvictionsPerCycle limits the evictions of the pods covered by a PodDisruptionBudget
+	// in a descheduling cycle. Defaults to 1.
+	MaxEvictionsPerCycle *uint `json:"maxEvictionsPerCycle,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// BatchProtection protects the batch pods close to completion from eviction, so long-running
+// batch pods are not killed by the strategies shortly before they complete.
+type BatchProtection struct {
+	// ProgressAnnotation is the pod annotation holding the progress of the pod as a percentage, e.g. "85" or "85%"
+	ProgressAnnotation string `json:"progressAnnotation,omitempty"`
+	// JobProgress computes the progress of the pods owned by a Job from the succeeded completions of the Job
+	JobProgress bool `json:"jobProgress,omitempty"`
+	// MinProgressPercentage protects the pods with at least the given progress.
+	// Required with progressAnnotation or jobProgress.
+	MinProgressPercentage *int32 `json:"minProgressPercentage,omitempty"`
+	// MinRuntime protects the pods owned by a Job running for at least the given duration
+	MinRuntime *metav1.Duration `json:"minRuntime,omitempty"`
+}
+
+// NodeFitMode sets how the node fit of a pod is checked
+type NodeFitMode string
+
+const (
+	// NodeFitDefault checks the node fit the same way as for the pods scheduled by the default scheduler
+	NodeFitDefault NodeFitMode = "Default"
+	// NodeFitExtenderMode filters the nodes the pod fits by the extender of the scheduler
+	NodeFitExtenderMode NodeFitMode = "Extender"
+	// NodeFitAssumeFeasible skips the node fit check, the pod is assumed to fit another node
+	NodeFitAssumeFeasible NodeFitMode = "AssumeFeasible"
+	// NodeFitAssumeInfeasible skips the node fit check, the pod is assumed to fit no other node
+	NodeFitAssumeInfeasible NodeFitMode = "AssumeInfeasible"
+)
+
+// +k8s:deepcopy-gen=true
+
+// SchedulerNodeFit configures the node fit check of the pods scheduled by a scheduler other than
+// the default one, as the node fit check does not reflect the constraints of such schedulers
+type SchedulerNodeFit struct {
+	// SchedulerName matches the schedulerName of the pods
+	SchedulerName string      `json:"schedulerName"`
+	Mode          NodeFitMode `json:"mode"`
+	// Extender of the scheduler, required with the Extender mode
+	Extender *NodeFitExtender `json:"extender,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// NodeFitExtender configures a scheduler extender the nodes a pod fits according to nodeFit are filtered by.
+// The extender is called the same way kube-scheduler calls the filter verb of its extenders.
+type NodeFitExtender struct {
+	// URLPrefix at which the extender is available, e.g. http://127.0.0.1:12346/scheduler
+	URLPrefix string `json:"urlPrefix"`
+	// FilterVerb is appended to the URLPrefix when calling the filter of the extender. Defaults to filter.
+	FilterVerb string `json:"filterVerb,omitempty"`
+	// NodeCacheCapable sends the node names only instead of the full nodes, as the extender caches the nodes
+	NodeCacheCapable bool `json:"nodeCacheCapable,omitempty"`
+	// Timeout of a call to the extender. Defaults to 5s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Ignorable lets the pods be evicted when the extender fails, otherwise the pods are not evicted
+	Ignorable bool `json:"ignorable,omitempty"`
+}
+
+// PluginOverride lets the Filter extension point pass pods bound to their nodes
+// (DaemonSet, mirror and static pods) to the given plugin so the plugin can report them.
+// The pods are never evicted.
+type PluginOverride struct {
+	// Name of the plugin
+	Name                string `json:"name"`
+	ReportDaemonSetPods bool   `json:"reportDaemonSetPods,omitempty"`
+	ReportMirrorPods    bool   `json:"reportMirrorPods,omitempty"`
+	ReportStaticPods    bool   `json:"reportStaticPods,omitempty"`
+}
+
+// BarePodsEvictionMode sets which pods without owner references can be evicted
+type BarePodsEvictionMode string
+
+const (
+	// BarePodsEvictionNever never evicts pods without owner references
+	BarePodsEvictionNever BarePodsEvictionMode = "Never"
+	// BarePodsEvictionFailedOnly evicts pods without owner references in failed phase only
+	BarePodsEvictionFailedOnly BarePodsEvictionMode = "FailedOnly"
+	// BarePodsEvictionAlways evicts pods without owner references in any phase
+	BarePodsEvictionAlways BarePodsEvictionMode = "Always"
+)
+
+// +k8s:deepcopy-gen=true
+
+// BarePodsPolicy configures the eviction of pods without owner references.
+// Bare pods are not recreated once evicted.
+type BarePodsPolicy struct {
+	Mode BarePodsEvictionMode `json:"mode,omitempty"`
+	// Namespaces limits the bare pods the mode applies to, bare pods in other namespaces are never evicted
+	Namespaces *api.Namespaces `json:"namespaces,omitempty"`
+	// LabelSelector limits the bare pods the mode applies to, other bare pods are never evicted
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// LocalStoragePodsPolicy limits the pods ignored for their local storage to the pods with hostPath volumes
+// or emptyDir volumes larger than the threshold. Pods with small emptyDir volumes only stay evictable.
+type LocalStoragePodsPolicy struct {
+	// EmptyDirSizeThreshold is the largest size of an emptyDir volume the pod stays evictable with.
+	// An emptyDir volume is sized by its sizeLimit, volumes without a sizeLimit are larger than any threshold.
+	EmptyDirSizeThreshold *resource.Quantity `json:"emptyDirSizeThreshold,omitempty"`
+	// MeasureEmptyDirUsage sizes the emptyDir volumes by their usage measured by the kubelet,
+	// the sizeLimit still sizes the volumes without a measured usage
+	MeasureEmptyDirUsage bool `json:"measureEmptyDirUsage,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// PvcPodsPolicy limits the pods ignored through ignorePvcPods to the pods with PVCs matching the policy.
+// Pods with other PVCs stay evictable.
+type PvcPodsPolicy struct {
+	// IgnoredStorageClasses limits the ignored PVCs to the PVCs of the given storage classes
+	IgnoredStorageClasses []string `json:"ignoredStorageClasses,omitempty"`
+	// EvictableStorageClasses excludes the PVCs of the given storage classes from the ignored PVCs.
+	// Only one of ignoredStorageClasses and evictableStorageClasses can be set.
+	EvictableStorageClasses []string `json:"evictableStorageClasses,omitempty"`
+	// AttachedReadWriteOnceOnly limits the ignored PVCs to the ReadWriteOnce(Pod) PVCs
+	// bound to a volume currently attached to a node
+	AttachedReadWriteOnceOnly bool `json:"attachedReadWriteOnceOnly,omitempty"`
 }
@@ -15,17 +15,152 @@ package defaultevictor
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 
 	"k8s.io/klog/v2"
 
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 func ValidateDefaultEvictorArgs(obj runtime.Object) error {
 	args := obj.(*DefaultEvictorArgs)
 
-	if args.PriorityThreshold != nil && args.PriorityThreshold.Value != nil && len(args.PriorityThreshold.Name) > 0 {
-		return fmt.Errorf("priority threshold misconfigured, only one of priorityThreshold fields can be set, got %v", args)
+	if args.PriorityThreshold != nil {
+		set := 0
+		if args.PriorityThreshold.Value != nil {
+			set++
+		}
+		if len(args.PriorityThreshold.Name) > 0 {
+			set++
+		}
+		if args.PriorityThreshold.LabelSelector != nil {
+			set++
+			if _, err := metav1.LabelSelectorAsSelector(args.PriorityThreshold.LabelSelector); err != nil {
+				return fmt.Errorf("failed to get priority threshold label selector from %+v: %v", args.PriorityThreshold.LabelSelector, err)
+			}
+		}
+		if set > 1 {
+			return fmt.Errorf("priority threshold misconfigured, only one of priorityThreshold fields can be set, got %v", args)
+		}
+	}
+
+	if args.BarePods != nil {
+		if args.EvictFailedBarePods {
+			return fmt.Errorf("only one of evictFailedBarePods and barePods can be set")
+		}
+		switch args.BarePods.Mode {
+		case BarePodsEvictionNever, BarePodsEvictionFailedOnly, BarePodsEvictionAlways:
+		default:
+			return fmt.Errorf("bare pods mode %q not supported, expected one of %q, %q, %q", args.BarePods.Mode, BarePodsEvictionNever, BarePodsEvictionFailedOnly, BarePodsEvictionAlways)
+		}
+		if err := utils.ValidateNamespaces(args.BarePods.Namespaces); err != nil {
+			return fmt.Errorf("%v in the bare pods policy", err)
+		}
+		if args.BarePods.LabelSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(args.BarePods.LabelSelector); err != nil {
+				return fmt.Errorf("failed to get bare pods label selector from %+v: %v", args.BarePods.LabelSelector, err)
+			}
+		}
+	}
+
+	if args.PvcPods != nil {
+		if !args.IgnorePvcPods {
+			return fmt.Errorf("pvcPods can be set only together with ignorePvcPods")
+		}
+		if len(args.PvcPods.IgnoredStorageClasses) > 0 && len(args.PvcPods.EvictableStorageClasses) > 0 {
+			return fmt.Errorf("only one of ignoredStorageClasses and evictableStorageClasses can be set in the PVC pods policy")
+		}
+	}
+
+	if args.LocalStoragePods != nil {
+		if args.EvictLocalStoragePods {
+			return fmt.Errorf("localStoragePods can be set only while evictLocalStoragePods is not set")
+		}
+		if threshold := args.LocalStoragePods.EmptyDirSizeThreshold; threshold == nil || threshold.Sign() <= 0 {
+			return fmt.Errorf("local storage pods policy emptyDirSizeThreshold must be positive")
+		}
+	}
+
+	if len(args.ControlPlaneNamespaces) > 0 {
+		if !args.IgnoreControlPlanePods {
+			return fmt.Errorf("controlPlaneNamespaces can be set only together with ignoreControlPlanePods")
+		}
+		for _, namespace := range args.ControlPlaneNamespaces {
+			if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+				return fmt.Errorf("control-plane namespace %q is not a valid namespace name: %s", namespace, strings.Join(errs, ", "))
+			}
+		}
+	}
+
+	if args.BatchProtection != nil {
+		if err := validateBatchProtection(args.BatchProtection); err != nil {
+			return err
+		}
+	}
+
+	if args.PDBPacing != nil && args.PDBPacing.MaxEvictionsPerCycle != nil && *args.PDBPacing.MaxEvictionsPerCycle == 0 {
+		return fmt.Errorf("PDB pacing maxEvictionsPerCycle must be positive")
+	}
+
+	if args.SoftEviction != nil {
+		if err := validateSoftEviction(args.SoftEviction); err != nil {
+			return err
+		}
+	}
+
+	if args.NodeFitExtender != nil {
+		if !args.NodeFit {
+			return fmt.Errorf("nodeFitExtender can be set only together with nodeFit")
+		}
+		if err := validateNodeFitExtender(args.NodeFitExtender); err != nil {
+			return err
+		}
+	}
+
+	schedulers := map[string]bool{}
+	for _, nodeFit := range args.SchedulerNodeFit {
+		if !args.NodeFit {
+			return fmt.Errorf("schedulerNodeFit can be set only together with nodeFit")
+		}
+		if nodeFit.SchedulerName == "" {
+			return fmt.Errorf("scheduler node fit is missing the scheduler name")
+		}
+		if schedulers[nodeFit.SchedulerName] {
+			return fmt.Errorf("node fit of scheduler %q configured more than once", nodeFit.SchedulerName)
+		}
+		schedulers[nodeFit.SchedulerName] = true
+		switch nodeFit.Mode {
+		case NodeFitDefault, NodeFitAssumeFeasible, NodeFitAssumeInfeasible:
+			if nodeFit.Extender != nil {
+				return fmt.Errorf("extender of scheduler %q can be set only with the %q mode", nodeFit.SchedulerName, NodeFitExtenderMode)
+			}
+		case NodeFitExtenderMode:
+			if nodeFit.Extender == nil {
+				return fmt.Errorf("extender of scheduler %q is required with the %q mode", nodeFit.SchedulerName, NodeFitExtenderMode)
+			}
+			if err := validateNodeFitExtender(nodeFit.Extender); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("node fit mode %q of scheduler %q not supported, expected one of %q, %q, %q, %q", nodeFit.Mode, nodeFit.SchedulerName, NodeFitDefault, NodeFitExtenderMode, NodeFitAssumeFeasible, NodeFitAssumeInfeasible)
+		}
+	}
+
+	overridden := map[string]bool{}
+	for _, override := range args.PluginOverrides {
+		if override.Name == "" {
+			return fmt.Errorf("plugin override is missing the plugin name")
+		}
+		if overridden[override.Name] {
+			return fmt.Errorf("plugin %q overridden more than once", override.Name)
+		}
+		overridden[override.Name] = true
 	}
 
 	if args.MinReplicas == 1 {
@@ -34,3 +169,62 @@ func ValidateDefaultEvictorArgs(obj runtime.Object) error {
 
 	return nil
 }
+
+func validateNodeFitExtender(extender *NodeFitExtender) error {
+	u, err := url.Parse(extender.URLPrefix)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("node fit extender urlPrefix %q is not a valid http(s) URL", extender.URLPrefix)
+	}
+	if extender.Timeout != nil && extender.Timeout.Duration <= 0 {
+		return fmt.Errorf("node fit extender timeout must be positive")
+	}
+	return nil
+}
+
+func validateBatchProtection(protection *BatchProtection) error {
+	progress := protection.ProgressAnnotation != "" || protection.JobProgress
+	if !progress && protection.MinRuntime == nil {
+		return fmt.Errorf("batch protection requires progressAnnotation, jobProgress or minRuntime to be set")
+	}
+	if progress {
+		if protection.MinProgressPercentage == nil {
+			return fmt.Errorf("batch protection minProgressPercentage is required with progressAnnotation or jobProgress")
+		}
+		if *protection.MinProgressPercentage < 0 || *protection.MinProgressPercentage > 100 {
+			return fmt.Errorf("batch protection minProgressPercentage must be in the range of [0, 100]")
+		}
+	} else if protection.MinProgressPercentage != nil {
+		return fmt.Errorf("batch protection minProgressPercentage can be set only together with progressAnnotation or jobProgress")
+	}
+	if protection.MinRuntime != nil && protection.MinRuntime.Duration <= 0 {
+		return fmt.Errorf("batch protection minRuntime must be positive")
+	}
+	return nil
+}
+
+func validateSoftEviction(softEviction *SoftEviction) error {
+	switch softEviction.Mode {
+	case SoftEvictionAnnotation:
+		if softEviction.Taint != nil {
+			return fmt.Errorf("soft eviction taint can be set only in the %s mode", SoftEvictionTaint)
+		}
+	case SoftEvictionTaint:
+		if taint := softEviction.Taint; taint != nil {
+			if errs := validation.IsQualifiedName(taint.Key); len(errs) > 0 {
+				return fmt.Errorf("soft eviction taint key %q is not valid: %s", taint.Key, strings.Join(errs, ", "))
+			}
+			// A NoExecute taint would evict every pod of the node, not only the pod the disruption is requested for
+			switch taint.Effect {
+			case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule:
+			default:
+				return fmt.Errorf("soft eviction taint effect %q not supported, expected one of %q, %q", taint.Effect, v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule)
+			}
+		}
+	default:
+		return fmt.Errorf("soft eviction mode %q not supported, expected one of %q, %q", softEviction.Mode, SoftEvictionAnnotation, SoftEvictionTaint)
+	}
+	if softEviction.TTL != nil && softEviction.TTL.Duration <= 0 {
+		return fmt.Errorf("soft eviction ttl must be positive, got %v", softEviction.TTL.Duration)
+	}
+	return nil
+}
@@ -22,11 +22,64 @@ limitations under the License.
 package defaultevictor
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	api "sigs.k8s.io/descheduler/pkg/api"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BarePodsPolicy) DeepCopyInto(out *BarePodsPolicy) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(api.Namespaces)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BarePodsPolicy.
+func (in *BarePodsPolicy) DeepCopy() *BarePodsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BarePodsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchProtection) DeepCopyInto(out *BatchProtection) {
+	*out = *in
+	if in.MinProgressPercentage != nil {
+		in, out := &in.MinProgressPercentage, &out.MinProgressPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRuntime != nil {
+		in, out := &in.MinRuntime, &out.MinRuntime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchProtection.
+func (in *BatchProtection) DeepCopy() *BatchProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultEvictorArgs) DeepCopyInto(out *DefaultEvictorArgs) {
 	*out = *in
@@ -46,6 +99,58 @@ func (in *DefaultEvictorArgs) DeepCopyInto(out *DefaultEvictorArgs) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.PluginOverrides != nil {
+		in, out := &in.PluginOverrides, &out.PluginOverrides
+		*out = make([]PluginOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.BarePods != nil {
+		in, out := &in.BarePods, &out.BarePods
+		*out = new(BarePodsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PvcPods != nil {
+		in, out := &in.PvcPods, &out.PvcPods
+		*out = new(PvcPodsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalStoragePods != nil {
+		in, out := &in.LocalStoragePods, &out.LocalStoragePods
+		*out = new(LocalStoragePodsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeFitExtender != nil {
+		in, out := &in.NodeFitExtender, &out.NodeFitExtender
+		*out = new(NodeFitExtender)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulerNodeFit != nil {
+		in, out := &in.SchedulerNodeFit, &out.SchedulerNodeFit
+		*out = make([]SchedulerNodeFit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BatchProtection != nil {
+		in, out := &in.BatchProtection, &out.BatchProtection
+		*out = new(BatchProtection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PDBPacing != nil {
+		in, out := &in.PDBPacing, &out.PDBPacing
+		*out = new(PDBPacing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneNamespaces != nil {
+		in, out := &in.ControlPlaneNamespaces, &out.ControlPlaneNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SoftEviction != nil {
+		in, out := &in.SoftEviction, &out.SoftEviction
+		*out = new(SoftEviction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -66,3 +171,139 @@ func (in *DefaultEvictorArgs) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStoragePodsPolicy) DeepCopyInto(out *LocalStoragePodsPolicy) {
+	*out = *in
+	if in.EmptyDirSizeThreshold != nil {
+		in, out := &in.EmptyDirSizeThreshold, &out.EmptyDirSizeThreshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStoragePodsPolicy.
+func (in *LocalStoragePodsPolicy) DeepCopy() *LocalStoragePodsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStoragePodsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFitExtender) DeepCopyInto(out *NodeFitExtender) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFitExtender.
+func (in *NodeFitExtender) DeepCopy() *NodeFitExtender {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFitExtender)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDBPacing) DeepCopyInto(out *PDBPacing) {
+	*out = *in
+	if in.MaxEvictionsPerCycle != nil {
+		in, out := &in.MaxEvictionsPerCycle, &out.MaxEvictionsPerCycle
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDBPacing.
+func (in *PDBPacing) DeepCopy() *PDBPacing {
+	if in == nil {
+		return nil
+	}
+	out := new(PDBPacing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PvcPodsPolicy) DeepCopyInto(out *PvcPodsPolicy) {
+	*out = *in
+	if in.IgnoredStorageClasses != nil {
+		in, out := &in.IgnoredStorageClasses, &out.IgnoredStorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EvictableStorageClasses != nil {
+		in, out := &in.EvictableStorageClasses, &out.EvictableStorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PvcPodsPolicy.
+func (in *PvcPodsPolicy) DeepCopy() *PvcPodsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PvcPodsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerNodeFit) DeepCopyInto(out *SchedulerNodeFit) {
+	*out = *in
+	if in.Extender != nil {
+		in, out := &in.Extender, &out.Extender
+		*out = new(NodeFitExtender)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerNodeFit.
+func (in *SchedulerNodeFit) DeepCopy() *SchedulerNodeFit {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerNodeFit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftEviction) DeepCopyInto(out *SoftEviction) {
+	*out = *in
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		*out = new(corev1.Taint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftEviction.
+func (in *SoftEviction) DeepCopy() *SoftEviction {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftEviction)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/metrics"
+
+	deschedulermetrics "sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// ClassificationUnderutilized nodes are below the thresholds
+	ClassificationUnderutilized = "underutilized"
+	// ClassificationAppropriatelyUtilized nodes are neither under nor overutilized
+	ClassificationAppropriatelyUtilized = "appropriatelyUtilized"
+	// ClassificationOverutilized nodes are above the target thresholds
+	ClassificationOverutilized = "overutilized"
+)
+
+var nodeClassification = metrics.NewGaugeVec(
+	&metrics.GaugeOpts{
+		Subsystem:      deschedulermetrics.PluginSubsystem,
+		Name:           "node_classification",
+		Help:           "Classification of the nodes in the last run of the node utilization plugins, 1 for the class of the node, by the plugin, the node and the classification",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"plugin", "node", "classification"})
+
+// NodeClassification is the class of a node in the last run of a plugin
+type NodeClassification struct {
+	Node           string `json:"node"`
+	Classification string `json:"classification"`
+	// UsagePercentage of the resources the node was classified by
+	UsagePercentage api.ResourceThresholds `json:"usagePercentage,omitempty"`
+}
+
+// PluginClassifications are the classes of the nodes in the last run of a plugin
+type PluginClassifications struct {
+	Plugin string               `json:"plugin"`
+	Time   time.Time            `json:"time"`
+	Nodes  []NodeClassification `json:"nodes"`
+}
+
+// classifications remembers the classes of the nodes of the last run of every plugin.
+// Plugins are built anew for every cycle so the classes can not be kept in the plugin itself.
+var classifications = &classificationStore{plugins: make(map[string]PluginClassifications)}
+
+type classificationStore struct {
+	mu      sync.Mutex
+	plugins map[string]PluginClassifications
+}
+
+// record replaces the classes of the nodes of the plugin, the nodes missing from the usage
+// are left out, and publishes them in the node classification metric
+func (s *classificationStore) record(plugin string, classes map[string]string, usage map[string]api.ResourceThresholds, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, node := range s.plugins[plugin].Nodes {
+		nodeClassification.Delete(map[string]string{"plugin": plugin, "node": node.Node, "classification": node.Classification})
+	}
+	nodes := make([]NodeClassification, 0, len(classes))
+	for node, classification := range classes {
+		nodes = append(nodes, NodeClassification{Node: node, Classification: classification, UsagePercentage: usage[node]})
+		nodeClassification.WithLabelValues(plugin, node, classification).Set(1)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Node < nodes[j].Node
+	})
+	s.plugins[plugin] = PluginClassifications{Plugin: plugin, Time: now, Nodes: nodes}
+}
+
+// list returns the classes of the nodes of every plugin sorted by the plugin name
+func (s *classificationStore) list() []PluginClassifications {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	plugins := make([]PluginClassifications, 0, len(s.plugins))
+	for _, classification := range s.plugins {
+		plugins = append(plugins, classification)
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Plugin < plugins[j].Plugin
+	})
+	return plugins
+}
+
+// ClassificationsHandler serves the classes of the nodes in the last run of every node utilization plugin as JSON
+func ClassificationsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(classifications.list()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// recordClassifications records the classes of the nodes from the groups of the classifier, the class of every
+// group given in order. The nodes in none of the groups are appropriately utilized.
+func recordClassifications(plugin string, nodes map[string]*v1.Node, groups []map[string]api.ResourceThresholds, groupClassifications []string, usage map[string]api.ResourceThresholds, now time.Time) {
+	classes := make(map[string]string, len(nodes))
+	for nodeName := range nodes {
+		classes[nodeName] = ClassificationAppropriatelyUtilized
+	}
+	for i, group := range groups {
+		for nodeName := range group {
+			classes[nodeName] = groupClassifications[i]
+		}
+	}
+	classifications.record(plugin, classes, usage, now)
+}
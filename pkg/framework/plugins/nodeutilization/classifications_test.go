@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/metrics/testutil"
+
+	deschedulermetrics "sigs.k8s.io/descheduler/metrics"
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRecordClassifications(t *testing.T) {
+	if err := deschedulermetrics.RegisterPluginMetric(nodeClassification); err != nil {
+		t.Fatalf("Unable to register the node classification metric: %v", err)
+	}
+	nodes := map[string]*v1.Node{
+		"n1": test.BuildTestNode("n1", 2000, 3000, 10, nil),
+		"n2": test.BuildTestNode("n2", 2000, 3000, 10, nil),
+		"n3": test.BuildTestNode("n3", 2000, 3000, 10, nil),
+	}
+	usage := map[string]api.ResourceThresholds{
+		"n1": {v1.ResourceCPU: 10},
+		"n2": {v1.ResourceCPU: 50},
+		"n3": {v1.ResourceCPU: 90},
+	}
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	classificationOf := func(plugin, node, classification string) float64 {
+		t.Helper()
+		value, err := testutil.GetGaugeMetricValue(nodeClassification.WithLabelValues(plugin, node, classification))
+		if err != nil {
+			t.Fatalf("Unable to read the node classification metric: %v", err)
+		}
+		return value
+	}
+
+	recordClassifications(LowNodeUtilizationPluginName, nodes,
+		[]map[string]api.ResourceThresholds{{"n1": usage["n1"]}, {"n3": usage["n3"]}},
+		[]string{ClassificationUnderutilized, ClassificationOverutilized}, usage, now)
+
+	expected := []PluginClassifications{{
+		Plugin: LowNodeUtilizationPluginName,
+		Time:   now,
+		Nodes: []NodeClassification{
+			{Node: "n1", Classification: ClassificationUnderutilized, UsagePercentage: usage["n1"]},
+			{Node: "n2", Classification: ClassificationAppropriatelyUtilized, UsagePercentage: usage["n2"]},
+			{Node: "n3", Classification: ClassificationOverutilized, UsagePercentage: usage["n3"]},
+		},
+	}}
+	recorder := httptest.NewRecorder()
+	ClassificationsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/nodeutilization/classifications", nil))
+	var served []PluginClassifications
+	if err := json.Unmarshal(recorder.Body.Bytes(), &served); err != nil {
+		t.Fatalf("Unable to decode the classifications: %v", err)
+	}
+	if !reflect.DeepEqual(served, expected) {
+		t.Errorf("Expected the classifications %v, got %v", expected, served)
+	}
+	if value := classificationOf(LowNodeUtilizationPluginName, "n3", ClassificationOverutilized); value != 1 {
+		t.Errorf("Expected n3 classified overutilized in the metric, got %v", value)
+	}
+
+	// The classes of the previous run are replaced
+	recordClassifications(LowNodeUtilizationPluginName, nodes,
+		[]map[string]api.ResourceThresholds{{"n1": usage["n1"]}, {}},
+		[]string{ClassificationUnderutilized, ClassificationOverutilized}, usage, now.Add(time.Minute))
+	if value := classificationOf(LowNodeUtilizationPluginName, "n3", ClassificationOverutilized); value != 0 {
+		t.Errorf("Expected the previous class of n3 removed from the metric, got %v", value)
+	}
+	if value := classificationOf(LowNodeUtilizationPluginName, "n3", ClassificationAppropriatelyUtilized); value != 1 {
+		t.Errorf("Expected n3 classified appropriately utilized in the metric, got %v", value)
+	}
+}
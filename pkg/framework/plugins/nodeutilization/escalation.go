@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+)
+
+// DefaultPriorityEscalationThreshold makes the pods of no or a non-positive
+// priority the only low priority pods.
+const DefaultPriorityEscalationThreshold = 1
+
+// overutilizedNodes remembers the nodes found overutilized in the previous
+// descheduling cycle. Plugins are built anew for every cycle so the escalation
+// state can not be kept in the plugin itself.
+var overutilizedNodes = &nodeTracker{nodes: sets.New[string]()}
+
+// nodeTracker keeps a set of node names across descheduling cycles.
+type nodeTracker struct {
+	mu    sync.Mutex
+	nodes sets.Set[string]
+}
+
+// has tells whether the node was recorded in the previous cycle.
+func (t *nodeTracker) has(nodeName string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.nodes.Has(nodeName)
+}
+
+// record replaces the nodes recorded in the previous cycle.
+func (t *nodeTracker) record(nodeNames sets.Set[string]) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nodes = nodeNames
+}
+
+// lowPriorityPodFilter returns a filter accepting only the pods outside of the
+// Guaranteed QoS class with a priority lower than the given threshold, the
+// pods without a priority being of priority 0.
+func lowPriorityPodFilter(priorityThreshold int32) func(pod *v1.Pod) bool {
+	return func(pod *v1.Pod) bool {
+		if podutil.IsGuaranteedPod(pod) {
+			return false
+		}
+		var priority int32
+		if pod.Spec.Priority != nil {
+			priority = *pod.Spec.Priority
+		}
+		return priority < priorityThreshold
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+)
+
+// nodeSlots counts the pods of the given shape the node has room for given
+// its usage. the pods resource, when in use, bounds the slots as well.
+func nodeSlots(node *v1.Node, usage api.ReferencedResourceList, podRequests v1.ResourceList) int64 {
+	capacity := referencedResourceListForNodeCapacity(node, nil)
+	slots := int64(-1)
+	for name, request := range podRequests {
+		free := freeMilliValue(capacity, usage, name) / request.MilliValue()
+		if slots < 0 || free < slots {
+			slots = free
+		}
+	}
+	if _, ok := usage[v1.ResourcePods]; ok {
+		if free := freeMilliValue(capacity, usage, v1.ResourcePods) / 1000; free < slots {
+			slots = free
+		}
+	}
+	return max(slots, 0)
+}
+
+// freeMilliValue returns the part of the node capacity of the resource not
+// in use, in milli units.
+func freeMilliValue(capacity, usage api.ReferencedResourceList, name v1.ResourceName) int64 {
+	var free int64
+	if quantity := capacity[name]; quantity != nil {
+		free = quantity.MilliValue()
+	}
+	if quantity := usage[name]; quantity != nil {
+		free -= quantity.MilliValue()
+	}
+	return max(free, 0)
+}
+
+// schedulableSlots counts the pods of the given shape the schedulable nodes
+// have room for, the nodes in skip excluded.
+func schedulableSlots(
+	nodesMap map[string]*v1.Node,
+	nodesUsageMap map[string]api.ReferencedResourceList,
+	podRequests v1.ResourceList,
+	skip map[string]bool,
+) int64 {
+	var slots int64
+	for nodeName, node := range nodesMap {
+		if skip[nodeName] || nodeutil.IsNodeUnschedulable(node) {
+			continue
+		}
+		slots += nodeSlots(node, nodesUsageMap[nodeName], podRequests)
+	}
+	return slots
+}
+
+// defragmentedSlots counts the pods of the given shape the schedulable nodes
+// would have room for if their free resources were not fragmented. moving
+// pods around never gets the cluster more slots than that.
+func defragmentedSlots(
+	nodesMap map[string]*v1.Node,
+	nodesUsageMap map[string]api.ReferencedResourceList,
+	podRequests v1.ResourceList,
+) int64 {
+	free := map[v1.ResourceName]int64{}
+	for nodeName, node := range nodesMap {
+		if nodeutil.IsNodeUnschedulable(node) {
+			continue
+		}
+		capacity := referencedResourceListForNodeCapacity(node, nil)
+		for name := range podRequests {
+			free[name] += freeMilliValue(capacity, nodesUsageMap[nodeName], name)
+		}
+	}
+	slots := int64(-1)
+	for name, request := range podRequests {
+		if resourceSlots := free[name] / request.MilliValue(); slots < 0 || resourceSlots < slots {
+			slots = resourceSlots
+		}
+	}
+	return max(slots, 0)
+}
+
+// destinationSlots estimates the slots left on the destination nodes once
+// the given resources are moved to them. the moved pods are expected to be
+// bin-packed, i.e. to fill the free resources not forming a whole slot
+// first and to take whole slots only once those are filled.
+func destinationSlots(destinationNodes []NodeInfo, moved api.ReferencedResourceList, podRequests v1.ResourceList) int64 {
+	var slots int64
+	fragments := map[v1.ResourceName]int64{}
+	for _, nodeInfo := range destinationNodes {
+		if nodeutil.IsNodeUnschedulable(nodeInfo.node) {
+			continue
+		}
+		free := nodeSlots(nodeInfo.node, nodeInfo.usage, podRequests)
+		slots += free
+		capacity := referencedResourceListForNodeCapacity(nodeInfo.node, nil)
+		for name, request := range podRequests {
+			fragments[name] += freeMilliValue(capacity, nodeInfo.usage, name) - free*request.MilliValue()
+		}
+	}
+
+	var taken int64
+	for name, request := range podRequests {
+		if moved[name] == nil {
+			continue
+		}
+		if beyond := moved[name].MilliValue() - fragments[name]; beyond > 0 {
+			// a slot partially taken is taken.
+			taken = max(taken, (beyond+request.MilliValue()-1)/request.MilliValue())
+		}
+	}
+	return max(slots-taken, 0)
+}
+
+// headroomNotMetCond returns a condition telling whether the cluster is still
+// short of the schedulable headroom as the pods of the source nodes are moved
+// to the destination nodes. the source nodes usage is updated in place on
+// every eviction while the resources moved to the destination nodes are
+// derived from their remaining available resources.
+func headroomNotMetCond(
+	headroom *SchedulableHeadroom,
+	nodesMap map[string]*v1.Node,
+	nodesUsageMap map[string]api.ReferencedResourceList,
+	destinationNodes []NodeInfo,
+	resourceNames []v1.ResourceName,
+) func(NodeInfo, api.ReferencedResourceList) bool {
+	initialAvailable, err := assessAvailableResourceInNodes(destinationNodes, resourceNames)
+	if err != nil {
+		klog.ErrorS(err, "unable to assess the schedulable headroom")
+		return func(NodeInfo, api.ReferencedResourceList) bool { return false }
+	}
+	destinationNames := map[string]bool{}
+	for _, nodeInfo := range destinationNodes {
+		destinationNames[nodeInfo.node.Name] = true
+	}
+
+	return func(_ NodeInfo, totalAvailableUsage api.ReferencedResourceList) bool {
+		moved := api.ReferencedResourceList{}
+		for name, quantity := range initialAvailable {
+			if totalAvailableUsage[name] == nil {
+				continue
+			}
+			movedQuantity := quantity.DeepCopy()
+			movedQuantity.Sub(*totalAvailableUsage[name])
+			moved[name] = &movedQuantity
+		}
+		slots := schedulableSlots(nodesMap, nodesUsageMap, headroom.PodRequests, destinationNames) +
+			destinationSlots(destinationNodes, moved, headroom.PodRequests)
+		if slots >= int64(headroom.Slots) {
+			klog.V(1).InfoS("The schedulable headroom is met, stopping the eviction", "slots", slots)
+			return false
+		}
+		return true
+	}
+}
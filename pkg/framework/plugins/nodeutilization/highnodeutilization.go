@@ -19,6 +19,9 @@ package nodeutilization
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -43,13 +46,14 @@ var _ frameworktypes.BalancePlugin = &HighNodeUtilization{}
 // can schedule according to its plugin. Note that CPU/Memory requests are used
 // to calculate nodes' utilization and not the actual resource usage.
 type HighNodeUtilization struct {
-	handle         frameworktypes.Handle
-	args           *HighNodeUtilizationArgs
-	podFilter      func(pod *v1.Pod) bool
-	criteria       []any
-	resourceNames  []v1.ResourceName
-	highThresholds api.ResourceThresholds
-	usageClient    usageClient
+	handle               frameworktypes.Handle
+	args                 *HighNodeUtilizationArgs
+	podFilter            func(pod *v1.Pod) bool
+	criteria             []any
+	resourceNames        []v1.ResourceName
+	queriedResourceNames []v1.ResourceName
+	highThresholds       api.ResourceThresholds
+	usageClient          usageClient
 }
 
 // NewHighNodeUtilization builds plugin from its arguments while passing a handle.
@@ -101,17 +105,38 @@ func NewHighNodeUtilization(
 		),
 	)
 
+	// the resources backed by prometheus queries are collected on top of
+	// the requests of the pods.
+	queries := resourceQueries(nil, args.CustomResources)
+	queriedResourceNames := slices.Collect(maps.Keys(queries))
+	requestedResourceNames := resourceNames
+	for _, resourceName := range queriedResourceNames {
+		requestedResourceNames = withoutResourceName(requestedResourceNames, resourceName)
+	}
+	var usageClient usageClient = newRequestedUsageClient(
+		requestedResourceNames,
+		handle.GetPodsAssignedToNodeFunc(),
+	)
+	if len(queries) > 0 {
+		if handle.PrometheusClient() == nil {
+			return nil, fmt.Errorf("prometheus client not initialized")
+		}
+		usageClient = newQueryUsageClient(usageClient, handle.PrometheusClient(), queries)
+	}
+
+	if err := handle.RegisterMetric(nodeClassification); err != nil {
+		return nil, fmt.Errorf("error registering metrics: %v", err)
+	}
+
 	return &HighNodeUtilization{
-		handle:         handle,
-		args:           args,
-		resourceNames:  resourceNames,
-		highThresholds: highThresholds,
-		criteria:       criteria,
-		podFilter:      podFilter,
-		usageClient: newRequestedUsageClient(
-			resourceNames,
-			handle.GetPodsAssignedToNodeFunc(),
-		),
+		handle:               handle,
+		args:                 args,
+		resourceNames:        resourceNames,
+		queriedResourceNames: queriedResourceNames,
+		highThresholds:       highThresholds,
+		criteria:             criteria,
+		podFilter:            podFilter,
+		usageClient:          usageClient,
 	}, nil
 }
 
@@ -126,14 +151,14 @@ func (h *HighNodeUtilization) Name() string {
 func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
 	if err := h.usageClient.sync(ctx, nodes); err != nil {
 		return &frameworktypes.Status{
-			Err: fmt.Errorf("error getting node usage: %v", err),
+			Err: &frameworktypes.TransientAPIError{Err: fmt.Errorf("error getting node usage: %v", err)},
 		}
 	}
 
 	// take a picture of the current state of the nodes, everything else
 	// here is based on this snapshot.
 	nodesMap, nodesUsageMap, podListMap := getNodeUsageSnapshot(nodes, h.usageClient)
-	capacities := referencedResourceListForNodesCapacity(nodes)
+	capacities := referencedResourceListForNodesCapacity(nodes, h.queriedResourceNames)
 
 	// node usages are not presented as percentages over the capacity.
 	// we need to normalize them to be able to compare them with the
@@ -164,6 +189,8 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 		},
 	)
 
+	recordClassifications(h.Name(), nodesMap, nodeGroups, []string{ClassificationUnderutilized, ClassificationAppropriatelyUtilized}, usage, time.Now())
+
 	// the nodeplugin package works by means of NodeInfo structures. these
 	// structures hold a series of information about the nodes. now that
 	// we have classified the nodes, we can build the NodeInfo structures
@@ -190,6 +217,7 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 					nodesMap[nodeName],
 					thresholds[nodeName][1],
 					h.resourceNames,
+					h.queriedResourceNames,
 				),
 			})
 		}
@@ -239,6 +267,7 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 	}
 
 	// sorts the nodes by the usage in ascending order.
+	shuffleNodes(lowNodes, h.handle.Rand())
 	sortNodesByUsage(lowNodes, true)
 
 	evictPodsFromSourceNodes(
@@ -253,6 +282,7 @@ func (h *HighNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fr
 		continueEvictionCond,
 		h.usageClient,
 		nil,
+		nil,
 	)
 
 	return nil
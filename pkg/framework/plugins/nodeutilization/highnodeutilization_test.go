@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/prometheus/common/model"
 	v1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -494,6 +495,84 @@ func TestHighNodeUtilization(t *testing.T) {
 	}
 }
 
+func TestHighNodeUtilizationWithCustomResources(t *testing.T) {
+	n1NodeName := "n1"
+	n2NodeName := "n2"
+	n3NodeName := "n3"
+
+	testCases := []struct {
+		name                string
+		samples             model.Vector
+		expectedPodsEvicted uint
+	}{
+		{
+			name: "node below the custom resource threshold",
+			samples: model.Vector{
+				sample("gpu_mem_util", n1NodeName, 0.1),
+				sample("gpu_mem_util", n2NodeName, 0.9),
+				sample("gpu_mem_util", n3NodeName, 0.6),
+			},
+			expectedPodsEvicted: 1,
+		},
+		{
+			name: "no node below the custom resource threshold",
+			samples: model.Vector{
+				sample("gpu_mem_util", n1NodeName, 0.3),
+				sample("gpu_mem_util", n2NodeName, 0.9),
+				sample("gpu_mem_util", n3NodeName, 0.6),
+			},
+			expectedPodsEvicted: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			nodes := []*v1.Node{
+				test.BuildTestNode(n1NodeName, 4000, 3000, 10, nil),
+				test.BuildTestNode(n2NodeName, 4000, 3000, 10, nil),
+				test.BuildTestNode(n3NodeName, 4000, 3000, 10, nil),
+			}
+			fakeClient := fake.NewSimpleClientset(
+				nodes[0], nodes[1], nodes[2],
+				test.BuildTestPod("p1", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p2", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p3", 400, 0, n2NodeName, test.SetRSOwnerRef),
+			)
+
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+			handle.PrometheusClientImpl = &fakePromClient{
+				result:   testCase.samples,
+				dataType: model.ValVector,
+			}
+
+			plugin, err := NewHighNodeUtilization(&HighNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{"gpu_mem_util": 20},
+				CustomResources: []CustomResource{
+					{Name: "gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+				},
+			}, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			status := plugin.(frameworktypes.BalancePlugin).Balance(ctx, nodes)
+			if status != nil {
+				t.Fatalf("Balance.err: %v", status.Err)
+			}
+
+			podsEvicted := podEvictor.TotalEvicted()
+			if testCase.expectedPodsEvicted != podsEvicted {
+				t.Errorf("Expected %v pods to be evicted but %v got evicted", testCase.expectedPodsEvicted, podsEvicted)
+			}
+		})
+	}
+}
+
 func TestHighNodeUtilizationWithTaints(t *testing.T) {
 	n1 := test.BuildTestNode("n1", 1000, 3000, 10, nil)
 	n2 := test.BuildTestNode("n2", 1000, 3000, 10, nil)
@@ -19,10 +19,15 @@ package nodeutilization
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
@@ -50,6 +55,7 @@ type LowNodeUtilization struct {
 	overCriteria          []any
 	resourceNames         []v1.ResourceName
 	extendedResourceNames []v1.ResourceName
+	queriedResourceNames  []v1.ResourceName
 	usageClient           usageClient
 }
 
@@ -114,15 +120,33 @@ func NewLowNodeUtilization(
 	// different way provides its own "usageClient". here we make sure we
 	// have the correct one or an error is triggered. XXX MetricsServer is
 	// deprecated, removed once dropped.
+	// the resources backed by prometheus queries, e.g. the network
+	// throughput, are collected on top of the usage of the other resources.
+	queries := resourceQueries(args.NetworkUtilization, args.CustomResources)
+	queriedResourceNames := slices.Collect(maps.Keys(queries))
+	collectedResourceNames := extendedResourceNames
+	for _, resourceName := range queriedResourceNames {
+		collectedResourceNames = withoutResourceName(collectedResourceNames, resourceName)
+	}
 	var usageClient usageClient = newRequestedUsageClient(
-		extendedResourceNames, handle.GetPodsAssignedToNodeFunc(),
+		collectedResourceNames, handle.GetPodsAssignedToNodeFunc(),
 	)
 	if metrics != nil {
-		usageClient, err = usageClientForMetrics(args, handle, extendedResourceNames)
+		usageClient, err = usageClientForMetrics(args, handle, collectedResourceNames)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if len(queries) > 0 {
+		if handle.PrometheusClient() == nil {
+			return nil, fmt.Errorf("prometheus client not initialized")
+		}
+		usageClient = newQueryUsageClient(usageClient, handle.PrometheusClient(), queries)
+	}
+
+	if err := handle.RegisterMetric(nodeClassification); err != nil {
+		return nil, fmt.Errorf("error registering metrics: %v", err)
+	}
 
 	return &LowNodeUtilization{
 		handle:                handle,
@@ -131,6 +155,7 @@ func NewLowNodeUtilization(
 		overCriteria:          overCriteria,
 		resourceNames:         resourceNames,
 		extendedResourceNames: extendedResourceNames,
+		queriedResourceNames:  queriedResourceNames,
 		podFilter:             podFilter,
 		usageClient:           usageClient,
 	}, nil
@@ -145,9 +170,14 @@ func (l *LowNodeUtilization) Name() string {
 // utilized nodes to under utilized nodes. The goal here is to evenly
 // distribute pods across nodes.
 func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	// the nodes with stale or volatile metrics are left out of the cycle.
+	if metrics := l.args.MetricsUtilization; metrics != nil && metrics.SampleGuard != nil && l.handle.MetricsCollector() != nil {
+		nodes = nodesWithReliableSamples(nodes, l.handle.MetricsCollector(), metrics.SampleGuard, time.Now())
+	}
+
 	if err := l.usageClient.sync(ctx, nodes); err != nil {
 		return &frameworktypes.Status{
-			Err: fmt.Errorf("error getting node usage: %v", err),
+			Err: &frameworktypes.TransientAPIError{Err: fmt.Errorf("error getting node usage: %v", err)},
 		}
 	}
 
@@ -155,7 +185,35 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 	// snapshot to assess the nodes usage and classify them as
 	// underutilized or overutilized.
 	nodesMap, nodesUsageMap, podListMap := getNodeUsageSnapshot(nodes, l.usageClient)
-	capacities := referencedResourceListForNodesCapacity(nodes)
+	capacities := referencedResourceListForNodesCapacity(nodes, l.queriedResourceNames)
+
+	// with the schedulable headroom as the objective there is nothing to do
+	// while the cluster has room for the configured number of pods.
+	if l.args.SchedulableHeadroom != nil {
+		slots := schedulableSlots(nodesMap, nodesUsageMap, l.args.SchedulableHeadroom.PodRequests, nil)
+		if slots >= int64(l.args.SchedulableHeadroom.Slots) {
+			klog.V(1).InfoS(
+				"The schedulable headroom is met, nothing to do here",
+				"slots", slots,
+				"targetSlots", l.args.SchedulableHeadroom.Slots,
+			)
+			return nil
+		}
+		if defragmented := defragmentedSlots(nodesMap, nodesUsageMap, l.args.SchedulableHeadroom.PodRequests); defragmented < int64(l.args.SchedulableHeadroom.Slots) {
+			klog.V(1).InfoS(
+				"The schedulable headroom can not be met by moving pods, nothing to do here",
+				"slots", slots,
+				"defragmentedSlots", defragmented,
+				"targetSlots", l.args.SchedulableHeadroom.Slots,
+			)
+			return nil
+		}
+		klog.V(1).InfoS(
+			"The schedulable headroom is not met",
+			"slots", slots,
+			"targetSlots", l.args.SchedulableHeadroom.Slots,
+		)
+	}
 
 	// usage, by default, is exposed in absolute values. we need to normalize
 	// them (convert them to percentages) to be able to compare them with the
@@ -206,6 +264,15 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 		},
 	)
 
+	recordClassifications(l.Name(), nodesMap, nodeGroups, []string{ClassificationUnderutilized, ClassificationOverutilized}, usage, time.Now())
+
+	// in the threshold recommendation mode the usage of the nodes is only
+	// observed, nothing gets evicted.
+	if l.args.ThresholdRecommendation != nil {
+		l.reportThresholdRecommendation(nodesMap, usage, podListMap)
+		return nil
+	}
+
 	// the nodeutilization package was designed to work with NodeInfo
 	// structs. these structs holds information about how utilized a node
 	// is. we need to go through the result of the classification and turn
@@ -235,6 +302,7 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 					nodesMap[nodeName],
 					thresholds[nodeName][1],
 					l.extendedResourceNames,
+					l.queriedResourceNames,
 				),
 			})
 		}
@@ -287,9 +355,18 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 	}
 
 	// this is a stop condition for the eviction process. we stop as soon
-	// as the node usage drops below the threshold.
+	// as the node usage drops below the threshold or, with the schedulable
+	// headroom as the objective, as soon as the headroom is met.
+	objectiveNotMet := func(nodeInfo NodeInfo, _ api.ReferencedResourceList) bool {
+		return isNodeAboveTargetUtilization(nodeInfo.NodeUsage, nodeInfo.available)
+	}
+	if l.args.SchedulableHeadroom != nil {
+		objectiveNotMet = headroomNotMetCond(
+			l.args.SchedulableHeadroom, nodesMap, nodesUsageMap, lowNodes, l.extendedResourceNames,
+		)
+	}
 	continueEvictionCond := func(nodeInfo NodeInfo, totalAvailableUsage api.ReferencedResourceList) bool {
-		if !isNodeAboveTargetUtilization(nodeInfo.NodeUsage, nodeInfo.available) {
+		if !objectiveNotMet(nodeInfo, totalAvailableUsage) {
 			return false
 		}
 		for name := range totalAvailableUsage {
@@ -301,7 +378,30 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 		return true
 	}
 
+	// with the priority escalation only the low priority pods are evicted
+	// from the nodes overutilized for the first time. the nodes still
+	// overutilized in a later cycle have all their pods considered.
+	if l.args.PriorityEscalation != nil {
+		lowPriorityFilter := lowPriorityPodFilter(ptr.Deref(
+			l.args.PriorityEscalation.PriorityThreshold, DefaultPriorityEscalationThreshold,
+		))
+		highNodeNames := sets.New[string]()
+		for i := range highNodes {
+			highNodeNames.Insert(highNodes[i].node.Name)
+			if overutilizedNodes.has(highNodes[i].node.Name) {
+				klog.V(1).InfoS(
+					"Node is still overutilized, considering all its pods",
+					"node", klog.KObj(highNodes[i].node),
+				)
+				continue
+			}
+			highNodes[i].podFilter = lowPriorityFilter
+		}
+		overutilizedNodes.record(highNodeNames)
+	}
+
 	// sort the nodes by the usage in descending order
+	shuffleNodes(highNodes, l.handle.Rand())
 	sortNodesByUsage(highNodes, false)
 
 	var nodeLimit *uint
@@ -309,6 +409,19 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 		nodeLimit = l.args.EvictionLimits.Node
 	}
 
+	// the pods are evicted as usual when the cluster can not resize them.
+	var resizer *podResizer
+	if l.args.InPlaceResize != nil {
+		if inPlaceResizeSupported(l.handle.ClientSet()) {
+			resizer = &podResizer{
+				client:                l.handle.ClientSet(),
+				minRequestsPercentage: l.args.InPlaceResize.MinRequestsPercentage,
+			}
+		} else {
+			klog.V(1).InfoS("The in-place pod resize is not supported by the cluster, evicting the pods instead")
+		}
+	}
+
 	evictPodsFromSourceNodes(
 		ctx,
 		l.args.EvictableNamespaces,
@@ -321,11 +434,53 @@ func (l *LowNodeUtilization) Balance(ctx context.Context, nodes []*v1.Node) *fra
 		continueEvictionCond,
 		l.usageClient,
 		nodeLimit,
+		resizer,
 	)
 
 	return nil
 }
 
+// reportThresholdRecommendation records the usage of the nodes in the cycle
+// and logs the thresholds recommended from the observed cycles once there
+// are enough of them.
+func (l *LowNodeUtilization) reportThresholdRecommendation(
+	nodesMap map[string]*v1.Node, usage map[string]api.ResourceThresholds, podListMap map[string][]*v1.Pod,
+) {
+	cycle := make([]nodeObservation, 0, len(nodesMap))
+	for nodeName, node := range nodesMap {
+		cycle = append(cycle, nodeObservation{
+			usage:       usage[nodeName],
+			pods:        len(podListMap[nodeName]),
+			schedulable: !nodeutil.IsNodeUnschedulable(node),
+		})
+	}
+	requiredCycles := int(ptr.Deref(l.args.ThresholdRecommendation.Cycles, DefaultThresholdRecommendationCycles))
+	cycles := usageObservations.record(cycle, requiredCycles)
+	if len(cycles) < requiredCycles {
+		klog.V(1).InfoS(
+			"Observing the node usage for the threshold recommendation",
+			"observedCycles", len(cycles),
+			"requiredCycles", requiredCycles,
+		)
+		return
+	}
+
+	recommendation := recommendThresholds(
+		cycles, l.args.Thresholds, l.args.TargetThresholds, l.args.NumberOfNodes, l.args.ThresholdRecommendation.TargetEvictions,
+	)
+	klog.InfoS(
+		"Threshold recommendation",
+		"observedCycles", len(cycles),
+		"targetEvictions", l.args.ThresholdRecommendation.TargetEvictions,
+		"thresholds", l.args.Thresholds,
+		"targetThresholds", l.args.TargetThresholds,
+		"estimatedEvictions", estimatedEvictions(cycles, l.args.Thresholds, l.args.TargetThresholds, l.args.NumberOfNodes),
+		"recommendedThresholds", recommendation.thresholds,
+		"recommendedTargetThresholds", recommendation.targetThresholds,
+		"recommendedEstimatedEvictions", recommendation.estimatedEvictions,
+	)
+}
+
 // validatePrometheusMetricsUtilization validates the Prometheus metrics
 // utilization. XXX this should be done way earlier than this.
 func validatePrometheusMetricsUtilization(args *LowNodeUtilizationArgs) error {
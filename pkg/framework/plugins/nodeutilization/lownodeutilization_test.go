@@ -20,12 +20,15 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
@@ -1572,6 +1575,378 @@ func TestLowNodeUtilizationWithTaints(t *testing.T) {
 	}
 }
 
+func TestLowNodeUtilizationWithPriorityEscalation(t *testing.T) {
+	ctx := context.Background()
+
+	n1 := test.BuildTestNode("n1", 4000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 4000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 4000, 3000, 10, nil)
+
+	lowPriorityPod := func(name string) *v1.Pod {
+		return test.BuildTestPod(name, 200, 100, n1.Name, test.SetRSOwnerRef)
+	}
+	guaranteedPod := func(name string) *v1.Pod {
+		return test.BuildTestPod(name, 200, 100, n1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			test.MakeGuaranteedPod(pod)
+		})
+	}
+	highPriorityPod := func(name string) *v1.Pod {
+		return test.BuildTestPod(name, 200, 100, n1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			test.SetPodPriority(pod, 1000)
+		})
+	}
+
+	// every cycle runs a new plugin against the pods left by the previous cycle
+	cycles := []struct {
+		name              string
+		pods              []*v1.Pod
+		evictedPods       []string
+		evictionsExpected uint
+	}{
+		{
+			name: "first cycle evicts only the low priority pods",
+			pods: []*v1.Pod{
+				lowPriorityPod("p1"),
+				lowPriorityPod("p2"),
+				guaranteedPod("p3"),
+				highPriorityPod("p4"),
+				highPriorityPod("p5"),
+				highPriorityPod("p6"),
+				highPriorityPod("p7"),
+				highPriorityPod("p8"),
+			},
+			evictedPods:       []string{"p1", "p2"},
+			evictionsExpected: 2,
+		},
+		{
+			name: "node still overutilized in the next cycle has all its pods considered",
+			pods: []*v1.Pod{
+				guaranteedPod("p3"),
+				highPriorityPod("p4"),
+				highPriorityPod("p5"),
+				highPriorityPod("p6"),
+				highPriorityPod("p7"),
+				highPriorityPod("p8"),
+			},
+			evictedPods:       []string{"p3", "p4", "p5", "p6", "p7", "p8"},
+			evictionsExpected: 2,
+		},
+	}
+
+	overutilizedNodes.record(sets.New[string]())
+	defer overutilizedNodes.record(sets.New[string]())
+
+	for _, cycle := range cycles {
+		t.Run(cycle.name, func(t *testing.T) {
+			objs := []runtime.Object{n1, n2, n3}
+			for _, pod := range cycle.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			podsForEviction := sets.New(cycle.evictedPods...)
+			evictionFailed := false
+			fakeClient.Fake.AddReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				getAction := action.(core.CreateAction)
+				obj := getAction.GetObject()
+				if eviction, ok := obj.(*policy.Eviction); ok {
+					if podsForEviction.Has(eviction.Name) {
+						return true, obj, nil
+					}
+					evictionFailed = true
+					return true, nil, fmt.Errorf("pod %q was unexpectedly evicted", eviction.Name)
+				}
+				return true, obj, nil
+			})
+
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			plugin, err := NewLowNodeUtilization(&LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourcePods: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourcePods: 40,
+				},
+				PriorityEscalation: &PriorityEscalation{
+					PriorityThreshold: ptr.To[int32](DefaultPriorityEscalationThreshold),
+				},
+			}, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			plugin.(frameworktypes.BalancePlugin).Balance(ctx, []*v1.Node{n1, n2, n3})
+
+			if evictionFailed {
+				t.Errorf("Pod evictions failed unexpectedly")
+			}
+			if cycle.evictionsExpected != podEvictor.TotalEvicted() {
+				t.Errorf("Expected %v evictions, got %v", cycle.evictionsExpected, podEvictor.TotalEvicted())
+			}
+		})
+	}
+}
+
+func TestLowNodeUtilizationWithInPlaceResize(t *testing.T) {
+	ctx := context.Background()
+
+	n1 := test.BuildTestNode("n1", 4000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 4000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 4000, 3000, 10, nil)
+
+	burstablePod := func(name string) *v1.Pod {
+		return test.BuildTestPod(name, 300, 100, n1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			pod.Spec.Containers[0].Resources.Limits = v1.ResourceList{
+				v1.ResourceCPU: *resource.NewMilliQuantity(400, resource.DecimalSI),
+			}
+		})
+	}
+	guaranteedPod := func(name string) *v1.Pod {
+		return test.BuildTestPod(name, 300, 100, n1.Name, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			test.MakeGuaranteedPod(pod)
+		})
+	}
+
+	testCases := []struct {
+		name              string
+		pods              []*v1.Pod
+		resizeSupported   bool
+		resizesExpected   int
+		evictionsExpected uint
+	}{
+		{
+			name: "burstable pods are resized instead of evicted",
+			pods: []*v1.Pod{
+				burstablePod("p1"),
+				burstablePod("p2"),
+				burstablePod("p3"),
+				burstablePod("p4"),
+				burstablePod("p5"),
+				burstablePod("p6"),
+				guaranteedPod("p7"),
+				guaranteedPod("p8"),
+			},
+			resizeSupported: true,
+			// every resize frees 100m out of the 400m above the target
+			resizesExpected:   4,
+			evictionsExpected: 0,
+		},
+		{
+			name: "guaranteed pods are evicted",
+			pods: []*v1.Pod{
+				burstablePod("p1"),
+				guaranteedPod("p2"),
+				guaranteedPod("p3"),
+				guaranteedPod("p4"),
+				guaranteedPod("p5"),
+				guaranteedPod("p6"),
+				guaranteedPod("p7"),
+				guaranteedPod("p8"),
+			},
+			resizeSupported:   true,
+			resizesExpected:   1,
+			evictionsExpected: 1,
+		},
+		{
+			name: "pods are evicted when the cluster does not support the resize",
+			pods: []*v1.Pod{
+				burstablePod("p1"),
+				burstablePod("p2"),
+				burstablePod("p3"),
+				burstablePod("p4"),
+				burstablePod("p5"),
+				burstablePod("p6"),
+				guaranteedPod("p7"),
+				guaranteedPod("p8"),
+			},
+			evictionsExpected: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := []runtime.Object{n1, n2, n3}
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+			if tc.resizeSupported {
+				fakeClient.Resources = []*metav1.APIResourceList{{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{{Name: "pods"}, {Name: "pods/resize"}},
+				}}
+			}
+
+			var resizedPods []string
+			fakeClient.Fake.PrependReactor("update", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "resize" {
+					return false, nil, nil
+				}
+				pod := action.(core.UpdateAction).GetObject().(*v1.Pod)
+				if request := pod.Spec.Containers[0].Resources.Requests[v1.ResourceCPU]; request.MilliValue() != 200 {
+					t.Errorf("Expected the cpu request of %v shrunk to 200m, got %v", pod.Name, request.String())
+				}
+				resizedPods = append(resizedPods, pod.Name)
+				return true, pod, nil
+			})
+
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			plugin, err := NewLowNodeUtilization(&LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 50,
+				},
+				InPlaceResize: &InPlaceResize{MinRequestsPercentage: 50},
+			}, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			plugin.(frameworktypes.BalancePlugin).Balance(ctx, []*v1.Node{n1, n2, n3})
+
+			if len(resizedPods) != tc.resizesExpected {
+				t.Errorf("Expected %v resizes, got %v", tc.resizesExpected, resizedPods)
+			}
+			if tc.evictionsExpected != podEvictor.TotalEvicted() {
+				t.Errorf("Expected %v evictions, got %v", tc.evictionsExpected, podEvictor.TotalEvicted())
+			}
+		})
+	}
+}
+
+func TestLowNodeUtilizationWithSchedulableHeadroom(t *testing.T) {
+	ctx := context.Background()
+
+	n1 := test.BuildTestNode("n1", 4000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 4000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 4000, 3000, 10, nil)
+
+	// n1 is overutilized with 800m free, n2 appropriately utilized with
+	// 2800m free and n3 underutilized with 3700m free: 5 slots of 1 cpu.
+	objs := []runtime.Object{n1, n2, n3}
+	for i := range 8 {
+		objs = append(objs, test.BuildTestPod(fmt.Sprintf("p%d", i), 400, 0, n1.Name, test.SetRSOwnerRef))
+	}
+	for i := range 2 {
+		objs = append(objs, test.BuildTestPod(fmt.Sprintf("q%d", i), 600, 0, n2.Name, test.SetRSOwnerRef))
+	}
+	objs = append(objs, test.BuildTestPod("r0", 300, 0, n3.Name, test.SetRSOwnerRef))
+
+	testCases := []struct {
+		name              string
+		headroom          *SchedulableHeadroom
+		evictionsExpected uint
+	}{
+		{
+			name:              "the target utilization is the objective without headroom",
+			evictionsExpected: 3,
+		},
+		{
+			name:              "nothing is evicted when the headroom is met",
+			headroom:          &SchedulableHeadroom{Slots: 5},
+			evictionsExpected: 0,
+		},
+		{
+			// moving a pod of n1 to the 700m of n3 not forming a slot
+			// frees a slot on n1.
+			name:              "the eviction stops as soon as the headroom is met",
+			headroom:          &SchedulableHeadroom{Slots: 6},
+			evictionsExpected: 1,
+		},
+		{
+			name:              "nothing is evicted when the headroom can not be met",
+			headroom:          &SchedulableHeadroom{Slots: 8},
+			evictionsExpected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset(objs...)
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			if tc.headroom != nil {
+				tc.headroom.PodRequests = v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}
+			}
+			plugin, err := NewLowNodeUtilization(&LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 50,
+				},
+				SchedulableHeadroom: tc.headroom,
+			}, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			plugin.(frameworktypes.BalancePlugin).Balance(ctx, []*v1.Node{n1, n2, n3})
+
+			if tc.evictionsExpected != podEvictor.TotalEvicted() {
+				t.Errorf("Expected %v evictions, got %v", tc.evictionsExpected, podEvictor.TotalEvicted())
+			}
+		})
+	}
+}
+
+func TestLowNodeUtilizationWithThresholdRecommendation(t *testing.T) {
+	ctx := context.Background()
+
+	n1 := test.BuildTestNode("n1", 4000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 4000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 4000, 3000, 10, nil)
+
+	objs := []runtime.Object{n1, n2, n3}
+	for i := range 8 {
+		objs = append(objs, test.BuildTestPod(fmt.Sprintf("p%d", i), 400, 0, n1.Name, test.SetRSOwnerRef))
+	}
+	objs = append(objs, test.BuildTestPod("r0", 300, 0, n3.Name, test.SetRSOwnerRef))
+
+	usageObservations = &observationStore{}
+	fakeClient := fake.NewSimpleClientset(objs...)
+	for range 3 {
+		handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+		if err != nil {
+			t.Fatalf("Unable to initialize a framework handle: %v", err)
+		}
+		plugin, err := NewLowNodeUtilization(&LowNodeUtilizationArgs{
+			Thresholds: api.ResourceThresholds{
+				v1.ResourceCPU: 20,
+			},
+			TargetThresholds: api.ResourceThresholds{
+				v1.ResourceCPU: 50,
+			},
+			ThresholdRecommendation: &ThresholdRecommendation{TargetEvictions: 1, Cycles: ptr.To[int32](2)},
+		}, handle)
+		if err != nil {
+			t.Fatalf("Unable to initialize the plugin: %v", err)
+		}
+		plugin.(frameworktypes.BalancePlugin).Balance(ctx, []*v1.Node{n1, n2, n3})
+
+		if podEvictor.TotalEvicted() != 0 {
+			t.Errorf("Expected no evictions in the threshold recommendation mode, got %v", podEvictor.TotalEvicted())
+		}
+	}
+	if got := len(usageObservations.cycles); got != 2 {
+		t.Errorf("Expected the observations of the last 2 cycles kept, got %d", got)
+	}
+}
+
 func withLocalStorage(pod *v1.Pod) {
 	// A pod with local storage.
 	test.SetNormalOwnerRef(pod)
@@ -1776,6 +2151,162 @@ func TestLowNodeUtilizationWithPrometheusMetrics(t *testing.T) {
 			},
 			expectedPodsEvicted: 1,
 		},
+		{
+			name: "with network utilization",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					NetworkResource: 30,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					NetworkResource: 50,
+				},
+				NetworkUtilization: &NetworkUtilization{
+					Query: "instance:node_network_utilisation:ratio",
+				},
+			},
+			samples: model.Vector{
+				sample("instance:node_network_utilisation:ratio", n1NodeName, 0.8),
+				sample("instance:node_network_utilisation:ratio", n2NodeName, 0.4),
+				sample("instance:node_network_utilisation:ratio", n3NodeName, 0.1),
+			},
+			nodes: []*v1.Node{
+				test.BuildTestNode(n1NodeName, 4000, 3000, 9, nil),
+				test.BuildTestNode(n2NodeName, 4000, 3000, 10, nil),
+				test.BuildTestNode(n3NodeName, 4000, 3000, 10, nil),
+			},
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p2", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p3", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p4", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p5", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				// These won't be evicted.
+				test.BuildTestPod("p6", 400, 0, n1NodeName, test.SetDSOwnerRef),
+				test.BuildTestPod("p7", 400, 0, n1NodeName, withLocalStorage),
+				test.BuildTestPod("p8", 400, 0, n1NodeName, withCriticalPod),
+				test.BuildTestPod("p9", 400, 0, n2NodeName, test.SetRSOwnerRef),
+			},
+			expectedPodsEvicted: 1,
+		},
+		{
+			name: "with network utilization with more evictions",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					NetworkResource: 30,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					NetworkResource: 50,
+				},
+				EvictionLimits: &api.EvictionLimits{
+					Node: ptr.To[uint](3),
+				},
+				NetworkUtilization: &NetworkUtilization{
+					Query: "instance:node_network_utilisation:ratio",
+				},
+			},
+			samples: model.Vector{
+				sample("instance:node_network_utilisation:ratio", n1NodeName, 0.8),
+				sample("instance:node_network_utilisation:ratio", n2NodeName, 0.4),
+				sample("instance:node_network_utilisation:ratio", n3NodeName, 0.1),
+			},
+			nodes: []*v1.Node{
+				test.BuildTestNode(n1NodeName, 4000, 3000, 9, nil),
+				test.BuildTestNode(n2NodeName, 4000, 3000, 10, nil),
+				test.BuildTestNode(n3NodeName, 4000, 3000, 10, nil),
+			},
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p2", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p3", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p4", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p5", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				// These won't be evicted.
+				test.BuildTestPod("p6", 400, 0, n1NodeName, test.SetDSOwnerRef),
+				test.BuildTestPod("p7", 400, 0, n1NodeName, withLocalStorage),
+				test.BuildTestPod("p8", 400, 0, n1NodeName, withCriticalPod),
+				test.BuildTestPod("p9", 400, 0, n2NodeName, test.SetRSOwnerRef),
+			},
+			expectedPodsEvicted: 3,
+		},
+		{
+			name: "with network utilization without underutilized nodes",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					NetworkResource: 30,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					NetworkResource: 50,
+				},
+				NetworkUtilization: &NetworkUtilization{
+					Query: "instance:node_network_utilisation:ratio",
+				},
+			},
+			samples: model.Vector{
+				sample("instance:node_network_utilisation:ratio", n1NodeName, 0.8),
+				sample("instance:node_network_utilisation:ratio", n2NodeName, 0.4),
+				sample("instance:node_network_utilisation:ratio", n3NodeName, 0.6),
+			},
+			nodes: []*v1.Node{
+				test.BuildTestNode(n1NodeName, 4000, 3000, 9, nil),
+				test.BuildTestNode(n2NodeName, 4000, 3000, 10, nil),
+				test.BuildTestNode(n3NodeName, 4000, 3000, 10, nil),
+			},
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p2", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p3", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p4", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p5", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				// These won't be evicted.
+				test.BuildTestPod("p6", 400, 0, n1NodeName, test.SetDSOwnerRef),
+				test.BuildTestPod("p7", 400, 0, n1NodeName, withLocalStorage),
+				test.BuildTestPod("p8", 400, 0, n1NodeName, withCriticalPod),
+				test.BuildTestPod("p9", 400, 0, n2NodeName, test.SetRSOwnerRef),
+			},
+			expectedPodsEvicted: 0,
+		},
+		{
+			name: "with custom resources",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					"gpu_mem_util":  30,
+					"disk_iops_sat": 30,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  100,
+					"gpu_mem_util":  50,
+					"disk_iops_sat": 50,
+				},
+				CustomResources: []CustomResource{
+					{Name: "gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+					{Name: "disk_iops_sat", Query: "instance:node_disk_io_time_seconds:rate5m"},
+				},
+			},
+			samples: model.Vector{
+				sample("gpu_mem_util", n1NodeName, 0.8),
+				sample("gpu_mem_util", n2NodeName, 0.4),
+				sample("gpu_mem_util", n3NodeName, 0.1),
+			},
+			nodes: []*v1.Node{
+				test.BuildTestNode(n1NodeName, 4000, 3000, 9, nil),
+				test.BuildTestNode(n2NodeName, 4000, 3000, 10, nil),
+				test.BuildTestNode(n3NodeName, 4000, 3000, 10, nil),
+			},
+			pods: []*v1.Pod{
+				test.BuildTestPod("p1", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p2", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p3", 400, 0, n1NodeName, test.SetRSOwnerRef),
+				test.BuildTestPod("p9", 400, 0, n2NodeName, test.SetRSOwnerRef),
+			},
+			expectedPodsEvicted: 1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1846,3 +2377,71 @@ func TestLowNodeUtilizationWithPrometheusMetrics(t *testing.T) {
 		t.Run(tc.name, testFnc(false, tc.expectedPodsEvicted))
 	}
 }
+
+func TestNodesWithReliableSamples(t *testing.T) {
+	ctx := context.Background()
+	scrapeTime := time.Date(2025, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	n1 := test.BuildTestNode("n1", 4000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 4000, 3000, 10, nil)
+	n3 := test.BuildTestNode("n3", 4000, 3000, 10, nil)
+	n4 := test.BuildTestNode("n4", 4000, 3000, 10, nil)
+	nodemetricses := map[string]*v1beta1.NodeMetrics{}
+	for _, node := range []*v1.Node{n1, n2, n3} {
+		nodemetricses[node.Name] = test.BuildNodeMetrics(node.Name, 400, 1714978816)
+		nodemetricses[node.Name].Timestamp = metav1.NewTime(scrapeTime)
+	}
+
+	metricsClientset := fakemetricsclient.NewSimpleClientset()
+	for _, nodemetrics := range nodemetricses {
+		metricsClientset.Tracker().Create(nodesgvr, nodemetrics, "")
+	}
+	sharedInformerFactory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(n1, n2, n3, n4), 0)
+	nodeLister := sharedInformerFactory.Core().V1().Nodes().Lister()
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	collector := metricscollector.NewMetricsCollector(nodeLister, metricsClientset, labels.Everything())
+	collector.Collect(ctx)
+
+	// n1 changes by 5%, n3 by 150% and n2 is not scraped again
+	for nodeName, millicpu := range map[string]int64{"n1": 420, "n3": 1000} {
+		nodemetricses[nodeName].Usage[v1.ResourceCPU] = *resource.NewMilliQuantity(millicpu, resource.DecimalSI)
+		nodemetricses[nodeName].Timestamp = metav1.NewTime(scrapeTime.Add(20 * time.Second))
+		metricsClientset.Tracker().Update(nodesgvr, nodemetricses[nodeName], "")
+	}
+	collector.Collect(ctx)
+
+	tests := []struct {
+		name          string
+		guard         *SampleGuard
+		expectedNodes []string
+	}{
+		{
+			name:          "stale samples",
+			guard:         &SampleGuard{MaxSampleAgeSeconds: 60},
+			expectedNodes: []string{"n1", "n3"},
+		},
+		{
+			name:          "volatile samples",
+			guard:         &SampleGuard{MaxChangePercentage: 50},
+			expectedNodes: []string{"n1", "n2"},
+		},
+		{
+			name:          "stale and volatile samples",
+			guard:         &SampleGuard{MaxSampleAgeSeconds: 60, MaxChangePercentage: 50},
+			expectedNodes: []string{"n1"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var nodeNames []string
+			for _, node := range nodesWithReliableSamples([]*v1.Node{n1, n2, n3, n4}, collector, tc.guard, scrapeTime.Add(70*time.Second)) {
+				nodeNames = append(nodeNames, node.Name)
+			}
+			if fmt.Sprint(nodeNames) != fmt.Sprint(tc.expectedNodes) {
+				t.Errorf("Expected nodes %v, got %v", tc.expectedNodes, nodeNames)
+			}
+		})
+	}
+}
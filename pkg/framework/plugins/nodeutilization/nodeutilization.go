@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"math/rand"
 	"slices"
 	"sort"
 
@@ -76,6 +77,9 @@ const (
 	// MetricResource is a special resource name we use to keep track of a
 	// metric obtained from a third party entity.
 	MetricResource = v1.ResourceName("MetricResource")
+	// NetworkResource is a special resource name we use to keep track of the
+	// network throughput of a node obtained from Prometheus.
+	NetworkResource = v1.ResourceName("network")
 	// MinResourcePercentage is the minimum value of a resource's percentage
 	MinResourcePercentage = 0
 	// MaxResourcePercentage is the maximum value of a resource's percentage
@@ -97,6 +101,8 @@ type NodeUsage struct {
 type NodeInfo struct {
 	NodeUsage
 	available api.ReferencedResourceList
+	// podFilter further restricts the pods evicted from the node, if set.
+	podFilter func(pod *v1.Pod) bool
 }
 
 // continueEvictionCont is a function that determines if we should keep
@@ -164,6 +170,7 @@ func evictPodsFromSourceNodes(
 	continueEviction continueEvictionCond,
 	usageClient usageClient,
 	maxNoOfPodsToEvictPerNode *uint,
+	resizer *podResizer,
 ) {
 	available, err := assessAvailableResourceInNodes(destinationNodes, resourceNames)
 	if err != nil {
@@ -185,7 +192,11 @@ func evictPodsFromSourceNodes(
 			"usage", node.usage,
 		)
 
-		nonRemovablePods, removablePods := classifyPods(node.allPods, podFilter)
+		nodePodFilter := podFilter
+		if node.podFilter != nil {
+			nodePodFilter = podutil.WrapFilterFuncs(podFilter, node.podFilter)
+		}
+		nonRemovablePods, removablePods := classifyPods(node.allPods, nodePodFilter)
 		klog.V(2).InfoS(
 			"Pods on node",
 			"node", klog.KObj(node.node),
@@ -210,6 +221,7 @@ func evictPodsFromSourceNodes(
 		// them based on QoS. If there are multiple pods with same
 		// priority, they are sorted based on QoS tiers.
 		podutil.SortPodsBasedOnPriorityLowToHigh(removablePods)
+		frameworktypes.OrderPodsForEviction(podEvictor, removablePods)
 
 		if err := evictPods(
 			ctx,
@@ -223,6 +235,7 @@ func evictPodsFromSourceNodes(
 			continueEviction,
 			usageClient,
 			maxNoOfPodsToEvictPerNode,
+			resizer,
 		); err != nil {
 			switch err.(type) {
 			case *evictions.EvictionTotalLimitError:
@@ -235,7 +248,8 @@ func evictPodsFromSourceNodes(
 
 // evictPods keeps evicting pods until the continueEviction function returns
 // false or we can't or shouldn't evict any more pods. available node resources
-// are updated after each eviction.
+// are updated after each eviction. with a resizer the pods are shrunk in place
+// first, only the pods that can not be resized are evicted.
 func evictPods(
 	ctx context.Context,
 	evictableNamespaces *api.Namespaces,
@@ -248,6 +262,7 @@ func evictPods(
 	continueEviction continueEvictionCond,
 	usageClient usageClient,
 	maxNoOfPodsToEvictPerNode *uint,
+	resizer *podResizer,
 ) error {
 	// preemptive check to see if we should continue evicting pods.
 	if !continueEviction(nodeInfo, totalAvailableUsage) {
@@ -309,9 +324,23 @@ func evictPods(
 			unconstrainedResourceEviction = true
 		}
 
-		if err := podEvictor.Evict(ctx, pod, evictOptions); err != nil {
+		if resizer != nil && !unconstrainedResourceEviction {
+			if freed, ok := resizer.resize(ctx, pod); ok {
+				subtractFreedUsageFromNode(&nodeInfo, freed)
+				if !continueEviction(nodeInfo, totalAvailableUsage) {
+					break
+				}
+				continue
+			}
+		}
+
+		// the destination nodes are the good targets for the replacement
+		// of the pod.
+		podEvictOptions := evictOptions
+		podEvictOptions.PreferredNodes = toleratedNodes(pod, destinationTaints)
+		if err := podEvictor.Evict(ctx, pod, podEvictOptions); err != nil {
 			switch err.(type) {
-			case *evictions.EvictionNodeLimitError, *evictions.EvictionTotalLimitError:
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError, *evictions.EvictionTopologyDomainLimitError, *evictions.EvictionTotalLimitError:
 				return err
 			default:
 				klog.Errorf("eviction failed: %v", err)
@@ -344,6 +373,19 @@ func evictPods(
 	return nil
 }
 
+// toleratedNodes lists the names of the nodes, sorted, the pod tolerates the
+// taints of.
+func toleratedNodes(pod *v1.Pod, taintsOfNodes map[string][]v1.Taint) []string {
+	nodeNames := []string{}
+	for nodeName, taints := range taintsOfNodes {
+		if utils.TolerationsTolerateTaintsWithFilter(pod.Spec.Tolerations, taints, nil) {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	sort.Strings(nodeNames)
+	return nodeNames
+}
+
 // subtractPodUsageFromNodeAvailability subtracts the pod usage from the node
 // available resources. this is done to keep track of the remaining resources
 // that can be used to move pods around.
@@ -365,7 +407,7 @@ func subtractPodUsageFromNodeAvailability(
 
 // sortNodesByUsage sorts nodes based on usage according to the given plugin.
 func sortNodesByUsage(nodes []NodeInfo, ascending bool) {
-	sort.Slice(nodes, func(i, j int) bool {
+	sort.SliceStable(nodes, func(i, j int) bool {
 		ti := resource.NewQuantity(0, resource.DecimalSI).Value()
 		tj := resource.NewQuantity(0, resource.DecimalSI).Value()
 		for resourceName := range nodes[i].usage {
@@ -393,6 +435,17 @@ func sortNodesByUsage(nodes []NodeInfo, ascending bool) {
 	})
 }
 
+// shuffleNodes orders the nodes by name and shuffles them with the random source, the
+// nodes of the same usage are then sorted in an order reproducible from the seed of the cycle
+func shuffleNodes(nodes []NodeInfo, rng *rand.Rand) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].node.Name < nodes[j].node.Name
+	})
+	rng.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+}
+
 // isNodeAboveTargetUtilization checks if a node is overutilized
 // At least one resource has to be above the high threshold
 func isNodeAboveTargetUtilization(usage NodeUsage, threshold api.ReferencedResourceList) bool {
@@ -514,18 +567,19 @@ func assessNodesUsagesAndRelativeThresholds(
 // referencedResourceListForNodesCapacity returns a ReferencedResourceList for
 // the capacity of a list of nodes. If allocatable resources are present, they
 // are used instead of capacity.
-func referencedResourceListForNodesCapacity(nodes []*v1.Node) map[string]api.ReferencedResourceList {
+func referencedResourceListForNodesCapacity(nodes []*v1.Node, queriedResourceNames []v1.ResourceName) map[string]api.ReferencedResourceList {
 	capacities := map[string]api.ReferencedResourceList{}
 	for _, node := range nodes {
-		capacities[node.Name] = referencedResourceListForNodeCapacity(node)
+		capacities[node.Name] = referencedResourceListForNodeCapacity(node, queriedResourceNames)
 	}
 	return capacities
 }
 
 // referencedResourceListForNodeCapacity returns a ReferencedResourceList for
 // the capacity of a node. If allocatable resources are present, they are used
-// instead of capacity.
-func referencedResourceListForNodeCapacity(node *v1.Node) api.ReferencedResourceList {
+// instead of capacity. The resources collected through prometheus queries
+// are given a capacity of 100 as their usage is a percentage.
+func referencedResourceListForNodeCapacity(node *v1.Node, queriedResourceNames []v1.ResourceName) api.ReferencedResourceList {
 	capacity := node.Status.Capacity
 	if len(node.Status.Allocatable) > 0 {
 		capacity = node.Status.Allocatable
@@ -543,6 +597,11 @@ func referencedResourceListForNodeCapacity(node *v1.Node) api.ReferencedResource
 	referenced[MetricResource] = resource.NewQuantity(
 		100, resource.DecimalSI,
 	)
+	// the same goes for the resources collected through prometheus
+	// queries, e.g. the network throughput of the node.
+	for _, name := range queriedResourceNames {
+		referenced[name] = resource.NewQuantity(100, resource.DecimalSI)
+	}
 
 	return referenced
 }
@@ -590,6 +649,18 @@ func uniquifyResourceNames(resourceNames []v1.ResourceName) []v1.ResourceName {
 	return slices.Collect(maps.Keys(resourceNamesMap))
 }
 
+// withoutResourceName returns a slice of resource names without the given
+// resource name.
+func withoutResourceName(resourceNames []v1.ResourceName, name v1.ResourceName) []v1.ResourceName {
+	result := make([]v1.ResourceName, 0, len(resourceNames))
+	for _, resourceName := range resourceNames {
+		if resourceName != name {
+			result = append(result, resourceName)
+		}
+	}
+	return result
+}
+
 // filterResourceNamesFromNodeUsage removes from the node usage slice all keys
 // that are not present in the resourceNames slice.
 func filterResourceNames(
@@ -614,11 +685,13 @@ func capNodeCapacitiesToThreshold(
 	node *v1.Node,
 	thresholds api.ResourceThresholds,
 	resourceNames []v1.ResourceName,
+	queriedResourceNames []v1.ResourceName,
 ) api.ReferencedResourceList {
+	capacities := referencedResourceListForNodeCapacity(node, queriedResourceNames)
 	capped := api.ReferencedResourceList{}
 	for _, resourceName := range resourceNames {
 		capped[resourceName] = capNodeCapacityToThreshold(
-			node, thresholds, resourceName,
+			capacities, thresholds, resourceName,
 		)
 	}
 	return capped
@@ -627,9 +700,8 @@ func capNodeCapacitiesToThreshold(
 // capNodeCapacityToThreshold caps the node capacity to the given threshold. if
 // no threshold is set for the resource, the full capacity is returned.
 func capNodeCapacityToThreshold(
-	node *v1.Node, thresholds api.ResourceThresholds, resourceName v1.ResourceName,
+	capacities api.ReferencedResourceList, thresholds api.ResourceThresholds, resourceName v1.ResourceName,
 ) *resource.Quantity {
-	capacities := referencedResourceListForNodeCapacity(node)
 	if _, ok := capacities[resourceName]; !ok {
 		// if the node knows nothing about the resource we return a
 		// zero capacity for it.
@@ -17,7 +17,9 @@ limitations under the License.
 package nodeutilization
 
 import (
+	"fmt"
 	"math"
+	"math/rand"
 	"reflect"
 	"testing"
 
@@ -139,6 +141,39 @@ func TestSortNodesByUsage(t *testing.T) {
 	}
 }
 
+func TestShuffleNodesBreaksTiesBySeed(t *testing.T) {
+	order := func(seed int64) []string {
+		var nodes []NodeInfo
+		for _, name := range []string{"node1", "node2", "node3", "node4", "node5"} {
+			nodes = append(nodes, *BuildTestNodeInfo(name, func(nodeInfo *NodeInfo) {
+				nodeInfo.usage = api.ReferencedResourceList{
+					v1.ResourceCPU: resource.NewMilliQuantity(1000, resource.DecimalSI),
+				}
+			}))
+		}
+		// The input order must not matter
+		nodes[0], nodes[4] = nodes[4], nodes[0]
+		shuffleNodes(nodes, rand.New(rand.NewSource(seed)))
+		sortNodesByUsage(nodes, false)
+		var names []string
+		for _, nodeInfo := range nodes {
+			names = append(names, nodeInfo.node.Name)
+		}
+		return names
+	}
+
+	if first, second := order(42), order(42); !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected the same order of the nodes of the same usage for the same seed, got %v and %v", first, second)
+	}
+	orders := map[string]bool{}
+	for seed := range int64(10) {
+		orders[fmt.Sprint(order(seed))] = true
+	}
+	if len(orders) < 2 {
+		t.Errorf("Expected the order of the nodes of the same usage to depend on the seed, got %v", orders)
+	}
+}
+
 func TestResourceUsageToResourceThreshold(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
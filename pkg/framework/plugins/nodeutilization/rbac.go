@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LowNodeUtilizationPolicyRules declares the RBAC policy rule of the in-place resizes only when configured so
+func LowNodeUtilizationPolicyRules(args runtime.Object) []rbacv1.PolicyRule {
+	lowNodeUtilizationArgs, ok := args.(*LowNodeUtilizationArgs)
+	if !ok || lowNodeUtilizationArgs.InPlaceResize == nil {
+		return nil
+	}
+	return []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{resizeSubresource}, Verbs: []string{"update", "patch"}}}
+}
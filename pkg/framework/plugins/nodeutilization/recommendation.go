@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"math"
+	"sync"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// DefaultThresholdRecommendationCycles is the number of the last descheduling
+// cycles the threshold recommendation is based on by default.
+const DefaultThresholdRecommendationCycles = 10
+
+// nodeObservation is the usage of a node observed in a descheduling cycle.
+type nodeObservation struct {
+	usage       api.ResourceThresholds
+	pods        int
+	schedulable bool
+}
+
+// usageObservations remembers the usage of the nodes over the last
+// descheduling cycles. Plugins are built anew for every cycle so the
+// observations can not be kept in the plugin itself.
+var usageObservations = &observationStore{}
+
+type observationStore struct {
+	mu     sync.Mutex
+	cycles [][]nodeObservation
+}
+
+// record appends the observations of a cycle, keeps only the given number
+// of the last cycles and returns them.
+func (s *observationStore) record(cycle []nodeObservation, keep int) [][]nodeObservation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cycles = append(s.cycles, cycle)
+	if len(s.cycles) > keep {
+		s.cycles = s.cycles[len(s.cycles)-keep:]
+	}
+	return append([][]nodeObservation(nil), s.cycles...)
+}
+
+// estimatedNodeEvictions estimates the number of pods evicted from a node
+// until its usage drops below the target thresholds, every pod of the node
+// taking an equal share of the usage.
+func estimatedNodeEvictions(node nodeObservation, targetThresholds api.ResourceThresholds) int {
+	if node.pods == 0 {
+		return 0
+	}
+	evictions := 0
+	for name, threshold := range targetThresholds {
+		usage := node.usage[name]
+		if usage <= threshold {
+			continue
+		}
+		podShare := float64(usage) / float64(node.pods)
+		evictions = max(evictions, int(math.Ceil(float64(usage-threshold)/podShare)))
+	}
+	return min(evictions, node.pods)
+}
+
+// estimatedEvictions estimates the average number of evictions per cycle the
+// thresholds would have produced over the observed cycles. There are no
+// evictions in a cycle without more underutilized nodes than numberOfNodes
+// as the plugin would have done nothing.
+func estimatedEvictions(cycles [][]nodeObservation, thresholds, targetThresholds api.ResourceThresholds, numberOfNodes int) float64 {
+	if len(cycles) == 0 {
+		return 0
+	}
+	total := 0
+	for _, cycle := range cycles {
+		underutilized, evictions := 0, 0
+		for _, node := range cycle {
+			if node.schedulable && isNodeBelowThreshold(node.usage, thresholds) {
+				underutilized++
+				continue
+			}
+			evictions += estimatedNodeEvictions(node, targetThresholds)
+		}
+		if underutilized == 0 || underutilized <= numberOfNodes || underutilized == len(cycle) {
+			continue
+		}
+		total += evictions
+	}
+	return float64(total) / float64(len(cycles))
+}
+
+// shiftThresholds shifts every threshold by the given percentage points
+// keeping it in the <0; 100> interval.
+func shiftThresholds(thresholds api.ResourceThresholds, shift api.Percentage) api.ResourceThresholds {
+	shifted := make(api.ResourceThresholds, len(thresholds))
+	for name, threshold := range thresholds {
+		shifted[name] = min(max(threshold+shift, MinResourcePercentage), MaxResourcePercentage)
+	}
+	return shifted
+}
+
+// thresholdRecommendation is the outcome of the threshold recommendation.
+type thresholdRecommendation struct {
+	thresholds         api.ResourceThresholds
+	targetThresholds   api.ResourceThresholds
+	estimatedEvictions float64
+}
+
+// recommendThresholds looks for the thresholds that would have produced the
+// most evictions per cycle without exceeding the target evictions over the
+// observed cycles. The recommended thresholds keep the shape of the configured
+// ones, all of them shifted by the same percentage points, the smallest shift
+// winning the ties. The thresholds with the fewest evictions are recommended
+// when none of them meets the target.
+func recommendThresholds(cycles [][]nodeObservation, thresholds, targetThresholds api.ResourceThresholds, numberOfNodes int, targetEvictions int32) thresholdRecommendation {
+	var best *thresholdRecommendation
+	var bestShift api.Percentage
+	better := func(candidate thresholdRecommendation, shift api.Percentage) bool {
+		if best == nil {
+			return true
+		}
+		target := float64(targetEvictions)
+		candidateMeets, bestMeets := candidate.estimatedEvictions <= target, best.estimatedEvictions <= target
+		switch {
+		case candidateMeets != bestMeets:
+			return candidateMeets
+		case candidate.estimatedEvictions != best.estimatedEvictions:
+			// closer to the target from below, or fewer evictions above the target
+			return candidateMeets == (candidate.estimatedEvictions > best.estimatedEvictions)
+		default:
+			return math.Abs(float64(shift)) < math.Abs(float64(bestShift))
+		}
+	}
+	for shift := api.Percentage(-MaxResourcePercentage); shift <= MaxResourcePercentage; shift++ {
+		candidate := thresholdRecommendation{
+			thresholds:       shiftThresholds(thresholds, shift),
+			targetThresholds: shiftThresholds(targetThresholds, shift),
+		}
+		candidate.estimatedEvictions = estimatedEvictions(cycles, candidate.thresholds, candidate.targetThresholds, numberOfNodes)
+		if better(candidate, shift) {
+			best, bestShift = &candidate, shift
+		}
+	}
+	return *best
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestEstimatedNodeEvictions(t *testing.T) {
+	targetThresholds := api.ResourceThresholds{v1.ResourceCPU: 50, v1.ResourceMemory: 50}
+	tests := []struct {
+		name     string
+		node     nodeObservation
+		expected int
+	}{
+		{
+			name:     "node without pods",
+			node:     nodeObservation{usage: api.ResourceThresholds{v1.ResourceCPU: 80}},
+			expected: 0,
+		},
+		{
+			name:     "node below the target thresholds",
+			node:     nodeObservation{usage: api.ResourceThresholds{v1.ResourceCPU: 50, v1.ResourceMemory: 40}, pods: 5},
+			expected: 0,
+		},
+		{
+			name:     "pods evicted until the cpu drops below the target",
+			node:     nodeObservation{usage: api.ResourceThresholds{v1.ResourceCPU: 80, v1.ResourceMemory: 40}, pods: 8},
+			expected: 3,
+		},
+		{
+			name:     "the resource needing the most evictions wins",
+			node:     nodeObservation{usage: api.ResourceThresholds{v1.ResourceCPU: 60, v1.ResourceMemory: 90}, pods: 9},
+			expected: 4,
+		},
+		{
+			name:     "no more evictions than pods",
+			node:     nodeObservation{usage: api.ResourceThresholds{v1.ResourceCPU: 100}, pods: 1},
+			expected: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := estimatedNodeEvictions(tc.node, targetThresholds); got != tc.expected {
+				t.Errorf("Expected %d evictions, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRecommendThresholds(t *testing.T) {
+	observation := func(cpu api.Percentage, pods int) nodeObservation {
+		return nodeObservation{usage: api.ResourceThresholds{v1.ResourceCPU: cpu}, pods: pods, schedulable: true}
+	}
+	// n1 overutilized with 10% of cpu per pod, then 7.5%, n2 with 25% of cpu
+	// per pod and n3 underutilized
+	cycles := [][]nodeObservation{
+		{observation(80, 8), observation(50, 2), observation(5, 1)},
+		{observation(60, 8), observation(50, 2), observation(5, 1)},
+	}
+	thresholds := api.ResourceThresholds{v1.ResourceCPU: 20}
+	targetThresholds := api.ResourceThresholds{v1.ResourceCPU: 50}
+
+	if got := estimatedEvictions(cycles, thresholds, targetThresholds, 0); got != 2.5 {
+		t.Errorf("Expected 2.5 evictions per cycle with the configured thresholds, got %v", got)
+	}
+	if got := estimatedEvictions(cycles, thresholds, targetThresholds, 1); got != 0 {
+		t.Errorf("Expected no evictions with no more underutilized nodes than numberOfNodes, got %v", got)
+	}
+
+	tests := []struct {
+		name            string
+		targetEvictions int32
+		expected        thresholdRecommendation
+	}{
+		{
+			name:            "the configured thresholds meet the target",
+			targetEvictions: 3,
+			expected: thresholdRecommendation{
+				thresholds:         api.ResourceThresholds{v1.ResourceCPU: 20},
+				targetThresholds:   api.ResourceThresholds{v1.ResourceCPU: 50},
+				estimatedEvictions: 2.5,
+			},
+		},
+		{
+			name:            "the thresholds are raised to evict less",
+			targetEvictions: 1,
+			expected: thresholdRecommendation{
+				thresholds:         api.ResourceThresholds{v1.ResourceCPU: 30},
+				targetThresholds:   api.ResourceThresholds{v1.ResourceCPU: 60},
+				estimatedEvictions: 1,
+			},
+		},
+		{
+			name:            "the thresholds are lowered to evict more",
+			targetEvictions: 5,
+			expected: thresholdRecommendation{
+				thresholds:         api.ResourceThresholds{v1.ResourceCPU: 9},
+				targetThresholds:   api.ResourceThresholds{v1.ResourceCPU: 39},
+				estimatedEvictions: 5,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := recommendThresholds(cycles, thresholds, targetThresholds, 0, tc.targetEvictions)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected the recommendation %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// resizeSubresource is the subresource of the pods the in-place resize is
+// requested through.
+const resizeSubresource = "pods/resize"
+
+// inPlaceResizeSupported tells whether the apiserver serves the resize
+// subresource of the pods.
+func inPlaceResizeSupported(client clientset.Interface) bool {
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(v1.SchemeGroupVersion.String())
+	if err != nil {
+		klog.ErrorS(err, "unable to discover the in-place pod resize support")
+		return false
+	}
+	for _, apiResource := range resources.APIResources {
+		if apiResource.Name == resizeSubresource {
+			return true
+		}
+	}
+	return false
+}
+
+// podResizer shrinks the requests of the pods in place instead of evicting
+// them.
+type podResizer struct {
+	client                clientset.Interface
+	minRequestsPercentage api.Percentage
+}
+
+// shrunkRequests returns a copy of the pod with the cpu and memory requests
+// of its containers shrunk to the minimum percentage of their limits. nil is
+// returned for the Guaranteed pods, their QoS class can not change, and for
+// the pods with no requests above the minimum.
+func shrunkRequests(pod *v1.Pod, minRequestsPercentage api.Percentage) *v1.Pod {
+	if podutil.IsGuaranteedPod(pod) {
+		return nil
+	}
+	resized := pod.DeepCopy()
+	shrunk := false
+	for i := range resized.Spec.Containers {
+		container := &resized.Spec.Containers[i]
+		for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			limit, hasLimit := container.Resources.Limits[name]
+			request, hasRequest := container.Resources.Requests[name]
+			if !hasLimit || !hasRequest {
+				continue
+			}
+			var minimum *resource.Quantity
+			if name == v1.ResourceCPU {
+				minimum = resource.NewMilliQuantity(int64(float64(limit.MilliValue())*float64(minRequestsPercentage)/100), resource.DecimalSI)
+			} else {
+				minimum = resource.NewQuantity(int64(float64(limit.Value())*float64(minRequestsPercentage)/100), resource.BinarySI)
+			}
+			if request.Cmp(*minimum) > 0 {
+				container.Resources.Requests[name] = *minimum
+				shrunk = true
+			}
+		}
+	}
+	if !shrunk {
+		return nil
+	}
+	return resized
+}
+
+// resize shrinks the requests of the pod in place and returns the requests
+// freed on the node. false is returned when the pod can not be resized and
+// is to be evicted instead.
+func (r *podResizer) resize(ctx context.Context, pod *v1.Pod) (api.ReferencedResourceList, bool) {
+	resized := shrunkRequests(pod, r.minRequestsPercentage)
+	if resized == nil {
+		return nil, false
+	}
+	if _, err := r.client.CoreV1().Pods(pod.Namespace).UpdateResize(ctx, pod.Name, resized, metav1.UpdateOptions{}); err != nil {
+		if apierrors.IsForbidden(err) {
+			klog.ErrorS(err, "Unable to resize the pod in place, the update of pods/resize is forbidden, evicting it instead", "pod", klog.KObj(pod))
+		} else {
+			klog.V(1).InfoS("Unable to resize the pod in place, evicting it instead", "pod", klog.KObj(pod), "err", err)
+		}
+		return nil, false
+	}
+
+	before, _ := utils.PodRequestsAndLimits(pod)
+	after, _ := utils.PodRequestsAndLimits(resized)
+	freed := api.ReferencedResourceList{}
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		quantity := before[name].DeepCopy()
+		quantity.Sub(after[name])
+		freed[name] = &quantity
+	}
+	klog.V(1).InfoS("Resized the pod in place", "pod", klog.KObj(pod), "freedCPU", freed[v1.ResourceCPU], "freedMemory", freed[v1.ResourceMemory])
+	return freed, true
+}
+
+// subtractFreedUsageFromNode lowers the node usage by the requests freed by
+// an in-place resize. unlike an eviction the resize moves nothing to the
+// destination nodes.
+func subtractFreedUsageFromNode(nodeInfo *NodeInfo, freed api.ReferencedResourceList) {
+	for name, quantity := range freed {
+		if usage, ok := nodeInfo.usage[name]; ok && usage != nil {
+			usage.Sub(*quantity)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeutilization
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+// nodesWithReliableSamples drops the nodes whose latest metrics sample is
+// missing, older than the max sample age or changed by more than the max
+// change since the previous sample. Such nodes are neither overutilized nor
+// underutilized for the cycle, acting on a scrape gap or the usage spike of a
+// restarted node would evict pods for nothing.
+func nodesWithReliableSamples(
+	nodes []*v1.Node, collector frameworktypes.MetricsCollector, guard *SampleGuard, now time.Time,
+) []*v1.Node {
+	var reliableNodes []*v1.Node
+	for _, node := range nodes {
+		// no metrics are collected for the simulated nodes
+		if collector.SimulatedNodesIgnored() && nodeutil.IsSimulatedNode(node) {
+			reliableNodes = append(reliableNodes, node)
+			continue
+		}
+		sample, ok := collector.NodeSample(node.Name)
+		if !ok {
+			klog.V(2).InfoS("Node ignored, no metrics sample collected", "node", klog.KObj(node))
+			continue
+		}
+		if age := now.Sub(sample.Timestamp); guard.MaxSampleAgeSeconds > 0 && age > time.Duration(guard.MaxSampleAgeSeconds)*time.Second {
+			klog.V(2).InfoS("Node ignored, the metrics sample is stale", "node", klog.KObj(node), "age", age)
+			continue
+		}
+		if guard.MaxChangePercentage > 0 && sample.Change > float64(guard.MaxChangePercentage) {
+			klog.V(2).InfoS("Node ignored, the metrics sample is volatile", "node", klog.KObj(node), "changePercentage", sample.Change)
+			continue
+		}
+		reliableNodes = append(reliableNodes, node)
+	}
+	return reliableNodes
+}
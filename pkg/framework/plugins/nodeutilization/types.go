@@ -14,6 +14,7 @@ limitations under the License.
 package nodeutilization
 
 import (
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/descheduler/pkg/api"
 )
@@ -29,6 +30,12 @@ type LowNodeUtilizationArgs struct {
 	TargetThresholds       api.ResourceThresholds `json:"targetThresholds"`
 	NumberOfNodes          int                    `json:"numberOfNodes,omitempty"`
 	MetricsUtilization     *MetricsUtilization    `json:"metricsUtilization,omitempty"`
+	// networkUtilization enables the network throughput of the nodes as the
+	// "network" resource of the thresholds.
+	NetworkUtilization *NetworkUtilization `json:"networkUtilization,omitempty"`
+	// customResources backs arbitrary resource names of the thresholds with
+	// prometheus queries, e.g. gpu_mem_util.
+	CustomResources []CustomResource `json:"customResources,omitempty"`
 
 	// Naming this one differently since namespaces are still
 	// considered while considering resources used by pods
@@ -37,6 +44,28 @@ type LowNodeUtilizationArgs struct {
 
 	// evictionLimits limits the number of evictions per domain. E.g. node, namespace, total.
 	EvictionLimits *api.EvictionLimits `json:"evictionLimits,omitempty"`
+
+	// priorityEscalation evicts only the low priority pods outside of the
+	// Guaranteed QoS class from the overutilized nodes first. The remaining
+	// pods are considered only for nodes still overutilized in a later cycle.
+	PriorityEscalation *PriorityEscalation `json:"priorityEscalation,omitempty"`
+
+	// inPlaceResize shrinks the requests of the pods of the overutilized
+	// nodes in place instead of evicting them when the cluster supports
+	// the in-place pod resize. The pods that can not be resized are evicted.
+	InPlaceResize *InPlaceResize `json:"inPlaceResize,omitempty"`
+
+	// schedulableHeadroom replaces the target utilization as the objective
+	// of the plugin. The plugin does nothing while the cluster can schedule
+	// the configured number of pods of the given shape and stops evicting
+	// as soon as it can.
+	SchedulableHeadroom *SchedulableHeadroom `json:"schedulableHeadroom,omitempty"`
+
+	// thresholdRecommendation turns the plugin into an analysis mode. The
+	// plugin evicts nothing, it observes the usage of the nodes over the
+	// descheduling cycles and reports the thresholds that would have
+	// produced the target number of evictions.
+	ThresholdRecommendation *ThresholdRecommendation `json:"thresholdRecommendation,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -47,6 +76,9 @@ type HighNodeUtilizationArgs struct {
 
 	Thresholds    api.ResourceThresholds `json:"thresholds"`
 	NumberOfNodes int                    `json:"numberOfNodes,omitempty"`
+	// customResources backs arbitrary resource names of the thresholds with
+	// prometheus queries, e.g. gpu_mem_util.
+	CustomResources []CustomResource `json:"customResources,omitempty"`
 
 	// Naming this one differently since namespaces are still
 	// considered while considering resources used by pods
@@ -68,6 +100,80 @@ type MetricsUtilization struct {
 
 	// prometheus enables metrics collection through a prometheus query.
 	Prometheus *Prometheus `json:"prometheus,omitempty"`
+
+	// sampleGuard ignores the nodes whose kubernetes metrics are stale or
+	// volatile instead of acting on scrape gaps and restart artifacts.
+	SampleGuard *SampleGuard `json:"sampleGuard,omitempty"`
+}
+
+// SampleGuard configures the checks of the metrics samples of the nodes before their use
+// +k8s:deepcopy-gen=true
+type SampleGuard struct {
+	// maxSampleAgeSeconds ignores the nodes whose latest metrics sample
+	// is older than the given number of seconds.
+	MaxSampleAgeSeconds int64 `json:"maxSampleAgeSeconds,omitempty"`
+	// maxChangePercentage ignores the nodes whose cpu or memory usage
+	// changed by more than the given percentage since the previous sample.
+	MaxChangePercentage api.Percentage `json:"maxChangePercentage,omitempty"`
+}
+
+// NetworkUtilization allow to consume the network throughput of the nodes from Prometheus
+// +k8s:deepcopy-gen=true
+type NetworkUtilization struct {
+	// query returning a vector of samples, each sample labeled with `instance`
+	// corresponding to a node name with each sample value as the fraction of
+	// the network bandwidth of the node in use as a real number in <0; 1> interval.
+	Query string `json:"query,omitempty"`
+}
+
+// CustomResource backs a resource name of the thresholds with a prometheus query
+type CustomResource struct {
+	// name of the resource in the thresholds, e.g. gpu_mem_util.
+	Name v1.ResourceName `json:"name"`
+	// query returning a vector of samples, each sample labeled with `instance`
+	// corresponding to a node name with each sample value as the fraction of
+	// the resource of the node in use as a real number in <0; 1> interval.
+	Query string `json:"query"`
+}
+
+// PriorityEscalation configures the two-phase eviction of the LowNodeUtilization plugin
+// +k8s:deepcopy-gen=true
+type PriorityEscalation struct {
+	// priorityThreshold separates the low priority pods, the pods with
+	// a priority lower than the threshold, from the high priority ones.
+	// Defaults to 1, i.e. only the pods of no or a non-positive priority
+	// are low priority.
+	PriorityThreshold *int32 `json:"priorityThreshold,omitempty"`
+}
+
+// InPlaceResize configures the in-place resize of the LowNodeUtilization plugin
+// +k8s:deepcopy-gen=true
+type InPlaceResize struct {
+	// minRequestsPercentage is the percentage of the limits the cpu and
+	// memory requests of a container are never shrunk below. The requests
+	// of the resources without a limit are kept.
+	MinRequestsPercentage api.Percentage `json:"minRequestsPercentage"`
+}
+
+// SchedulableHeadroom configures the schedulable headroom objective of the LowNodeUtilization plugin
+// +k8s:deepcopy-gen=true
+type SchedulableHeadroom struct {
+	// slots is the number of pods of the given shape the schedulable nodes
+	// are to have room for.
+	Slots int32 `json:"slots"`
+	// podRequests is the shape of the pods, i.e. their cpu and memory requests.
+	PodRequests v1.ResourceList `json:"podRequests"`
+}
+
+// ThresholdRecommendation configures the threshold recommendation mode of the LowNodeUtilization plugin
+// +k8s:deepcopy-gen=true
+type ThresholdRecommendation struct {
+	// targetEvictions is the average number of evictions per descheduling
+	// cycle the recommended thresholds are to produce at most.
+	TargetEvictions int32 `json:"targetEvictions"`
+	// cycles is the number of the last descheduling cycles the
+	// recommendation is based on. Defaults to 10.
+	Cycles *int32 `json:"cycles,omitempty"`
 }
 
 type Prometheus struct {
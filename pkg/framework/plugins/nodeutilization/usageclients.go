@@ -31,9 +31,9 @@ import (
 	utilptr "k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/api"
-	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
 	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
@@ -135,10 +135,12 @@ func (s *requestedUsageClient) sync(ctx context.Context, nodes []*v1.Node) error
 type actualUsageClient struct {
 	resourceNames         []v1.ResourceName
 	getPodsAssignedToNode podutil.GetPodsAssignedToNodeFunc
-	metricsCollector      *metricscollector.MetricsCollector
+	metricsCollector      frameworktypes.MetricsCollector
 
 	_pods            map[string][]*v1.Pod
 	_nodeUtilization map[string]api.ReferencedResourceList
+	// _simulatedNodes lists nodes with the utilization computed from the pod requests
+	_simulatedNodes map[string]bool
 }
 
 var _ usageClient = &actualUsageClient{}
@@ -146,7 +148,7 @@ var _ usageClient = &actualUsageClient{}
 func newActualUsageClient(
 	resourceNames []v1.ResourceName,
 	getPodsAssignedToNode podutil.GetPodsAssignedToNodeFunc,
-	metricsCollector *metricscollector.MetricsCollector,
+	metricsCollector frameworktypes.MetricsCollector,
 ) *actualUsageClient {
 	return &actualUsageClient{
 		resourceNames:         resourceNames,
@@ -164,6 +166,13 @@ func (client *actualUsageClient) pods(node string) []*v1.Pod {
 }
 
 func (client *actualUsageClient) podUsage(pod *v1.Pod) (api.ReferencedResourceList, error) {
+	if client._simulatedNodes[pod.Spec.NodeName] {
+		usage := make(api.ReferencedResourceList)
+		for _, resourceName := range client.resourceNames {
+			usage[resourceName] = utilptr.To[resource.Quantity](utils.GetResourceRequestQuantity(pod, resourceName).DeepCopy())
+		}
+		return usage, nil
+	}
 	// It's not efficient to keep track of all pods in a cluster when only their fractions is evicted.
 	// Thus, take the current pod metrics without computing any softening (like e.g. EWMA).
 	podMetrics, err := client.metricsCollector.MetricsClient().MetricsV1beta1().PodMetricses(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
@@ -194,6 +203,7 @@ func (client *actualUsageClient) podUsage(pod *v1.Pod) (api.ReferencedResourceLi
 func (client *actualUsageClient) sync(ctx context.Context, nodes []*v1.Node) error {
 	client._nodeUtilization = make(map[string]api.ReferencedResourceList)
 	client._pods = make(map[string][]*v1.Pod)
+	client._simulatedNodes = make(map[string]bool)
 
 	nodesUsage, err := client.metricsCollector.AllNodesUsage()
 	if err != nil {
@@ -207,6 +217,21 @@ func (client *actualUsageClient) sync(ctx context.Context, nodes []*v1.Node) err
 			return fmt.Errorf("error accessing %q node's pods: %v", node.Name, err)
 		}
 
+		if client.metricsCollector.SimulatedNodesIgnored() && nodeutil.IsSimulatedNode(node) {
+			// No metrics are collected for simulated nodes, the pods only request resources
+			nodeUsage, err := nodeutil.NodeUtilization(pods, client.resourceNames, func(pod *v1.Pod) (v1.ResourceList, error) {
+				req, _ := utils.PodRequestsAndLimits(pod)
+				return req, nil
+			})
+			if err != nil {
+				return err
+			}
+			client._pods[node.Name] = pods
+			client._nodeUtilization[node.Name] = nodeUsage
+			client._simulatedNodes[node.Name] = true
+			continue
+		}
+
 		collectedNodeUsage, ok := nodesUsage[node.Name]
 		if !ok {
 			return fmt.Errorf("unable to find node %q in the collected metrics", node.Name)
@@ -319,3 +344,81 @@ func (client *prometheusUsageClient) sync(ctx context.Context, nodes []*v1.Node)
 
 	return nil
 }
+
+// queryUsageClient extends the node usage of another usage client with the
+// usage of the resources collected through prometheus queries, e.g. the
+// network throughput of the nodes. The usage of the pods is not known.
+type queryUsageClient struct {
+	usageClient
+	promClient promapi.Client
+	queries    map[v1.ResourceName]string
+
+	_nodeUtilization map[string]api.ReferencedResourceList
+}
+
+var _ usageClient = &queryUsageClient{}
+
+func newQueryUsageClient(
+	client usageClient,
+	promClient promapi.Client,
+	queries map[v1.ResourceName]string,
+) *queryUsageClient {
+	return &queryUsageClient{
+		usageClient: client,
+		promClient:  promClient,
+		queries:     queries,
+	}
+}
+
+func (client *queryUsageClient) nodeUtilization(node string) api.ReferencedResourceList {
+	return client._nodeUtilization[node]
+}
+
+func (client *queryUsageClient) podUsage(pod *v1.Pod) (api.ReferencedResourceList, error) {
+	return nil, newNotSupportedError(prometheusUsageClientType)
+}
+
+func (client *queryUsageClient) sync(ctx context.Context, nodes []*v1.Node) error {
+	client._nodeUtilization = make(map[string]api.ReferencedResourceList)
+
+	if err := client.usageClient.sync(ctx, nodes); err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		nodeUsage := api.ReferencedResourceList{}
+		for resourceName, quantity := range client.usageClient.nodeUtilization(node.Name) {
+			nodeUsage[resourceName] = quantity
+		}
+		client._nodeUtilization[node.Name] = nodeUsage
+	}
+
+	for resourceName, query := range client.queries {
+		queryUsages, err := NodeUsageFromPrometheusMetrics(ctx, client.promClient, query)
+		if err != nil {
+			return fmt.Errorf("unable to collect %q usage: %v", resourceName, err)
+		}
+		for _, node := range nodes {
+			queryUsage, exists := queryUsages[node.Name]
+			if !exists {
+				return fmt.Errorf("unable to find %q metric entry for %v", resourceName, node.Name)
+			}
+			client._nodeUtilization[node.Name][resourceName] = queryUsage[MetricResource]
+		}
+	}
+
+	return nil
+}
+
+// resourceQueries maps the resource names collected through prometheus
+// queries to their queries.
+func resourceQueries(network *NetworkUtilization, customResources []CustomResource) map[v1.ResourceName]string {
+	queries := make(map[v1.ResourceName]string)
+	if network != nil {
+		queries[NetworkResource] = network.Query
+	}
+	for _, customResource := range customResources {
+		queries[customResource.Name] = customResource.Query
+	}
+	return queries
+}
@@ -36,6 +36,7 @@ import (
 	fakemetricsclient "k8s.io/metrics/pkg/client/clientset/versioned/fake"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	"sigs.k8s.io/descheduler/test"
 )
@@ -143,6 +144,64 @@ func TestActualUsageClient(t *testing.T) {
 	)
 }
 
+func TestActualUsageClientSimulatedNodes(t *testing.T) {
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, func(node *v1.Node) {
+		node.Annotations = map[string]string{nodeutil.SimulatedNodeAnnotationKey: "fake"}
+	})
+
+	p1 := test.BuildTestPod("p1", 400, 0, n1.Name, nil)
+	p21 := test.BuildTestPod("p21", 400, 0, n2.Name, nil)
+	p22 := test.BuildTestPod("p22", 300, 0, n2.Name, nil)
+
+	nodes := []*v1.Node{n1, n2}
+
+	clientset := fakeclientset.NewSimpleClientset(n1, n2, p1, p21, p22)
+	metricsClientset := fakemetricsclient.NewSimpleClientset()
+	metricsClientset.Tracker().Create(nodesgvr, test.BuildNodeMetrics("n1", 900, 1714978816), "")
+
+	ctx := context.TODO()
+
+	sharedInformerFactory := informers.NewSharedInformerFactory(clientset, 0)
+	podInformer := sharedInformerFactory.Core().V1().Pods().Informer()
+	nodeLister := sharedInformerFactory.Core().V1().Nodes().Lister()
+	podsAssignedToNode, err := podutil.BuildGetPodsAssignedToNodeFunc(podInformer)
+	if err != nil {
+		t.Fatalf("Build get pods assigned to node function error: %v", err)
+	}
+
+	sharedInformerFactory.Start(ctx.Done())
+	sharedInformerFactory.WaitForCacheSync(ctx.Done())
+
+	collector := metricscollector.NewMetricsCollector(nodeLister, metricsClientset, labels.Everything())
+	usageClient := newActualUsageClient([]v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}, podsAssignedToNode, collector)
+
+	if err := collector.Collect(ctx); err != nil {
+		t.Fatalf("failed to capture metrics: %v", err)
+	}
+	if err := usageClient.sync(ctx, nodes); err == nil {
+		t.Fatalf("expected the sync to fail for a node without metrics")
+	}
+
+	collector.IgnoreSimulatedNodes()
+	if err := usageClient.sync(ctx, nodes); err != nil {
+		t.Fatalf("failed to sync a snapshot: %v", err)
+	}
+	if usage := usageClient.nodeUtilization(n1.Name)[v1.ResourceCPU].MilliValue(); usage != 900 {
+		t.Errorf("expected cpu usage of %v to come from the metrics, expected 900, got %v", n1.Name, usage)
+	}
+	if usage := usageClient.nodeUtilization(n2.Name)[v1.ResourceCPU].MilliValue(); usage != 700 {
+		t.Errorf("expected cpu usage of %v to come from the pod requests, expected 700, got %v", n2.Name, usage)
+	}
+	podUsage, err := usageClient.podUsage(p22)
+	if err != nil {
+		t.Fatalf("failed to get pod usage: %v", err)
+	}
+	if podUsage[v1.ResourceCPU].MilliValue() != 300 {
+		t.Errorf("expected cpu usage of %v to come from the pod requests, expected 300, got %v", p22.Name, podUsage[v1.ResourceCPU].MilliValue())
+	}
+}
+
 type fakePromClient struct {
 	result   interface{}
 	dataType model.ValueType
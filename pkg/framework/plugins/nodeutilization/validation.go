@@ -15,9 +15,14 @@ package nodeutilization
 
 import (
 	"fmt"
+	"strings"
 
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 func ValidateHighNodeUtilizationArgs(obj runtime.Object) error {
@@ -26,10 +31,16 @@ func ValidateHighNodeUtilizationArgs(obj runtime.Object) error {
 	if args.EvictableNamespaces != nil && len(args.EvictableNamespaces.Include) > 0 {
 		return fmt.Errorf("only Exclude namespaces can be set, inclusion is not supported")
 	}
+	if err := utils.ValidateNamespaces(args.EvictableNamespaces); err != nil {
+		return err
+	}
 	err := validateThresholds(args.Thresholds)
 	if err != nil {
 		return err
 	}
+	if err := validateCustomResources(args.CustomResources, args.Thresholds); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -40,6 +51,9 @@ func ValidateLowNodeUtilizationArgs(obj runtime.Object) error {
 	if args.EvictableNamespaces != nil && len(args.EvictableNamespaces.Include) > 0 {
 		return fmt.Errorf("only Exclude namespaces can be set, inclusion is not supported")
 	}
+	if err := utils.ValidateNamespaces(args.EvictableNamespaces); err != nil {
+		return err
+	}
 	err := validateLowNodeUtilizationThresholds(args.Thresholds, args.TargetThresholds, args.UseDeviationThresholds)
 	if err != nil {
 		return err
@@ -54,10 +68,141 @@ func ValidateLowNodeUtilizationArgs(obj runtime.Object) error {
 		if args.MetricsUtilization.Source == api.PrometheusMetrics && (args.MetricsUtilization.Prometheus == nil || args.MetricsUtilization.Prometheus.Query == "") {
 			return fmt.Errorf("prometheus query is required when metrics source is set to %q", api.PrometheusMetrics)
 		}
+		if err := validateSampleGuard(args.MetricsUtilization); err != nil {
+			return err
+		}
+	}
+	if _, ok := args.Thresholds[NetworkResource]; ok && args.NetworkUtilization == nil {
+		return fmt.Errorf("networkUtilization is required when %q thresholds are set", NetworkResource)
+	}
+	if args.NetworkUtilization != nil {
+		if _, ok := args.Thresholds[NetworkResource]; !ok {
+			return fmt.Errorf("%q thresholds are required when networkUtilization is set", NetworkResource)
+		}
+		if args.NetworkUtilization.Query == "" {
+			return fmt.Errorf("networkUtilization query is required")
+		}
+		if args.MetricsUtilization != nil && args.MetricsUtilization.Source == api.PrometheusMetrics {
+			return fmt.Errorf("networkUtilization is not allowed to set when metrics source is set to %q", api.PrometheusMetrics)
+		}
+	}
+	if err := validateCustomResources(args.CustomResources, args.Thresholds); err != nil {
+		return err
+	}
+	if len(args.CustomResources) > 0 && args.MetricsUtilization != nil && args.MetricsUtilization.Source == api.PrometheusMetrics {
+		return fmt.Errorf("customResources are not allowed to set when metrics source is set to %q", api.PrometheusMetrics)
+	}
+	if args.InPlaceResize != nil {
+		// shrinking the requests does not lower the actual utilization
+		if args.MetricsUtilization != nil {
+			return fmt.Errorf("inPlaceResize is not allowed to set together with metricsUtilization")
+		}
+		if args.InPlaceResize.MinRequestsPercentage <= 0 || args.InPlaceResize.MinRequestsPercentage >= 100 {
+			return fmt.Errorf("inPlaceResize minRequestsPercentage must be in (0, 100), got %v", args.InPlaceResize.MinRequestsPercentage)
+		}
+	}
+	if args.SchedulableHeadroom != nil {
+		// the slots are counted from the requests of the pods
+		if args.MetricsUtilization != nil {
+			return fmt.Errorf("schedulableHeadroom is not allowed to set together with metricsUtilization")
+		}
+		if err := validateSchedulableHeadroom(args.SchedulableHeadroom); err != nil {
+			return err
+		}
+	}
+	if args.ThresholdRecommendation != nil {
+		// the recommended thresholds are absolute percentages
+		if args.UseDeviationThresholds {
+			return fmt.Errorf("thresholdRecommendation is not allowed to set together with useDeviationThresholds")
+		}
+		if args.ThresholdRecommendation.TargetEvictions < 0 {
+			return fmt.Errorf("thresholdRecommendation targetEvictions must not be negative, got %d", args.ThresholdRecommendation.TargetEvictions)
+		}
+		if args.ThresholdRecommendation.Cycles != nil && *args.ThresholdRecommendation.Cycles < 1 {
+			return fmt.Errorf("thresholdRecommendation cycles must be at least 1, got %d", *args.ThresholdRecommendation.Cycles)
+		}
 	}
 	return nil
 }
 
+// validateSchedulableHeadroom checks the headroom asks for at least one slot
+// of a pod shape made of positive cpu and memory requests
+// validateSampleGuard checks the sample guard is set only for the kubernetes
+// metrics, the samples of the prometheus queries are not kept across cycles.
+func validateSampleGuard(metrics *MetricsUtilization) error {
+	guard := metrics.SampleGuard
+	if guard == nil {
+		return nil
+	}
+	if metrics.Source != api.KubernetesMetrics && !metrics.MetricsServer {
+		return fmt.Errorf("sampleGuard is only supported with the %q metrics source", api.KubernetesMetrics)
+	}
+	if guard.MaxSampleAgeSeconds < 0 {
+		return fmt.Errorf("sampleGuard maxSampleAgeSeconds must not be negative, got %v", guard.MaxSampleAgeSeconds)
+	}
+	if guard.MaxChangePercentage < 0 {
+		return fmt.Errorf("sampleGuard maxChangePercentage must not be negative, got %v", guard.MaxChangePercentage)
+	}
+	if guard.MaxSampleAgeSeconds == 0 && guard.MaxChangePercentage == 0 {
+		return fmt.Errorf("sampleGuard requires maxSampleAgeSeconds or maxChangePercentage to be set")
+	}
+	return nil
+}
+
+func validateSchedulableHeadroom(headroom *SchedulableHeadroom) error {
+	if headroom.Slots < 1 {
+		return fmt.Errorf("schedulableHeadroom slots must be at least 1, got %d", headroom.Slots)
+	}
+	if len(headroom.PodRequests) == 0 {
+		return fmt.Errorf("schedulableHeadroom podRequests are required")
+	}
+	for name, quantity := range headroom.PodRequests {
+		if name != v1.ResourceCPU && name != v1.ResourceMemory {
+			return fmt.Errorf("schedulableHeadroom podRequests only support %q and %q, got %q", v1.ResourceCPU, v1.ResourceMemory, name)
+		}
+		if quantity.Sign() <= 0 {
+			return fmt.Errorf("schedulableHeadroom podRequests %q must be positive, got %v", name, quantity.String())
+		}
+	}
+	return nil
+}
+
+// validateCustomResources checks the custom resources have a valid and unique name
+// not shadowing a node resource, a query and a threshold configured
+func validateCustomResources(customResources []CustomResource, thresholds api.ResourceThresholds) error {
+	seen := sets.New[v1.ResourceName]()
+	for _, customResource := range customResources {
+		name := customResource.Name
+		if errs := validation.IsQualifiedName(string(name)); len(errs) > 0 || strings.Contains(string(name), "/") {
+			return fmt.Errorf("customResources name %q must be a qualified name without a prefix", name)
+		}
+		if isNodeResourceName(name) {
+			return fmt.Errorf("customResources name %q is reserved", name)
+		}
+		if seen.Has(name) {
+			return fmt.Errorf("customResources name %q is duplicated", name)
+		}
+		seen.Insert(name)
+		if customResource.Query == "" {
+			return fmt.Errorf("customResources %q query is required", name)
+		}
+		if _, ok := thresholds[name]; !ok {
+			return fmt.Errorf("customResources %q threshold is required", name)
+		}
+	}
+	return nil
+}
+
+// isNodeResourceName checks whether the resource name is taken by a resource of
+// the nodes or a resource with a special meaning for the plugins
+func isNodeResourceName(name v1.ResourceName) bool {
+	switch name {
+	case v1.ResourceCPU, v1.ResourceMemory, v1.ResourcePods, v1.ResourceEphemeralStorage, v1.ResourceStorage, NetworkResource, MetricResource:
+		return true
+	}
+	return strings.HasPrefix(string(name), v1.ResourceHugePagesPrefix)
+}
+
 func validateLowNodeUtilizationThresholds(thresholds, targetThresholds api.ResourceThresholds, useDeviationThresholds bool) error {
 	// validate thresholds and targetThresholds config
 	if err := validateThresholds(thresholds); err != nil {
@@ -21,7 +21,9 @@ import (
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/api"
 )
@@ -242,6 +244,351 @@ func TestValidateLowNodeUtilizationPluginConfig(t *testing.T) {
 			},
 			errInfo: fmt.Errorf("prometheus configuration is not allowed to set when source is set to \"KubernetesMetrics\""),
 		},
+		{
+			name: "valid sample guard",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+				},
+				MetricsUtilization: &MetricsUtilization{
+					Source:      api.KubernetesMetrics,
+					SampleGuard: &SampleGuard{MaxSampleAgeSeconds: 60, MaxChangePercentage: 50},
+				},
+			},
+			errInfo: nil,
+		},
+		{
+			name: "sample guard with prometheus source",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					MetricResource: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					MetricResource: 80,
+				},
+				MetricsUtilization: &MetricsUtilization{
+					Source:      api.PrometheusMetrics,
+					Prometheus:  &Prometheus{Query: "instance:node_cpu:rate:sum"},
+					SampleGuard: &SampleGuard{MaxSampleAgeSeconds: 60},
+				},
+			},
+			errInfo: fmt.Errorf("sampleGuard is only supported with the \"KubernetesMetrics\" metrics source"),
+		},
+		{
+			name: "sample guard with no checks",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+				},
+				MetricsUtilization: &MetricsUtilization{
+					MetricsServer: true,
+					SampleGuard:   &SampleGuard{},
+				},
+			},
+			errInfo: fmt.Errorf("sampleGuard requires maxSampleAgeSeconds or maxChangePercentage to be set"),
+		}, {
+			name: "valid network utilization",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  20,
+					NetworkResource: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  80,
+					NetworkResource: 80,
+				},
+				NetworkUtilization: &NetworkUtilization{
+					Query: "instance:node_network_utilisation:ratio",
+				},
+			},
+			errInfo: nil,
+		},
+		{
+			name: "network thresholds without network utilization",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  20,
+					NetworkResource: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  80,
+					NetworkResource: 80,
+				},
+			},
+			errInfo: fmt.Errorf("networkUtilization is required when \"network\" thresholds are set"),
+		},
+		{
+			name: "network utilization without network thresholds",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+				},
+				NetworkUtilization: &NetworkUtilization{
+					Query: "instance:node_network_utilisation:ratio",
+				},
+			},
+			errInfo: fmt.Errorf("\"network\" thresholds are required when networkUtilization is set"),
+		},
+		{
+			name: "missing network utilization query",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  20,
+					NetworkResource: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  80,
+					NetworkResource: 80,
+				},
+				NetworkUtilization: &NetworkUtilization{},
+			},
+			errInfo: fmt.Errorf("networkUtilization query is required"),
+		},
+		{
+			name: "network utilization with prometheus source",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  20,
+					NetworkResource: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU:  80,
+					NetworkResource: 80,
+				},
+				NetworkUtilization: &NetworkUtilization{
+					Query: "instance:node_network_utilisation:ratio",
+				},
+				MetricsUtilization: &MetricsUtilization{
+					Source: api.PrometheusMetrics,
+					Prometheus: &Prometheus{
+						Query: "instance:node_cpu:rate:sum",
+					},
+				},
+			},
+			errInfo: fmt.Errorf("networkUtilization is not allowed to set when metrics source is set to \"Prometheus\""),
+		},
+		{
+			name: "valid custom resources",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+					"gpu_mem_util": 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+					"gpu_mem_util": 80,
+				},
+				CustomResources: []CustomResource{
+					{Name: "gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+				},
+			},
+			errInfo: nil,
+		},
+		{
+			name: "custom resource without threshold",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+				},
+				CustomResources: []CustomResource{
+					{Name: "gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+				},
+			},
+			errInfo: fmt.Errorf("customResources \"gpu_mem_util\" threshold is required"),
+		},
+		{
+			name: "custom resource without query",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+					"gpu_mem_util": 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+					"gpu_mem_util": 80,
+				},
+				CustomResources: []CustomResource{
+					{Name: "gpu_mem_util"},
+				},
+			},
+			errInfo: fmt.Errorf("customResources \"gpu_mem_util\" query is required"),
+		},
+		{
+			name: "custom resource shadowing a node resource",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+					"gpu_mem_util": 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+					"gpu_mem_util": 80,
+				},
+				CustomResources: []CustomResource{
+					{Name: "cpu", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+				},
+			},
+			errInfo: fmt.Errorf("customResources name \"cpu\" is reserved"),
+		},
+		{
+			name: "custom resource with an extended resource name",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+					"gpu_mem_util": 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+					"gpu_mem_util": 80,
+				},
+				CustomResources: []CustomResource{
+					{Name: "example.com/gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+				},
+			},
+			errInfo: fmt.Errorf("customResources name \"example.com/gpu_mem_util\" must be a qualified name without a prefix"),
+		},
+		{
+			name: "duplicated custom resources",
+			args: &LowNodeUtilizationArgs{
+				Thresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 20,
+					"gpu_mem_util": 20,
+				},
+				TargetThresholds: api.ResourceThresholds{
+					v1.ResourceCPU: 80,
+					"gpu_mem_util": 80,
+				},
+				CustomResources: []CustomResource{
+					{Name: "gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+					{Name: "gpu_mem_util", Query: "avg by (instance) (DCGM_FI_DEV_MEM_COPY_UTIL) / 100"},
+				},
+			},
+			errInfo: fmt.Errorf("customResources name \"gpu_mem_util\" is duplicated"),
+		},
+		{
+			name: "in-place resize with metrics utilization",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:         api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds:   api.ResourceThresholds{v1.ResourceCPU: 80},
+				MetricsUtilization: &MetricsUtilization{Source: api.KubernetesMetrics},
+				InPlaceResize:      &InPlaceResize{MinRequestsPercentage: 50},
+			},
+			errInfo: fmt.Errorf("inPlaceResize is not allowed to set together with metricsUtilization"),
+		},
+		{
+			name: "in-place resize with zero min requests percentage",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds: api.ResourceThresholds{v1.ResourceCPU: 80},
+				InPlaceResize:    &InPlaceResize{},
+			},
+			errInfo: fmt.Errorf("inPlaceResize minRequestsPercentage must be in (0, 100), got 0"),
+		},
+		{
+			name: "valid in-place resize",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds: api.ResourceThresholds{v1.ResourceCPU: 80},
+				InPlaceResize:    &InPlaceResize{MinRequestsPercentage: 50},
+			},
+		},
+		{
+			name: "schedulable headroom without slots",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds: api.ResourceThresholds{v1.ResourceCPU: 80},
+				SchedulableHeadroom: &SchedulableHeadroom{
+					PodRequests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+			errInfo: fmt.Errorf("schedulableHeadroom slots must be at least 1, got 0"),
+		},
+		{
+			name: "schedulable headroom with an unsupported pod request",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds: api.ResourceThresholds{v1.ResourceCPU: 80},
+				SchedulableHeadroom: &SchedulableHeadroom{
+					Slots:       2,
+					PodRequests: v1.ResourceList{v1.ResourcePods: resource.MustParse("1")},
+				},
+			},
+			errInfo: fmt.Errorf("schedulableHeadroom podRequests only support \"cpu\" and \"memory\", got \"pods\""),
+		},
+		{
+			name: "schedulable headroom with a zero pod request",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds: api.ResourceThresholds{v1.ResourceCPU: 80},
+				SchedulableHeadroom: &SchedulableHeadroom{
+					Slots:       2,
+					PodRequests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("0")},
+				},
+			},
+			errInfo: fmt.Errorf("schedulableHeadroom podRequests \"memory\" must be positive, got 0"),
+		},
+		{
+			name: "valid schedulable headroom",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:       api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds: api.ResourceThresholds{v1.ResourceCPU: 80},
+				SchedulableHeadroom: &SchedulableHeadroom{
+					Slots: 2,
+					PodRequests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("1"),
+						v1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+				},
+			},
+		},
+		{
+			name: "threshold recommendation with deviation thresholds",
+			args: &LowNodeUtilizationArgs{
+				UseDeviationThresholds:  true,
+				Thresholds:              api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds:        api.ResourceThresholds{v1.ResourceCPU: 20},
+				ThresholdRecommendation: &ThresholdRecommendation{TargetEvictions: 5},
+			},
+			errInfo: fmt.Errorf("thresholdRecommendation is not allowed to set together with useDeviationThresholds"),
+		},
+		{
+			name: "threshold recommendation with negative target evictions",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:              api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds:        api.ResourceThresholds{v1.ResourceCPU: 80},
+				ThresholdRecommendation: &ThresholdRecommendation{TargetEvictions: -1},
+			},
+			errInfo: fmt.Errorf("thresholdRecommendation targetEvictions must not be negative, got -1"),
+		},
+		{
+			name: "threshold recommendation with zero cycles",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:              api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds:        api.ResourceThresholds{v1.ResourceCPU: 80},
+				ThresholdRecommendation: &ThresholdRecommendation{TargetEvictions: 5, Cycles: ptr.To[int32](0)},
+			},
+			errInfo: fmt.Errorf("thresholdRecommendation cycles must be at least 1, got 0"),
+		},
+		{
+			name: "valid threshold recommendation",
+			args: &LowNodeUtilizationArgs{
+				Thresholds:              api.ResourceThresholds{v1.ResourceCPU: 20},
+				TargetThresholds:        api.ResourceThresholds{v1.ResourceCPU: 80},
+				ThresholdRecommendation: &ThresholdRecommendation{TargetEvictions: 5, Cycles: ptr.To[int32](3)},
+			},
+		},
 	}
 
 	for _, testCase := range tests {
@@ -22,6 +22,7 @@ limitations under the License.
 package nodeutilization
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	api "sigs.k8s.io/descheduler/pkg/api"
 )
@@ -37,6 +38,11 @@ func (in *HighNodeUtilizationArgs) DeepCopyInto(out *HighNodeUtilizationArgs) {
 			(*out)[key] = val
 		}
 	}
+	if in.CustomResources != nil {
+		in, out := &in.CustomResources, &out.CustomResources
+		*out = make([]CustomResource, len(*in))
+		copy(*out, *in)
+	}
 	if in.EvictableNamespaces != nil {
 		in, out := &in.EvictableNamespaces, &out.EvictableNamespaces
 		*out = new(api.Namespaces)
@@ -63,6 +69,22 @@ func (in *HighNodeUtilizationArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceResize) DeepCopyInto(out *InPlaceResize) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceResize.
+func (in *InPlaceResize) DeepCopy() *InPlaceResize {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceResize)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LowNodeUtilizationArgs) DeepCopyInto(out *LowNodeUtilizationArgs) {
 	*out = *in
@@ -86,6 +108,16 @@ func (in *LowNodeUtilizationArgs) DeepCopyInto(out *LowNodeUtilizationArgs) {
 		*out = new(MetricsUtilization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkUtilization != nil {
+		in, out := &in.NetworkUtilization, &out.NetworkUtilization
+		*out = new(NetworkUtilization)
+		**out = **in
+	}
+	if in.CustomResources != nil {
+		in, out := &in.CustomResources, &out.CustomResources
+		*out = make([]CustomResource, len(*in))
+		copy(*out, *in)
+	}
 	if in.EvictableNamespaces != nil {
 		in, out := &in.EvictableNamespaces, &out.EvictableNamespaces
 		*out = new(api.Namespaces)
@@ -96,6 +128,26 @@ func (in *LowNodeUtilizationArgs) DeepCopyInto(out *LowNodeUtilizationArgs) {
 		*out = new(api.EvictionLimits)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PriorityEscalation != nil {
+		in, out := &in.PriorityEscalation, &out.PriorityEscalation
+		*out = new(PriorityEscalation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InPlaceResize != nil {
+		in, out := &in.InPlaceResize, &out.InPlaceResize
+		*out = new(InPlaceResize)
+		**out = **in
+	}
+	if in.SchedulableHeadroom != nil {
+		in, out := &in.SchedulableHeadroom, &out.SchedulableHeadroom
+		*out = new(SchedulableHeadroom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThresholdRecommendation != nil {
+		in, out := &in.ThresholdRecommendation, &out.ThresholdRecommendation
+		*out = new(ThresholdRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -125,6 +177,11 @@ func (in *MetricsUtilization) DeepCopyInto(out *MetricsUtilization) {
 		*out = new(Prometheus)
 		**out = **in
 	}
+	if in.SampleGuard != nil {
+		in, out := &in.SampleGuard, &out.SampleGuard
+		*out = new(SampleGuard)
+		**out = **in
+	}
 	return
 }
 
@@ -137,3 +194,100 @@ func (in *MetricsUtilization) DeepCopy() *MetricsUtilization {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkUtilization) DeepCopyInto(out *NetworkUtilization) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkUtilization.
+func (in *NetworkUtilization) DeepCopy() *NetworkUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityEscalation) DeepCopyInto(out *PriorityEscalation) {
+	*out = *in
+	if in.PriorityThreshold != nil {
+		in, out := &in.PriorityThreshold, &out.PriorityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityEscalation.
+func (in *PriorityEscalation) DeepCopy() *PriorityEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SampleGuard) DeepCopyInto(out *SampleGuard) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SampleGuard.
+func (in *SampleGuard) DeepCopy() *SampleGuard {
+	if in == nil {
+		return nil
+	}
+	out := new(SampleGuard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulableHeadroom) DeepCopyInto(out *SchedulableHeadroom) {
+	*out = *in
+	if in.PodRequests != nil {
+		in, out := &in.PodRequests, &out.PodRequests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulableHeadroom.
+func (in *SchedulableHeadroom) DeepCopy() *SchedulableHeadroom {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulableHeadroom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThresholdRecommendation) DeepCopyInto(out *ThresholdRecommendation) {
+	*out = *in
+	if in.Cycles != nil {
+		in, out := &in.Cycles, &out.Cycles
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThresholdRecommendation.
+func (in *ThresholdRecommendation) DeepCopy() *ThresholdRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ThresholdRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
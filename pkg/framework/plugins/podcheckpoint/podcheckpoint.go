@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podcheckpoint
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+const (
+	PluginName = "PodCheckpoint"
+	// CheckpointURLAnnotationKey is the pod annotation holding the URL the checkpoint of the pod is requested at.
+	// The pod IP is used when the URL has no host, e.g. http://:8080/checkpoint.
+	CheckpointURLAnnotationKey = "descheduler.alpha.kubernetes.io/checkpoint-url"
+)
+
+// PodCheckpoint requests the pods to checkpoint their state before they are evicted
+// and waits until the pods acknowledge they are ready to be evicted
+type PodCheckpoint struct {
+	handle frameworktypes.Handle
+	args   *PodCheckpointArgs
+	client *http.Client
+}
+
+var _ frameworktypes.PreEvictionHookEvictorPlugin = &PodCheckpoint{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	podCheckpointArgs, ok := args.(*PodCheckpointArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type PodCheckpointArgs, got %T", args)
+	}
+
+	return &PodCheckpoint{
+		handle: handle,
+		args:   podCheckpointArgs,
+		client: &http.Client{Timeout: podCheckpointArgs.Interval.Duration},
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *PodCheckpoint) Name() string {
+	return PluginName
+}
+
+// Filter does not constrain the eviction, the pods are prepared for the eviction by the pre-eviction hook
+func (d *PodCheckpoint) Filter(pod *v1.Pod) bool {
+	return true
+}
+
+// PreEvictionFilter does not constrain the eviction, the pods are prepared for the eviction by the pre-eviction hook
+func (d *PodCheckpoint) PreEvictionFilter(pod *v1.Pod) bool {
+	return true
+}
+
+// PreEviction requests the checkpoint of the pod and waits until the pod acknowledges it is ready to be evicted.
+// The checkpoint endpoint responds with 200 (OK) or 204 (No Content) once the pod is ready to be evicted
+// and with 202 (Accepted) while the checkpoint is in progress, the request is repeated until the timeout.
+// Pods without the checkpoint URL annotation are evicted right away.
+func (d *PodCheckpoint) PreEviction(ctx context.Context, pod *v1.Pod) error {
+	value, ok := pod.Annotations[CheckpointURLAnnotationKey]
+	if !ok {
+		return nil
+	}
+	checkpointURL, err := podCheckpointURL(pod, value)
+	if err != nil {
+		return err
+	}
+
+	klog.V(3).InfoS("Requesting the checkpoint of the pod", "pod", klog.KObj(pod), "url", checkpointURL)
+	var lastErr error
+	err = wait.PollUntilContextTimeout(ctx, d.args.Interval.Duration, d.args.Timeout.Duration, true, func(ctx context.Context) (bool, error) {
+		ready, err := d.requestCheckpoint(ctx, checkpointURL)
+		if err != nil {
+			// the pod may be momentarily unavailable, the request is repeated until the timeout
+			klog.V(4).InfoS("Checkpoint request failed", "pod", klog.KObj(pod), "err", err)
+			lastErr = err
+			return false, nil
+		}
+		return ready, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("pod did not acknowledge the checkpoint: %v", lastErr)
+		}
+		return fmt.Errorf("pod did not acknowledge the checkpoint within %v", d.args.Timeout.Duration)
+	}
+	klog.V(3).InfoS("Pod is ready to be evicted", "pod", klog.KObj(pod))
+	return nil
+}
+
+// requestCheckpoint returns true once the pod acknowledges it is ready to be evicted
+func (d *PodCheckpoint) requestCheckpoint(ctx context.Context, checkpointURL string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, checkpointURL, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return true, nil
+	case http.StatusAccepted:
+		return false, nil
+	default:
+		return false, fmt.Errorf("checkpoint endpoint responded with %q", resp.Status)
+	}
+}
+
+// podCheckpointURL resolves the checkpoint URL of the pod, the pod IP is used when the URL has no host
+func podCheckpointURL(pod *v1.Pod, value string) (string, error) {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("annotation %q is not a valid http(s) URL: %q", CheckpointURLAnnotationKey, value)
+	}
+	if u.Hostname() == "" {
+		if pod.Status.PodIP == "" {
+			return "", fmt.Errorf("pod has no IP to request the checkpoint at")
+		}
+		host := pod.Status.PodIP
+		if net.ParseIP(host).To4() == nil {
+			host = "[" + host + "]"
+		}
+		if port := u.Port(); port != "" {
+			host = net.JoinHostPort(pod.Status.PodIP, port)
+		}
+		u.Host = host
+	}
+	return u.String(), nil
+}
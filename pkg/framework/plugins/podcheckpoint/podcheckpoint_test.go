@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podcheckpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestPodCheckpointPreEviction(t *testing.T) {
+	testCases := []struct {
+		description string
+		responses   []int
+		annotation  func(serverURL *url.URL) string
+		podIP       string
+		expectError bool
+	}{
+		{
+			description: "pod without the annotation, evicts",
+			expectError: false,
+		},
+		{
+			description: "pod ready to be evicted",
+			responses:   []int{http.StatusOK},
+			expectError: false,
+		},
+		{
+			description: "checkpoint in progress, then ready to be evicted",
+			responses:   []int{http.StatusAccepted, http.StatusAccepted, http.StatusNoContent},
+			expectError: false,
+		},
+		{
+			description: "checkpoint URL without host, the pod IP is used",
+			responses:   []int{http.StatusOK},
+			annotation: func(serverURL *url.URL) string {
+				return "http://:" + serverURL.Port() + "/checkpoint"
+			},
+			podIP:       "127.0.0.1",
+			expectError: false,
+		},
+		{
+			description: "checkpoint URL without host, pod without IP",
+			annotation: func(serverURL *url.URL) string {
+				return "http://:" + serverURL.Port() + "/checkpoint"
+			},
+			expectError: true,
+		},
+		{
+			description: "checkpoint never acknowledged",
+			responses:   []int{http.StatusAccepted},
+			expectError: true,
+		},
+		{
+			description: "checkpoint fails",
+			responses:   []int{http.StatusInternalServerError},
+			expectError: true,
+		},
+		{
+			description: "invalid checkpoint URL",
+			annotation: func(serverURL *url.URL) string {
+				return "tcp://" + serverURL.Host
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/checkpoint" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				idx := int(atomic.AddInt32(&requests, 1)) - 1
+				if idx >= len(tc.responses) {
+					idx = len(tc.responses) - 1
+				}
+				w.WriteHeader(tc.responses[idx])
+			}))
+			defer server.Close()
+			serverURL, _ := url.Parse(server.URL)
+
+			pod := test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) {
+				pod.Status.PodIP = tc.podIP
+				if tc.annotation != nil {
+					pod.Annotations = map[string]string{CheckpointURLAnnotationKey: tc.annotation(serverURL)}
+				} else if len(tc.responses) > 0 {
+					pod.Annotations = map[string]string{CheckpointURLAnnotationKey: server.URL + "/checkpoint"}
+				}
+			})
+
+			plugin, err := New(&PodCheckpointArgs{
+				Timeout:  &metav1.Duration{Duration: 500 * time.Millisecond},
+				Interval: &metav1.Duration{Duration: 10 * time.Millisecond},
+			}, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			err = plugin.(frameworktypes.PreEvictionHookEvictorPlugin).PreEviction(context.Background(), pod)
+			if tc.expectError && err == nil {
+				t.Errorf("Expected the pre-eviction hook to fail")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podcheckpoint
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodCheckpointArgs holds arguments used to configure PodCheckpoint plugin.
+type PodCheckpointArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Timeout bounds the wait for a pod to acknowledge it is ready to be evicted. Defaults to 5m.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Interval between the checkpoint requests while the checkpoint is in progress. Defaults to 5s.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podcheckpoint
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ValidatePodCheckpointArgs validates PodCheckpoint arguments
+func ValidatePodCheckpointArgs(obj runtime.Object) error {
+	args := obj.(*PodCheckpointArgs)
+	if args.Timeout != nil && args.Timeout.Duration <= 0 {
+		return fmt.Errorf("timeout must be positive")
+	}
+	if args.Interval != nil && args.Interval.Duration <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if args.Timeout != nil && args.Interval != nil && args.Interval.Duration > args.Timeout.Duration {
+		return fmt.Errorf("interval must not be longer than the timeout")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podcheckpoint
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidatePodCheckpointArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *PodCheckpointArgs
+		expectError bool
+	}{
+		{
+			description: "valid args, no errors",
+			args: &PodCheckpointArgs{
+				Timeout:  &metav1.Duration{Duration: time.Minute},
+				Interval: &metav1.Duration{Duration: time.Second},
+			},
+			expectError: false,
+		},
+		{
+			description: "non-positive timeout, expects error",
+			args: &PodCheckpointArgs{
+				Timeout: &metav1.Duration{},
+			},
+			expectError: true,
+		},
+		{
+			description: "non-positive interval, expects error",
+			args: &PodCheckpointArgs{
+				Interval: &metav1.Duration{Duration: -time.Second},
+			},
+			expectError: true,
+		},
+		{
+			description: "interval longer than the timeout, expects error",
+			args: &PodCheckpointArgs{
+				Timeout:  &metav1.Duration{Duration: time.Second},
+				Interval: &metav1.Duration{Duration: time.Minute},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidatePodCheckpointArgs(tc.args)
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptioncondition
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultLeadTime = 10 * time.Second
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_PodDisruptionConditionArgs
+// TODO: the final default values would be discussed in community
+func SetDefaults_PodDisruptionConditionArgs(obj runtime.Object) {
+	args := obj.(*PodDisruptionConditionArgs)
+	if args.ConditionType == "" {
+		args.ConditionType = v1.DisruptionTarget
+	}
+	if args.ConditionStatus == "" {
+		args.ConditionStatus = v1.ConditionFalse
+		if args.ConditionType == v1.DisruptionTarget {
+			args.ConditionStatus = v1.ConditionTrue
+		}
+	}
+	if args.LeadTime == nil {
+		args.LeadTime = &metav1.Duration{Duration: defaultLeadTime}
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptioncondition
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+const (
+	PluginName = "PodDisruptionCondition"
+	// ConditionReason is the reason of the condition set on the pods about to be evicted
+	ConditionReason = "EvictionByDescheduler"
+)
+
+// PodDisruptionCondition sets a condition on the pods a lead time before they are evicted,
+// so the pods and the load balancers can start draining the connections ahead of the eviction
+type PodDisruptionCondition struct {
+	handle frameworktypes.Handle
+	args   *PodDisruptionConditionArgs
+}
+
+var _ frameworktypes.PreEvictionRevertEvictorPlugin = &PodDisruptionCondition{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	podDisruptionConditionArgs, ok := args.(*PodDisruptionConditionArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type PodDisruptionConditionArgs, got %T", args)
+	}
+
+	return &PodDisruptionCondition{
+		handle: handle,
+		args:   podDisruptionConditionArgs,
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *PodDisruptionCondition) Name() string {
+	return PluginName
+}
+
+// Filter does not constrain the eviction, the pods are prepared for the eviction by the pre-eviction hook
+func (d *PodDisruptionCondition) Filter(pod *v1.Pod) bool {
+	return true
+}
+
+// PreEvictionFilter does not constrain the eviction, the pods are prepared for the eviction by the pre-eviction hook
+func (d *PodDisruptionCondition) PreEvictionFilter(pod *v1.Pod) bool {
+	return true
+}
+
+// PreEviction sets the condition on the pod and waits the lead time before the pod is evicted.
+// The pod is not evicted when the condition can not be set, the condition is reverted when the wait is interrupted.
+func (d *PodDisruptionCondition) PreEviction(ctx context.Context, pod *v1.Pod) error {
+	leadTime := d.args.LeadTime.Duration
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"conditions": []v1.PodCondition{{
+				Type:               d.args.ConditionType,
+				Status:             d.args.ConditionStatus,
+				Reason:             ConditionReason,
+				Message:            fmt.Sprintf("pod to be evicted by sigs.k8s.io/descheduler in %v", leadTime),
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := d.handle.ClientSet().CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "status"); err != nil {
+		return fmt.Errorf("unable to set the %s condition: %v", d.args.ConditionType, err)
+	}
+	klog.V(3).InfoS("Set the disruption condition of the pod", "pod", klog.KObj(pod), "condition", d.args.ConditionType, "status", d.args.ConditionStatus, "leadTime", leadTime)
+
+	if leadTime <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		if err := d.RevertPreEviction(context.WithoutCancel(ctx), pod); err != nil {
+			klog.ErrorS(err, "Unable to revert the disruption condition of the pod", "pod", klog.KObj(pod))
+		}
+		return ctx.Err()
+	case <-time.After(leadTime):
+		return nil
+	}
+}
+
+// RevertPreEviction restores the condition of the pod not evicted after all as it was before PreEviction,
+// so the pod is not left marked as being disrupted. The condition is removed when the pod had none.
+func (d *PodDisruptionCondition) RevertPreEviction(ctx context.Context, pod *v1.Pod) error {
+	condition := map[string]any{"type": d.args.ConditionType, "$patch": "delete"}
+	for _, podCondition := range pod.Status.Conditions {
+		if podCondition.Type == d.args.ConditionType {
+			// the fields the previous condition lacks are cleared rather than merged with the ones set by PreEviction
+			data, err := json.Marshal(podCondition)
+			if err != nil {
+				return err
+			}
+			condition = map[string]any{"reason": nil, "message": nil}
+			if err := json.Unmarshal(data, &condition); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"conditions": []any{condition},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := d.handle.ClientSet().CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "status"); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to revert the %s condition: %v", d.args.ConditionType, err)
+	}
+	klog.V(3).InfoS("Reverted the disruption condition of the pod not evicted", "pod", klog.KObj(pod), "condition", d.args.ConditionType)
+	return nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptioncondition
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestPodDisruptionConditionPreEviction(t *testing.T) {
+	testCases := []struct {
+		description     string
+		args            *PodDisruptionConditionArgs
+		podMissing      bool
+		expectCondition v1.PodConditionType
+		expectStatus    v1.ConditionStatus
+		expectError     bool
+	}{
+		{
+			description:     "default condition",
+			args:            &PodDisruptionConditionArgs{},
+			expectCondition: v1.DisruptionTarget,
+			expectStatus:    v1.ConditionTrue,
+		},
+		{
+			description:     "readiness gate condition",
+			args:            &PodDisruptionConditionArgs{ConditionType: "example.com/serving"},
+			expectCondition: "example.com/serving",
+			expectStatus:    v1.ConditionFalse,
+		},
+		{
+			description: "condition not set, the pod is not evicted",
+			args:        &PodDisruptionConditionArgs{},
+			podMissing:  true,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx := context.Background()
+			pod := test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) {
+				pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+			})
+			fakeClient := fake.NewSimpleClientset()
+			if !tc.podMissing {
+				fakeClient = fake.NewSimpleClientset(pod)
+			}
+
+			SetDefaults_PodDisruptionConditionArgs(tc.args)
+			tc.args.LeadTime = &metav1.Duration{Duration: 10 * time.Millisecond}
+			plugin, err := New(tc.args, &frameworkfake.HandleImpl{ClientsetImpl: fakeClient})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			start := time.Now()
+			err = plugin.(frameworktypes.PreEvictionHookEvictorPlugin).PreEviction(ctx, pod)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected the pre-eviction hook to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed < tc.args.LeadTime.Duration {
+				t.Errorf("Expected the pre-eviction hook to wait the lead time %v, returned after %v", tc.args.LeadTime.Duration, elapsed)
+			}
+
+			updated, err := fakeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error when getting the pod: %v", err)
+			}
+			var found *v1.PodCondition
+			for i, condition := range updated.Status.Conditions {
+				if condition.Type == tc.expectCondition {
+					found = &updated.Status.Conditions[i]
+				}
+			}
+			if found == nil {
+				t.Fatalf("Expected the %s condition to be set, got %v", tc.expectCondition, updated.Status.Conditions)
+			}
+			if found.Status != tc.expectStatus || found.Reason != ConditionReason {
+				t.Errorf("Expected the %s condition with status %s and reason %s, got %v", tc.expectCondition, tc.expectStatus, ConditionReason, *found)
+			}
+			if len(updated.Status.Conditions) != 2 {
+				t.Errorf("Expected the other conditions of the pod to be kept, got %v", updated.Status.Conditions)
+			}
+		})
+	}
+}
+
+func TestPodDisruptionConditionPreEvictionCanceled(t *testing.T) {
+	pod := test.BuildTestPod("p1", 100, 0, "n1", nil)
+	args := &PodDisruptionConditionArgs{LeadTime: &metav1.Duration{Duration: time.Hour}}
+	SetDefaults_PodDisruptionConditionArgs(args)
+	fakeClient := fake.NewSimpleClientset(pod)
+	plugin, err := New(args, &frameworkfake.HandleImpl{ClientsetImpl: fakeClient})
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := plugin.(frameworktypes.PreEvictionHookEvictorPlugin).PreEviction(ctx, pod); err == nil {
+		t.Errorf("Expected the pre-eviction hook to fail once the context is done")
+	}
+
+	updated, err := fakeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting the pod: %v", err)
+	}
+	if len(updated.Status.Conditions) != 0 {
+		t.Errorf("Expected the condition to be reverted once the wait is interrupted, got %v", updated.Status.Conditions)
+	}
+}
+
+func TestPodDisruptionConditionRevertPreEviction(t *testing.T) {
+	testCases := []struct {
+		description      string
+		conditions       []v1.PodCondition
+		expectConditions []v1.PodCondition
+	}{
+		{
+			description:      "condition removed",
+			conditions:       []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+			expectConditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+		},
+		{
+			description: "previous condition restored",
+			conditions: []v1.PodCondition{
+				{Type: v1.PodReady, Status: v1.ConditionTrue},
+				{Type: v1.DisruptionTarget, Status: v1.ConditionFalse, Reason: "Example"},
+			},
+			expectConditions: []v1.PodCondition{
+				{Type: v1.PodReady, Status: v1.ConditionTrue},
+				{Type: v1.DisruptionTarget, Status: v1.ConditionFalse, Reason: "Example"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx := context.Background()
+			pod := test.BuildTestPod("p1", 100, 0, "n1", func(pod *v1.Pod) {
+				pod.Status.Conditions = tc.conditions
+			})
+			fakeClient := fake.NewSimpleClientset(pod)
+			args := &PodDisruptionConditionArgs{LeadTime: &metav1.Duration{}}
+			SetDefaults_PodDisruptionConditionArgs(args)
+			plugin, err := New(args, &frameworkfake.HandleImpl{ClientsetImpl: fakeClient})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			if err := plugin.(frameworktypes.PreEvictionHookEvictorPlugin).PreEviction(ctx, pod); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := plugin.(frameworktypes.PreEvictionRevertEvictorPlugin).RevertPreEviction(ctx, pod); err != nil {
+				t.Fatalf("Unexpected error when reverting: %v", err)
+			}
+
+			updated, err := fakeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error when getting the pod: %v", err)
+			}
+			if !reflect.DeepEqual(updated.Status.Conditions, tc.expectConditions) {
+				t.Errorf("Expected the conditions %v, got %v", tc.expectConditions, updated.Status.Conditions)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptioncondition
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodDisruptionConditionArgs holds arguments used to configure PodDisruptionCondition plugin.
+type PodDisruptionConditionArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ConditionType set on the pods before they are evicted. Defaults to DisruptionTarget.
+	ConditionType v1.PodConditionType `json:"conditionType,omitempty"`
+	// ConditionStatus of the condition. Defaults to True for DisruptionTarget and to False otherwise,
+	// turning the pods declaring the condition as a readiness gate unready.
+	ConditionStatus v1.ConditionStatus `json:"conditionStatus,omitempty"`
+	// LeadTime between setting the condition and evicting the pod. Defaults to 10s.
+	LeadTime *metav1.Duration `json:"leadTime,omitempty"`
+}
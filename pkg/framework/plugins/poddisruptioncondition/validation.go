@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptioncondition
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ValidatePodDisruptionConditionArgs validates PodDisruptionCondition arguments
+func ValidatePodDisruptionConditionArgs(obj runtime.Object) error {
+	args := obj.(*PodDisruptionConditionArgs)
+	if args.ConditionType != "" {
+		if errs := validation.IsQualifiedName(string(args.ConditionType)); len(errs) > 0 {
+			return fmt.Errorf("invalid conditionType %q: %s", args.ConditionType, strings.Join(errs, "; "))
+		}
+	}
+	switch args.ConditionStatus {
+	case "", v1.ConditionTrue, v1.ConditionFalse:
+	default:
+		return fmt.Errorf("conditionStatus must be one of %q or %q, got %q", v1.ConditionTrue, v1.ConditionFalse, args.ConditionStatus)
+	}
+	if args.LeadTime != nil && args.LeadTime.Duration < 0 {
+		return fmt.Errorf("leadTime must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptioncondition
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidatePodDisruptionConditionArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *PodDisruptionConditionArgs
+		expectError bool
+	}{
+		{
+			description: "valid args, no errors",
+			args: &PodDisruptionConditionArgs{
+				ConditionType:   "example.com/serving",
+				ConditionStatus: "False",
+				LeadTime:        &metav1.Duration{Duration: 30 * time.Second},
+			},
+			expectError: false,
+		},
+		{
+			description: "invalid condition type, expects error",
+			args: &PodDisruptionConditionArgs{
+				ConditionType: "not a condition",
+			},
+			expectError: true,
+		},
+		{
+			description: "unknown condition status, expects error",
+			args: &PodDisruptionConditionArgs{
+				ConditionStatus: "Unknown",
+			},
+			expectError: true,
+		},
+		{
+			description: "negative lead time, expects error",
+			args: &PodDisruptionConditionArgs{
+				LeadTime: &metav1.Duration{Duration: -time.Second},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidatePodDisruptionConditionArgs(tc.args)
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
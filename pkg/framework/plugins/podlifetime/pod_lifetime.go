@@ -156,7 +156,7 @@ loop:
 			continue
 		}
 		switch err.(type) {
-		case *evictions.EvictionNodeLimitError:
+		case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 			continue loop
 		case *evictions.EvictionTotalLimitError:
 			return nil
@@ -24,6 +24,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 // ValidatePodLifeTimeArgs validates PodLifeTime arguments
@@ -34,8 +36,8 @@ func ValidatePodLifeTimeArgs(obj runtime.Object) error {
 	}
 
 	// At most one of include/exclude can be set
-	if args.Namespaces != nil && len(args.Namespaces.Include) > 0 && len(args.Namespaces.Exclude) > 0 {
-		return fmt.Errorf("only one of Include/Exclude namespaces can be set")
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
 	}
 
 	if args.LabelSelector != nil {
@@ -0,0 +1,37 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsensitivity
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DefaultClassKey is the default key of the label or annotation carrying the sensitivity class of a pod
+const DefaultClassKey = "descheduler.alpha.kubernetes.io/disruption-class"
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_PodSensitivityArgs
+// TODO: the final default values would be discussed in community
+func SetDefaults_PodSensitivityArgs(obj runtime.Object) {
+	args := obj.(*PodSensitivityArgs)
+	if args.ClassKey == "" {
+		args.ClassKey = DefaultClassKey
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsensitivity
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+const PluginName = "PodSensitivity"
+
+// PodSensitivity classifies pods by a sensitivity class read from a pod label or annotation
+// and limits the evictions and the plugins allowed to evict pods of every class
+type PodSensitivity struct {
+	handle  frameworktypes.Handle
+	args    *PodSensitivityArgs
+	classes map[string]sensitivityClass
+
+	mu      sync.Mutex
+	evicted map[string]uint
+}
+
+type sensitivityClass struct {
+	SensitivityClass
+	allowedPlugins sets.Set[string]
+}
+
+var _ frameworktypes.PluginFilterEvictorPlugin = &PodSensitivity{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	podSensitivityArgs, ok := args.(*PodSensitivityArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type PodSensitivityArgs, got %T", args)
+	}
+
+	classes := make(map[string]sensitivityClass)
+	for _, class := range podSensitivityArgs.Classes {
+		classes[class.Name] = sensitivityClass{
+			SensitivityClass: class,
+			allowedPlugins:   sets.New(class.AllowedPlugins...),
+		}
+	}
+
+	return &PodSensitivity{
+		handle:  handle,
+		args:    podSensitivityArgs,
+		classes: classes,
+		evicted: make(map[string]uint),
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *PodSensitivity) Name() string {
+	return PluginName
+}
+
+// classOf returns the sensitivity class of the pod
+func (d *PodSensitivity) classOf(pod *v1.Pod) (sensitivityClass, bool) {
+	name, ok := pod.Labels[d.args.ClassKey]
+	if !ok {
+		name, ok = pod.Annotations[d.args.ClassKey]
+	}
+	if class, exists := d.classes[name]; ok && exists {
+		return class, true
+	}
+	class, exists := d.classes[d.args.DefaultClass]
+	return class, exists
+}
+
+// belowLimit checks whether more pods of the class can be evicted in the current cycle
+func (d *PodSensitivity) belowLimit(class sensitivityClass) bool {
+	if class.MaxEvictionsPerCycle == nil {
+		return true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.evicted[class.Name] < *class.MaxEvictionsPerCycle
+}
+
+// Filter checks whether the eviction limit of the class of the pod is not reached yet
+func (d *PodSensitivity) Filter(pod *v1.Pod) bool {
+	class, ok := d.classOf(pod)
+	if !ok {
+		return true
+	}
+	if !d.belowLimit(class) {
+		klog.V(4).InfoS("Pod fails the eviction limit of its sensitivity class", "pod", klog.KObj(pod), "class", class.Name)
+		return false
+	}
+	return true
+}
+
+// PreEvictionFilter does not constrain the eviction, the limits are checked when filtering the pods
+func (d *PodSensitivity) PreEvictionFilter(pod *v1.Pod) bool {
+	return true
+}
+
+// FilterForPlugin checks whether the plugin is allowed to evict pods of the class of the pod
+// and the eviction limit of the class is not reached yet
+func (d *PodSensitivity) FilterForPlugin(pluginName string, pod *v1.Pod) bool {
+	class, ok := d.classOf(pod)
+	if !ok {
+		return true
+	}
+	if class.allowedPlugins.Len() > 0 && !class.allowedPlugins.Has(pluginName) {
+		klog.V(4).InfoS("Plugin is not allowed to evict pods of the sensitivity class", "pod", klog.KObj(pod), "class", class.Name, "plugin", pluginName)
+		return false
+	}
+	return d.Filter(pod)
+}
+
+// PodEvicted counts the evictions of pods of every class
+func (d *PodSensitivity) PodEvicted(pluginName string, pod *v1.Pod) {
+	class, ok := d.classOf(pod)
+	if !ok {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.evicted[class.Name]++
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsensitivity
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	utilptr "k8s.io/utils/ptr"
+
+	frameworkfake "sigs.k8s.io/descheduler/pkg/framework/fake"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestPodSensitivity(t *testing.T) {
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	buildPod := func(name, key, class string, annotation bool) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+			if key == "" {
+				return
+			}
+			if annotation {
+				pod.Annotations = map[string]string{key: class}
+			} else {
+				pod.Labels = map[string]string{key: class}
+			}
+		})
+	}
+
+	args := &PodSensitivityArgs{
+		ClassKey:     DefaultClassKey,
+		DefaultClass: "standard",
+		Classes: []SensitivityClass{
+			{Name: "critical", MaxEvictionsPerCycle: utilptr.To[uint](0)},
+			{Name: "standard", MaxEvictionsPerCycle: utilptr.To[uint](2), AllowedPlugins: []string{"LowNodeUtilization", "PodLifeTime"}},
+			{Name: "flexible"},
+		},
+	}
+
+	testCases := []struct {
+		description string
+		pod         *v1.Pod
+		pluginName  string
+		evictions   int
+		expected    []bool
+	}{
+		{
+			description: "critical pods never evicted",
+			pod:         buildPod("p1", DefaultClassKey, "critical", false),
+			pluginName:  "PodLifeTime",
+			evictions:   1,
+			expected:    []bool{false},
+		},
+		{
+			description: "class read from the annotation",
+			pod:         buildPod("p2", DefaultClassKey, "critical", true),
+			pluginName:  "PodLifeTime",
+			evictions:   1,
+			expected:    []bool{false},
+		},
+		{
+			description: "flexible pods evicted without limits by all plugins",
+			pod:         buildPod("p3", DefaultClassKey, "flexible", false),
+			pluginName:  "RemoveDuplicates",
+			evictions:   4,
+			expected:    []bool{true, true, true, true},
+		},
+		{
+			description: "standard pods limited per cycle",
+			pod:         buildPod("p4", DefaultClassKey, "standard", false),
+			pluginName:  "PodLifeTime",
+			evictions:   3,
+			expected:    []bool{true, true, false},
+		},
+		{
+			description: "standard pods not evicted by plugins not allowed",
+			pod:         buildPod("p5", DefaultClassKey, "standard", false),
+			pluginName:  "RemoveDuplicates",
+			evictions:   1,
+			expected:    []bool{false},
+		},
+		{
+			description: "pods without class fall back to the default class",
+			pod:         buildPod("p6", "", "", false),
+			pluginName:  "RemoveDuplicates",
+			evictions:   1,
+			expected:    []bool{false},
+		},
+		{
+			description: "pods with unknown class fall back to the default class",
+			pod:         buildPod("p7", DefaultClassKey, "unknown", false),
+			pluginName:  "LowNodeUtilization",
+			evictions:   3,
+			expected:    []bool{true, true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			plugin, err := New(args, &frameworkfake.HandleImpl{})
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			podSensitivity := plugin.(*PodSensitivity)
+
+			for i := 0; i < tc.evictions; i++ {
+				result := podSensitivity.FilterForPlugin(tc.pluginName, tc.pod)
+				if result != tc.expected[i] {
+					t.Errorf("Expected eviction %v of pod %v by %v to be allowed: %v, got %v", i, tc.pod.Name, tc.pluginName, tc.expected[i], result)
+				}
+				if result {
+					podSensitivity.PodEvicted(tc.pluginName, tc.pod)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsensitivity
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodSensitivityArgs holds arguments used to configure PodSensitivity plugin.
+type PodSensitivityArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ClassKey is the key of the pod label or annotation carrying the sensitivity class of a pod.
+	// The label takes precedence over the annotation.
+	ClassKey string `json:"classKey,omitempty"`
+	// DefaultClass is the class of pods without a class or with a class not listed in Classes.
+	// Such pods are not constrained when empty.
+	DefaultClass string             `json:"defaultClass,omitempty"`
+	Classes      []SensitivityClass `json:"classes,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// SensitivityClass constrains the eviction of pods of the class
+type SensitivityClass struct {
+	Name string `json:"name"`
+	// MaxEvictionsPerCycle limits the evictions of pods of the class in a descheduling cycle of the profile.
+	// Pods of the class are never evicted when set to 0, the evictions are not limited when not set.
+	MaxEvictionsPerCycle *uint `json:"maxEvictionsPerCycle,omitempty"`
+	// AllowedPlugins lists the plugins allowed to evict pods of the class. All plugins are allowed when empty.
+	AllowedPlugins []string `json:"allowedPlugins,omitempty"`
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsensitivity
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ValidatePodSensitivityArgs validates PodSensitivity arguments
+func ValidatePodSensitivityArgs(obj runtime.Object) error {
+	args := obj.(*PodSensitivityArgs)
+	if args.ClassKey == "" {
+		return fmt.Errorf("classKey must be set")
+	}
+	if len(args.Classes) == 0 {
+		return fmt.Errorf("at least one class must be set")
+	}
+
+	classes := sets.New[string]()
+	for _, class := range args.Classes {
+		if class.Name == "" {
+			return fmt.Errorf("class is missing the name")
+		}
+		if classes.Has(class.Name) {
+			return fmt.Errorf("class %q set more than once", class.Name)
+		}
+		classes.Insert(class.Name)
+	}
+	if args.DefaultClass != "" && !classes.Has(args.DefaultClass) {
+		return fmt.Errorf("default class %q is not one of the classes", args.DefaultClass)
+	}
+
+	return nil
+}
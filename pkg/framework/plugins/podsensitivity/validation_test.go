@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podsensitivity
+
+import (
+	"testing"
+)
+
+func TestValidatePodSensitivityArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *PodSensitivityArgs
+		expectError bool
+	}{
+		{
+			description: "valid args, no errors",
+			args: &PodSensitivityArgs{
+				ClassKey:     DefaultClassKey,
+				DefaultClass: "standard",
+				Classes:      []SensitivityClass{{Name: "critical"}, {Name: "standard"}},
+			},
+			expectError: false,
+		},
+		{
+			description: "missing class key, expects error",
+			args: &PodSensitivityArgs{
+				Classes: []SensitivityClass{{Name: "critical"}},
+			},
+			expectError: true,
+		},
+		{
+			description: "no classes, expects error",
+			args: &PodSensitivityArgs{
+				ClassKey: DefaultClassKey,
+			},
+			expectError: true,
+		},
+		{
+			description: "class without name, expects error",
+			args: &PodSensitivityArgs{
+				ClassKey: DefaultClassKey,
+				Classes:  []SensitivityClass{{}},
+			},
+			expectError: true,
+		},
+		{
+			description: "duplicate classes, expects error",
+			args: &PodSensitivityArgs{
+				ClassKey: DefaultClassKey,
+				Classes:  []SensitivityClass{{Name: "critical"}, {Name: "critical"}},
+			},
+			expectError: true,
+		},
+		{
+			description: "unknown default class, expects error",
+			args: &PodSensitivityArgs{
+				ClassKey:     DefaultClassKey,
+				DefaultClass: "flexible",
+				Classes:      []SensitivityClass{{Name: "critical"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidatePodSensitivityArgs(tc.args)
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
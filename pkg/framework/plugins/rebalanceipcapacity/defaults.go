@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalanceipcapacity
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// DefaultResourceName is the resource the VPC CNI advertises the branch network interfaces of a node with
+	DefaultResourceName = "vpc.amazonaws.com/pod-eni"
+	// DefaultExhaustedThreshold is the default percentage of the IP capacity in use of an exhausted node
+	DefaultExhaustedThreshold api.Percentage = 100
+	// DefaultTargetThreshold is the default percentage of the IP capacity in use under which a node takes pods
+	DefaultTargetThreshold api.Percentage = 80
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_RebalanceIPCapacityArgs
+// TODO: the final default values would be discussed in community
+func SetDefaults_RebalanceIPCapacityArgs(obj runtime.Object) {
+	args := obj.(*RebalanceIPCapacityArgs)
+	if args.ResourceName == "" {
+		args.ResourceName = DefaultResourceName
+	}
+	if args.ExhaustedThreshold == 0 {
+		args.ExhaustedThreshold = DefaultExhaustedThreshold
+	}
+	if args.TargetThreshold == 0 {
+		args.TargetThreshold = DefaultTargetThreshold
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalanceipcapacity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const PluginName = "RebalanceIPCapacity"
+
+// RebalanceIPCapacity evicts pods from the nodes running out of IP addresses or network interfaces
+// towards the nodes with free IP capacity. The capacity of a node is its allocatable of the configured
+// resource, the usage the requests of the resource of the pods on the node. The pods are only evicted
+// as long as the nodes under the target threshold have room for them, the scheduler has nowhere else
+// to place the pods otherwise.
+type RebalanceIPCapacity struct {
+	handle    frameworktypes.Handle
+	args      *RebalanceIPCapacityArgs
+	podFilter podutil.FilterFunc
+}
+
+var _ frameworktypes.BalancePlugin = &RebalanceIPCapacity{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	ipCapacityArgs, ok := args.(*RebalanceIPCapacityArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type RebalanceIPCapacityArgs, got %T", args)
+	}
+
+	var includedNamespaces, excludedNamespaces sets.Set[string]
+	if ipCapacityArgs.Namespaces != nil {
+		includedNamespaces = sets.New(ipCapacityArgs.Namespaces.Include...)
+		excludedNamespaces = sets.New(ipCapacityArgs.Namespaces.Exclude...)
+	}
+
+	podFilter, err := podutil.NewOptions().
+		WithFilter(handle.Evictor().Filter).
+		WithNamespaces(includedNamespaces).
+		WithoutNamespaces(excludedNamespaces).
+		WithLabelSelector(ipCapacityArgs.LabelSelector).
+		BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	return &RebalanceIPCapacity{
+		handle:    handle,
+		args:      ipCapacityArgs,
+		podFilter: podFilter,
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *RebalanceIPCapacity) Name() string {
+	return PluginName
+}
+
+// nodeIPUsage holds the IP capacity of a node and the part of it taken by the pods on the node
+type nodeIPUsage struct {
+	node     *v1.Node
+	capacity int64
+	usage    int64
+	pods     []*v1.Pod
+}
+
+// Balance extension point implementation for the plugin
+func (d *RebalanceIPCapacity) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	var exhaustedNodes []*nodeIPUsage
+	var freeCapacity int64
+	for _, node := range nodes {
+		capacity := d.capacity(node)
+		if capacity <= 0 {
+			klog.V(4).InfoS("Node has no IP capacity, skipping", "node", klog.KObj(node), "resource", d.args.ResourceName)
+			continue
+		}
+		pods, err := podutil.ListPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), nil)
+		if err != nil {
+			return &frameworktypes.Status{
+				Err: fmt.Errorf("error listing pods on a node: %v", err),
+			}
+		}
+		nodeUsage := &nodeIPUsage{node: node, capacity: capacity, pods: pods}
+		for _, pod := range pods {
+			nodeUsage.usage += d.request(pod)
+		}
+
+		switch {
+		case nodeUsage.usage*100 >= capacity*int64(d.args.ExhaustedThreshold):
+			klog.V(2).InfoS("Node is IP exhausted", "node", klog.KObj(node), "usage", nodeUsage.usage, "capacity", capacity)
+			exhaustedNodes = append(exhaustedNodes, nodeUsage)
+		case nodeUsage.usage*100 < capacity*int64(d.args.TargetThreshold):
+			freeCapacity += capacity*int64(d.args.TargetThreshold)/100 - nodeUsage.usage
+		}
+	}
+
+	if len(exhaustedNodes) == 0 {
+		klog.V(1).InfoS("No node is IP exhausted, nothing to do here")
+		return nil
+	}
+	if freeCapacity <= 0 {
+		klog.V(1).InfoS("No node has free IP capacity, nothing to do here")
+		return nil
+	}
+
+	// The most used nodes are relieved first
+	sort.SliceStable(exhaustedNodes, func(i, j int) bool {
+		return exhaustedNodes[i].usage*exhaustedNodes[j].capacity > exhaustedNodes[j].usage*exhaustedNodes[i].capacity
+	})
+
+	for _, nodeUsage := range exhaustedNodes {
+		var evictable []*v1.Pod
+		for _, pod := range nodeUsage.pods {
+			if d.request(pod) > 0 && d.podFilter(pod) {
+				evictable = append(evictable, pod)
+			}
+		}
+		podutil.SortPodsBasedOnPriorityLowToHigh(evictable)
+		frameworktypes.OrderPodsForEviction(d.handle.Evictor(), evictable)
+
+	loop:
+		for _, pod := range evictable {
+			if nodeUsage.usage*100 < nodeUsage.capacity*int64(d.args.ExhaustedThreshold) {
+				break
+			}
+			request := d.request(pod)
+			if request > freeCapacity {
+				continue
+			}
+			if !d.handle.Evictor().PreEvictionFilter(pod) {
+				continue
+			}
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+			if err == nil {
+				nodeUsage.usage -= request
+				freeCapacity -= request
+				if freeCapacity <= 0 {
+					return nil
+				}
+				continue
+			}
+			switch err.(type) {
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
+				break loop
+			case *evictions.EvictionTotalLimitError:
+				return nil
+			default:
+				klog.Errorf("eviction failed: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// capacity gives the allocatable of the IP resource of the node
+func (d *RebalanceIPCapacity) capacity(node *v1.Node) int64 {
+	quantity, ok := node.Status.Allocatable[d.args.ResourceName]
+	if !ok {
+		return 0
+	}
+	return quantity.Value()
+}
+
+// request gives the part of the IP capacity the pod takes. The pods on the host network use the
+// address of the node.
+func (d *RebalanceIPCapacity) request(pod *v1.Pod) int64 {
+	if pod.Spec.HostNetwork {
+		return 0
+	}
+	return utils.GetResourceRequest(pod, d.args.ResourceName)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalanceipcapacity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRebalanceIPCapacity(t *testing.T) {
+	buildNode := func(name string, enis int64) *v1.Node {
+		return test.BuildTestNode(name, 4000, 3000, 20, func(node *v1.Node) {
+			if enis > 0 {
+				node.Status.Allocatable[DefaultResourceName] = *resource.NewQuantity(enis, resource.DecimalSI)
+			}
+		})
+	}
+	buildPod := func(name, nodeName string, priority int32, enis int64, apply func(*v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, nodeName, func(pod *v1.Pod) {
+			test.SetNormalOwnerRef(pod)
+			pod.Spec.Priority = utilptr.To(priority)
+			if enis > 0 {
+				pod.Spec.Containers[0].Resources.Requests[DefaultResourceName] = *resource.NewQuantity(enis, resource.DecimalSI)
+			}
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+	buildPods := func(prefix, nodeName string, count int) []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < count; i++ {
+			pods = append(pods, buildPod(fmt.Sprintf("%s-%d", prefix, i), nodeName, 100, 1, nil))
+		}
+		return pods
+	}
+
+	exhausted := buildNode("exhausted", 4)
+	free := buildNode("free", 10)
+	noENI := buildNode("no-eni", 0)
+
+	exhaustedPods := func() []*v1.Pod {
+		return []*v1.Pod{
+			buildPod("p1", exhausted.Name, 1, 1, nil),
+			buildPod("p10", exhausted.Name, 10, 1, nil),
+			buildPod("p100", exhausted.Name, 100, 1, nil),
+			buildPod("p1000", exhausted.Name, 1000, 1, nil),
+		}
+	}
+
+	tests := []struct {
+		description     string
+		args            RebalanceIPCapacityArgs
+		nodes           []*v1.Node
+		pods            []*v1.Pod
+		expectedEvicted []string
+	}{
+		{
+			description:     "lowest priority pod evicted from the exhausted node",
+			args:            RebalanceIPCapacityArgs{ExhaustedThreshold: 100, TargetThreshold: 80},
+			nodes:           []*v1.Node{exhausted, free, noENI},
+			pods:            append(exhaustedPods(), buildPods("free", free.Name, 2)...),
+			expectedEvicted: []string{"p1"},
+		},
+		{
+			description:     "pods evicted until the node is under the exhausted threshold",
+			args:            RebalanceIPCapacityArgs{ExhaustedThreshold: 75, TargetThreshold: 50},
+			nodes:           []*v1.Node{exhausted, free, noENI},
+			pods:            append(exhaustedPods(), buildPods("free", free.Name, 2)...),
+			expectedEvicted: []string{"p1", "p10"},
+		},
+		{
+			description: "no node with free IP capacity",
+			args:        RebalanceIPCapacityArgs{ExhaustedThreshold: 100, TargetThreshold: 80},
+			nodes:       []*v1.Node{exhausted, free, noENI},
+			pods:        append(exhaustedPods(), buildPods("free", free.Name, 8)...),
+		},
+		{
+			description:     "evictions bounded by the free IP capacity",
+			args:            RebalanceIPCapacityArgs{ExhaustedThreshold: 50, TargetThreshold: 40},
+			nodes:           []*v1.Node{exhausted, free, noENI},
+			pods:            append(exhaustedPods(), buildPods("free", free.Name, 3)...),
+			expectedEvicted: []string{"p1"},
+		},
+		{
+			description: "pods not taking an IP not evicted",
+			args:        RebalanceIPCapacityArgs{ExhaustedThreshold: 100, TargetThreshold: 80},
+			nodes:       []*v1.Node{exhausted, free, noENI},
+			pods: append(exhaustedPods(),
+				buildPod("no-eni", exhausted.Name, 0, 0, nil),
+				buildPod("host-network", exhausted.Name, 0, 1, func(pod *v1.Pod) {
+					pod.Spec.HostNetwork = true
+				}),
+			),
+			expectedEvicted: []string{"p1"},
+		},
+		{
+			description: "non evictable pods count but are not evicted",
+			args:        RebalanceIPCapacityArgs{ExhaustedThreshold: 100, TargetThreshold: 80},
+			nodes:       []*v1.Node{exhausted, free, noENI},
+			pods: append(exhaustedPods()[2:],
+				buildPod("daemon-1", exhausted.Name, 0, 1, test.SetDSOwnerRef),
+				buildPod("daemon-2", exhausted.Name, 0, 1, test.SetDSOwnerRef),
+			),
+			expectedEvicted: []string{"p100"},
+		},
+		{
+			description:     "namespaces excluded from the evictions",
+			args:            RebalanceIPCapacityArgs{ExhaustedThreshold: 100, TargetThreshold: 80, Namespaces: &api.Namespaces{Exclude: []string{"default"}}},
+			nodes:           []*v1.Node{exhausted, free, noENI},
+			pods:            exhaustedPods(),
+			expectedEvicted: nil,
+		},
+		{
+			description: "pods resource taking an IP per pod",
+			args:        RebalanceIPCapacityArgs{ResourceName: v1.ResourcePods, ExhaustedThreshold: 100, TargetThreshold: 80},
+			nodes: []*v1.Node{
+				test.BuildTestNode("full", 4000, 3000, 2, nil),
+				test.BuildTestNode("empty", 4000, 3000, 10, nil),
+			},
+			pods: []*v1.Pod{
+				buildPod("low", "full", 1, 0, nil),
+				buildPod("high", "full", 100, 0, nil),
+			},
+			expectedEvicted: []string{"low"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var objs []runtime.Object
+			for _, node := range tc.nodes {
+				objs = append(objs, node)
+			}
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			evicted := sets.New[string]()
+			fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() == "eviction" {
+					evicted.Insert(action.(core.CreateAction).GetObject().(metav1.Object).GetName())
+				}
+				return false, nil, nil
+			})
+
+			handle, _, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				fakeClient,
+				evictions.NewOptions(),
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			args := tc.args
+			SetDefaults_RebalanceIPCapacityArgs(&args)
+			plugin, err := New(&args, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			plugin.(frameworktypes.BalancePlugin).Balance(ctx, tc.nodes)
+			if !evicted.Equal(sets.New(tc.expectedEvicted...)) {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, sets.List(evicted))
+			}
+		})
+	}
+}
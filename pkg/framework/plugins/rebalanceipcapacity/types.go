@@ -0,0 +1,43 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalanceipcapacity
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RebalanceIPCapacityArgs holds arguments used to configure RebalanceIPCapacity plugin.
+type RebalanceIPCapacityArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Namespaces    *api.Namespaces       `json:"namespaces,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// ResourceName is the extended resource the nodes advertise their IP or ENI capacity with,
+	// e.g. vpc.amazonaws.com/pod-eni. With "pods" every pod not on the host network takes an IP.
+	ResourceName v1.ResourceName `json:"resourceName,omitempty"`
+	// ExhaustedThreshold is the percentage of the IP capacity of a node in use from which the node is IP exhausted.
+	ExhaustedThreshold api.Percentage `json:"exhaustedThreshold,omitempty"`
+	// TargetThreshold is the percentage of the IP capacity of a node in use under which the node takes
+	// the evicted pods. The pods are only evicted as long as the nodes under the threshold have room for them.
+	TargetThreshold api.Percentage `json:"targetThreshold,omitempty"`
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalanceipcapacity
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// ValidateRebalanceIPCapacityArgs validates RebalanceIPCapacity arguments
+func ValidateRebalanceIPCapacityArgs(obj runtime.Object) error {
+	args := obj.(*RebalanceIPCapacityArgs)
+	// At most one of include/exclude can be set
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
+	}
+	if args.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(args.LabelSelector); err != nil {
+			return fmt.Errorf("failed to get label selectors from strategy's params: %+v", err)
+		}
+	}
+	if args.ResourceName != v1.ResourcePods && !isExtendedResourceName(args.ResourceName) {
+		return fmt.Errorf("resourceName must be %q or an extended resource name, got %q", v1.ResourcePods, args.ResourceName)
+	}
+	if args.ExhaustedThreshold <= 0 || args.ExhaustedThreshold > 100 {
+		return fmt.Errorf("exhaustedThreshold must be in (0, 100], got %v", args.ExhaustedThreshold)
+	}
+	if args.TargetThreshold <= 0 || args.TargetThreshold >= args.ExhaustedThreshold {
+		return fmt.Errorf("targetThreshold must be in (0, exhaustedThreshold), got %v", args.TargetThreshold)
+	}
+	return nil
+}
+
+// isExtendedResourceName accepts the domain prefixed resource names the device plugins and the CNIs advertise
+func isExtendedResourceName(name v1.ResourceName) bool {
+	return strings.Contains(string(name), "/") && len(validation.IsQualifiedName(string(name))) == 0
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebalanceipcapacity
+
+import (
+	"testing"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestValidateRebalanceIPCapacityArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *RebalanceIPCapacityArgs
+		expectError bool
+	}{
+		{
+			description: "valid arg, no errors",
+			args: &RebalanceIPCapacityArgs{
+				ResourceName:       DefaultResourceName,
+				ExhaustedThreshold: 100,
+				TargetThreshold:    80,
+			},
+			expectError: false,
+		},
+		{
+			description: "pods resource name, no errors",
+			args: &RebalanceIPCapacityArgs{
+				ResourceName:       "pods",
+				ExhaustedThreshold: 90,
+				TargetThreshold:    50,
+			},
+			expectError: false,
+		},
+		{
+			description: "native resource name, expects errors",
+			args: &RebalanceIPCapacityArgs{
+				ResourceName:       "cpu",
+				ExhaustedThreshold: 100,
+				TargetThreshold:    80,
+			},
+			expectError: true,
+		},
+		{
+			description: "ExhaustedThreshold arg above 100, expects errors",
+			args: &RebalanceIPCapacityArgs{
+				ResourceName:       DefaultResourceName,
+				ExhaustedThreshold: 120,
+				TargetThreshold:    80,
+			},
+			expectError: true,
+		},
+		{
+			description: "TargetThreshold arg not under ExhaustedThreshold, expects errors",
+			args: &RebalanceIPCapacityArgs{
+				ResourceName:       DefaultResourceName,
+				ExhaustedThreshold: 80,
+				TargetThreshold:    80,
+			},
+			expectError: true,
+		},
+		{
+			description: "both included and excluded namespaces, expects errors",
+			args: &RebalanceIPCapacityArgs{
+				ResourceName:       DefaultResourceName,
+				ExhaustedThreshold: 100,
+				TargetThreshold:    80,
+				Namespaces:         &api.Namespaces{Include: []string{"a"}, Exclude: []string{"b"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateRebalanceIPCapacityArgs(tc.args)
+
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 )
@@ -103,6 +104,9 @@ func (r *RemoveDuplicates) Balance(ctx context.Context, nodes []*v1.Node) *frame
 	nodeCount := 0
 	nodeMap := make(map[string]*v1.Node)
 
+	// The pods on the nodes going away are ghosts, counting them would leave the actually
+	// running replicas looking like duplicates
+	nodes = nodeutil.WithoutGoneNodes(nodes)
 	for _, node := range nodes {
 		klog.V(2).InfoS("Processing node", "node", klog.KObj(node))
 		pods, err := podutil.ListPodsOnANode(node.Name, r.handle.GetPodsAssignedToNodeFunc(), r.podFilter)
@@ -112,6 +116,11 @@ func (r *RemoveDuplicates) Balance(ctx context.Context, nodes []*v1.Node) *frame
 		}
 		nodeMap[node.Name] = node
 		nodeCount++
+		// The first pod of a kind is kept, the duplicates are evicted from the last one. Ranking the pods from
+		// the highest deletion cost keeps the pods the ReplicaSet controller would delete last.
+		sort.SliceStable(pods, func(i, j int) bool {
+			return podutil.PodDeletionCost(pods[i]) > podutil.PodDeletionCost(pods[j])
+		})
 		// Each pod has a list of owners and a list of containers, and each container has 1 image spec.
 		// For each pod, we go through all the OwnerRef/Image mappings and represent them as a "key" string.
 		// All of those mappings together makes a list of "key" strings that essentially represent that pod's uniqueness.
@@ -127,6 +136,9 @@ func (r *RemoveDuplicates) Balance(ctx context.Context, nodes []*v1.Node) *frame
 		// If not, then we add this pod's list to the list of lists for that key.
 		duplicateKeysMap := map[string][][]string{}
 		for _, pod := range pods {
+			if utils.IsPodTerminating(pod) {
+				continue
+			}
 			ownerRefList := podutil.OwnerRef(pod)
 
 			if len(ownerRefList) == 0 || hasExcludedOwnerRefKind(ownerRefList, r.args.ExcludeOwnerKinds) {
@@ -215,7 +227,7 @@ func (r *RemoveDuplicates) Balance(ctx context.Context, nodes []*v1.Node) *frame
 						continue
 					}
 					switch err.(type) {
-					case *evictions.EvictionNodeLimitError:
+					case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 						continue loop
 					case *evictions.EvictionTotalLimitError:
 						return nil
@@ -291,9 +303,10 @@ func hasExcludedOwnerRefKind(ownerRefs []metav1.OwnerReference, excludeOwnerKind
 		return false
 	}
 
-	exclude := sets.New(excludeOwnerKinds...)
+	// the patterns are compiled by the args validation already
+	exclude, _ := utils.NewOwnerKinds(excludeOwnerKinds...)
 	for _, owner := range ownerRefs {
-		if exclude.Has(owner.Kind) {
+		if exclude.Has(owner) {
 			return true
 		}
 	}
@@ -21,11 +21,14 @@ import (
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
 
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
 	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
@@ -468,6 +471,26 @@ func TestRemoveDuplicatesUniformly(t *testing.T) {
 				test.BuildTestNode("n3", 2000, 3000, 10, nil),
 			},
 		},
+		{
+			description: "Evict pods uniformly ignoring the pods of the node being deleted",
+			pods: []*v1.Pod{
+				// (2,0) -> (1,1) -> 1 eviction, the pods of n3 not counted
+				test.BuildTestPod("p1", 100, 0, "n1", test.SetRSOwnerRef),
+				test.BuildTestPod("p2", 100, 0, "n1", test.SetRSOwnerRef),
+				test.BuildTestPod("p3", 100, 0, "n3", test.SetRSOwnerRef),
+				test.BuildTestPod("p4", 100, 0, "n3", test.SetRSOwnerRef),
+				test.BuildTestPod("p5", 100, 0, "n3", test.SetRSOwnerRef),
+				test.BuildTestPod("p6", 100, 0, "n3", test.SetRSOwnerRef),
+			},
+			expectedEvictedPodCount: 1,
+			nodes: []*v1.Node{
+				test.BuildTestNode("n1", 2000, 3000, 10, nil),
+				test.BuildTestNode("n2", 2000, 3000, 10, nil),
+				test.BuildTestNode("n3", 2000, 3000, 10, func(node *v1.Node) {
+					node.DeletionTimestamp = &metav1.Time{}
+				}),
+			},
+		},
 		{
 			description: "Evict pods uniformly with one node left out",
 			pods: []*v1.Pod{
@@ -717,3 +740,39 @@ func TestRemoveDuplicatesUniformly(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveDuplicatesDeletionCost(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	node2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	var objs []runtime.Object
+	objs = append(objs, node1, node2)
+	for name, cost := range map[string]string{"p1": "100", "p2": "-10", "p3": "50"} {
+		pod := buildTestPodWithImage(name, node1.Name, "foo")
+		pod.Annotations = map[string]string{podutil.PodDeletionCostAnnotationKey: cost}
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+
+	handle, _, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+	plugin, err := New(&RemoveDuplicatesArgs{}, handle)
+	if err != nil {
+		t.Fatalf("Unable to initialize the plugin: %v", err)
+	}
+	plugin.(frameworktypes.BalancePlugin).Balance(ctx, []*v1.Node{node1, node2})
+
+	var evicted []string
+	for _, action := range fakeClient.Actions() {
+		if action.GetSubresource() == "eviction" {
+			evicted = append(evicted, action.(core.CreateAction).GetObject().(*policy.Eviction).Name)
+		}
+	}
+	if len(evicted) != 1 || evicted[0] != "p2" {
+		t.Errorf("Expected the duplicate of the lowest deletion cost evicted, got %v", evicted)
+	}
+}
@@ -17,13 +17,19 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 func ValidateRemoveDuplicatesArgs(obj runtime.Object) error {
 	args := obj.(*RemoveDuplicatesArgs)
 	// At most one of include/exclude can be set
-	if args.Namespaces != nil && len(args.Namespaces.Include) > 0 && len(args.Namespaces.Exclude) > 0 {
-		return fmt.Errorf("only one of Include/Exclude namespaces can be set")
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
+	}
+
+	if _, err := utils.NewOwnerKinds(args.ExcludeOwnerKinds...); err != nil {
+		return fmt.Errorf("invalid excludeOwnerKinds: %v", err)
 	}
 
 	return nil
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 const PluginName = "RemoveFailedPods"
@@ -109,7 +110,7 @@ func (d *RemoveFailedPods) Deschedule(ctx context.Context, nodes []*v1.Node) *fr
 				continue
 			}
 			switch err.(type) {
-			case *evictions.EvictionNodeLimitError:
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 				break loop
 			case *evictions.EvictionTotalLimitError:
 				return nil
@@ -133,9 +134,11 @@ func validateCanEvict(pod *v1.Pod, failedPodArgs *RemoveFailedPodsArgs) error {
 	}
 
 	if len(failedPodArgs.ExcludeOwnerKinds) > 0 {
+		// the patterns are compiled by the args validation already
+		excludeOwnerKinds, _ := utils.NewOwnerKinds(failedPodArgs.ExcludeOwnerKinds...)
 		ownerRefList := podutil.OwnerRef(pod)
 		for _, owner := range ownerRefList {
-			if sets.New(failedPodArgs.ExcludeOwnerKinds...).Has(owner.Kind) {
+			if excludeOwnerKinds.Has(owner) {
 				errs = append(errs, fmt.Errorf("pod's owner kind of %s is excluded", owner.Kind))
 			}
 		}
@@ -300,6 +300,17 @@ func TestRemoveFailedPods(t *testing.T) {
 				}, nil), nil),
 			},
 		},
+		{
+			description:             "excluded owner kind=ReplicaSet.argoproj.io, 1 init container terminated with owner kind=ReplicaSet of the core group, 1 eviction",
+			args:                    createRemoveFailedPodsArgs(true, nil, nil, []string{"ReplicaSet.argoproj.io"}, nil),
+			nodes:                   []*v1.Node{test.BuildTestNode("node1", 2000, 3000, 10, nil)},
+			expectedEvictedPodCount: 1,
+			pods: []*v1.Pod{
+				buildTestPod("p1", "node1", newPodStatus("", "", &v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: "NodeAffinity"},
+				}, nil), nil),
+			},
+		},
 		{
 			description:             "excluded owner kind=DaemonSet, 1 init container terminated with owner kind=ReplicaSet, 1 eviction",
 			args:                    createRemoveFailedPodsArgs(true, nil, nil, []string{"DaemonSet"}, nil),
@@ -18,14 +18,16 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 // ValidateRemoveFailedPodsArgs validates RemoveFailedPods arguments
 func ValidateRemoveFailedPodsArgs(obj runtime.Object) error {
 	args := obj.(*RemoveFailedPodsArgs)
 	// At most one of include/exclude can be set
-	if args.Namespaces != nil && len(args.Namespaces.Include) > 0 && len(args.Namespaces.Exclude) > 0 {
-		return fmt.Errorf("only one of Include/Exclude namespaces can be set")
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
 	}
 
 	if args.LabelSelector != nil {
@@ -34,5 +36,9 @@ func ValidateRemoveFailedPodsArgs(obj runtime.Object) error {
 		}
 	}
 
+	if _, err := utils.NewOwnerKinds(args.ExcludeOwnerKinds...); err != nil {
+		return fmt.Errorf("invalid excludeOwnerKinds: %v", err)
+	}
+
 	return nil
 }
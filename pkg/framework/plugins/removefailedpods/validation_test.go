@@ -36,6 +36,23 @@ func TestValidateRemoveFailedPodsArgs(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			description: "namespace and owner kind patterns, no errors",
+			args: &RemoveFailedPodsArgs{
+				Namespaces: &api.Namespaces{
+					Exclude: []string{"kube-*", "/^team-[0-9]+$/"},
+				},
+				ExcludeOwnerKinds: []string{"*Set"},
+			},
+			expectError: false,
+		},
+		{
+			description: "invalid owner kind pattern, expects error",
+			args: &RemoveFailedPodsArgs{
+				ExcludeOwnerKinds: []string{"/(/"},
+			},
+			expectError: true,
+		},
 		{
 			description: "valid label selector args, no errors",
 			args: &RemoveFailedPodsArgs{
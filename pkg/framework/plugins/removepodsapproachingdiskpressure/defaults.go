@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsapproachingdiskpressure
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	// DefaultStorageThreshold is the default percentage of the ephemeral storage of a node in use from which pods are evicted.
+	// The kubelet starts the image garbage collection at 85% by default.
+	DefaultStorageThreshold api.Percentage = 80
+	// DefaultInodesThreshold is the default percentage of the inodes of a node in use from which pods are evicted
+	DefaultInodesThreshold api.Percentage = 80
+	// DefaultStorageQuery is the default query of the ephemeral storage usage of the nodes from the node exporter metrics
+	DefaultStorageQuery = `1 - node_filesystem_avail_bytes{mountpoint="/"} / node_filesystem_size_bytes{mountpoint="/"}`
+	// DefaultInodesQuery is the default query of the inodes usage of the nodes from the node exporter metrics
+	DefaultInodesQuery = `1 - node_filesystem_files_free{mountpoint="/"} / node_filesystem_files{mountpoint="/"}`
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_RemovePodsApproachingDiskPressureArgs
+// TODO: the final default values would be discussed in community
+func SetDefaults_RemovePodsApproachingDiskPressureArgs(obj runtime.Object) {
+	args := obj.(*RemovePodsApproachingDiskPressureArgs)
+	if args.StorageThreshold == 0 {
+		args.StorageThreshold = DefaultStorageThreshold
+	}
+	if args.InodesThreshold == 0 {
+		args.InodesThreshold = DefaultInodesThreshold
+	}
+	if args.Prometheus == nil {
+		args.Prometheus = &Prometheus{}
+	}
+	if args.Prometheus.StorageQuery == "" {
+		args.Prometheus.StorageQuery = DefaultStorageQuery
+	}
+	if args.Prometheus.InodesQuery == "" {
+		args.Prometheus.InodesQuery = DefaultInodesQuery
+	}
+}
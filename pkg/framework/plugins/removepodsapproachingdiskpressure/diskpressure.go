@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsapproachingdiskpressure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/nodeutilization"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const PluginName = "RemovePodsApproachingDiskPressure"
+
+// RemovePodsApproachingDiskPressure evicts pods from the nodes approaching disk pressure, as reported
+// by the ephemeral storage and the inodes usage of the nodes collected by Prometheus, before the kubelet
+// garbage collects the images and evicts the pods on its own. The pod freeing the most ephemeral storage
+// on the node is evicted first: the ephemeral storage the pod requests and the images no other pod on the
+// node runs. At most a single pod is evicted from each node as the metrics lag behind the evictions.
+type RemovePodsApproachingDiskPressure struct {
+	handle    frameworktypes.Handle
+	args      *RemovePodsApproachingDiskPressureArgs
+	podFilter podutil.FilterFunc
+}
+
+var _ frameworktypes.DeschedulePlugin = &RemovePodsApproachingDiskPressure{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	diskPressureArgs, ok := args.(*RemovePodsApproachingDiskPressureArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type RemovePodsApproachingDiskPressureArgs, got %T", args)
+	}
+	if handle.PrometheusClient() == nil {
+		return nil, fmt.Errorf("prometheus client not initialized")
+	}
+
+	var includedNamespaces, excludedNamespaces sets.Set[string]
+	if diskPressureArgs.Namespaces != nil {
+		includedNamespaces = sets.New(diskPressureArgs.Namespaces.Include...)
+		excludedNamespaces = sets.New(diskPressureArgs.Namespaces.Exclude...)
+	}
+
+	// We can combine Filter and PreEvictionFilter since for this strategy it does not matter where we run PreEvictionFilter
+	podFilter, err := podutil.NewOptions().
+		WithFilter(podutil.WrapFilterFuncs(handle.Evictor().Filter, handle.Evictor().PreEvictionFilter)).
+		WithNamespaces(includedNamespaces).
+		WithoutNamespaces(excludedNamespaces).
+		WithLabelSelector(diskPressureArgs.LabelSelector).
+		BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	return &RemovePodsApproachingDiskPressure{
+		handle:    handle,
+		args:      diskPressureArgs,
+		podFilter: podFilter,
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *RemovePodsApproachingDiskPressure) Name() string {
+	return PluginName
+}
+
+// Deschedule extension point implementation for the plugin
+func (d *RemovePodsApproachingDiskPressure) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	storageUsage, err := nodeutilization.NodeUsageFromPrometheusMetrics(ctx, d.handle.PrometheusClient(), d.args.Prometheus.StorageQuery)
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: &frameworktypes.TransientAPIError{Err: fmt.Errorf("error querying the ephemeral storage usage: %v", err)},
+		}
+	}
+	inodesUsage, err := nodeutilization.NodeUsageFromPrometheusMetrics(ctx, d.handle.PrometheusClient(), d.args.Prometheus.InodesQuery)
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: &frameworktypes.TransientAPIError{Err: fmt.Errorf("error querying the inodes usage: %v", err)},
+		}
+	}
+
+	for _, node := range nodes {
+		storage, storageFound := usagePercentage(storageUsage, node.Name)
+		inodes, inodesFound := usagePercentage(inodesUsage, node.Name)
+		if !storageFound && !inodesFound {
+			klog.V(4).InfoS("No disk usage collected for the node, skipping", "node", klog.KObj(node))
+			continue
+		}
+		if storage < d.args.StorageThreshold && inodes < d.args.InodesThreshold {
+			continue
+		}
+		klog.V(2).InfoS("Node approaching disk pressure", "node", klog.KObj(node), "storage", storage, "inodes", inodes)
+
+		pods, err := podutil.ListPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), nil)
+		if err != nil {
+			return &frameworktypes.Status{
+				Err: fmt.Errorf("error listing pods on a node: %v", err),
+			}
+		}
+		reclaimable := reclaimableStorage(node, pods)
+
+		var candidates []*v1.Pod
+		for _, pod := range pods {
+			if d.podFilter(pod) {
+				candidates = append(candidates, pod)
+			}
+		}
+		// The lowest priority pods go first among the pods freeing the same storage
+		podutil.SortPodsBasedOnPriorityLowToHigh(candidates)
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return reclaimable[candidates[i].UID] > reclaimable[candidates[j].UID]
+		})
+		frameworktypes.OrderPodsForEviction(d.handle.Evictor(), candidates)
+
+	loop:
+		for _, pod := range candidates {
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+			if err == nil {
+				break
+			}
+			switch err.(type) {
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
+				break loop
+			case *evictions.EvictionTotalLimitError:
+				return nil
+			default:
+				klog.Errorf("eviction failed: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// usagePercentage gives the usage of the node from the collected metrics as a percentage
+func usagePercentage(usage map[string]map[v1.ResourceName]*resource.Quantity, nodeName string) (api.Percentage, bool) {
+	quantity, ok := usage[nodeName][nodeutilization.MetricResource]
+	if !ok || quantity == nil {
+		return 0, false
+	}
+	return api.Percentage(quantity.Value()), true
+}
+
+// reclaimableStorage estimates the ephemeral storage in bytes the eviction of each pod frees on the node:
+// the ephemeral storage the pod requests for its writable layers, logs and emptyDir volumes, and the images
+// no other pod on the node runs, the kubelet garbage collects the images once the pod is gone.
+func reclaimableStorage(node *v1.Node, pods []*v1.Pod) map[types.UID]int64 {
+	imageIndex := make(map[string]int)
+	for i, image := range node.Status.Images {
+		for _, name := range image.Names {
+			imageIndex[name] = i
+		}
+	}
+
+	podImages := make(map[types.UID]sets.Set[int], len(pods))
+	imageUsers := make(map[int]int)
+	for _, pod := range pods {
+		images := sets.New[int]()
+		for _, name := range podImageNames(pod) {
+			if i, ok := imageIndex[name]; ok {
+				images.Insert(i)
+			}
+		}
+		for i := range images {
+			imageUsers[i]++
+		}
+		podImages[pod.UID] = images
+	}
+
+	reclaimable := make(map[types.UID]int64, len(pods))
+	for _, pod := range pods {
+		size := utils.GetResourceRequest(pod, v1.ResourceEphemeralStorage)
+		for i := range podImages[pod.UID] {
+			if imageUsers[i] == 1 {
+				size += node.Status.Images[i].SizeBytes
+			}
+		}
+		reclaimable[pod.UID] = size
+	}
+	return reclaimable
+}
+
+// podImageNames lists the names the images of the pod may be reported with in the node status,
+// the container runtime reports the resolved image references and digests in the pod status.
+func podImageNames(pod *v1.Pod) []string {
+	var names []string
+	for _, containers := range [][]v1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			names = append(names, container.Image)
+		}
+	}
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			names = append(names, status.Image, status.ImageID)
+		}
+	}
+	return names
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsapproachingdiskpressure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+// fakePromClient answers every query with the samples of the node usage listed for the query
+type fakePromClient struct {
+	usage map[string]map[string]float64
+}
+
+func (client *fakePromClient) URL(ep string, args map[string]string) *url.URL {
+	return &url.URL{}
+}
+
+func (client *fakePromClient) Do(ctx context.Context, request *http.Request) (*http.Response, []byte, error) {
+	if err := request.ParseForm(); err != nil {
+		return nil, nil, err
+	}
+	samples := model.Vector{}
+	for nodeName, value := range client.usage[request.Form.Get("query")] {
+		samples = append(samples, &model.Sample{
+			Metric:    model.Metric{"instance": model.LabelValue(nodeName)},
+			Value:     model.SampleValue(value),
+			Timestamp: 1728991761711,
+		})
+	}
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"resultType": model.ValVector,
+			"result":     samples,
+		},
+	})
+	return &http.Response{StatusCode: 200}, jsonData, err
+}
+
+func TestRemovePodsApproachingDiskPressure(t *testing.T) {
+	buildPod := func(name, nodeName string, priority int32, image string, storage int64) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, nodeName, func(pod *v1.Pod) {
+			test.SetNormalOwnerRef(pod)
+			pod.UID = types.UID(name)
+			pod.Spec.Priority = utilptr.To(priority)
+			pod.Spec.Containers[0].Image = image
+			if storage > 0 {
+				pod.Spec.Containers[0].Resources.Requests[v1.ResourceEphemeralStorage] = *resource.NewQuantity(storage, resource.BinarySI)
+			}
+		})
+	}
+	buildNode := func(name string) *v1.Node {
+		return test.BuildTestNode(name, 4000, 3000, 10, func(node *v1.Node) {
+			node.Status.Images = []v1.ContainerImage{
+				{Names: []string{"docker.io/library/shared:1", "shared:1"}, SizeBytes: 500},
+				{Names: []string{"docker.io/library/big:1", "big:1"}, SizeBytes: 300},
+				{Names: []string{"docker.io/library/small:1", "small:1"}, SizeBytes: 100},
+			}
+		})
+	}
+	n1 := buildNode("n1")
+	n2 := buildNode("n2")
+
+	n1Pods := func() []*v1.Pod {
+		return []*v1.Pod{
+			buildPod("shared-1", n1.Name, 10, "shared:1", 0),
+			buildPod("shared-2", n1.Name, 10, "shared:1", 0),
+			buildPod("big", n1.Name, 100, "big:1", 0),
+			buildPod("small", n1.Name, 10, "small:1", 0),
+			buildPod("logs", n1.Name, 100, "shared:1", 0),
+		}
+	}
+
+	tests := []struct {
+		description     string
+		args            RemovePodsApproachingDiskPressureArgs
+		usage           map[string]map[string]float64
+		pods            []*v1.Pod
+		expectedEvicted []string
+	}{
+		{
+			description: "pod freeing the most storage evicted from the node over the storage threshold",
+			usage: map[string]map[string]float64{
+				DefaultStorageQuery: {n1.Name: 0.9, n2.Name: 0.5},
+				DefaultInodesQuery:  {n1.Name: 0.1, n2.Name: 0.1},
+			},
+			pods:            n1Pods(),
+			expectedEvicted: []string{"big"},
+		},
+		{
+			description: "ephemeral storage requests count towards the freed storage",
+			usage: map[string]map[string]float64{
+				DefaultStorageQuery: {n1.Name: 0.9},
+				DefaultInodesQuery:  {n1.Name: 0.1},
+			},
+			pods: append(n1Pods()[:4],
+				buildPod("logs", n1.Name, 100, "shared:1", 1000),
+			),
+			expectedEvicted: []string{"logs"},
+		},
+		{
+			description: "pod evicted from the node over the inodes threshold",
+			usage: map[string]map[string]float64{
+				DefaultStorageQuery: {n1.Name: 0.5, n2.Name: 0.5},
+				DefaultInodesQuery:  {n1.Name: 0.1, n2.Name: 0.95},
+			},
+			pods: append(n1Pods(),
+				buildPod("n2-small", n2.Name, 10, "small:1", 0),
+				buildPod("n2-big", n2.Name, 10, "big:1", 0),
+			),
+			expectedEvicted: []string{"n2-big"},
+		},
+		{
+			description: "lowest priority pod evicted among the pods freeing the same storage",
+			usage: map[string]map[string]float64{
+				DefaultStorageQuery: {n1.Name: 0.9},
+				DefaultInodesQuery:  {n1.Name: 0.1},
+			},
+			pods: []*v1.Pod{
+				buildPod("high", n1.Name, 100, "shared:1", 0),
+				buildPod("low", n1.Name, 10, "shared:1", 0),
+			},
+			expectedEvicted: []string{"low"},
+		},
+		{
+			description: "nodes under the thresholds",
+			args:        RemovePodsApproachingDiskPressureArgs{StorageThreshold: 95, InodesThreshold: 95},
+			usage: map[string]map[string]float64{
+				DefaultStorageQuery: {n1.Name: 0.9},
+				DefaultInodesQuery:  {n1.Name: 0.9},
+			},
+			pods: n1Pods(),
+		},
+		{
+			description: "namespaces excluded from the evictions",
+			args:        RemovePodsApproachingDiskPressureArgs{Namespaces: &api.Namespaces{Exclude: []string{"default"}}},
+			usage: map[string]map[string]float64{
+				DefaultStorageQuery: {n1.Name: 0.9},
+				DefaultInodesQuery:  {n1.Name: 0.9},
+			},
+			pods: n1Pods(),
+		},
+		{
+			description: "nodes without collected usage skipped",
+			pods:        n1Pods(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			objs := []runtime.Object{n1, n2}
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			evicted := sets.New[string]()
+			fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() == "eviction" {
+					evicted.Insert(action.(core.CreateAction).GetObject().(metav1.Object).GetName())
+				}
+				return false, nil, nil
+			})
+
+			handle, _, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				fakeClient,
+				evictions.NewOptions(),
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+			handle.PrometheusClientImpl = &fakePromClient{usage: tc.usage}
+
+			args := tc.args
+			SetDefaults_RemovePodsApproachingDiskPressureArgs(&args)
+			plugin, err := New(&args, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			status := plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{n1, n2})
+			if status != nil {
+				t.Fatalf("Deschedule.err: %v", status.Err)
+			}
+			if !evicted.Equal(sets.New(tc.expectedEvicted...)) {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, sets.List(evicted))
+			}
+		})
+	}
+}
+
+func TestNewWithoutPrometheusClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handle, _, err := frameworktesting.InitFrameworkHandle(ctx, fake.NewSimpleClientset(), evictions.NewOptions(), defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+	args := &RemovePodsApproachingDiskPressureArgs{}
+	SetDefaults_RemovePodsApproachingDiskPressureArgs(args)
+	if _, err := New(args, handle); err == nil {
+		t.Errorf("Expected an error without a prometheus client")
+	}
+}
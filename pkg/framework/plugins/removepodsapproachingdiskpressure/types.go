@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsapproachingdiskpressure
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RemovePodsApproachingDiskPressureArgs holds arguments used to configure RemovePodsApproachingDiskPressure plugin.
+type RemovePodsApproachingDiskPressureArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Namespaces    *api.Namespaces       `json:"namespaces,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// StorageThreshold is the percentage of the ephemeral storage of a node in use from which pods are evicted from the node.
+	StorageThreshold api.Percentage `json:"storageThreshold,omitempty"`
+	// InodesThreshold is the percentage of the inodes of a node in use from which pods are evicted from the node.
+	InodesThreshold api.Percentage `json:"inodesThreshold,omitempty"`
+	// Prometheus configures the queries of the ephemeral storage and the inodes usage of the nodes.
+	Prometheus *Prometheus `json:"prometheus,omitempty"`
+}
+
+type Prometheus struct {
+	// storageQuery returns a vector of samples, each sample labeled with `instance`
+	// corresponding to a node name with the fraction of the ephemeral storage of the node
+	// in use as a real number in <0; 1> interval.
+	StorageQuery string `json:"storageQuery,omitempty"`
+	// inodesQuery returns a vector of samples, each sample labeled with `instance`
+	// corresponding to a node name with the fraction of the inodes of the node
+	// in use as a real number in <0; 1> interval.
+	InodesQuery string `json:"inodesQuery,omitempty"`
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsapproachingdiskpressure
+
+import (
+	"testing"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestValidateRemovePodsApproachingDiskPressureArgs(t *testing.T) {
+	prometheus := &Prometheus{StorageQuery: DefaultStorageQuery, InodesQuery: DefaultInodesQuery}
+	testCases := []struct {
+		description string
+		args        *RemovePodsApproachingDiskPressureArgs
+		expectError bool
+	}{
+		{
+			description: "valid arg, no errors",
+			args: &RemovePodsApproachingDiskPressureArgs{
+				StorageThreshold: 80,
+				InodesThreshold:  90,
+				Prometheus:       prometheus,
+			},
+			expectError: false,
+		},
+		{
+			description: "zero StorageThreshold arg, expects errors",
+			args: &RemovePodsApproachingDiskPressureArgs{
+				InodesThreshold: 90,
+				Prometheus:      prometheus,
+			},
+			expectError: true,
+		},
+		{
+			description: "InodesThreshold arg above 100, expects errors",
+			args: &RemovePodsApproachingDiskPressureArgs{
+				StorageThreshold: 80,
+				InodesThreshold:  120,
+				Prometheus:       prometheus,
+			},
+			expectError: true,
+		},
+		{
+			description: "missing inodes query, expects errors",
+			args: &RemovePodsApproachingDiskPressureArgs{
+				StorageThreshold: 80,
+				InodesThreshold:  90,
+				Prometheus:       &Prometheus{StorageQuery: DefaultStorageQuery},
+			},
+			expectError: true,
+		},
+		{
+			description: "both included and excluded namespaces, expects errors",
+			args: &RemovePodsApproachingDiskPressureArgs{
+				StorageThreshold: 80,
+				InodesThreshold:  90,
+				Prometheus:       prometheus,
+				Namespaces:       &api.Namespaces{Include: []string{"a"}, Exclude: []string{"b"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateRemovePodsApproachingDiskPressureArgs(tc.args)
+
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsexceedingpoddensity
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// DefaultMaxPodDensity is the default percentage of the allocatable pods of a node the pods may take
+const DefaultMaxPodDensity api.Percentage = 80
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_RemovePodsExceedingPodDensityArgs
+// TODO: the final default values would be discussed in community
+func SetDefaults_RemovePodsExceedingPodDensityArgs(obj runtime.Object) {
+	args := obj.(*RemovePodsExceedingPodDensityArgs)
+	if args.MaxPodDensity == 0 {
+		args.MaxPodDensity = DefaultMaxPodDensity
+	}
+}
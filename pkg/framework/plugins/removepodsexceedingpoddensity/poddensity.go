@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsexceedingpoddensity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+)
+
+const PluginName = "RemovePodsExceedingPodDensity"
+
+// RemovePodsExceedingPodDensity evicts pods from the nodes running more pods than the given percentage
+// of their allocatable pods. The kubelet and the CNI performance degrades with the number of pods
+// before the resource thresholds of the nodes trip. The lowest priority pods are evicted first,
+// the newest pods first among the pods of the same priority.
+type RemovePodsExceedingPodDensity struct {
+	handle    frameworktypes.Handle
+	args      *RemovePodsExceedingPodDensityArgs
+	podFilter podutil.FilterFunc
+}
+
+var _ frameworktypes.DeschedulePlugin = &RemovePodsExceedingPodDensity{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	podDensityArgs, ok := args.(*RemovePodsExceedingPodDensityArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type RemovePodsExceedingPodDensityArgs, got %T", args)
+	}
+
+	var includedNamespaces, excludedNamespaces sets.Set[string]
+	if podDensityArgs.Namespaces != nil {
+		includedNamespaces = sets.New(podDensityArgs.Namespaces.Include...)
+		excludedNamespaces = sets.New(podDensityArgs.Namespaces.Exclude...)
+	}
+
+	// We can combine Filter and PreEvictionFilter since for this strategy it does not matter where we run PreEvictionFilter
+	podFilter, err := podutil.NewOptions().
+		WithFilter(podutil.WrapFilterFuncs(handle.Evictor().Filter, handle.Evictor().PreEvictionFilter)).
+		WithNamespaces(includedNamespaces).
+		WithoutNamespaces(excludedNamespaces).
+		WithLabelSelector(podDensityArgs.LabelSelector).
+		BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	return &RemovePodsExceedingPodDensity{
+		handle:    handle,
+		args:      podDensityArgs,
+		podFilter: podutil.WrapFilterFuncs(podFilter, isActive),
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *RemovePodsExceedingPodDensity) Name() string {
+	return PluginName
+}
+
+// Deschedule extension point implementation for the plugin
+func (d *RemovePodsExceedingPodDensity) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	for _, node := range nodes {
+		maxPods := maxPodsOnNode(node, float64(d.args.MaxPodDensity))
+		if maxPods < 0 {
+			klog.V(4).InfoS("Node has no allocatable pods, skipping", "node", klog.KObj(node))
+			continue
+		}
+		// Every pod on the node counts towards the density, whether it can be evicted or not
+		activePods, err := podutil.ListAllPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), isActive)
+		if err != nil {
+			// no pods evicted as error encountered retrieving evictable Pods
+			return &frameworktypes.Status{
+				Err: fmt.Errorf("error listing pods on a node: %v", err),
+			}
+		}
+		excess := len(activePods) - maxPods
+		if excess <= 0 {
+			continue
+		}
+		klog.V(2).InfoS("Node exceeds the pod density", "node", klog.KObj(node), "pods", len(activePods), "maxPods", maxPods)
+
+		pods, err := podutil.ListAllPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
+		if err != nil {
+			return &frameworktypes.Status{
+				Err: fmt.Errorf("error listing pods on a node: %v", err),
+			}
+		}
+		sortByPriorityAndAge(pods)
+		frameworktypes.OrderPodsForEviction(d.handle.Evictor(), pods)
+
+		evicted := 0
+	loop:
+		for _, pod := range pods {
+			if evicted >= excess {
+				break
+			}
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+			if err == nil {
+				evicted++
+				continue
+			}
+			switch err.(type) {
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
+				break loop
+			case *evictions.EvictionTotalLimitError:
+				return nil
+			default:
+				klog.Errorf("eviction failed: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// maxPodsOnNode gives the number of pods the node may run under the density, -1 when the node
+// reports no allocatable pods
+func maxPodsOnNode(node *v1.Node, maxPodDensity float64) int {
+	allocatable := node.Status.Allocatable.Pods().Value()
+	if allocatable <= 0 {
+		return -1
+	}
+	return int(float64(allocatable) * maxPodDensity / 100)
+}
+
+// isActive excludes the terminated pods, the kubelet does not count them against the pod capacity
+func isActive(pod *v1.Pod) bool {
+	return pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed
+}
+
+// sortByPriorityAndAge orders the pods by the priority from the lowest, the newest pods first
+// among the pods of the same priority. Pods without a priority go first.
+func sortByPriorityAndAge(pods []*v1.Pod) {
+	priority := func(pod *v1.Pod) int64 {
+		if pod.Spec.Priority == nil {
+			return math.MinInt64
+		}
+		return int64(*pod.Spec.Priority)
+	}
+	sort.SliceStable(pods, func(i, j int) bool {
+		if pi, pj := priority(pods[i]), priority(pods[j]); pi != pj {
+			return pi < pj
+		}
+		return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+	})
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsexceedingpoddensity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRemovePodsExceedingPodDensity(t *testing.T) {
+	now := time.Now()
+	buildPod := func(name, nodeName string, priority int32, age time.Duration, apply func(*v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, nodeName, func(pod *v1.Pod) {
+			test.SetNormalOwnerRef(pod)
+			pod.Spec.Priority = utilptr.To(priority)
+			pod.CreationTimestamp = metav1.NewTime(now.Add(-age))
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+	// 10 allocatable pods, 8 allowed with the default density
+	dense := test.BuildTestNode("dense", 4000, 3000, 10, nil)
+	sparse := test.BuildTestNode("sparse", 4000, 3000, 10, nil)
+
+	densePods := func() []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < 7; i++ {
+			pods = append(pods, buildPod(fmt.Sprintf("high-%d", i), dense.Name, 100, time.Duration(i+1)*time.Hour, nil))
+		}
+		pods = append(pods,
+			buildPod("low-old", dense.Name, 10, 10*time.Hour, nil),
+			buildPod("low-new", dense.Name, 10, time.Minute, nil),
+			buildPod("high-newest", dense.Name, 100, time.Second, nil),
+		)
+		return pods
+	}
+
+	tests := []struct {
+		description     string
+		args            RemovePodsExceedingPodDensityArgs
+		pods            []*v1.Pod
+		expectedEvicted []string
+	}{
+		{
+			description:     "lowest priority newest pods evicted first",
+			args:            RemovePodsExceedingPodDensityArgs{MaxPodDensity: 80},
+			pods:            densePods(),
+			expectedEvicted: []string{"low-new", "low-old"},
+		},
+		{
+			description:     "newest pods evicted among the same priority",
+			args:            RemovePodsExceedingPodDensityArgs{MaxPodDensity: 70},
+			pods:            densePods(),
+			expectedEvicted: []string{"low-new", "low-old", "high-newest"},
+		},
+		{
+			description: "terminated pods do not count",
+			args:        RemovePodsExceedingPodDensityArgs{MaxPodDensity: 80},
+			pods: append(densePods()[:8], buildPod("succeeded", dense.Name, 10, time.Minute, func(pod *v1.Pod) {
+				pod.Status.Phase = v1.PodSucceeded
+			})),
+		},
+		{
+			description: "non evictable pods count but are not evicted",
+			args:        RemovePodsExceedingPodDensityArgs{MaxPodDensity: 80},
+			pods: append(densePods()[:8],
+				buildPod("daemon-1", dense.Name, 0, time.Minute, test.SetDSOwnerRef),
+				buildPod("daemon-2", dense.Name, 0, time.Minute, test.SetDSOwnerRef),
+			),
+			expectedEvicted: []string{"low-old", "high-0"},
+		},
+		{
+			description:     "namespaces excluded from the evictions",
+			args:            RemovePodsExceedingPodDensityArgs{MaxPodDensity: 80, Namespaces: &api.Namespaces{Exclude: []string{"default"}}},
+			pods:            densePods(),
+			expectedEvicted: nil,
+		},
+		{
+			description: "node under the density",
+			args:        RemovePodsExceedingPodDensityArgs{MaxPodDensity: 80},
+			pods: []*v1.Pod{
+				buildPod("p1", sparse.Name, 10, time.Minute, nil),
+				buildPod("p2", sparse.Name, 10, time.Minute, nil),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			objs := []runtime.Object{dense, sparse}
+			for _, pod := range tc.pods {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			evicted := sets.New[string]()
+			fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() == "eviction" {
+					evicted.Insert(action.(core.CreateAction).GetObject().(metav1.Object).GetName())
+				}
+				return false, nil, nil
+			})
+
+			handle, _, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				fakeClient,
+				evictions.NewOptions(),
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			plugin, err := New(&tc.args, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{dense, sparse})
+			if !evicted.Equal(sets.New(tc.expectedEvicted...)) {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, sets.List(evicted))
+			}
+		})
+	}
+}
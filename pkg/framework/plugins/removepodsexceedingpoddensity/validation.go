@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsexceedingpoddensity
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// ValidateRemovePodsExceedingPodDensityArgs validates RemovePodsExceedingPodDensity arguments
+func ValidateRemovePodsExceedingPodDensityArgs(obj runtime.Object) error {
+	args := obj.(*RemovePodsExceedingPodDensityArgs)
+	// At most one of include/exclude can be set
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
+	}
+	if args.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(args.LabelSelector); err != nil {
+			return fmt.Errorf("failed to get label selectors from strategy's params: %+v", err)
+		}
+	}
+	if args.MaxPodDensity <= 0 || args.MaxPodDensity > 100 {
+		return fmt.Errorf("maxPodDensity must be in (0, 100], got %v", args.MaxPodDensity)
+	}
+	return nil
+}
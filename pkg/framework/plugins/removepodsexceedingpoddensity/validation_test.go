@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsexceedingpoddensity
+
+import (
+	"testing"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestValidateRemovePodsExceedingPodDensityArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *RemovePodsExceedingPodDensityArgs
+		expectError bool
+	}{
+		{
+			description: "valid arg, no errors",
+			args: &RemovePodsExceedingPodDensityArgs{
+				MaxPodDensity: 80,
+			},
+			expectError: false,
+		},
+		{
+			description: "zero MaxPodDensity arg, expects errors",
+			args:        &RemovePodsExceedingPodDensityArgs{},
+			expectError: true,
+		},
+		{
+			description: "MaxPodDensity arg above 100, expects errors",
+			args: &RemovePodsExceedingPodDensityArgs{
+				MaxPodDensity: 120,
+			},
+			expectError: true,
+		},
+		{
+			description: "both included and excluded namespaces, expects errors",
+			args: &RemovePodsExceedingPodDensityArgs{
+				MaxPodDensity: 80,
+				Namespaces:    &api.Namespaces{Include: []string{"a"}, Exclude: []string{"b"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateRemovePodsExceedingPodDensityArgs(tc.args)
+
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+const (
+	defaultNotReadyThresholdSeconds   = 600
+	defaultMaxNotReadyNodesPercentage = 10
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_RemovePodsFromNotReadyNodesArgs
+// TODO: the final default values would be discussed in community
+func SetDefaults_RemovePodsFromNotReadyNodesArgs(obj runtime.Object) {
+	args := obj.(*RemovePodsFromNotReadyNodesArgs)
+	if args.NotReadyThresholdSeconds == nil {
+		args.NotReadyThresholdSeconds = ptr.To[uint](defaultNotReadyThresholdSeconds)
+	}
+	if args.MaxNotReadyNodesPercentage == nil {
+		args.MaxNotReadyNodesPercentage = ptr.To[api.Percentage](defaultMaxNotReadyNodesPercentage)
+	}
+}
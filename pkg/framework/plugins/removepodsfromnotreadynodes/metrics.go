@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	"k8s.io/component-base/metrics"
+
+	deschedulermetrics "sigs.k8s.io/descheduler/metrics"
+)
+
+var forceDeletedPods = metrics.NewCounterVec(
+	&metrics.CounterOpts{
+		Subsystem:      deschedulermetrics.PluginSubsystem,
+		Name:           "not_ready_node_force_deleted_pods",
+		Help:           "Number of pods stuck terminating on unreachable nodes force deleted, by the namespace and the node",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"namespace", "node"})
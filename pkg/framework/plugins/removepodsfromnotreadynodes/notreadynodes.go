@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const (
+	PluginName = "RemovePodsFromNotReadyNodes"
+	// minNotReadyThresholdSeconds keeps the plugin from racing the kubelets reconnecting after a short outage
+	minNotReadyThresholdSeconds = 60
+)
+
+// RemovePodsFromNotReadyNodes evicts the pods of the nodes NotReady for longer than a threshold, ahead of
+// the slow timeouts of the node lifecycle controller, and optionally force deletes the pods stuck terminating
+// on the unreachable nodes. The nodes NotReady are not passed to the plugins, the plugin picks them from the
+// node lister, which lists only the nodes matching the policy node selector.
+type RemovePodsFromNotReadyNodes struct {
+	handle            frameworktypes.Handle
+	args              *RemovePodsFromNotReadyNodesArgs
+	nodeLister        listersv1.NodeLister
+	podFilter         podutil.FilterFunc
+	terminatingFilter podutil.FilterFunc
+}
+
+var _ frameworktypes.DeschedulePlugin = &RemovePodsFromNotReadyNodes{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	notReadyArgs, ok := args.(*RemovePodsFromNotReadyNodesArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type RemovePodsFromNotReadyNodesArgs, got %T", args)
+	}
+
+	var includedNamespaces, excludedNamespaces sets.Set[string]
+	if notReadyArgs.Namespaces != nil {
+		includedNamespaces = sets.New(notReadyArgs.Namespaces.Include...)
+		excludedNamespaces = sets.New(notReadyArgs.Namespaces.Exclude...)
+	}
+
+	options := func() *podutil.Options {
+		return podutil.NewOptions().
+			WithNamespaces(includedNamespaces).
+			WithoutNamespaces(excludedNamespaces).
+			WithLabelSelector(notReadyArgs.LabelSelector)
+	}
+	// We can combine Filter and PreEvictionFilter since for this strategy it does not matter where we run PreEvictionFilter
+	podFilter, err := options().
+		WithFilter(podutil.WrapFilterFuncs(handle.Evictor().Filter, handle.Evictor().PreEvictionFilter)).
+		BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+	// The terminating pods are filtered out by the evictor, they are checked by the evictor as if they were
+	// not terminating in forceDeleteTerminatingPods
+	terminatingFilter, err := options().WithFilter(isForceDeletable).BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	if err := handle.RegisterMetric(forceDeletedPods); err != nil {
+		return nil, fmt.Errorf("error registering metrics: %v", err)
+	}
+
+	return &RemovePodsFromNotReadyNodes{
+		handle:            handle,
+		args:              notReadyArgs,
+		nodeLister:        handle.SharedInformerFactory().Core().V1().Nodes().Lister(),
+		podFilter:         podFilter,
+		terminatingFilter: terminatingFilter,
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *RemovePodsFromNotReadyNodes) Name() string {
+	return PluginName
+}
+
+// Deschedule extension point implementation for the plugin
+func (d *RemovePodsFromNotReadyNodes) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	// The node lister lists the nodes matching the policy node selector, the nodes passed are the Ready ones of them
+	scopedNodes, err := d.nodeLister.List(labels.Everything())
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: fmt.Errorf("error listing nodes: %v", err),
+		}
+	}
+
+	now := time.Now()
+	threshold := time.Duration(*d.args.NotReadyThresholdSeconds) * time.Second
+	var notReady, stale []*v1.Node
+	for _, node := range scopedNodes {
+		since, ok := notReadySince(node)
+		if !ok {
+			continue
+		}
+		notReady = append(notReady, node)
+		if now.Sub(since) >= threshold {
+			stale = append(stale, node)
+		}
+	}
+	// Many nodes going NotReady at once is more likely a partition or a control plane issue than failed nodes
+	total := len(nodes) + len(notReady)
+	maxNotReady := max(1, int(math.Floor(float64(total)*float64(*d.args.MaxNotReadyNodesPercentage)/100)))
+	if len(notReady) > maxNotReady {
+		klog.InfoS("Too many NotReady nodes, skipping the eviction of their pods", "notReady", len(notReady), "nodes", total, "maxNotReady", maxNotReady)
+		return nil
+	}
+
+	for _, node := range stale {
+		klog.V(1).InfoS("Processing the pods of the NotReady node", "node", klog.KObj(node))
+		pods, err := podutil.ListPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
+		if err != nil {
+			return &frameworktypes.Status{
+				Err: fmt.Errorf("error listing pods on a node: %v", err),
+			}
+		}
+	loop:
+		for _, pod := range pods {
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+			if err == nil {
+				continue
+			}
+			switch err.(type) {
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
+				break loop
+			case *evictions.EvictionTotalLimitError:
+				return nil
+			default:
+				klog.Errorf("eviction failed: %v", err)
+			}
+		}
+
+		if d.args.ForceDeleteTerminatingPods && isUnreachable(node) {
+			totalLimitReached, err := d.forceDeleteTerminatingPods(ctx, node, now)
+			if err != nil {
+				return &frameworktypes.Status{Err: err}
+			}
+			if totalLimitReached {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// forceDeleteTerminatingPods deletes the pods of the unreachable node terminating past their grace period.
+// The kubelet of the node can not confirm the termination, the pods would stay terminating until the node
+// comes back or gets deleted. The pods are checked by the evictor as if they were not terminating, and are
+// deleted through the evictor under the eviction limits. Returns true once the total eviction limit is reached.
+func (d *RemovePodsFromNotReadyNodes) forceDeleteTerminatingPods(ctx context.Context, node *v1.Node, now time.Time) (bool, error) {
+	pods, err := podutil.ListPodsOnANode(node.Name, d.handle.GetPodsAssignedToNodeFunc(), d.terminatingFilter)
+	if err != nil {
+		return false, fmt.Errorf("error listing pods on a node: %v", err)
+	}
+	for _, pod := range pods {
+		// The deletion timestamp of a pod is the end of its grace period
+		if now.Before(pod.DeletionTimestamp.Time) {
+			continue
+		}
+		candidate := pod.DeepCopy()
+		candidate.DeletionTimestamp = nil
+		if !d.handle.Evictor().Filter(candidate) || !d.handle.Evictor().PreEvictionFilter(candidate) {
+			continue
+		}
+		err := d.handle.Evictor().Evict(ctx, candidate, evictions.EvictOptions{StrategyName: PluginName, ForceDelete: true})
+		if err == nil {
+			klog.V(1).InfoS("Force deleted the pod stuck terminating on the unreachable node", "pod", klog.KObj(pod), "node", klog.KObj(node), "deletionTimestamp", pod.DeletionTimestamp)
+			forceDeletedPods.WithLabelValues(pod.Namespace, node.Name).Inc()
+			continue
+		}
+		switch err.(type) {
+		case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
+			return false, nil
+		case *evictions.EvictionTotalLimitError:
+			return true, nil
+		default:
+			klog.ErrorS(err, "Unable to force delete the pod stuck terminating", "pod", klog.KObj(pod), "node", klog.KObj(node))
+		}
+	}
+	return false, nil
+}
+
+// notReadySince returns the time the node turned NotReady, false for the Ready nodes and
+// the nodes not reporting their readiness yet
+func notReadySince(node *v1.Node) (time.Time, bool) {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.LastTransitionTime.Time, condition.Status != v1.ConditionTrue
+		}
+	}
+	return time.Time{}, false
+}
+
+// isUnreachable tells whether the node controller lost the contact with the kubelet of the node
+func isUnreachable(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == v1.TaintNodeUnreachable && taint.Effect == v1.TaintEffectNoExecute {
+			return true
+		}
+	}
+	return false
+}
+
+// isForceDeletable tells whether the pod is terminating and may be force deleted. Force deleting a StatefulSet
+// pod may run two pods with the same identity, the DaemonSet pods are bound to their nodes anyway.
+func isForceDeletable(pod *v1.Pod) bool {
+	if pod.DeletionTimestamp == nil {
+		return false
+	}
+	ownerRefs := podutil.OwnerRef(pod)
+	for _, ownerRef := range ownerRefs {
+		if ownerRef.Kind == "StatefulSet" {
+			return false
+		}
+	}
+	return !utils.IsDaemonsetPod(ownerRefs)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRemovePodsFromNotReadyNodes(t *testing.T) {
+	now := time.Now()
+	notReadyFor := func(duration time.Duration, unreachable bool) func(*v1.Node) {
+		return func(node *v1.Node) {
+			node.Status.Conditions = []v1.NodeCondition{{
+				Type:               v1.NodeReady,
+				Status:             v1.ConditionUnknown,
+				LastTransitionTime: metav1.NewTime(now.Add(-duration)),
+			}}
+			if unreachable {
+				node.Spec.Taints = []v1.Taint{{Key: v1.TaintNodeUnreachable, Effect: v1.TaintEffectNoExecute}}
+			}
+		}
+	}
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, nil)
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, nil)
+	stale := test.BuildTestNode("stale", 2000, 3000, 10, notReadyFor(20*time.Minute, true))
+	recent := test.BuildTestNode("recent", 2000, 3000, 10, notReadyFor(time.Minute, true))
+
+	terminating := func(deletedAgo time.Duration, setOwnerRef func(*v1.Pod)) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			setOwnerRef(pod)
+			pod.DeletionTimestamp = &metav1.Time{Time: now.Add(-deletedAgo)}
+		}
+	}
+	pods := []*v1.Pod{
+		test.BuildTestPod("running-stale", 100, 0, "stale", test.SetRSOwnerRef),
+		test.BuildTestPod("running-recent", 100, 0, "recent", test.SetRSOwnerRef),
+		test.BuildTestPod("running-n1", 100, 0, "n1", test.SetRSOwnerRef),
+		test.BuildTestPod("stuck", 100, 0, "stale", terminating(time.Minute, test.SetRSOwnerRef)),
+		test.BuildTestPod("stuck-statefulset", 100, 0, "stale", terminating(time.Minute, test.SetSSOwnerRef)),
+		test.BuildTestPod("within-grace-period", 100, 0, "stale", terminating(-time.Minute, test.SetRSOwnerRef)),
+		test.BuildTestPod("stuck-recent", 100, 0, "recent", terminating(time.Minute, test.SetRSOwnerRef)),
+		// The evictor filters apply to the pods force deleted
+		test.BuildTestPod("stuck-mirror", 100, 0, "stale", terminating(time.Minute, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			pod.Annotations = test.GetMirrorPodAnnotation()
+		})),
+	}
+
+	testCases := []struct {
+		description           string
+		args                  RemovePodsFromNotReadyNodesArgs
+		maxPodsToEvictPerNode *uint
+		expectedEvicted       []string
+		expectedForceDeleted  []string
+	}{
+		{
+			description:     "pods of the stale NotReady node evicted",
+			args:            RemovePodsFromNotReadyNodesArgs{MaxNotReadyNodesPercentage: ptr.To[api.Percentage](50)},
+			expectedEvicted: []string{"running-stale"},
+		},
+		{
+			description:          "pods stuck terminating force deleted",
+			args:                 RemovePodsFromNotReadyNodesArgs{MaxNotReadyNodesPercentage: ptr.To[api.Percentage](50), ForceDeleteTerminatingPods: true},
+			expectedEvicted:      []string{"running-stale"},
+			expectedForceDeleted: []string{"stuck"},
+		},
+		{
+			description:           "force deletions counted against the eviction limits",
+			args:                  RemovePodsFromNotReadyNodesArgs{MaxNotReadyNodesPercentage: ptr.To[api.Percentage](50), ForceDeleteTerminatingPods: true},
+			maxPodsToEvictPerNode: ptr.To[uint](1),
+			expectedEvicted:       []string{"running-stale"},
+		},
+		{
+			description: "too many NotReady nodes",
+			args:        RemovePodsFromNotReadyNodesArgs{ForceDeleteTerminatingPods: true},
+		},
+		{
+			description:     "longer threshold",
+			args:            RemovePodsFromNotReadyNodesArgs{MaxNotReadyNodesPercentage: ptr.To[api.Percentage](50), NotReadyThresholdSeconds: ptr.To[uint](3600)},
+			expectedEvicted: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			objs := []runtime.Object{n1, n2, stale, recent}
+			for _, pod := range pods {
+				objs = append(objs, pod.DeepCopy())
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			evicted, forceDeleted := sets.New[string](), sets.New[string]()
+			fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() == "eviction" {
+					evicted.Insert(action.(core.CreateAction).GetObject().(metav1.Object).GetName())
+				}
+				return false, nil, nil
+			})
+			fakeClient.PrependReactor("delete", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				forceDeleted.Insert(action.(core.DeleteAction).GetName())
+				return false, nil, nil
+			})
+
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				fakeClient,
+				evictions.NewOptions().WithMaxPodsToEvictPerNode(tc.maxPodsToEvictPerNode),
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			SetDefaults_RemovePodsFromNotReadyNodesArgs(&tc.args)
+			plugin, err := New(&tc.args, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+			// The node informer is registered by the plugin
+			handle.SharedInformerFactoryImpl.Start(ctx.Done())
+			handle.SharedInformerFactoryImpl.WaitForCacheSync(ctx.Done())
+
+			plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{n1, n2})
+			if !evicted.Equal(sets.New(tc.expectedEvicted...)) {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, sets.List(evicted))
+			}
+			if !forceDeleted.Equal(sets.New(tc.expectedForceDeleted...)) {
+				t.Errorf("Expected %v pods force deleted, got %v", tc.expectedForceDeleted, sets.List(forceDeleted))
+			}
+			if total := uint(len(tc.expectedEvicted) + len(tc.expectedForceDeleted)); podEvictor.TotalEvicted() != total {
+				t.Errorf("Expected the evictor to count %d evictions, got %d", total, podEvictor.TotalEvicted())
+			}
+		})
+	}
+}
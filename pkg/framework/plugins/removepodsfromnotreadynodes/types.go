@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RemovePodsFromNotReadyNodesArgs holds arguments used to configure RemovePodsFromNotReadyNodes plugin.
+type RemovePodsFromNotReadyNodesArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Namespaces    *api.Namespaces       `json:"namespaces,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// NotReadyThresholdSeconds is the time a node has to be NotReady for its pods to be evicted. Defaults to 600.
+	NotReadyThresholdSeconds *uint `json:"notReadyThresholdSeconds,omitempty"`
+	// MaxNotReadyNodesPercentage of the nodes NotReady at most, no pods are evicted while more nodes are
+	// NotReady, e.g. during a network partition. At least one NotReady node is always allowed. Defaults to 10.
+	MaxNotReadyNodesPercentage *api.Percentage `json:"maxNotReadyNodesPercentage,omitempty"`
+	// ForceDeleteTerminatingPods force deletes the pods stuck terminating on the unreachable nodes
+	// past their grace period. The StatefulSet and DaemonSet pods are never force deleted.
+	ForceDeleteTerminatingPods bool `json:"forceDeleteTerminatingPods,omitempty"`
+}
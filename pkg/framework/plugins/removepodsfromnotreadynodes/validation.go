@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// ValidateRemovePodsFromNotReadyNodesArgs validates RemovePodsFromNotReadyNodes arguments
+func ValidateRemovePodsFromNotReadyNodesArgs(obj runtime.Object) error {
+	args := obj.(*RemovePodsFromNotReadyNodesArgs)
+	// At most one of include/exclude can be set
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
+	}
+	if args.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(args.LabelSelector); err != nil {
+			return fmt.Errorf("failed to get label selectors from strategy's params: %+v", err)
+		}
+	}
+	if args.NotReadyThresholdSeconds != nil && *args.NotReadyThresholdSeconds < minNotReadyThresholdSeconds {
+		return fmt.Errorf("notReadyThresholdSeconds must be at least %d, got %d", minNotReadyThresholdSeconds, *args.NotReadyThresholdSeconds)
+	}
+	if percentage := args.MaxNotReadyNodesPercentage; percentage != nil && (*percentage < 0 || *percentage > 100) {
+		return fmt.Errorf("maxNotReadyNodesPercentage must be in [0, 100], got %v", *percentage)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsfromnotreadynodes
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestValidateRemovePodsFromNotReadyNodesArgs(t *testing.T) {
+	testCases := []struct {
+		description string
+		args        *RemovePodsFromNotReadyNodesArgs
+		expectError bool
+	}{
+		{
+			description: "valid args, no errors",
+			args: &RemovePodsFromNotReadyNodesArgs{
+				Namespaces:                 &api.Namespaces{Exclude: []string{"kube-system"}},
+				NotReadyThresholdSeconds:   ptr.To[uint](300),
+				MaxNotReadyNodesPercentage: ptr.To[api.Percentage](20),
+				ForceDeleteTerminatingPods: true,
+			},
+			expectError: false,
+		},
+		{
+			description: "both namespaces included and excluded, expects error",
+			args: &RemovePodsFromNotReadyNodesArgs{
+				Namespaces: &api.Namespaces{Include: []string{"default"}, Exclude: []string{"kube-system"}},
+			},
+			expectError: true,
+		},
+		{
+			description: "invalid label selector, expects error",
+			args: &RemovePodsFromNotReadyNodesArgs{
+				LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Matches"}}},
+			},
+			expectError: true,
+		},
+		{
+			description: "threshold below the minimum, expects error",
+			args: &RemovePodsFromNotReadyNodesArgs{
+				NotReadyThresholdSeconds: ptr.To[uint](30),
+			},
+			expectError: true,
+		},
+		{
+			description: "percentage out of range, expects error",
+			args: &RemovePodsFromNotReadyNodesArgs{
+				MaxNotReadyNodesPercentage: ptr.To[api.Percentage](120),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateRemovePodsFromNotReadyNodesArgs(tc.args)
+			hasError := err != nil
+			if tc.expectError != hasError {
+				t.Error("unexpected arg validation behavior")
+			}
+		})
+	}
+}
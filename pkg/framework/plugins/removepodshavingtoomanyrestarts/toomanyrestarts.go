@@ -19,8 +19,10 @@ package removepodshavingtoomanyrestarts
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
@@ -129,7 +131,7 @@ func (d *RemovePodsHavingTooManyRestarts) Deschedule(ctx context.Context, nodes
 				continue
 			}
 			switch err.(type) {
-			case *evictions.EvictionNodeLimitError:
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 				break loop
 			case *evictions.EvictionTotalLimitError:
 				return nil
@@ -154,9 +156,49 @@ func validateCanEvict(pod *v1.Pod, tooManyRestartsArgs *RemovePodsHavingTooManyR
 		err = fmt.Errorf("number of container restarts (%v) not exceeding the threshold", restarts)
 	}
 
+	if err == nil && tooManyRestartsArgs.CrashLoopBackOffMinDurationSeconds != nil {
+		since := crashLoopBackOffSince(pod, tooManyRestartsArgs.IncludingInitContainers)
+		minDuration := time.Duration(*tooManyRestartsArgs.CrashLoopBackOffMinDurationSeconds) * time.Second
+		if since == nil {
+			err = fmt.Errorf("pod has no container in CrashLoopBackOff")
+		} else if time.Since(since.Time) < minDuration {
+			err = fmt.Errorf("pod is not in CrashLoopBackOff for longer than %v", minDuration)
+		}
+	}
+
 	return err
 }
 
+// crashLoopBackOffSince returns the time the pod is continuously in CrashLoopBackOff since.
+// The kubelet restarts crashing containers with an exponential back-off, so the time the containers
+// of the pod are not ready since is used. It returns nil when no container is in CrashLoopBackOff.
+func crashLoopBackOffSince(pod *v1.Pod, includingInitContainers bool) *metav1.Time {
+	containerStatuses := pod.Status.ContainerStatuses
+	if includingInitContainers {
+		containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+	}
+	crashLooping := false
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == "CrashLoopBackOff" {
+			crashLooping = true
+			break
+		}
+	}
+	if !crashLooping {
+		return nil
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.ContainersReady && condition.Status != v1.ConditionTrue && !condition.LastTransitionTime.IsZero() {
+			return &condition.LastTransitionTime
+		}
+	}
+	if pod.Status.StartTime != nil {
+		return pod.Status.StartTime
+	}
+	return &pod.CreationTimestamp
+}
+
 // calcContainerRestartsFromStatuses get container restarts from container statuses.
 func calcContainerRestartsFromStatuses(statuses []v1.ContainerStatus) int32 {
 	var restarts int32
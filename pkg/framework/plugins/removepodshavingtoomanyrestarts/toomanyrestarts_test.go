@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -81,6 +83,22 @@ func initPods(node *v1.Node) []*v1.Pod {
 	return pods
 }
 
+func setCrashLoopBackOffSince(since time.Time) func([]*v1.Pod) {
+	return func(pods []*v1.Pod) {
+		for _, pod := range pods {
+			if len(pod.Status.ContainerStatuses) == 0 {
+				continue
+			}
+			pod.Status.ContainerStatuses[0].State = v1.ContainerState{
+				Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+			}
+			pod.Status.Conditions = []v1.PodCondition{
+				{Type: v1.ContainersReady, Status: v1.ConditionFalse, LastTransitionTime: metav1.NewTime(since)},
+			}
+		}
+	}
+}
+
 func TestRemovePodsHavingTooManyRestarts(t *testing.T) {
 	node1 := test.BuildTestNode("node1", 2000, 3000, 10, nil)
 	node2 := test.BuildTestNode("node2", 2000, 3000, 10, func(node *v1.Node) {
@@ -111,6 +129,7 @@ func TestRemovePodsHavingTooManyRestarts(t *testing.T) {
 	}
 
 	var uint3 uint = 3
+	var oneHour uint = 3600
 
 	tests := []struct {
 		description                    string
@@ -234,6 +253,26 @@ func TestRemovePodsHavingTooManyRestarts(t *testing.T) {
 				}
 			},
 		},
+		{
+			description:             "pods in CrashLoopBackOff for 2 hours with crashLoopBackOffMinDurationSeconds=1 hour, 6 pod evictions",
+			args:                    RemovePodsHavingTooManyRestartsArgs{PodRestartThreshold: 1, CrashLoopBackOffMinDurationSeconds: &oneHour},
+			nodes:                   []*v1.Node{node1},
+			expectedEvictedPodCount: 6,
+			applyFunc:               setCrashLoopBackOffSince(time.Now().Add(-2 * time.Hour)),
+		},
+		{
+			description:             "pods in CrashLoopBackOff for 10 minutes with crashLoopBackOffMinDurationSeconds=1 hour, 0 pod evictions",
+			args:                    RemovePodsHavingTooManyRestartsArgs{PodRestartThreshold: 1, CrashLoopBackOffMinDurationSeconds: &oneHour},
+			nodes:                   []*v1.Node{node1},
+			expectedEvictedPodCount: 0,
+			applyFunc:               setCrashLoopBackOffSince(time.Now().Add(-10 * time.Minute)),
+		},
+		{
+			description:             "pods without CrashLoopBackOff with crashLoopBackOffMinDurationSeconds=1 hour, 0 pod evictions",
+			args:                    RemovePodsHavingTooManyRestartsArgs{PodRestartThreshold: 1, CrashLoopBackOffMinDurationSeconds: &oneHour},
+			nodes:                   []*v1.Node{node1},
+			expectedEvictedPodCount: 0,
+		},
 		{
 			description:             "pods without CrashLoopBackOff with states=CrashLoopBackOff, 0 pod evictions",
 			args:                    RemovePodsHavingTooManyRestartsArgs{PodRestartThreshold: 1, States: []string{"CrashLoopBackOff"}},
@@ -30,4 +30,7 @@ type RemovePodsHavingTooManyRestartsArgs struct {
 	PodRestartThreshold     int32                 `json:"podRestartThreshold,omitempty"`
 	IncludingInitContainers bool                  `json:"includingInitContainers,omitempty"`
 	States                  []string              `json:"states,omitempty"`
+	// CrashLoopBackOffMinDurationSeconds limits the evicted pods to the pods with a container
+	// in CrashLoopBackOff and no ready containers for longer than the given number of seconds
+	CrashLoopBackOffMinDurationSeconds *uint `json:"crashLoopBackOffMinDurationSeconds,omitempty"`
 }
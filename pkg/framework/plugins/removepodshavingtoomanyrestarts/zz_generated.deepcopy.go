@@ -46,6 +46,11 @@ func (in *RemovePodsHavingTooManyRestartsArgs) DeepCopyInto(out *RemovePodsHavin
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CrashLoopBackOffMinDurationSeconds != nil {
+		in, out := &in.CrashLoopBackOffMinDurationSeconds, &out.CrashLoopBackOffMinDurationSeconds
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
@@ -94,6 +94,7 @@ loop:
 		pods := podsOnANode[node.Name]
 		// sort the evict-able Pods based on priority, if there are multiple pods with same priority, they are sorted based on QoS tiers.
 		podutil.SortPodsBasedOnPriorityLowToHigh(pods)
+		frameworktypes.OrderPodsForEviction(d.handle.Evictor(), pods)
 		totalPods := len(pods)
 		for i := 0; i < totalPods; i++ {
 			if utils.CheckPodsWithAntiAffinityExist(pods[i], podsInANamespace, nodeMap) {
@@ -110,7 +111,7 @@ loop:
 						continue
 					}
 					switch err.(type) {
-					case *evictions.EvictionNodeLimitError:
+					case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 						continue loop
 					case *evictions.EvictionTotalLimitError:
 						return nil
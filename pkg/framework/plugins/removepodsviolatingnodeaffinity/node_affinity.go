@@ -16,9 +16,11 @@ package removepodsviolatingnodeaffinity
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -33,9 +35,12 @@ const PluginName = "RemovePodsViolatingNodeAffinity"
 
 // RemovePodsViolatingNodeAffinity evicts pods on the node which violate node affinity
 type RemovePodsViolatingNodeAffinity struct {
-	handle    frameworktypes.Handle
-	args      *RemovePodsViolatingNodeAffinityArgs
-	podFilter podutil.FilterFunc
+	handle               frameworktypes.Handle
+	args                 *RemovePodsViolatingNodeAffinityArgs
+	podFilter            podutil.FilterFunc
+	violationGracePeriod time.Duration
+	// violated collects the violations seen in the current cycle
+	violated map[violationKey]struct{}
 }
 
 var _ frameworktypes.DeschedulePlugin = &RemovePodsViolatingNodeAffinity{}
@@ -65,9 +70,11 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 	}
 
 	return &RemovePodsViolatingNodeAffinity{
-		handle:    handle,
-		podFilter: podFilter,
-		args:      nodeAffinityArgs,
+		handle:               handle,
+		podFilter:            podFilter,
+		args:                 nodeAffinityArgs,
+		violationGracePeriod: time.Duration(ptr.Deref(nodeAffinityArgs.ViolationGracePeriodSeconds, 0)) * time.Second,
+		violated:             map[violationKey]struct{}{},
 	}, nil
 }
 
@@ -77,6 +84,9 @@ func (d *RemovePodsViolatingNodeAffinity) Name() string {
 }
 
 func (d *RemovePodsViolatingNodeAffinity) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	if d.violationGracePeriod > 0 {
+		defer func() { violations.retain(d.violated) }()
+	}
 	for _, nodeAffinity := range d.args.NodeAffinityType {
 		klog.V(2).InfoS("Executing for nodeAffinityType", "nodeAffinity", nodeAffinity)
 		var err *frameworktypes.Status = nil
@@ -90,9 +100,10 @@ func (d *RemovePodsViolatingNodeAffinity) Deschedule(ctx context.Context, nodes
 			// In this specific case, the pod must also violate the nodeSelector to be evicted
 			filterFunc := func(pod *v1.Pod, node *v1.Node, nodes []*v1.Node) bool {
 				return utils.PodHasNodeAffinity(pod, utils.RequiredDuringSchedulingIgnoredDuringExecution) &&
+					!nodeutil.PodMatchNodeSelector(pod, node) &&
+					d.violationPersisted(nodeAffinity, pod) &&
 					d.handle.Evictor().Filter(pod) &&
-					nodeutil.PodFitsAnyNode(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes) &&
-					!nodeutil.PodMatchNodeSelector(pod, node)
+					nodeutil.PodFitsAnyNode(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes)
 			}
 			err = d.processNodes(ctx, nodes, filterFunc)
 		case "preferredDuringSchedulingIgnoredDuringExecution":
@@ -100,9 +111,10 @@ func (d *RemovePodsViolatingNodeAffinity) Deschedule(ctx context.Context, nodes
 			// in the current one based on the preferred node affinity
 			filterFunc := func(pod *v1.Pod, node *v1.Node, nodes []*v1.Node) bool {
 				return utils.PodHasNodeAffinity(pod, utils.PreferredDuringSchedulingIgnoredDuringExecution) &&
+					(nodeutil.GetBestNodeWeightGivenPodPreferredAffinity(pod, nodes) > nodeutil.GetNodeWeightGivenPodPreferredAffinity(pod, node)) &&
+					d.violationPersisted(nodeAffinity, pod) &&
 					d.handle.Evictor().Filter(pod) &&
-					nodeutil.PodFitsAnyNode(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes) &&
-					(nodeutil.GetBestNodeWeightGivenPodPreferredAffinity(pod, nodes) > nodeutil.GetNodeWeightGivenPodPreferredAffinity(pod, node))
+					nodeutil.PodFitsAnyNode(d.handle.GetPodsAssignedToNodeFunc(), pod, nodes)
 			}
 			err = d.processNodes(ctx, nodes, filterFunc)
 		default:
@@ -116,6 +128,22 @@ func (d *RemovePodsViolatingNodeAffinity) Deschedule(ctx context.Context, nodes
 	return nil
 }
 
+// violationPersisted records the pod violating its node affinity and tells whether the violation
+// lasts for the grace period already. A pod not passing the pod filter in a cycle starts over.
+func (d *RemovePodsViolatingNodeAffinity) violationPersisted(nodeAffinityType string, pod *v1.Pod) bool {
+	if d.violationGracePeriod <= 0 {
+		return true
+	}
+	key := violationKey{nodeAffinityType: nodeAffinityType, uid: pod.UID}
+	d.violated[key] = struct{}{}
+	now := time.Now()
+	if since := violations.observe(key, now); now.Sub(since) < d.violationGracePeriod {
+		klog.V(3).InfoS("Pod violates its node affinity within the grace period", "pod", klog.KObj(pod), "nodeAffinity", nodeAffinityType, "since", since)
+		return false
+	}
+	return true
+}
+
 func (d *RemovePodsViolatingNodeAffinity) processNodes(ctx context.Context, nodes []*v1.Node, filterFunc func(*v1.Pod, *v1.Node, []*v1.Node) bool) *frameworktypes.Status {
 	for _, node := range nodes {
 		klog.V(2).InfoS("Processing node", "node", klog.KObj(node))
@@ -142,7 +170,7 @@ func (d *RemovePodsViolatingNodeAffinity) processNodes(ctx context.Context, node
 				continue
 			}
 			switch err.(type) {
-			case *evictions.EvictionNodeLimitError:
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 				break loop
 			case *evictions.EvictionTotalLimitError:
 				return nil
@@ -19,11 +19,13 @@ package removepodsviolatingnodeaffinity
 import (
 	"context"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
@@ -386,3 +388,63 @@ func TestRemovePodsViolatingNodeAffinity(t *testing.T) {
 		})
 	}
 }
+
+func TestRemovePodsViolatingNodeAffinityViolationGracePeriod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodeWithLabels := test.BuildTestNode("nodeWithLabels", 2000, 3000, 10, nil)
+	nodeWithLabels.Labels["kubernetes.io/desiredNode"] = "yes"
+	nodeWithoutLabels := test.BuildTestNode("nodeWithoutLabels", 2000, 3000, 10, nil)
+
+	pod := test.BuildTestPod("podWithNodeAffinity", 100, 0, nodeWithoutLabels.Name, func(pod *v1.Pod) {
+		pod.ObjectMeta.OwnerReferences = test.GetNormalPodOwnerRefList()
+		pod.Spec.Affinity = &v1.Affinity{
+			NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+					NodeSelectorTerms: []v1.NodeSelectorTerm{{
+						MatchExpressions: []v1.NodeSelectorRequirement{{Key: "kubernetes.io/desiredNode", Operator: "In", Values: []string{"yes"}}},
+					}},
+				},
+			},
+		}
+	})
+	key := violationKey{nodeAffinityType: "requiredDuringSchedulingIgnoredDuringExecution", uid: pod.UID}
+	stale := violationKey{nodeAffinityType: "requiredDuringSchedulingIgnoredDuringExecution", uid: "gone"}
+	violations.observe(stale, time.Now().Add(-time.Hour))
+	defer violations.retain(nil)
+
+	nodes := []*v1.Node{nodeWithoutLabels, nodeWithLabels}
+	deschedule := func() uint {
+		fakeClient := fake.NewSimpleClientset(nodeWithLabels, nodeWithoutLabels, pod)
+		handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, evictions.NewOptions(), defaultevictor.DefaultEvictorArgs{}, nil)
+		if err != nil {
+			t.Fatalf("Unable to initialize a framework handle: %v", err)
+		}
+		plugin, err := New(
+			&RemovePodsViolatingNodeAffinityArgs{
+				NodeAffinityType:            []string{"requiredDuringSchedulingIgnoredDuringExecution"},
+				ViolationGracePeriodSeconds: ptr.To[uint](60),
+			},
+			handle,
+		)
+		if err != nil {
+			t.Fatalf("Unable to initialize the plugin: %v", err)
+		}
+		plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, nodes)
+		return podEvictor.TotalEvicted()
+	}
+
+	if evicted := deschedule(); evicted != 0 {
+		t.Errorf("Expected no pod evicted within the grace period, got %v", evicted)
+	}
+	if _, ok := violations.since[stale]; ok {
+		t.Errorf("Expected the violation of the gone pod to be forgotten")
+	}
+
+	// the violation persists past the grace period
+	violations.since[key] = time.Now().Add(-2 * time.Minute)
+	if evicted := deschedule(); evicted != 1 {
+		t.Errorf("Expected the pod evicted after the grace period, got %v", evicted)
+	}
+}
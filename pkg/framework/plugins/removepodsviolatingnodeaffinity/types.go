@@ -31,4 +31,7 @@ type RemovePodsViolatingNodeAffinityArgs struct {
 	Namespaces       *api.Namespaces       `json:"namespaces,omitempty"`
 	LabelSelector    *metav1.LabelSelector `json:"labelSelector,omitempty"`
 	NodeAffinityType []string              `json:"nodeAffinityType,omitempty"`
+	// ViolationGracePeriodSeconds requires the pods to violate their node affinity for at least the period
+	// before they are evicted, the node labels briefly removed and added back do not evict the pods.
+	ViolationGracePeriodSeconds *uint `json:"violationGracePeriodSeconds,omitempty"`
 }
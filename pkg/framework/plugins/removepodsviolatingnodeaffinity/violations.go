@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodsviolatingnodeaffinity
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// violations remembers since when the pods violate their node affinity. Plugins are built
+// anew for every cycle so the violation state can not be kept in the plugin itself.
+var violations = &violationTracker{since: map[violationKey]time.Time{}}
+
+// violationKey identifies a pod violating a node affinity type
+type violationKey struct {
+	nodeAffinityType string
+	uid              types.UID
+}
+
+// violationTracker keeps the time the pods were first seen violating their node affinity
+// across descheduling cycles.
+type violationTracker struct {
+	mu    sync.Mutex
+	since map[violationKey]time.Time
+}
+
+// observe records the violation seen at the given time and tells since when the violation persists.
+func (t *violationTracker) observe(key violationKey, now time.Time) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	since, ok := t.since[key]
+	if !ok {
+		since = now
+		t.since[key] = since
+	}
+	return since
+}
+
+// retain forgets the violations not seen in the current cycle, the pods either respect
+// their node affinity again or are gone.
+func (t *violationTracker) retain(keys map[violationKey]struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key := range t.since {
+		if _, ok := keys[key]; !ok {
+			delete(t.since, key)
+		}
+	}
+}
@@ -46,6 +46,11 @@ func (in *RemovePodsViolatingNodeAffinityArgs) DeepCopyInto(out *RemovePodsViola
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ViolationGracePeriodSeconds != nil {
+		in, out := &in.ViolationGracePeriodSeconds, &out.ViolationGracePeriodSeconds
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
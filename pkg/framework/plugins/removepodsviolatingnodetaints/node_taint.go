@@ -19,11 +19,13 @@ package removepodsviolatingnodetaints
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
@@ -33,12 +35,24 @@ import (
 
 const PluginName = "RemovePodsViolatingNodeTaints"
 
+// wellKnownStartupTaints are the keys of the taints set on the nodes until they are initialized
+var wellKnownStartupTaints = []string{
+	v1.TaintNodeNotReady,
+	v1.TaintNodeNetworkUnavailable,
+	"node.cloudprovider.kubernetes.io/uninitialized",
+	"node.cluster.x-k8s.io/uninitialized",
+	"karpenter.sh/unregistered",
+}
+
 // RemovePodsViolatingNodeTaints evicts pods on the node which violate NoSchedule Taints on nodes
 type RemovePodsViolatingNodeTaints struct {
 	handle         frameworktypes.Handle
 	args           *RemovePodsViolatingNodeTaintsArgs
 	taintFilterFnc func(taint *v1.Taint) bool
 	podFilter      podutil.FilterFunc
+	// startupTaints are ignored on the nodes younger than the startup taint grace period
+	startupTaints           sets.Set[string]
+	startupTaintGracePeriod time.Duration
 }
 
 var _ frameworktypes.DeschedulePlugin = &RemovePodsViolatingNodeTaints{}
@@ -89,12 +103,17 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 		}
 	}
 
-	return &RemovePodsViolatingNodeTaints{
+	plugin := &RemovePodsViolatingNodeTaints{
 		handle:         handle,
 		podFilter:      podFilter,
 		args:           nodeTaintsArgs,
 		taintFilterFnc: taintFilterFnc,
-	}, nil
+	}
+	if gracePeriod := ptr.Deref(nodeTaintsArgs.StartupTaintGracePeriodSeconds, 0); gracePeriod > 0 {
+		plugin.startupTaints = sets.New(wellKnownStartupTaints...).Insert(nodeTaintsArgs.StartupTaints...)
+		plugin.startupTaintGracePeriod = time.Duration(gracePeriod) * time.Second
+	}
+	return plugin, nil
 }
 
 // Name retrieves the plugin name
@@ -113,13 +132,20 @@ func (d *RemovePodsViolatingNodeTaints) Deschedule(ctx context.Context, nodes []
 				Err: fmt.Errorf("error listing pods on a node: %v", err),
 			}
 		}
+		taintFilterFnc := d.taintFilterFnc
+		if d.inStartupTaintGracePeriod(node) {
+			klog.V(3).InfoS("Ignoring the startup taints of the node being initialized", "node", klog.KObj(node))
+			taintFilterFnc = func(taint *v1.Taint) bool {
+				return !d.startupTaints.Has(taint.Key) && d.taintFilterFnc(taint)
+			}
+		}
 		totalPods := len(pods)
 	loop:
 		for i := 0; i < totalPods; i++ {
 			if !utils.TolerationsTolerateTaintsWithFilter(
 				pods[i].Spec.Tolerations,
 				node.Spec.Taints,
-				d.taintFilterFnc,
+				taintFilterFnc,
 			) {
 				klog.V(2).InfoS("Not all taints with NoSchedule effect are tolerated after update for pod on node", "pod", klog.KObj(pods[i]), "node", klog.KObj(node))
 				err := d.handle.Evictor().Evict(ctx, pods[i], evictions.EvictOptions{StrategyName: PluginName})
@@ -127,7 +153,7 @@ func (d *RemovePodsViolatingNodeTaints) Deschedule(ctx context.Context, nodes []
 					continue
 				}
 				switch err.(type) {
-				case *evictions.EvictionNodeLimitError:
+				case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 					break loop
 				case *evictions.EvictionTotalLimitError:
 					return nil
@@ -140,3 +166,8 @@ func (d *RemovePodsViolatingNodeTaints) Deschedule(ctx context.Context, nodes []
 
 	return nil
 }
+
+// inStartupTaintGracePeriod checks if the node is young enough for its startup taints to be ignored
+func (d *RemovePodsViolatingNodeTaints) inStartupTaintGracePeriod(node *v1.Node) bool {
+	return d.startupTaints != nil && time.Since(node.CreationTimestamp.Time) < d.startupTaintGracePeriod
+}
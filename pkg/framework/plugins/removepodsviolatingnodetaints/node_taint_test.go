@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -174,7 +176,26 @@ func TestDeletePodsViolatingNodeTaints(t *testing.T) {
 	p15 = addTolerationToPod(p15, "testTaint", "test", 1, v1.TaintEffectNoSchedule)
 	p15 = addTolerationToPod(p15, "testingTaint", "testing", 1, v1.TaintEffectNoSchedule)
 
+	// node8 and node10 are being initialized, node9 got stuck in the initialization
+	startupTaint := v1.Taint{Key: "node.cloudprovider.kubernetes.io/uninitialized", Value: "true", Effect: v1.TaintEffectNoSchedule}
+	node8 := test.BuildTestNode("n8", 2000, 3000, 10, func(node *v1.Node) {
+		node.CreationTimestamp = metav1.Now()
+		node.Spec.Taints = []v1.Taint{startupTaint}
+	})
+	node9 := test.BuildTestNode("n9", 2000, 3000, 10, func(node *v1.Node) {
+		node.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		node.Spec.Taints = []v1.Taint{startupTaint}
+	})
+	node10 := test.BuildTestNode("n10", 2000, 3000, 10, func(node *v1.Node) {
+		node.CreationTimestamp = metav1.Now()
+		node.Spec.Taints = []v1.Taint{{Key: "node.cilium.io/agent-not-ready", Effect: v1.TaintEffectNoSchedule}}
+	})
+	p16 := test.BuildTestPod("p16", 100, 0, node8.Name, test.SetNormalOwnerRef)
+	p17 := test.BuildTestPod("p17", 100, 0, node9.Name, test.SetNormalOwnerRef)
+	p18 := test.BuildTestPod("p18", 100, 0, node10.Name, test.SetNormalOwnerRef)
+
 	var uint1, uint2 uint = 1, 2
+	var uint600 uint = 600
 
 	tests := []struct {
 		description                    string
@@ -190,6 +211,8 @@ func TestDeletePodsViolatingNodeTaints(t *testing.T) {
 		includePreferNoSchedule        bool
 		excludedTaints                 []string
 		includedTaints                 []string
+		startupTaintGracePeriodSeconds *uint
+		startupTaints                  []string
 	}{
 		{
 			description:             "Pods not tolerating node taint should be evicted",
@@ -391,6 +414,34 @@ func TestDeletePodsViolatingNodeTaints(t *testing.T) {
 			evictSystemCriticalPods: false,
 			expectedEvictedPodCount: 1, // includedTaints is empty so all taints are included. p15 tolerates both node taints and does not get evicted. p14 tolerate only one and gets evicted
 		},
+		{
+			description:             "Pods not tolerating startup taints are evicted without the grace period",
+			pods:                    []*v1.Pod{p16, p17},
+			nodes:                   []*v1.Node{node8, node9},
+			expectedEvictedPodCount: 2,
+		},
+		{
+			description:                    "Pods not tolerating startup taints are evicted only from the nodes older than the grace period",
+			pods:                           []*v1.Pod{p16, p17},
+			nodes:                          []*v1.Node{node8, node9},
+			startupTaintGracePeriodSeconds: &uint600,
+			expectedEvictedPodCount:        1, // p17 gets evicted
+		},
+		{
+			description:                    "Pods not tolerating not well-known startup taints are evicted during the grace period",
+			pods:                           []*v1.Pod{p18},
+			nodes:                          []*v1.Node{node10},
+			startupTaintGracePeriodSeconds: &uint600,
+			expectedEvictedPodCount:        1,
+		},
+		{
+			description:                    "Pods not tolerating configured startup taints are not evicted during the grace period",
+			pods:                           []*v1.Pod{p18},
+			nodes:                          []*v1.Node{node10},
+			startupTaintGracePeriodSeconds: &uint600,
+			startupTaints:                  []string{"node.cilium.io/agent-not-ready"},
+			expectedEvictedPodCount:        0,
+		},
 	}
 
 	for _, tc := range tests {
@@ -426,9 +477,11 @@ func TestDeletePodsViolatingNodeTaints(t *testing.T) {
 			}
 
 			plugin, err := New(&RemovePodsViolatingNodeTaintsArgs{
-				IncludePreferNoSchedule: tc.includePreferNoSchedule,
-				ExcludedTaints:          tc.excludedTaints,
-				IncludedTaints:          tc.includedTaints,
+				IncludePreferNoSchedule:        tc.includePreferNoSchedule,
+				ExcludedTaints:                 tc.excludedTaints,
+				IncludedTaints:                 tc.includedTaints,
+				StartupTaintGracePeriodSeconds: tc.startupTaintGracePeriodSeconds,
+				StartupTaints:                  tc.startupTaints,
 			},
 				handle,
 			)
@@ -33,4 +33,9 @@ type RemovePodsViolatingNodeTaintsArgs struct {
 	IncludePreferNoSchedule bool                  `json:"includePreferNoSchedule,omitempty"`
 	ExcludedTaints          []string              `json:"excludedTaints,omitempty"`
 	IncludedTaints          []string              `json:"includedTaints,omitempty"`
+	// StartupTaintGracePeriodSeconds ignores the well-known startup taints of the nodes younger than the period,
+	// e.g. of the nodes freshly provisioned by an autoscaler still being initialized
+	StartupTaintGracePeriodSeconds *uint `json:"startupTaintGracePeriodSeconds,omitempty"`
+	// StartupTaints are the keys of the taints ignored during the grace period besides the well-known startup taints
+	StartupTaints []string `json:"startupTaints,omitempty"`
 }
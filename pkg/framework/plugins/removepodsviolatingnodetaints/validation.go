@@ -21,14 +21,16 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 // ValidateRemovePodsViolatingNodeTaintsArgs validates RemovePodsViolatingNodeTaints arguments
 func ValidateRemovePodsViolatingNodeTaintsArgs(obj runtime.Object) error {
 	args := obj.(*RemovePodsViolatingNodeTaintsArgs)
 	// At most one of include/exclude can be set
-	if args.Namespaces != nil && len(args.Namespaces.Include) > 0 && len(args.Namespaces.Exclude) > 0 {
-		return fmt.Errorf("only one of Include/Exclude namespaces can be set")
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
 	}
 
 	if args.LabelSelector != nil {
@@ -41,5 +43,9 @@ func ValidateRemovePodsViolatingNodeTaintsArgs(obj runtime.Object) error {
 		return fmt.Errorf("either includedTaints or excludedTaints can be set, but not both")
 	}
 
+	if len(args.StartupTaints) > 0 && args.StartupTaintGracePeriodSeconds == nil {
+		return fmt.Errorf("startupTaints can be set only together with startupTaintGracePeriodSeconds")
+	}
+
 	return nil
 }
@@ -69,6 +69,13 @@ func TestValidateRemovePodsViolatingNodeTaintsArgs(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			description: "startup taints without grace period, expects errors",
+			args: &RemovePodsViolatingNodeTaintsArgs{
+				StartupTaints: []string{"node.cilium.io/agent-not-ready"},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
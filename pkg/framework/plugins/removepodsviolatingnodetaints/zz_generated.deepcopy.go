@@ -51,6 +51,16 @@ func (in *RemovePodsViolatingNodeTaintsArgs) DeepCopyInto(out *RemovePodsViolati
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartupTaintGracePeriodSeconds != nil {
+		in, out := &in.StartupTaintGracePeriodSeconds, &out.StartupTaintGracePeriodSeconds
+		*out = new(uint)
+		**out = **in
+	}
+	if in.StartupTaints != nil {
+		in, out := &in.StartupTaints, &out.StartupTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -88,6 +88,10 @@ func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plug
 		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
 	}
 
+	if err := handle.RegisterMetric(maxSkew); err != nil {
+		return nil, fmt.Errorf("error registering metrics: %v", err)
+	}
+
 	return &RemovePodsViolatingTopologySpreadConstraint{
 		handle:    handle,
 		podFilter: podFilter,
@@ -102,6 +106,8 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Name() string {
 
 // nolint: gocyclo
 func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	// The pods on the nodes going away are ghosts inflating the size of their domains
+	nodes = node.WithoutGoneNodes(nodes)
 	nodeMap := make(map[string]*v1.Node, len(nodes))
 	for _, node := range nodes {
 		nodeMap[node.Name] = node
@@ -121,10 +127,11 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 
 	klog.V(1).Info("Processing namespaces for topology spread constraints")
 	podsForEviction := make(map[*v1.Pod]struct{})
-	var includedNamespaces, excludedNamespaces sets.Set[string]
-	if d.args.Namespaces != nil {
-		includedNamespaces = sets.New(d.args.Namespaces.Include...)
-		excludedNamespaces = sets.New(d.args.Namespaces.Exclude...)
+	includedNamespaces, excludedNamespaces, err := utils.NewNamespacesPatterns(d.args.Namespaces)
+	if err != nil {
+		return &frameworktypes.Status{
+			Err: fmt.Errorf("error compiling namespaces: %v", err),
+		}
 	}
 
 	pods, err := podutil.ListPodsOnNodes(nodes, d.handle.GetPodsAssignedToNodeFunc(), d.podFilter)
@@ -137,13 +144,14 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 	allowedConstraints := sets.New[v1.UnsatisfiableConstraintAction](d.args.Constraints...)
 
 	namespacedPods := podutil.GroupByNamespace(pods)
+	observedSkews := make(map[skewKey]int)
 
 	// 1. for each namespace...
 	for namespace := range namespacedPods {
 		klog.V(4).InfoS("Processing namespace for topology spread constraints", "namespace", namespace)
 
-		if (len(includedNamespaces) > 0 && !includedNamespaces.Has(namespace)) ||
-			(len(excludedNamespaces) > 0 && excludedNamespaces.Has(namespace)) {
+		if (includedNamespaces.Len() > 0 && !includedNamespaces.Has(namespace)) ||
+			(excludedNamespaces.Len() > 0 && excludedNamespaces.Has(namespace)) {
 			continue
 		}
 
@@ -217,6 +225,10 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 				constraintTopologies[topoPair] = append(constraintTopologies[topoPair], pod)
 				sumPods++
 			}
+			key := skewKey{namespace: namespace, topologyKey: tsc.TopologyKey}
+			if skew := topologySkew(constraintTopologies); skew > observedSkews[key] {
+				observedSkews[key] = skew
+			}
 			if topologyIsBalanced(constraintTopologies, tsc) {
 				klog.V(2).InfoS("Skipping topology constraint because it is already balanced", "constraint", tsc)
 				continue
@@ -225,6 +237,11 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 		}
 	}
 
+	maxSkew.Reset()
+	for key, skew := range observedSkews {
+		maxSkew.WithLabelValues(key.namespace, key.topologyKey).Set(float64(skew))
+	}
+
 	nodeLimitExceeded := map[string]bool{}
 	for pod := range podsForEviction {
 		if nodeLimitExceeded[pod.Spec.NodeName] {
@@ -240,7 +257,7 @@ func (d *RemovePodsViolatingTopologySpreadConstraint) Balance(ctx context.Contex
 				continue
 			}
 			switch err.(type) {
-			case *evictions.EvictionNodeLimitError:
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
 				nodeLimitExceeded[pod.Spec.NodeName] = true
 			case *evictions.EvictionTotalLimitError:
 				return nil
@@ -282,6 +299,20 @@ func topologyIsBalanced(topology map[topologyPair][]*v1.Pod, tsc topologySpreadC
 	return true
 }
 
+// topologySkew returns the difference between the sizes of the largest and the smallest topology domain
+func topologySkew(topology map[topologyPair][]*v1.Pod) int {
+	if len(topology) == 0 {
+		return 0
+	}
+	minDomainSize := math.MaxInt32
+	maxDomainSize := math.MinInt32
+	for _, pods := range topology {
+		minDomainSize = min(minDomainSize, len(pods))
+		maxDomainSize = max(maxDomainSize, len(pods))
+	}
+	return maxDomainSize - minDomainSize
+}
+
 // balanceDomains determines how many pods (minimum) should be evicted from large domains to achieve an ideal balance within maxSkew
 // To actually determine how many pods need to be moved, we sort the topology domains in ascending length
 // [2, 5, 3, 8, 5, 7]
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
+	"k8s.io/component-base/metrics/testutil"
 	utilptr "k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/api"
@@ -69,6 +70,45 @@ func TestTopologySpreadConstraint(t *testing.T) {
 			namespaces:           []string{"ns1"},
 			args:                 RemovePodsViolatingTopologySpreadConstraintArgs{},
 		},
+		{
+			name: "2 domains, sizes [2,0], maxSkew=1, the pods on the unreachable node of zoneB not counted, move 1 pod to achieve [1,1]",
+			nodes: []*v1.Node{
+				test.BuildTestNode("n1", 2000, 3000, 10, func(n *v1.Node) { n.Labels["zone"] = "zoneA" }),
+				test.BuildTestNode("n2", 2000, 3000, 10, func(n *v1.Node) { n.Labels["zone"] = "zoneB" }),
+				test.BuildTestNode("n3", 2000, 3000, 10, func(n *v1.Node) {
+					n.Labels["zone"] = "zoneB"
+					n.Spec.Taints = []v1.Taint{{Key: v1.TaintNodeUnreachable, Effect: v1.TaintEffectNoExecute}}
+				}),
+			},
+			pods: createTestPods([]testPodList{
+				{
+					count:  1,
+					node:   "n1",
+					labels: map[string]string{"foo": "bar"},
+					constraints: []v1.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       "zone",
+							WhenUnsatisfiable: v1.DoNotSchedule,
+							LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+					},
+				},
+				{
+					count:  1,
+					node:   "n1",
+					labels: map[string]string{"foo": "bar"},
+				},
+				{
+					count:  2,
+					node:   "n3",
+					labels: map[string]string{"foo": "bar"},
+				},
+			}),
+			expectedEvictedCount: 1,
+			namespaces:           []string{"ns1"},
+			args:                 RemovePodsViolatingTopologySpreadConstraintArgs{},
+		},
 		{
 			name: "2 domains, sizes [3,1], maxSkew=1, move 1 pod to achieve [2,2]",
 			nodes: []*v1.Node{
@@ -1476,6 +1516,62 @@ func TestTopologySpreadConstraint(t *testing.T) {
 	}
 }
 
+func TestTopologySpreadConstraintMaxSkewMetric(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodes := []*v1.Node{
+		test.BuildTestNode("n1", 2000, 3000, 10, func(n *v1.Node) { n.Labels["zone"] = "zoneA" }),
+		test.BuildTestNode("n2", 2000, 3000, 10, func(n *v1.Node) { n.Labels["zone"] = "zoneB" }),
+	}
+	pods := createTestPods([]testPodList{
+		{
+			count:       4,
+			node:        "n1",
+			labels:      map[string]string{"foo": "bar"},
+			constraints: getDefaultTopologyConstraints(1),
+		},
+		{
+			count:  1,
+			node:   "n2",
+			labels: map[string]string{"foo": "bar"},
+		},
+	})
+
+	var objs []runtime.Object
+	for _, node := range nodes {
+		objs = append(objs, node)
+	}
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	fakeClient := fake.NewSimpleClientset(objs...)
+
+	handle, _, err := frameworktesting.InitFrameworkHandle(ctx, fakeClient, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	args := RemovePodsViolatingTopologySpreadConstraintArgs{}
+	SetDefaults_RemovePodsViolatingTopologySpreadConstraintArgs(&args)
+	// the plugin is built every descheduling cycle, registering the metric again must not fail
+	for i := 0; i < 2; i++ {
+		plugin, err := New(&args, handle)
+		if err != nil {
+			t.Fatalf("Unable to initialize the plugin: %v", err)
+		}
+		plugin.(frameworktypes.BalancePlugin).Balance(ctx, nodes)
+	}
+
+	value, err := testutil.GetGaugeMetricValue(maxSkew.WithLabelValues("ns1", "zone"))
+	if err != nil {
+		t.Fatalf("Unable to read the max skew metric: %v", err)
+	}
+	if value != 3 {
+		t.Errorf("Expected the max skew of 3, got %v", value)
+	}
+}
+
 type testPodList struct {
 	count             int
 	node              string
@@ -24,6 +24,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
 )
 
 // ValidateRemovePodsViolatingTopologySpreadConstraintArgs validates RemovePodsViolatingTopologySpreadConstraint arguments
@@ -32,8 +34,8 @@ func ValidateRemovePodsViolatingTopologySpreadConstraintArgs(obj runtime.Object)
 
 	args := obj.(*RemovePodsViolatingTopologySpreadConstraintArgs)
 	// At most one of include/exclude can be set
-	if args.Namespaces != nil && len(args.Namespaces.Include) > 0 && len(args.Namespaces.Exclude) > 0 {
-		errs = append(errs, fmt.Errorf("only one of Include/Exclude namespaces can be set"))
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		errs = append(errs, err)
 	}
 
 	if args.LabelSelector != nil {
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodswithobsoletenodeselectors
+
+import (
+	"k8s.io/component-base/metrics"
+
+	deschedulermetrics "sigs.k8s.io/descheduler/metrics"
+)
+
+var obsoleteNodeSelectorPods = metrics.NewGaugeVec(
+	&metrics.GaugeOpts{
+		Subsystem:      deschedulermetrics.PluginSubsystem,
+		Name:           "obsolete_node_selector_pods",
+		Help:           "Number of pods whose node selector or required node affinity matches no schedulable node observed in the last run of the plugin, by the namespace, by the owner kind and name",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"namespace", "owner_kind", "owner_name"})
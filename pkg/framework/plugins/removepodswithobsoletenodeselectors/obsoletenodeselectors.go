@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodswithobsoletenodeselectors
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	nodeutil "sigs.k8s.io/descheduler/pkg/descheduler/node"
+	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+const PluginName = "RemovePodsWithObsoleteNodeSelectors"
+
+// RemovePodsWithObsoleteNodeSelectors reports or evicts the pods whose node selector or required node
+// affinity matches no schedulable node, e.g. the pods left behind after a node pool got renamed.
+// The pods keep running on their nodes until the nodes go away, their replacements would stay pending.
+// Evicting the pods surfaces the misconfiguration to the owners before the nodes get drained.
+type RemovePodsWithObsoleteNodeSelectors struct {
+	handle    frameworktypes.Handle
+	args      *RemovePodsWithObsoleteNodeSelectorsArgs
+	podFilter podutil.FilterFunc
+}
+
+var _ frameworktypes.DeschedulePlugin = &RemovePodsWithObsoleteNodeSelectors{}
+
+// New builds plugin from its arguments while passing a handle
+func New(args runtime.Object, handle frameworktypes.Handle) (frameworktypes.Plugin, error) {
+	obsoleteArgs, ok := args.(*RemovePodsWithObsoleteNodeSelectorsArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type RemovePodsWithObsoleteNodeSelectorsArgs, got %T", args)
+	}
+
+	var includedNamespaces, excludedNamespaces sets.Set[string]
+	if obsoleteArgs.Namespaces != nil {
+		includedNamespaces = sets.New(obsoleteArgs.Namespaces.Include...)
+		excludedNamespaces = sets.New(obsoleteArgs.Namespaces.Exclude...)
+	}
+
+	options := podutil.NewOptions().
+		WithNamespaces(includedNamespaces).
+		WithoutNamespaces(excludedNamespaces).
+		WithLabelSelector(obsoleteArgs.LabelSelector)
+	// Reporting does not evict, every pod is reported whether it can be evicted or not
+	if obsoleteArgs.Mode == ModeEvict {
+		// We can combine Filter and PreEvictionFilter since for this strategy it does not matter where we run PreEvictionFilter
+		options = options.WithFilter(podutil.WrapFilterFuncs(handle.Evictor().Filter, handle.Evictor().PreEvictionFilter))
+	}
+	podFilter, err := options.BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	if err := handle.RegisterMetric(obsoleteNodeSelectorPods); err != nil {
+		return nil, fmt.Errorf("error registering metrics: %v", err)
+	}
+
+	return &RemovePodsWithObsoleteNodeSelectors{
+		handle:    handle,
+		args:      obsoleteArgs,
+		podFilter: podFilter,
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (d *RemovePodsWithObsoleteNodeSelectors) Name() string {
+	return PluginName
+}
+
+// Deschedule extension point implementation for the plugin
+func (d *RemovePodsWithObsoleteNodeSelectors) Deschedule(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
+	var schedulableNodes []*v1.Node
+	for _, node := range nodes {
+		if !nodeutil.IsNodeUnschedulable(node) {
+			schedulableNodes = append(schedulableNodes, node)
+		}
+	}
+
+	obsoleteNodeSelectorPods.Reset()
+	evicting := d.args.Mode == ModeEvict
+	for _, node := range nodes {
+		pods, err := podutil.ListPodsOnANode(
+			node.Name,
+			d.handle.GetPodsAssignedToNodeFunc(),
+			podutil.WrapFilterFuncs(d.podFilter, func(pod *v1.Pod) bool {
+				return hasObsoleteNodeSelector(pod, schedulableNodes)
+			}),
+		)
+		if err != nil {
+			return &frameworktypes.Status{
+				Err: fmt.Errorf("error listing pods on a node: %v", err),
+			}
+		}
+
+		for _, pod := range pods {
+			klog.V(1).InfoS("Pod is pinned by a node selector matching no schedulable node", "pod", klog.KObj(pod), "node", klog.KObj(node), "nodeSelector", pod.Spec.NodeSelector)
+			ownerKind, ownerName := "", ""
+			if ownerRefs := podutil.OwnerRef(pod); len(ownerRefs) > 0 {
+				ownerKind, ownerName = ownerRefs[0].Kind, ownerRefs[0].Name
+			}
+			obsoleteNodeSelectorPods.WithLabelValues(pod.Namespace, ownerKind, ownerName).Inc()
+
+			if !evicting {
+				continue
+			}
+			err := d.handle.Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: PluginName})
+			if err == nil {
+				continue
+			}
+			switch err.(type) {
+			case *evictions.EvictionNodeLimitError, *evictions.EvictionNodeCooldownError:
+				// Keep reporting the remaining pods of the node
+			case *evictions.EvictionTotalLimitError:
+				evicting = false
+			default:
+				klog.Errorf("eviction failed: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// hasObsoleteNodeSelector tells whether the pod selects its nodes by the node selector or the required
+// node affinity and none of the schedulable nodes matches them. DaemonSet pods are pinned to their nodes
+// by design and never considered.
+func hasObsoleteNodeSelector(pod *v1.Pod, schedulableNodes []*v1.Node) bool {
+	if len(pod.Spec.NodeSelector) == 0 && !utils.PodHasNodeAffinity(pod, utils.RequiredDuringSchedulingIgnoredDuringExecution) {
+		return false
+	}
+	if utils.IsDaemonsetPod(podutil.OwnerRef(pod)) {
+		return false
+	}
+	requiredNodeAffinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+	for _, node := range schedulableNodes {
+		if match, _ := requiredNodeAffinity.Match(node); match {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodswithobsoletenodeselectors
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/component-base/metrics/testutil"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
+	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
+	"sigs.k8s.io/descheduler/test"
+)
+
+func TestRemovePodsWithObsoleteNodeSelectors(t *testing.T) {
+	withPool := func(pool string) func(*v1.Node) {
+		return func(node *v1.Node) {
+			node.Labels = map[string]string{"pool": pool}
+		}
+	}
+	// The "old" pool got renamed, the "drained" pool is cordoned
+	n1 := test.BuildTestNode("n1", 2000, 3000, 10, withPool("new"))
+	n2 := test.BuildTestNode("n2", 2000, 3000, 10, withPool("new"))
+	n3 := test.BuildTestNode("n3", 2000, 3000, 10, func(node *v1.Node) {
+		withPool("drained")(node)
+		node.Spec.Unschedulable = true
+	})
+
+	buildPod := func(name, nodeName string, apply func(*v1.Pod)) *v1.Pod {
+		return test.BuildTestPod(name, 100, 0, nodeName, func(pod *v1.Pod) {
+			test.SetRSOwnerRef(pod)
+			if apply != nil {
+				apply(pod)
+			}
+		})
+	}
+	withNodeSelector := func(pool string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Spec.NodeSelector = map[string]string{"pool": pool}
+		}
+	}
+	withRequiredAffinity := func(pools ...string) func(*v1.Pod) {
+		return func(pod *v1.Pod) {
+			pod.Spec.Affinity = &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{{
+							MatchExpressions: []v1.NodeSelectorRequirement{{Key: "pool", Operator: v1.NodeSelectorOpIn, Values: pools}},
+						}},
+					},
+				},
+			}
+		}
+	}
+	pods := func() []*v1.Pod {
+		return []*v1.Pod{
+			buildPod("selector-obsolete", n1.Name, withNodeSelector("old")),
+			buildPod("selector-current", n1.Name, withNodeSelector("new")),
+			buildPod("affinity-obsolete", n2.Name, withRequiredAffinity("old", "legacy")),
+			buildPod("affinity-current", n2.Name, withRequiredAffinity("old", "new")),
+			buildPod("selector-cordoned", n3.Name, withNodeSelector("drained")),
+			buildPod("unpinned", n3.Name, nil),
+			buildPod("daemon", n1.Name, func(pod *v1.Pod) {
+				withNodeSelector("old")(pod)
+				test.SetDSOwnerRef(pod)
+			}),
+		}
+	}
+
+	tests := []struct {
+		description     string
+		args            RemovePodsWithObsoleteNodeSelectorsArgs
+		expectedEvicted []string
+		expectedPods    float64
+	}{
+		{
+			description:  "pods reported only",
+			args:         RemovePodsWithObsoleteNodeSelectorsArgs{Mode: ModeReport},
+			expectedPods: 3,
+		},
+		{
+			description:     "pods matching no schedulable node evicted",
+			args:            RemovePodsWithObsoleteNodeSelectorsArgs{Mode: ModeEvict},
+			expectedEvicted: []string{"selector-obsolete", "affinity-obsolete", "selector-cordoned"},
+			expectedPods:    3,
+		},
+		{
+			description:     "namespaces excluded",
+			args:            RemovePodsWithObsoleteNodeSelectorsArgs{Mode: ModeEvict, Namespaces: &api.Namespaces{Exclude: []string{"default"}}},
+			expectedEvicted: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			objs := []runtime.Object{n1, n2, n3}
+			for _, pod := range pods() {
+				objs = append(objs, pod)
+			}
+			fakeClient := fake.NewSimpleClientset(objs...)
+
+			evicted := sets.New[string]()
+			fakeClient.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() == "eviction" {
+					evicted.Insert(action.(core.CreateAction).GetObject().(metav1.Object).GetName())
+				}
+				return false, nil, nil
+			})
+
+			handle, _, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				fakeClient,
+				evictions.NewOptions(),
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			plugin, err := New(&tc.args, handle)
+			if err != nil {
+				t.Fatalf("Unable to initialize the plugin: %v", err)
+			}
+
+			plugin.(frameworktypes.DeschedulePlugin).Deschedule(ctx, []*v1.Node{n1, n2, n3})
+			if !evicted.Equal(sets.New(tc.expectedEvicted...)) {
+				t.Errorf("Expected %v pods evicted, got %v", tc.expectedEvicted, sets.List(evicted))
+			}
+
+			value, err := testutil.GetGaugeMetricValue(obsoleteNodeSelectorPods.WithLabelValues("default", "ReplicaSet", "replicaset-1"))
+			if err != nil {
+				t.Fatalf("Unable to read the obsolete node selector pods metric: %v", err)
+			}
+			if value != tc.expectedPods {
+				t.Errorf("Expected %v pods reported, got %v", tc.expectedPods, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodswithobsoletenodeselectors
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// Mode tells what the plugin does with the pods pinned by obsolete node selectors
+type Mode string
+
+const (
+	// ModeReport logs the pods and exposes them through the plugin metrics
+	ModeReport Mode = "Report"
+	// ModeEvict evicts the pods on top of reporting them
+	ModeEvict Mode = "Evict"
+)
+
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RemovePodsWithObsoleteNodeSelectorsArgs holds arguments used to configure RemovePodsWithObsoleteNodeSelectors plugin.
+type RemovePodsWithObsoleteNodeSelectorsArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Namespaces    *api.Namespaces       `json:"namespaces,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Mode is either Report or Evict, defaults to Report.
+	Mode Mode `json:"mode,omitempty"`
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removepodswithobsoletenodeselectors
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/descheduler/pkg/utils"
+)
+
+// ValidateRemovePodsWithObsoleteNodeSelectorsArgs validates RemovePodsWithObsoleteNodeSelectors arguments
+func ValidateRemovePodsWithObsoleteNodeSelectorsArgs(obj runtime.Object) error {
+	args := obj.(*RemovePodsWithObsoleteNodeSelectorsArgs)
+	// At most one of include/exclude can be set
+	if err := utils.ValidateNamespaces(args.Namespaces); err != nil {
+		return err
+	}
+	if args.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(args.LabelSelector); err != nil {
+			return fmt.Errorf("failed to get label selectors from strategy's params: %+v", err)
+		}
+	}
+	if args.Mode != ModeReport && args.Mode != ModeEvict {
+		return fmt.Errorf("mode must be one of %q or %q, got %q", ModeReport, ModeEvict, args.Mode)
+	}
+	return nil
+}
@@ -15,7 +15,11 @@ package profile
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sync"
 	"time"
 
 	promapi "github.com/prometheus/client_golang/api"
@@ -23,10 +27,11 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/util/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
+	componentbasemetrics "k8s.io/component-base/metrics"
 	"k8s.io/klog/v2"
 
 	"sigs.k8s.io/descheduler/metrics"
@@ -43,27 +48,184 @@ import (
 // can evict a pod without importing a specific pod evictor
 type evictorImpl struct {
 	profileName       string
+	pluginName        string
 	podEvictor        *evictions.PodEvictor
 	filter            podutil.FilterFunc
 	preEvictionFilter podutil.FilterFunc
+	// reportOnly checks if a pod is passed to the plugin for reporting purposes only
+	reportOnly podutil.FilterFunc
+	// pluginFilter checks if a pod can be evicted by the plugin
+	pluginFilter podutil.FilterFunc
+	// podEvicted notifies the evictor plugins a pod was evicted by the plugin
+	podEvicted func(pod *v1.Pod)
+	// preEvictionHook prepares a pod for the eviction
+	preEvictionHook func(ctx context.Context, pod *v1.Pod) error
+	// preEvictionRevert reverts the preparation of a pod not evicted after all
+	preEvictionRevert func(ctx context.Context, pod *v1.Pod)
+	// softEviction requests the disruption of a pod instead of its eviction
+	softEviction func(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error)
+	// client the evictions are requested with, the client of the pod evictor when nil
+	client clientset.Interface
+	// filterReasons and preEvictionFilterReasons explain why the filters reject a pod
+	filterReasons            func(pod *v1.Pod) []string
+	preEvictionFilterReasons func(pod *v1.Pod) []string
+	// maxPodsToEvictPerNode and maxPodsToEvictPerCycle limit the evictions of the plugin, the evictor
+	// is built every descheduling cycle along with the profile so are the counts
+	maxPodsToEvictPerNode  *uint
+	maxPodsToEvictPerCycle *uint
+	mu                     sync.Mutex
+	nodePodCount           map[string]uint
+	podCount               uint
 }
 
-var _ frameworktypes.Evictor = &evictorImpl{}
+var (
+	_ frameworktypes.GroupEvictor    = &evictorImpl{}
+	_ frameworktypes.EvictionOrderer = &evictorImpl{}
+)
 
 // Filter checks if a pod can be evicted
 func (ei *evictorImpl) Filter(pod *v1.Pod) bool {
-	return ei.filter(pod)
+	if (ei.filter(pod) && (ei.pluginFilter == nil || ei.pluginFilter(pod))) || (ei.reportOnly != nil && ei.reportOnly(pod)) {
+		return true
+	}
+	ei.explainSkip(pod, ei.filterReasons)
+	return false
 }
 
 // PreEvictionFilter checks if pod can be evicted right before eviction
 func (ei *evictorImpl) PreEvictionFilter(pod *v1.Pod) bool {
-	return ei.preEvictionFilter(pod)
+	if ei.preEvictionFilter(pod) {
+		return true
+	}
+	ei.explainSkip(pod, ei.preEvictionFilterReasons)
+	return false
+}
+
+// OrderPodsForEviction reorders the pods picked for eviction as configured by the policy
+func (ei *evictorImpl) OrderPodsForEviction(pods []*v1.Pod) {
+	ei.podEvictor.OrderPodsForEviction(pods)
+}
+
+// explainSkip records the reasons the pod is skipped when the skips of the pod are explained
+func (ei *evictorImpl) explainSkip(pod *v1.Pod, reasons func(pod *v1.Pod) []string) {
+	if reasons == nil || !ei.podEvictor.ExplainsSkips(pod) {
+		return
+	}
+	ei.podEvictor.RecordSkip(pod, ei.pluginName, reasons(pod)...)
 }
 
-// Evict evicts a pod (no pre-check performed)
+// Evict evicts a pod (no pre-check performed except refusing pods passed for reporting purposes only
+// and pods the plugin is not allowed to evict)
 func (ei *evictorImpl) Evict(ctx context.Context, pod *v1.Pod, opts evictions.EvictOptions) error {
+	if ei.reportOnly != nil && ei.reportOnly(pod) {
+		return fmt.Errorf("pod %v is passed to %q for reporting only and cannot be evicted", klog.KObj(pod), opts.StrategyName)
+	}
+	if ei.pluginFilter != nil && !ei.pluginFilter(pod) {
+		// the evictions earlier in the cycle may have used up what the evictor plugins allow
+		ei.explainSkip(pod, ei.filterReasons)
+		return fmt.Errorf("pod %v cannot be evicted by %q", klog.KObj(pod), opts.StrategyName)
+	}
+	if err := ei.checkLimits([]*v1.Pod{pod}); err != nil {
+		return err
+	}
+	opts.ProfileName = ei.profileName
+	opts.PreEvictionHook = ei.preEvictionHook
+	opts.PreEvictionRevert = ei.preEvictionRevert
+	opts.SoftEviction = ei.softEviction
+	opts.Client = ei.client
+	opts.GroupFilter = ei.groupFilter
+	if err := ei.podEvictor.EvictPod(ctx, pod, opts); err != nil {
+		return err
+	}
+	ei.countEvictions([]*v1.Pod{pod})
+	if ei.podEvicted != nil {
+		ei.podEvicted(pod)
+	}
+	return nil
+}
+
+// checkLimits checks the pods can be evicted within the eviction limits of the plugin. The limits
+// are reported as the limits of the pod evictor so the plugins stop evicting from the node, respectively
+// stop evicting at all.
+func (ei *evictorImpl) checkLimits(pods []*v1.Pod) error {
+	if ei.maxPodsToEvictPerNode == nil && ei.maxPodsToEvictPerCycle == nil {
+		return nil
+	}
+	ei.mu.Lock()
+	defer ei.mu.Unlock()
+	if ei.maxPodsToEvictPerCycle != nil && ei.podCount+uint(len(pods)) > *ei.maxPodsToEvictPerCycle {
+		klog.V(2).InfoS("Plugin eviction limit reached, skipping pod eviction", "pod", klog.KObj(pods[0]), "plugin", ei.pluginName, "profile", ei.profileName, "limit", *ei.maxPodsToEvictPerCycle)
+		return evictions.NewEvictionTotalLimitError()
+	}
+	if ei.maxPodsToEvictPerNode != nil {
+		perNode := make(map[string]uint)
+		for _, pod := range pods {
+			perNode[pod.Spec.NodeName]++
+		}
+		for node, count := range perNode {
+			if node != "" && ei.nodePodCount[node]+count > *ei.maxPodsToEvictPerNode {
+				klog.V(2).InfoS("Plugin node eviction limit reached, skipping pod eviction", "pod", klog.KObj(pods[0]), "node", node, "plugin", ei.pluginName, "profile", ei.profileName, "limit", *ei.maxPodsToEvictPerNode)
+				return evictions.NewEvictionNodeLimitError(node)
+			}
+		}
+	}
+	return nil
+}
+
+// countEvictions counts the evicted pods against the eviction limits of the plugin
+func (ei *evictorImpl) countEvictions(pods []*v1.Pod) {
+	if ei.maxPodsToEvictPerNode == nil && ei.maxPodsToEvictPerCycle == nil {
+		return
+	}
+	ei.mu.Lock()
+	defer ei.mu.Unlock()
+	for _, pod := range pods {
+		ei.podCount++
+		if pod.Spec.NodeName != "" {
+			ei.nodePodCount[pod.Spec.NodeName]++
+		}
+	}
+}
+
+// groupFilter checks a pod evicted along with another pod can be evicted by the plugin and passes
+// the filters of the profile
+func (ei *evictorImpl) groupFilter(pod *v1.Pod) bool {
+	if ei.reportOnly != nil && ei.reportOnly(pod) {
+		return false
+	}
+	return ei.filter(pod) && (ei.pluginFilter == nil || ei.pluginFilter(pod)) && ei.preEvictionFilter(pod)
+}
+
+// EvictGroup evicts the pods of a single owner as a group, none of the pods is evicted unless all of them
+// can be evicted by the plugin and pass the pre-eviction filters and the eviction limits
+func (ei *evictorImpl) EvictGroup(ctx context.Context, pods []*v1.Pod, opts evictions.EvictOptions, pacing time.Duration) (int, error) {
+	for _, pod := range pods {
+		if ei.reportOnly != nil && ei.reportOnly(pod) {
+			return 0, fmt.Errorf("pod %v is passed to %q for reporting only and cannot be evicted", klog.KObj(pod), opts.StrategyName)
+		}
+		if ei.pluginFilter != nil && !ei.pluginFilter(pod) {
+			return 0, fmt.Errorf("pod %v cannot be evicted by %q", klog.KObj(pod), opts.StrategyName)
+		}
+		if !ei.PreEvictionFilter(pod) {
+			return 0, fmt.Errorf("pod %v of the group does not pass the pre-eviction filters", klog.KObj(pod))
+		}
+	}
+	if err := ei.checkLimits(pods); err != nil {
+		return 0, err
+	}
 	opts.ProfileName = ei.profileName
-	return ei.podEvictor.EvictPod(ctx, pod, opts)
+	opts.PreEvictionHook = ei.preEvictionHook
+	opts.PreEvictionRevert = ei.preEvictionRevert
+	opts.SoftEviction = ei.softEviction
+	opts.Client = ei.client
+	evicted, err := ei.podEvictor.EvictGroup(ctx, pods, opts, pacing)
+	ei.countEvictions(pods[:evicted])
+	if ei.podEvicted != nil {
+		for _, pod := range pods[:evicted] {
+			ei.podEvicted(pod)
+		}
+	}
+	return evicted, err
 }
 
 // handleImpl implements the framework handle which gets passed to plugins
@@ -74,6 +236,8 @@ type handleImpl struct {
 	getPodsAssignedToNodeFunc podutil.GetPodsAssignedToNodeFunc
 	sharedInformerFactory     informers.SharedInformerFactory
 	evictor                   *evictorImpl
+	evictableCapacity         *evictions.EvictableCapacityCache
+	rand                      *rand.Rand
 }
 
 var _ frameworktypes.Handle = &handleImpl{}
@@ -87,7 +251,10 @@ func (hi *handleImpl) PrometheusClient() promapi.Client {
 	return hi.prometheusClient
 }
 
-func (hi *handleImpl) MetricsCollector() *metricscollector.MetricsCollector {
+func (hi *handleImpl) MetricsCollector() frameworktypes.MetricsCollector {
+	if hi.metricsCollector == nil {
+		return nil
+	}
 	return hi.metricsCollector
 }
 
@@ -106,6 +273,34 @@ func (hi *handleImpl) Evictor() frameworktypes.Evictor {
 	return hi.evictor
 }
 
+// RecentEvictions retrieves the pods evicted in the recent descheduling cycles
+func (hi *handleImpl) RecentEvictions() frameworktypes.RecentEvictions {
+	return hi.evictor.podEvictor.RecentEvictions()
+}
+
+// RegisterMetric registers a Prometheus collector of the plugin
+func (hi *handleImpl) RegisterMetric(metric componentbasemetrics.Registerable) error {
+	return metrics.RegisterPluginMetric(metric)
+}
+
+// Rand retrieves the source of the randomized choices of the plugin
+func (hi *handleImpl) Rand() *rand.Rand {
+	return hi.rand
+}
+
+// EvictableCapacity retrieves the pods of the node the plugin can evict and their aggregate requests
+func (hi *handleImpl) EvictableCapacity(node *v1.Node) (*frameworktypes.NodeEvictableCapacity, error) {
+	return hi.evictableCapacity.Get(node)
+}
+
+// pluginRandomSeed derives the seed of a plugin from the seed of the cycle so the
+// randomized choices of a plugin do not depend on the choices of the other plugins
+func pluginRandomSeed(seed int64, profileName, pluginName string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(profileName + "/" + pluginName))
+	return seed ^ int64(h.Sum64())
+}
+
 type filterPlugin interface {
 	frameworktypes.Plugin
 	Filter(pod *v1.Pod) bool
@@ -124,6 +319,7 @@ type profileImpl struct {
 	balancePlugins           []frameworktypes.BalancePlugin
 	filterPlugins            []filterPlugin
 	preEvictionFilterPlugins []preEvictionFilterPlugin
+	cyclePlugins             []frameworktypes.CycleEvictorPlugin
 
 	// Each extension point with a list of plugins implementing the extension point.
 	deschedule        sets.Set[string]
@@ -141,7 +337,9 @@ type handleImplOpts struct {
 	sharedInformerFactory     informers.SharedInformerFactory
 	getPodsAssignedToNodeFunc podutil.GetPodsAssignedToNodeFunc
 	podEvictor                *evictions.PodEvictor
+	evictionClient            clientset.Interface
 	metricsCollector          *metricscollector.MetricsCollector
+	randomSeed                int64
 }
 
 // WithClientSet sets clientSet for the scheduling frameworkImpl.
@@ -170,12 +368,26 @@ func WithPodEvictor(podEvictor *evictions.PodEvictor) Option {
 	}
 }
 
+// WithEvictionClient sets the client the evictions of the profile are requested with
+func WithEvictionClient(client clientset.Interface) Option {
+	return func(o *handleImplOpts) {
+		o.evictionClient = client
+	}
+}
+
 func WithGetPodsAssignedToNodeFnc(getPodsAssignedToNodeFunc podutil.GetPodsAssignedToNodeFunc) Option {
 	return func(o *handleImplOpts) {
 		o.getPodsAssignedToNodeFunc = getPodsAssignedToNodeFunc
 	}
 }
 
+// WithRandomSeed sets the seed of the descheduling cycle the randomized choices of the plugins are seeded from
+func WithRandomSeed(seed int64) Option {
+	return func(o *handleImplOpts) {
+		o.randomSeed = seed
+	}
+}
+
 func WithMetricsCollector(metricsCollector *metricscollector.MetricsCollector) Option {
 	return func(o *handleImplOpts) {
 		o.metricsCollector = metricsCollector
@@ -272,24 +484,36 @@ func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts
 		return nil, fmt.Errorf("profile %q configures preEvictionFilter extension point of non-existing plugins: %v", config.Name, sets.New(config.Plugins.PreEvictionFilter.Enabled...).Difference(pi.preEvictionFilter))
 	}
 
-	handle := &handleImpl{
-		clientSet:                 hOpts.clientSet,
-		getPodsAssignedToNodeFunc: hOpts.getPodsAssignedToNodeFunc,
-		sharedInformerFactory:     hOpts.sharedInformerFactory,
-		evictor: &evictorImpl{
-			profileName: config.Name,
-			podEvictor:  hOpts.podEvictor,
-		},
-		metricsCollector: hOpts.metricsCollector,
-		prometheusClient: hOpts.prometheusClient,
-	}
-
 	pluginNames := append(config.Plugins.Deschedule.Enabled, config.Plugins.Balance.Enabled...)
 	pluginNames = append(pluginNames, config.Plugins.Filter.Enabled...)
 	pluginNames = append(pluginNames, config.Plugins.PreEvictionFilter.Enabled...)
 
 	plugins := make(map[string]frameworktypes.Plugin)
+	// Every plugin gets its own evictor so the evictor plugins can filter pods per plugin
+	evictors := make(map[string]*evictorImpl)
 	for _, plugin := range sets.New(pluginNames...).UnsortedList() {
+		handle := &handleImpl{
+			clientSet:                 hOpts.clientSet,
+			getPodsAssignedToNodeFunc: hOpts.getPodsAssignedToNodeFunc,
+			sharedInformerFactory:     hOpts.sharedInformerFactory,
+			evictor: &evictorImpl{
+				profileName: config.Name,
+				pluginName:  plugin,
+				podEvictor:  hOpts.podEvictor,
+				client:      hOpts.evictionClient,
+			},
+			metricsCollector: hOpts.metricsCollector,
+			prometheusClient: hOpts.prometheusClient,
+			rand:             rand.New(rand.NewSource(pluginRandomSeed(hOpts.randomSeed, config.Name, plugin))),
+		}
+		if pc, _ := getPluginConfig(plugin, config.PluginConfigs); pc != nil {
+			handle.evictor.maxPodsToEvictPerNode = pc.MaxPodsToEvictPerNode
+			handle.evictor.maxPodsToEvictPerCycle = pc.MaxPodsToEvictPerCycle
+			handle.evictor.nodePodCount = make(map[string]uint)
+		}
+		// the profile is built every descheduling cycle, so is the cache
+		handle.evictableCapacity = evictions.NewEvictableCapacityCache(hOpts.getPodsAssignedToNodeFunc, handle.evictor)
+		evictors[plugin] = handle.evictor
 		pg, err := buildPlugin(config, plugin, handle, reg)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build %v plugin: %v", plugin, err)
@@ -311,23 +535,171 @@ func NewProfile(config api.DeschedulerProfile, reg pluginregistry.Registry, opts
 	}
 
 	filters := []podutil.FilterFunc{}
+	reportingPlugins := []frameworktypes.ReportingEvictorPlugin{}
+	pluginFilterPlugins := []frameworktypes.PluginFilterEvictorPlugin{}
+	filterExplainingPlugins := []frameworktypes.ExplainingEvictorPlugin{}
 	for _, pluginName := range config.Plugins.Filter.Enabled {
 		pi.filterPlugins = append(pi.filterPlugins, plugins[pluginName].(filterPlugin))
 		filters = append(filters, plugins[pluginName].(filterPlugin).Filter)
+		if explainingPlugin, ok := plugins[pluginName].(frameworktypes.ExplainingEvictorPlugin); ok {
+			filterExplainingPlugins = append(filterExplainingPlugins, explainingPlugin)
+		}
+		if reportingPlugin, ok := plugins[pluginName].(frameworktypes.ReportingEvictorPlugin); ok {
+			reportingPlugins = append(reportingPlugins, reportingPlugin)
+		}
+		if pluginFilterPlugin, ok := plugins[pluginName].(frameworktypes.PluginFilterEvictorPlugin); ok {
+			pluginFilterPlugins = append(pluginFilterPlugins, pluginFilterPlugin)
+		}
 	}
 
 	preEvictionFilters := []podutil.FilterFunc{}
+	preEvictionHookPlugins := []frameworktypes.PreEvictionHookEvictorPlugin{}
+	softEvictorPlugins := []frameworktypes.SoftEvictorPlugin{}
+	preEvictionFilterExplainingPlugins := []frameworktypes.ExplainingEvictorPlugin{}
 	for _, pluginName := range config.Plugins.PreEvictionFilter.Enabled {
 		pi.preEvictionFilterPlugins = append(pi.preEvictionFilterPlugins, plugins[pluginName].(preEvictionFilterPlugin))
 		preEvictionFilters = append(preEvictionFilters, plugins[pluginName].(preEvictionFilterPlugin).PreEvictionFilter)
+		if explainingPlugin, ok := plugins[pluginName].(frameworktypes.ExplainingEvictorPlugin); ok {
+			preEvictionFilterExplainingPlugins = append(preEvictionFilterExplainingPlugins, explainingPlugin)
+		}
+		if preEvictionHookPlugin, ok := plugins[pluginName].(frameworktypes.PreEvictionHookEvictorPlugin); ok {
+			preEvictionHookPlugins = append(preEvictionHookPlugins, preEvictionHookPlugin)
+		}
+		if softEvictorPlugin, ok := plugins[pluginName].(frameworktypes.SoftEvictorPlugin); ok {
+			softEvictorPlugins = append(softEvictorPlugins, softEvictorPlugin)
+		}
+	}
+	preEvictionHook, preEvictionRevert := preEvictionHooks(preEvictionHookPlugins)
+	// an evictor plugin enabled in both the extension points is notified once
+	for _, pluginName := range sets.List(sets.New(config.Plugins.Filter.Enabled...).Insert(config.Plugins.PreEvictionFilter.Enabled...)) {
+		if cyclePlugin, ok := plugins[pluginName].(frameworktypes.CycleEvictorPlugin); ok {
+			pi.cyclePlugins = append(pi.cyclePlugins, cyclePlugin)
+		}
 	}
+	softEviction := softEvictions(softEvictorPlugins)
 
-	handle.evictor.filter = podutil.WrapFilterFuncs(filters...)
-	handle.evictor.preEvictionFilter = podutil.WrapFilterFuncs(preEvictionFilters...)
+	for pluginName, evictor := range evictors {
+		evictor.filter = podutil.WrapFilterFuncs(filters...)
+		evictor.preEvictionFilter = podutil.WrapFilterFuncs(preEvictionFilters...)
+		evictor.reportOnly = reportOnlyFilter(pluginName, reportingPlugins)
+		evictor.pluginFilter, evictor.podEvicted = pluginFilter(pluginName, pluginFilterPlugins)
+		evictor.preEvictionHook = preEvictionHook
+		evictor.preEvictionRevert = preEvictionRevert
+		evictor.softEviction = softEviction
+		evictor.filterReasons = filterReasons(filterExplainingPlugins, frameworktypes.ExplainingEvictorPlugin.FilterReasons)
+		evictor.preEvictionFilterReasons = filterReasons(preEvictionFilterExplainingPlugins, frameworktypes.ExplainingEvictorPlugin.PreEvictionFilterReasons)
+	}
 
 	return pi, nil
 }
 
+// reportOnlyFilter checks if any of the evictor plugins passes a pod to the plugin for reporting purposes only
+func reportOnlyFilter(pluginName string, reportingPlugins []frameworktypes.ReportingEvictorPlugin) podutil.FilterFunc {
+	if len(reportingPlugins) == 0 {
+		return nil
+	}
+	return func(pod *v1.Pod) bool {
+		for _, reportingPlugin := range reportingPlugins {
+			if reportingPlugin.ReportOnly(pluginName, pod) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// pluginFilter checks if all the evictor plugins allow the plugin to evict a pod and notifies them about the evictions
+func pluginFilter(pluginName string, pluginFilterPlugins []frameworktypes.PluginFilterEvictorPlugin) (podutil.FilterFunc, func(*v1.Pod)) {
+	if len(pluginFilterPlugins) == 0 {
+		return nil, nil
+	}
+	filter := func(pod *v1.Pod) bool {
+		for _, pluginFilterPlugin := range pluginFilterPlugins {
+			if !pluginFilterPlugin.FilterForPlugin(pluginName, pod) {
+				return false
+			}
+		}
+		return true
+	}
+	podEvicted := func(pod *v1.Pod) {
+		for _, pluginFilterPlugin := range pluginFilterPlugins {
+			pluginFilterPlugin.PodEvicted(pluginName, pod)
+		}
+	}
+	return filter, podEvicted
+}
+
+// filterReasons collects the reasons all the explaining evictor plugins reject a pod for
+func filterReasons(explainingPlugins []frameworktypes.ExplainingEvictorPlugin, reasons func(frameworktypes.ExplainingEvictorPlugin, *v1.Pod) []string) func(*v1.Pod) []string {
+	if len(explainingPlugins) == 0 {
+		return nil
+	}
+	return func(pod *v1.Pod) []string {
+		var all []string
+		for _, explainingPlugin := range explainingPlugins {
+			all = append(all, reasons(explainingPlugin, pod)...)
+		}
+		return all
+	}
+}
+
+// preEvictionHooks invokes the pre-eviction hooks of the evictor plugins in order, stopping at the first failure.
+// The preparations of the hooks already invoked are reverted on a failure, the returned revert reverts all of them.
+func preEvictionHooks(preEvictionHookPlugins []frameworktypes.PreEvictionHookEvictorPlugin) (func(context.Context, *v1.Pod) error, func(context.Context, *v1.Pod)) {
+	if len(preEvictionHookPlugins) == 0 {
+		return nil, nil
+	}
+	revert := func(ctx context.Context, pod *v1.Pod, preEvictionHookPlugins []frameworktypes.PreEvictionHookEvictorPlugin) {
+		for i := len(preEvictionHookPlugins) - 1; i >= 0; i-- {
+			revertPlugin, ok := preEvictionHookPlugins[i].(frameworktypes.PreEvictionRevertEvictorPlugin)
+			if !ok {
+				continue
+			}
+			if err := revertPlugin.RevertPreEviction(ctx, pod); err != nil {
+				klog.ErrorS(err, "Unable to revert the preparation of the pod not evicted", "pod", klog.KObj(pod), "plugin", revertPlugin.Name())
+			}
+		}
+	}
+	hook := func(ctx context.Context, pod *v1.Pod) error {
+		for i, preEvictionHookPlugin := range preEvictionHookPlugins {
+			if err := preEvictionHookPlugin.PreEviction(ctx, pod); err != nil {
+				revert(ctx, pod, preEvictionHookPlugins[:i])
+				return fmt.Errorf("%s: %v", preEvictionHookPlugin.Name(), err)
+			}
+		}
+		return nil
+	}
+	return hook, func(ctx context.Context, pod *v1.Pod) {
+		revert(ctx, pod, preEvictionHookPlugins)
+	}
+}
+
+// softEvictions requests the disruption of a pod from the first soft evictor plugin taking the pod
+func softEvictions(softEvictorPlugins []frameworktypes.SoftEvictorPlugin) func(context.Context, clientset.Interface, *v1.Pod) (bool, error) {
+	if len(softEvictorPlugins) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error) {
+		for _, softEvictorPlugin := range softEvictorPlugins {
+			requested, err := softEvictorPlugin.SoftEvict(ctx, client, pod)
+			if err != nil {
+				return false, fmt.Errorf("%s: %v", softEvictorPlugin.Name(), err)
+			}
+			if requested {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// StartCycle notifies the evictor plugins of the descheduling cycle the profile is run in
+func (d profileImpl) StartCycle(ctx context.Context) {
+	for _, cyclePlugin := range d.cyclePlugins {
+		cyclePlugin.StartCycle(ctx)
+	}
+}
+
 func (d profileImpl) RunDeschedulePlugins(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
 	errs := []error{}
 	for _, pl := range d.deschedulePlugins {
@@ -337,24 +709,24 @@ func (d profileImpl) RunDeschedulePlugins(ctx context.Context, nodes []*v1.Node)
 		evictedBeforeDeschedule := d.podEvictor.TotalEvicted()
 		evictionRequestsBeforeDeschedule := d.podEvictor.TotalEvictionRequests()
 		strategyStart := time.Now()
-		status := pl.Deschedule(ctx, nodes)
+		status := d.runPlugin(pl.Name(), func() *frameworktypes.Status { return pl.Deschedule(ctx, nodes) })
 		metrics.DeschedulerStrategyDuration.With(map[string]string{"strategy": pl.Name(), "profile": d.profileName}).Observe(time.Since(strategyStart).Seconds())
 
 		if status != nil && status.Err != nil {
 			span.AddEvent("Plugin Execution Failed", trace.WithAttributes(attribute.String("err", status.Err.Error())))
-			errs = append(errs, fmt.Errorf("plugin %q finished with error: %v", pl.Name(), status.Err))
+			errs = append(errs, fmt.Errorf("plugin %q finished with error: %w", pl.Name(), status.Err))
 		}
 		klog.V(1).InfoS("Total number of evictions/requests", "extension point", "Deschedule", "evictedPods", d.podEvictor.TotalEvicted()-evictedBeforeDeschedule, "evictionRequests", d.podEvictor.TotalEvictionRequests()-evictionRequestsBeforeDeschedule)
+		if status != nil && stopsProfile(status.Err) {
+			break
+		}
+		// the remaining plugins of the cycle are aborted once too many evictions failed
+		if d.podEvictor.CircuitBreakerOpen() {
+			break
+		}
 	}
 
-	aggrErr := errors.NewAggregate(errs)
-	if aggrErr == nil {
-		return &frameworktypes.Status{}
-	}
-
-	return &frameworktypes.Status{
-		Err: fmt.Errorf("%v", aggrErr.Error()),
-	}
+	return statusFromErrors(errs)
 }
 
 func (d profileImpl) RunBalancePlugins(ctx context.Context, nodes []*v1.Node) *frameworktypes.Status {
@@ -366,22 +738,70 @@ func (d profileImpl) RunBalancePlugins(ctx context.Context, nodes []*v1.Node) *f
 		evictedBeforeBalance := d.podEvictor.TotalEvicted()
 		evictionRequestsBeforeBalance := d.podEvictor.TotalEvictionRequests()
 		strategyStart := time.Now()
-		status := pl.Balance(ctx, nodes)
+		status := d.runPlugin(pl.Name(), func() *frameworktypes.Status { return pl.Balance(ctx, nodes) })
 		metrics.DeschedulerStrategyDuration.With(map[string]string{"strategy": pl.Name(), "profile": d.profileName}).Observe(time.Since(strategyStart).Seconds())
 
 		if status != nil && status.Err != nil {
 			span.AddEvent("Plugin Execution Failed", trace.WithAttributes(attribute.String("err", status.Err.Error())))
-			errs = append(errs, fmt.Errorf("plugin %q finished with error: %v", pl.Name(), status.Err))
+			errs = append(errs, fmt.Errorf("plugin %q finished with error: %w", pl.Name(), status.Err))
 		}
 		klog.V(1).InfoS("Total number of evictions/requests", "extension point", "Balance", "evictedPods", d.podEvictor.TotalEvicted()-evictedBeforeBalance, "evictionRequests", d.podEvictor.TotalEvictionRequests()-evictionRequestsBeforeBalance)
+		if status != nil && stopsProfile(status.Err) {
+			break
+		}
+		// the remaining plugins of the cycle are aborted once too many evictions failed
+		if d.podEvictor.CircuitBreakerOpen() {
+			break
+		}
+	}
+
+	return statusFromErrors(errs)
+}
+
+// runPlugin runs the extension point of the plugin once again when it fails with a transient API error
+// and counts the errors by their reason. The plugin is not run again once it evicted pods, the second run
+// would evict on top of the evictions of the first one.
+func (d profileImpl) runPlugin(pluginName string, run func() *frameworktypes.Status) *frameworktypes.Status {
+	evictedBefore := d.podEvictor.TotalEvicted() + d.podEvictor.TotalEvictionRequests()
+	status := run()
+	var transientAPIErr *frameworktypes.TransientAPIError
+	if status != nil && errors.As(status.Err, &transientAPIErr) {
+		metrics.StrategyErrors.With(map[string]string{"reason": frameworktypes.TransientAPIErrorReason, "strategy": pluginName, "profile": d.profileName}).Inc()
+		if evicted := d.podEvictor.TotalEvicted() + d.podEvictor.TotalEvictionRequests() - evictedBefore; evicted > 0 {
+			klog.V(1).InfoS("Not retrying the plugin after a transient API error, pods already evicted", "plugin", pluginName, "profile", d.profileName, "evictedPods", evicted, "err", status.Err)
+		} else {
+			klog.V(1).InfoS("Retrying the plugin after a transient API error", "plugin", pluginName, "profile", d.profileName, "err", status.Err)
+			status = run()
+		}
 	}
+	if status != nil && status.Err != nil {
+		metrics.StrategyErrors.With(map[string]string{"reason": frameworktypes.ErrorReason(status.Err), "strategy": pluginName, "profile": d.profileName}).Inc()
+	}
+	return status
+}
 
-	aggrErr := errors.NewAggregate(errs)
+// stopsProfile checks whether the error stops the remaining plugins of the profile from running
+func stopsProfile(err error) bool {
+	var throttledErr *frameworktypes.ThrottledError
+	var fatalConfigErr *frameworktypes.FatalConfigError
+	return errors.As(err, &throttledErr) || errors.As(err, &fatalConfigErr)
+}
+
+// pluginErrors aggregates the errors of the plugins keeping the typed errors detectable through errors.As
+type pluginErrors struct {
+	utilerrors.Aggregate
+}
+
+func (e pluginErrors) Unwrap() []error {
+	return e.Errors()
+}
+
+func statusFromErrors(errs []error) *frameworktypes.Status {
+	aggrErr := utilerrors.NewAggregate(errs)
 	if aggrErr == nil {
 		return &frameworktypes.Status{}
 	}
-
 	return &frameworktypes.Status{
-		Err: fmt.Errorf("%v", aggrErr.Error()),
+		Err: pluginErrors{aggrErr},
 	}
 }
@@ -3,8 +3,11 @@ package profile
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -15,12 +18,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
+	utilptr "k8s.io/utils/ptr"
 
 	"sigs.k8s.io/descheduler/pkg/api"
 	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
 	fakeplugin "sigs.k8s.io/descheduler/pkg/framework/fake/plugin"
 	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
 	"sigs.k8s.io/descheduler/pkg/framework/plugins/defaultevictor"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podcheckpoint"
+	"sigs.k8s.io/descheduler/pkg/framework/plugins/podsensitivity"
 	frameworktesting "sigs.k8s.io/descheduler/pkg/framework/testing"
 	frameworktypes "sigs.k8s.io/descheduler/pkg/framework/types"
 	testutils "sigs.k8s.io/descheduler/test"
@@ -677,3 +683,850 @@ func TestProfileExtensionPointOrdering(t *testing.T) {
 		t.Errorf("check for balance invocation order failed. Results are not deep equal. mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestProfilePluginErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		errs                []error
+		evict               bool
+		expectedInvocations []string
+		expectedErr         error
+	}{
+		{
+			name:                "transient API error is retried",
+			errs:                []error{&frameworktypes.TransientAPIError{Err: fmt.Errorf("timeout")}, nil},
+			expectedInvocations: []string{"FakePlugin_0", "FakePlugin_0", "FakePlugin_1"},
+		},
+		{
+			name:                "transient API error is retried once",
+			errs:                []error{&frameworktypes.TransientAPIError{Err: fmt.Errorf("timeout")}, &frameworktypes.TransientAPIError{Err: fmt.Errorf("timeout")}},
+			expectedInvocations: []string{"FakePlugin_0", "FakePlugin_0", "FakePlugin_1"},
+			expectedErr:         &frameworktypes.TransientAPIError{},
+		},
+		{
+			name:                "transient API error is not retried once pods are evicted",
+			errs:                []error{&frameworktypes.TransientAPIError{Err: fmt.Errorf("timeout")}, nil},
+			evict:               true,
+			expectedInvocations: []string{"FakePlugin_0", "FakePlugin_1"},
+			expectedErr:         &frameworktypes.TransientAPIError{},
+		},
+		{
+			name:                "throttled error skips the remaining plugins",
+			errs:                []error{&frameworktypes.ThrottledError{Err: fmt.Errorf("too many requests")}},
+			expectedInvocations: []string{"FakePlugin_0"},
+			expectedErr:         &frameworktypes.ThrottledError{},
+		},
+		{
+			name:                "fatal config error skips the remaining plugins",
+			errs:                []error{&frameworktypes.FatalConfigError{Err: fmt.Errorf("priority class not found")}},
+			expectedInvocations: []string{"FakePlugin_0"},
+			expectedErr:         &frameworktypes.FatalConfigError{},
+		},
+		{
+			name:                "unclassified error lets the remaining plugins run",
+			errs:                []error{fmt.Errorf("boom")},
+			expectedInvocations: []string{"FakePlugin_0", "FakePlugin_1"},
+			expectedErr:         fmt.Errorf("boom"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+			n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+			p1 := testutils.BuildTestPod("p1", 200, 0, n1.Name, func(pod *v1.Pod) {
+				pod.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+			})
+
+			pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+			var invocations []string
+			calls := 0
+			for i := 0; i < 2; i++ {
+				fakePluginName := fmt.Sprintf("FakePlugin_%v", i)
+				fakePlugin := fakeplugin.FakePlugin{}
+				idx := i
+				fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+					invocations = append(invocations, fakePluginName)
+					if idx == 0 && calls < len(test.errs) {
+						calls++
+						if dAction, ok := action.(fakeplugin.DescheduleAction); ok && test.evict && calls == 1 {
+							if err := dAction.Handle().Evictor().Evict(ctx, p1, evictions.EvictOptions{StrategyName: fakePluginName}); err != nil {
+								t.Errorf("Unexpected error when evicting the pod: %v", err)
+							}
+						}
+						return true, false, test.errs[calls-1]
+					}
+					return true, false, nil
+				})
+				pluginregistry.Register(
+					fakePluginName,
+					fakeplugin.NewPluginFncFromFake(&fakePlugin),
+					&fakeplugin.FakePlugin{},
+					&fakeplugin.FakePluginArgs{},
+					fakeplugin.ValidateFakePluginArgs,
+					fakeplugin.SetDefaults_FakePluginArgs,
+					pluginregistry.PluginRegistry,
+				)
+			}
+			pluginregistry.Register(
+				defaultevictor.PluginName,
+				defaultevictor.New,
+				&defaultevictor.DefaultEvictor{},
+				&defaultevictor.DefaultEvictorArgs{},
+				defaultevictor.ValidateDefaultEvictorArgs,
+				defaultevictor.SetDefaults_DefaultEvictorArgs,
+				pluginregistry.PluginRegistry,
+			)
+
+			client := fakeclientset.NewSimpleClientset(n1, n2, p1)
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(ctx, client, nil, defaultevictor.DefaultEvictorArgs{}, nil)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			prfl, err := NewProfile(
+				api.DeschedulerProfile{
+					Name: "strategy-test-profile",
+					PluginConfigs: []api.PluginConfig{
+						{Name: defaultevictor.PluginName, Args: &defaultevictor.DefaultEvictorArgs{}},
+						{Name: "FakePlugin_0", Args: &fakeplugin.FakePluginArgs{}},
+						{Name: "FakePlugin_1", Args: &fakeplugin.FakePluginArgs{}},
+					},
+					Plugins: api.Plugins{
+						Deschedule: api.PluginSet{Enabled: []string{"FakePlugin_0", "FakePlugin_1"}},
+						Filter:     api.PluginSet{Enabled: []string{defaultevictor.PluginName}},
+					},
+				},
+				pluginregistry.PluginRegistry,
+				WithClientSet(client),
+				WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+				WithPodEvictor(podEvictor),
+				WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+			)
+			if err != nil {
+				t.Fatalf("unable to create profile: %v", err)
+			}
+
+			status := prfl.RunDeschedulePlugins(ctx, []*v1.Node{n1, n2})
+			if diff := cmp.Diff(test.expectedInvocations, invocations); diff != "" {
+				t.Errorf("Unexpected invocations (-want +got):\n%s", diff)
+			}
+			switch {
+			case test.expectedErr == nil && status.Err != nil:
+				t.Errorf("Expected no error, got %v", status.Err)
+			case test.expectedErr != nil && status.Err == nil:
+				t.Errorf("Expected an error, got none")
+			case test.expectedErr != nil && frameworktypes.ErrorReason(status.Err) != frameworktypes.ErrorReason(test.expectedErr):
+				t.Errorf("Expected an error of %q reason, got %v", frameworktypes.ErrorReason(test.expectedErr), status.Err)
+			}
+		})
+	}
+}
+
+func TestProfileReportOnlyPods(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	p1 := testutils.BuildTestPod(fmt.Sprintf("pod_1_%s", n1.Name), 200, 0, n1.Name, nil)
+	p1.ObjectMeta.OwnerReferences = testutils.GetDaemonSetOwnerRefList()
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	filtered := map[string]bool{}
+	evictionErrs := map[string]error{}
+	for _, pluginName := range []string{"NodeDrain", "OtherPlugin"} {
+		fakePlugin := &fakeplugin.FakePlugin{PluginName: pluginName}
+		fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+			if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+				filtered[pluginName] = dAction.Handle().Evictor().Filter(p1)
+				if filtered[pluginName] {
+					evictionErrs[pluginName] = dAction.Handle().Evictor().Evict(ctx, p1, evictions.EvictOptions{StrategyName: pluginName})
+				}
+				return true, false, nil
+			}
+			return false, false, nil
+		})
+		pluginregistry.Register(
+			pluginName,
+			fakeplugin.NewPluginFncFromFake(fakePlugin),
+			&fakeplugin.FakePlugin{},
+			&fakeplugin.FakePluginArgs{},
+			fakeplugin.ValidateFakePluginArgs,
+			fakeplugin.SetDefaults_FakePluginArgs,
+			pluginregistry.PluginRegistry,
+		)
+	}
+	pluginregistry.Register(
+		defaultevictor.PluginName,
+		defaultevictor.New,
+		&defaultevictor.DefaultEvictor{},
+		&defaultevictor.DefaultEvictorArgs{},
+		defaultevictor.ValidateDefaultEvictorArgs,
+		defaultevictor.SetDefaults_DefaultEvictorArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, p1)
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionFuc(&evictedPods))
+
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: defaultevictor.PluginName,
+					Args: &defaultevictor.DefaultEvictorArgs{
+						PriorityThreshold: &api.PriorityThreshold{
+							Value: nil,
+						},
+						PluginOverrides: []defaultevictor.PluginOverride{
+							{Name: "NodeDrain", ReportDaemonSetPods: true},
+						},
+					},
+				},
+				{
+					Name: "NodeDrain",
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+				{
+					Name: "OtherPlugin",
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{"NodeDrain", "OtherPlugin"},
+				},
+				Filter: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName},
+				},
+				PreEvictionFilter: api.PluginSet{
+					Enabled: []string{defaultevictor.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if status := prfl.RunDeschedulePlugins(ctx, []*v1.Node{n1}); status != nil && status.Err != nil {
+		t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+	}
+
+	if !filtered["NodeDrain"] {
+		t.Errorf("Expected the daemonset pod to be passed to the NodeDrain plugin")
+	}
+	if evictionErrs["NodeDrain"] == nil {
+		t.Errorf("Expected the eviction of the reported daemonset pod to be refused")
+	}
+	if filtered["OtherPlugin"] {
+		t.Errorf("Expected the daemonset pod to be filtered out for the OtherPlugin plugin")
+	}
+	if len(evictedPods) > 0 {
+		t.Errorf("Unexpected evictions: %v", evictedPods)
+	}
+}
+
+func TestProfilePluginFilter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	var pods []*v1.Pod
+	for i := 0; i < 2; i++ {
+		pods = append(pods, testutils.BuildTestPod(fmt.Sprintf("pod_%d_%s", i, n1.Name), 200, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+			pod.Labels = map[string]string{podsensitivity.DefaultClassKey: "standard"}
+		}))
+	}
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	filtered := map[string]bool{}
+	evictionErrs := map[string][]error{}
+	for _, pluginName := range []string{"AllowedPlugin", "OtherPlugin"} {
+		fakePlugin := &fakeplugin.FakePlugin{PluginName: pluginName}
+		fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+			if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+				filtered[pluginName] = dAction.Handle().Evictor().Filter(pods[0])
+				for _, pod := range pods {
+					evictionErrs[pluginName] = append(evictionErrs[pluginName], dAction.Handle().Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: pluginName}))
+				}
+				return true, false, nil
+			}
+			return false, false, nil
+		})
+		pluginregistry.Register(
+			pluginName,
+			fakeplugin.NewPluginFncFromFake(fakePlugin),
+			&fakeplugin.FakePlugin{},
+			&fakeplugin.FakePluginArgs{},
+			fakeplugin.ValidateFakePluginArgs,
+			fakeplugin.SetDefaults_FakePluginArgs,
+			pluginregistry.PluginRegistry,
+		)
+	}
+	pluginregistry.Register(
+		podsensitivity.PluginName,
+		podsensitivity.New,
+		&podsensitivity.PodSensitivity{},
+		&podsensitivity.PodSensitivityArgs{},
+		podsensitivity.ValidatePodSensitivityArgs,
+		podsensitivity.SetDefaults_PodSensitivityArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, pods[0], pods[1])
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionFuc(&evictedPods))
+
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: podsensitivity.PluginName,
+					Args: &podsensitivity.PodSensitivityArgs{
+						ClassKey: podsensitivity.DefaultClassKey,
+						Classes: []podsensitivity.SensitivityClass{
+							{Name: "standard", MaxEvictionsPerCycle: utilptr.To[uint](1), AllowedPlugins: []string{"AllowedPlugin"}},
+						},
+					},
+				},
+				{
+					Name: "AllowedPlugin",
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+				{
+					Name: "OtherPlugin",
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{"OtherPlugin", "AllowedPlugin"},
+				},
+				Filter: api.PluginSet{
+					Enabled: []string{podsensitivity.PluginName},
+				},
+				PreEvictionFilter: api.PluginSet{
+					Enabled: []string{podsensitivity.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if status := prfl.RunDeschedulePlugins(ctx, []*v1.Node{n1}); status != nil && status.Err != nil {
+		t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+	}
+
+	if filtered["OtherPlugin"] {
+		t.Errorf("Expected the pod to be filtered out for the OtherPlugin plugin")
+	}
+	for _, err := range evictionErrs["OtherPlugin"] {
+		if err == nil {
+			t.Errorf("Expected the evictions by the OtherPlugin plugin to be refused")
+		}
+	}
+	if !filtered["AllowedPlugin"] {
+		t.Errorf("Expected the pod to be passed to the AllowedPlugin plugin")
+	}
+	if errs := evictionErrs["AllowedPlugin"]; len(errs) != 2 || errs[0] != nil || errs[1] == nil {
+		t.Errorf("Expected only the first eviction by the AllowedPlugin plugin to succeed, got %v", errs)
+	}
+	if len(evictedPods) != 1 {
+		t.Errorf("Expected a single eviction, got %v", evictedPods)
+	}
+}
+
+func TestProfilePreEvictionHook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ready" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+	var pods []*v1.Pod
+	for i, path := range []string{"/ready", "/failing"} {
+		pods = append(pods, testutils.BuildTestPod(fmt.Sprintf("pod_%d_%s", i, n1.Name), 200, 0, n1.Name, func(pod *v1.Pod) {
+			pod.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+			pod.Annotations = map[string]string{podcheckpoint.CheckpointURLAnnotationKey: server.URL + path}
+		}))
+	}
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	evictionErrs := []error{}
+	fakePlugin := &fakeplugin.FakePlugin{PluginName: "FakePlugin"}
+	fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+		if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+			for _, pod := range pods {
+				evictionErrs = append(evictionErrs, dAction.Handle().Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: "FakePlugin"}))
+			}
+			return true, false, nil
+		}
+		return false, false, nil
+	})
+	pluginregistry.Register(
+		"FakePlugin",
+		fakeplugin.NewPluginFncFromFake(fakePlugin),
+		&fakeplugin.FakePlugin{},
+		&fakeplugin.FakePluginArgs{},
+		fakeplugin.ValidateFakePluginArgs,
+		fakeplugin.SetDefaults_FakePluginArgs,
+		pluginregistry.PluginRegistry,
+	)
+	pluginregistry.Register(
+		podcheckpoint.PluginName,
+		podcheckpoint.New,
+		&podcheckpoint.PodCheckpoint{},
+		&podcheckpoint.PodCheckpointArgs{},
+		podcheckpoint.ValidatePodCheckpointArgs,
+		podcheckpoint.SetDefaults_PodCheckpointArgs,
+		pluginregistry.PluginRegistry,
+	)
+
+	client := fakeclientset.NewSimpleClientset(n1, pods[0], pods[1])
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionFuc(&evictedPods))
+
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name: podcheckpoint.PluginName,
+					Args: &podcheckpoint.PodCheckpointArgs{
+						Timeout:  &metav1.Duration{Duration: 100 * time.Millisecond},
+						Interval: &metav1.Duration{Duration: 10 * time.Millisecond},
+					},
+				},
+				{
+					Name: "FakePlugin",
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{"FakePlugin"},
+				},
+				Filter: api.PluginSet{
+					Enabled: []string{podcheckpoint.PluginName},
+				},
+				PreEvictionFilter: api.PluginSet{
+					Enabled: []string{podcheckpoint.PluginName},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if status := prfl.RunDeschedulePlugins(ctx, []*v1.Node{n1}); status != nil && status.Err != nil {
+		t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+	}
+
+	if len(evictionErrs) != 2 || evictionErrs[0] != nil || evictionErrs[1] == nil {
+		t.Errorf("Expected only the eviction of the pod acknowledging the checkpoint to succeed, got %v", evictionErrs)
+	}
+	if len(evictedPods) != 1 || evictedPods[0] != pods[0].Name {
+		t.Errorf("Expected only %v to be evicted, got %v", pods[0].Name, evictedPods)
+	}
+}
+
+func TestProfileEvictGroup(t *testing.T) {
+	tests := []struct {
+		description  string
+		nodeFit      bool
+		expectedPods []string
+	}{
+		{
+			description:  "all the pods of the group are evicted",
+			expectedPods: []string{"pod_1_n1", "pod_2_n1"},
+		},
+		{
+			description: "no pods are evicted when some pod of the group fails the pre-eviction filters",
+			nodeFit:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+
+			n1 := testutils.BuildTestNode("n1", 2000, 3000, 10, nil)
+			n2 := testutils.BuildTestNode("n2", 2000, 3000, 10, nil)
+			p1 := testutils.BuildTestPod("pod_1_n1", 200, 0, n1.Name, testutils.SetRSOwnerRef)
+			p2 := testutils.BuildTestPod("pod_2_n1", 200, 0, n1.Name, testutils.SetRSOwnerRef)
+			// the pod fits on no other node
+			p2.Spec.NodeSelector = map[string]string{"kubernetes.io/hostname": n1.Name}
+
+			pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+			var evicted int
+			var evictionErr error
+			fakePlugin := &fakeplugin.FakePlugin{PluginName: "GangPlugin"}
+			fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+				if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+					groupEvictor := dAction.Handle().Evictor().(frameworktypes.GroupEvictor)
+					evicted, evictionErr = groupEvictor.EvictGroup(ctx, []*v1.Pod{p1, p2}, evictions.EvictOptions{StrategyName: "GangPlugin"}, 0)
+					return true, false, nil
+				}
+				return false, false, nil
+			})
+			pluginregistry.Register(
+				"GangPlugin",
+				fakeplugin.NewPluginFncFromFake(fakePlugin),
+				&fakeplugin.FakePlugin{},
+				&fakeplugin.FakePluginArgs{},
+				fakeplugin.ValidateFakePluginArgs,
+				fakeplugin.SetDefaults_FakePluginArgs,
+				pluginregistry.PluginRegistry,
+			)
+			pluginregistry.Register(
+				defaultevictor.PluginName,
+				defaultevictor.New,
+				&defaultevictor.DefaultEvictor{},
+				&defaultevictor.DefaultEvictorArgs{},
+				defaultevictor.ValidateDefaultEvictorArgs,
+				defaultevictor.SetDefaults_DefaultEvictorArgs,
+				pluginregistry.PluginRegistry,
+			)
+
+			client := fakeclientset.NewSimpleClientset(n1, n2, p1, p2)
+			var evictedPods []string
+			client.PrependReactor("create", "pods", podEvictionReactionFuc(&evictedPods))
+
+			handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+				ctx,
+				client,
+				nil,
+				defaultevictor.DefaultEvictorArgs{},
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Unable to initialize a framework handle: %v", err)
+			}
+
+			prfl, err := NewProfile(
+				api.DeschedulerProfile{
+					Name: "strategy-test-profile",
+					PluginConfigs: []api.PluginConfig{
+						{
+							Name: defaultevictor.PluginName,
+							Args: &defaultevictor.DefaultEvictorArgs{
+								PriorityThreshold: &api.PriorityThreshold{
+									Value: nil,
+								},
+								NodeFit: test.nodeFit,
+							},
+						},
+						{
+							Name: "GangPlugin",
+							Args: &fakeplugin.FakePluginArgs{},
+						},
+					},
+					Plugins: api.Plugins{
+						Deschedule: api.PluginSet{
+							Enabled: []string{"GangPlugin"},
+						},
+						Filter: api.PluginSet{
+							Enabled: []string{defaultevictor.PluginName},
+						},
+						PreEvictionFilter: api.PluginSet{
+							Enabled: []string{defaultevictor.PluginName},
+						},
+					},
+				},
+				pluginregistry.PluginRegistry,
+				WithClientSet(client),
+				WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+				WithPodEvictor(podEvictor),
+				WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+			)
+			if err != nil {
+				t.Fatalf("unable to create profile: %v", err)
+			}
+
+			if status := prfl.RunDeschedulePlugins(ctx, []*v1.Node{n1, n2}); status != nil && status.Err != nil {
+				t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+			}
+
+			if evicted != len(test.expectedPods) {
+				t.Errorf("Expected %v pods evicted, got %v", len(test.expectedPods), evicted)
+			}
+			if (evictionErr != nil) != (len(test.expectedPods) == 0) {
+				t.Errorf("Unexpected group eviction error: %v", evictionErr)
+			}
+			if !sets.New(evictedPods...).Equal(sets.New(test.expectedPods...)) {
+				t.Errorf("Expected %v pods evicted, got %v", test.expectedPods, evictedPods)
+			}
+		})
+	}
+}
+
+func TestProfilePluginEvictionLimits(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	nodes := []*v1.Node{
+		testutils.BuildTestNode("n1", 2000, 3000, 10, nil),
+		testutils.BuildTestNode("n2", 2000, 3000, 10, nil),
+		testutils.BuildTestNode("n3", 2000, 3000, 10, nil),
+	}
+	objs := []runtime.Object{nodes[0], nodes[1], nodes[2]}
+	var pods []*v1.Pod
+	for _, node := range nodes {
+		for i := 0; i < 2; i++ {
+			pod := testutils.BuildTestPod(fmt.Sprintf("pod_%d_%s", i, node.Name), 200, 0, node.Name, func(pod *v1.Pod) {
+				pod.ObjectMeta.OwnerReferences = testutils.GetNormalPodOwnerRefList()
+			})
+			pods = append(pods, pod)
+			objs = append(objs, pod)
+		}
+	}
+
+	pluginregistry.PluginRegistry = pluginregistry.NewRegistry()
+	evictionErrs := map[string][]error{}
+	for _, pluginName := range []string{"LimitedPlugin", "OtherPlugin"} {
+		fakePlugin := &fakeplugin.FakePlugin{PluginName: pluginName}
+		fakePlugin.AddReactor(string(frameworktypes.DescheduleExtensionPoint), func(action fakeplugin.Action) (handled, filter bool, err error) {
+			if dAction, ok := action.(fakeplugin.DescheduleAction); ok {
+				for _, pod := range pods {
+					// The plugins evict the pods of every node but the last one
+					if pluginName == "OtherPlugin" && pod.Spec.NodeName != "n3" {
+						continue
+					}
+					evictionErrs[pluginName] = append(evictionErrs[pluginName], dAction.Handle().Evictor().Evict(ctx, pod, evictions.EvictOptions{StrategyName: pluginName}))
+				}
+				return true, false, nil
+			}
+			return false, false, nil
+		})
+		pluginregistry.Register(
+			pluginName,
+			fakeplugin.NewPluginFncFromFake(fakePlugin),
+			&fakeplugin.FakePlugin{},
+			&fakeplugin.FakePluginArgs{},
+			fakeplugin.ValidateFakePluginArgs,
+			fakeplugin.SetDefaults_FakePluginArgs,
+			pluginregistry.PluginRegistry,
+		)
+	}
+
+	client := fakeclientset.NewSimpleClientset(objs...)
+	var evictedPods []string
+	client.PrependReactor("create", "pods", podEvictionReactionFuc(&evictedPods))
+
+	handle, podEvictor, err := frameworktesting.InitFrameworkHandle(
+		ctx,
+		client,
+		nil,
+		defaultevictor.DefaultEvictorArgs{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unable to initialize a framework handle: %v", err)
+	}
+
+	prfl, err := NewProfile(
+		api.DeschedulerProfile{
+			Name: "strategy-test-profile",
+			PluginConfigs: []api.PluginConfig{
+				{
+					Name:                   "LimitedPlugin",
+					Args:                   &fakeplugin.FakePluginArgs{},
+					MaxPodsToEvictPerNode:  utilptr.To[uint](1),
+					MaxPodsToEvictPerCycle: utilptr.To[uint](3),
+				},
+				{
+					Name: "OtherPlugin",
+					Args: &fakeplugin.FakePluginArgs{},
+				},
+			},
+			Plugins: api.Plugins{
+				Deschedule: api.PluginSet{
+					Enabled: []string{"LimitedPlugin", "OtherPlugin"},
+				},
+			},
+		},
+		pluginregistry.PluginRegistry,
+		WithClientSet(client),
+		WithSharedInformerFactory(handle.SharedInformerFactoryImpl),
+		WithPodEvictor(podEvictor),
+		WithGetPodsAssignedToNodeFnc(handle.GetPodsAssignedToNodeFuncImpl),
+	)
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+
+	if status := prfl.RunDeschedulePlugins(ctx, nodes); status != nil && status.Err != nil {
+		t.Fatalf("Expected nil error in status, got %q instead", status.Err)
+	}
+
+	errs := evictionErrs["LimitedPlugin"]
+	if len(errs) != 6 {
+		t.Fatalf("Expected 6 evictions by the LimitedPlugin plugin, got %v", errs)
+	}
+	for _, i := range []int{0, 2, 4} {
+		if errs[i] != nil {
+			t.Errorf("Expected the eviction of the first pod of the node %d to succeed, got %v", i/2, errs[i])
+		}
+	}
+	for _, i := range []int{1, 3} {
+		if _, ok := errs[i].(*evictions.EvictionNodeLimitError); !ok {
+			t.Errorf("Expected the eviction of the second pod of the node %d to fail on the node limit of the plugin, got %v", i/2, errs[i])
+		}
+	}
+	if _, ok := errs[5].(*evictions.EvictionTotalLimitError); !ok {
+		t.Errorf("Expected the last eviction to fail on the cycle limit of the plugin, got %v", errs[5])
+	}
+	// The limits of a plugin do not bound the other plugins of the profile
+	for _, err := range evictionErrs["OtherPlugin"] {
+		if err != nil {
+			t.Errorf("Unexpected error of an eviction by the OtherPlugin plugin: %v", err)
+		}
+	}
+	if len(evictedPods) != 5 {
+		t.Errorf("Expected 5 evictions, got %v", evictedPods)
+	}
+}
+
+type fakePreEvictionHookPlugin struct {
+	name  string
+	err   error
+	calls *[]string
+}
+
+func (p *fakePreEvictionHookPlugin) Name() string                       { return p.name }
+func (p *fakePreEvictionHookPlugin) Filter(pod *v1.Pod) bool            { return true }
+func (p *fakePreEvictionHookPlugin) PreEvictionFilter(pod *v1.Pod) bool { return true }
+
+func (p *fakePreEvictionHookPlugin) PreEviction(ctx context.Context, pod *v1.Pod) error {
+	*p.calls = append(*p.calls, "prepare "+p.name)
+	return p.err
+}
+
+type fakePreEvictionRevertPlugin struct {
+	fakePreEvictionHookPlugin
+}
+
+func (p *fakePreEvictionRevertPlugin) RevertPreEviction(ctx context.Context, pod *v1.Pod) error {
+	*p.calls = append(*p.calls, "revert "+p.name)
+	return nil
+}
+
+func TestPreEvictionHooksRevert(t *testing.T) {
+	pod := testutils.BuildTestPod("p1", 100, 0, "n1", nil)
+	tests := []struct {
+		name          string
+		failing       string
+		revert        bool
+		expectedCalls []string
+	}{
+		{
+			name:          "all hooks succeed",
+			expectedCalls: []string{"prepare a", "prepare b", "prepare c"},
+		},
+		{
+			name:          "hook failing, the earlier hooks are reverted",
+			failing:       "c",
+			expectedCalls: []string{"prepare a", "prepare b", "prepare c", "revert a"},
+		},
+		{
+			name:          "pod not evicted, all the hooks are reverted in reverse order",
+			revert:        true,
+			expectedCalls: []string{"prepare a", "prepare b", "prepare c", "revert c", "revert a"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			newPlugin := func(name string) fakePreEvictionHookPlugin {
+				plugin := fakePreEvictionHookPlugin{name: name, calls: &calls}
+				if name == tc.failing {
+					plugin.err = fmt.Errorf("failing")
+				}
+				return plugin
+			}
+			// b can not be reverted
+			b := newPlugin("b")
+			hook, revert := preEvictionHooks([]frameworktypes.PreEvictionHookEvictorPlugin{
+				&fakePreEvictionRevertPlugin{newPlugin("a")},
+				&b,
+				&fakePreEvictionRevertPlugin{newPlugin("c")},
+			})
+			err := hook(context.Background(), pod)
+			if (err != nil) != (tc.failing != "") {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if tc.revert {
+				revert(context.Background(), pod)
+			}
+			if diff := cmp.Diff(tc.expectedCalls, calls); diff != "" {
+				t.Errorf("Unexpected calls (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reasons of the plugin errors as reported by the metrics
+const (
+	ThrottledErrorReason    = "throttled"
+	FatalConfigErrorReason  = "fatal_config"
+	TransientAPIErrorReason = "transient_api"
+	UnclassifiedErrorReason = "unclassified"
+)
+
+// ThrottledError reports the plugin backed off as it was throttled, e.g. by the API server
+// or an external system. The runner skips the remaining plugins of the profile in the
+// descheduling cycle, they run again in the next cycle.
+type ThrottledError struct {
+	Err error
+}
+
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("throttled: %v", e.Err)
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// FatalConfigError reports the plugin can never run with its configuration, e.g. a resource
+// referenced by the arguments does not exist. The runner aborts the descheduler.
+type FatalConfigError struct {
+	Err error
+}
+
+func (e *FatalConfigError) Error() string {
+	return fmt.Sprintf("fatal configuration error: %v", e.Err)
+}
+
+func (e *FatalConfigError) Unwrap() error {
+	return e.Err
+}
+
+// TransientAPIError reports an API call failed with an error expected to go away, e.g. a timeout
+// or a conflict. The runner runs the plugin once again within the descheduling cycle, so the plugin
+// must return the error only when running it again is safe.
+type TransientAPIError struct {
+	Err error
+}
+
+func (e *TransientAPIError) Error() string {
+	return fmt.Sprintf("transient API error: %v", e.Err)
+}
+
+func (e *TransientAPIError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorReason classifies the error returned by a plugin
+func ErrorReason(err error) string {
+	var throttled *ThrottledError
+	var fatalConfig *FatalConfigError
+	var transientAPI *TransientAPIError
+	switch {
+	case errors.As(err, &fatalConfig):
+		return FatalConfigErrorReason
+	case errors.As(err, &throttled):
+		return ThrottledErrorReason
+	case errors.As(err, &transientAPI):
+		return TransientAPIErrorReason
+	default:
+		return UnclassifiedErrorReason
+	}
+}
@@ -18,14 +18,17 @@ package types
 
 import (
 	"context"
+	"math/rand"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
-
-	"sigs.k8s.io/descheduler/pkg/descheduler/evictions"
-	"sigs.k8s.io/descheduler/pkg/descheduler/metricscollector"
-	podutil "sigs.k8s.io/descheduler/pkg/descheduler/pod"
+	"k8s.io/component-base/metrics"
+	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
 
 	promapi "github.com/prometheus/client_golang/api"
 )
@@ -38,9 +41,123 @@ type Handle interface {
 	ClientSet() clientset.Interface
 	PrometheusClient() promapi.Client
 	Evictor() Evictor
-	GetPodsAssignedToNodeFunc() podutil.GetPodsAssignedToNodeFunc
+	GetPodsAssignedToNodeFunc() GetPodsAssignedToNodeFunc
 	SharedInformerFactory() informers.SharedInformerFactory
-	MetricsCollector() *metricscollector.MetricsCollector
+	// MetricsCollector returns the collector of the actual usage of the nodes, nil unless the metrics are collected
+	MetricsCollector() MetricsCollector
+	// RecentEvictions returns the pods evicted in the recent descheduling cycles
+	RecentEvictions() RecentEvictions
+	// EvictableCapacity returns the pods of the node passing all the filters of the evictor
+	// and their aggregate requests, computed once per descheduling cycle
+	EvictableCapacity(node *v1.Node) (*NodeEvictableCapacity, error)
+	// RegisterMetric registers a Prometheus collector of the plugin namespaced under descheduler_plugin_.
+	// Registering the same collector again, e.g. in a later descheduling cycle, is a no-op.
+	RegisterMetric(metric metrics.Registerable) error
+	// Rand returns the source of the randomized choices of the plugin, e.g. sampling or tie-breaking,
+	// seeded from the seed of the descheduling cycle so a cycle can be reproduced. Not safe for concurrent use.
+	Rand() *rand.Rand
+}
+
+// NodeEvictableCapacity holds the pods of a node passing all the filters of the evictor
+// and the aggregate requests of the pods
+type NodeEvictableCapacity struct {
+	// Pods passing both the Filter and the PreEvictionFilter extension points
+	Pods []*v1.Pod
+	// Requests of the Pods summed up per resource
+	Requests v1.ResourceList
+}
+
+// FilterFunc is a filter for a pod.
+type FilterFunc func(*v1.Pod) bool
+
+// GetPodsAssignedToNodeFunc is a function which accept a node name and a pod filter function
+// as input and returns the pods that assigned to the node.
+type GetPodsAssignedToNodeFunc func(string, FilterFunc) ([]*v1.Pod, error)
+
+// MetricsCollector provides the actual usage of the nodes collected from the metrics server
+type MetricsCollector interface {
+	// HasSynced checks the usage of the nodes has been collected at least once
+	HasSynced() bool
+	// AllNodesUsage returns the usage of every node collected
+	AllNodesUsage() (map[string]map[v1.ResourceName]*resource.Quantity, error)
+	// NodeUsage returns the usage of the node
+	NodeUsage(node *v1.Node) (map[v1.ResourceName]*resource.Quantity, error)
+	// NodeSample returns the latest metrics sample collected for the node
+	NodeSample(nodeName string) (NodeSample, bool)
+	// SimulatedNodesIgnored checks the nodes simulated by kwok are left out of the collection
+	SimulatedNodesIgnored() bool
+	// MetricsClient returns the client of the metrics server
+	MetricsClient() metricsclient.Interface
+}
+
+// NodeSample describes the latest metrics sample collected for a node
+type NodeSample struct {
+	// Timestamp of the sample as reported by the metrics server
+	Timestamp time.Time
+	// Change of the usage since the previous sample in percentage,
+	// the largest change of the cpu and memory usage
+	Change float64
+}
+
+// RecentEvictions is a queryable cache of the pods evicted in the recent descheduling cycles
+// (including evictions in dry run mode). Plugins use it to avoid selecting the replacements of
+// the evicted pods and breaking eviction/reschedule loops.
+type RecentEvictions interface {
+	// List returns the retained evictions from the oldest to the newest
+	List() []RecentEviction
+	// EvictedSince returns the evictions since the given time from the oldest to the newest
+	EvictedSince(since time.Time) []RecentEviction
+	// Has returns true when the pod with the UID was evicted recently
+	Has(uid types.UID) bool
+	// ReplacedEviction returns the latest recent eviction the pod is a replacement of, i.e. a pod
+	// of the same controller evicted before the pod got created. Bare pods are never replacements.
+	ReplacedEviction(pod *v1.Pod) (RecentEviction, bool)
+}
+
+// RecentEviction describes a pod evicted in the recent descheduling cycles
+type RecentEviction struct {
+	PodUID    types.UID
+	Namespace string
+	Name      string
+	// Owner is the controller of the pod (the first owner when none is marked as the controller),
+	// nil for bare pods
+	Owner    *metav1.OwnerReference
+	Node     string
+	Time     time.Time
+	Strategy string
+	Profile  string
+}
+
+// EvictOptions provides a handle for passing additional info to EvictPod
+type EvictOptions struct {
+	// Reason allows for passing details about the specific eviction for logging.
+	Reason string
+	// ProfileName allows for passing details about profile for observability.
+	ProfileName string
+	// StrategyName allows for passing details about strategy for observability.
+	StrategyName string
+	// PreEvictionHook is invoked once the eviction limits are checked, without holding the lock of the evictor.
+	// The pod is not evicted when the hook fails. It is set by the framework from the evictor plugins of the profile.
+	PreEvictionHook func(ctx context.Context, pod *v1.Pod) error
+	// PreEvictionRevert reverts the preparation of the pod by PreEvictionHook when the pod is not evicted after all,
+	// e.g. the eviction is refused. It is set by the framework from the evictor plugins of the profile.
+	PreEvictionRevert func(ctx context.Context, pod *v1.Pod)
+	// SoftEviction requests the disruption of the pod with the client of the eviction instead of the eviction
+	// through the Eviction API, it returns false when the pod is to be evicted through the Eviction API.
+	// It is set by the framework from the evictor plugins of the profile.
+	SoftEviction func(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error)
+	// Client the eviction is requested with instead of the client of the evictor, e.g. the client
+	// of the profile's own identity. Ignored in the dry run mode.
+	Client clientset.Interface
+	// GroupFilter checks the pods evicted along with the pod, e.g. the other members of its gang, can be
+	// evicted. It is set by the framework from the filters of the profile.
+	GroupFilter func(pod *v1.Pod) bool
+	// PreferredNodes the plugin considers good targets for the replacement of the pod. They are
+	// hinted on the owner of the pod when the preferred node hints are enabled.
+	PreferredNodes []string
+	// ForceDelete deletes the pod with a zero grace period instead of evicting it, e.g. a pod stuck terminating
+	// on an unreachable node. The pod is neither prepared for the eviction nor evicted along with its gang.
+	ForceDelete bool
 }
 
 // Evictor defines an interface for filtering and evicting pods
@@ -51,7 +168,30 @@ type Evictor interface {
 	// PreEvictionFilter checks if pod can be evicted right before eviction
 	PreEvictionFilter(*v1.Pod) bool
 	// Evict evicts a pod (no pre-check performed)
-	Evict(context.Context, *v1.Pod, evictions.EvictOptions) error
+	Evict(context.Context, *v1.Pod, EvictOptions) error
+}
+
+// GroupEvictor is an optional extension of Evictor evicting the pods of a single owner as a group.
+// None of the pods is evicted unless all of them pass the pre-eviction filters and the eviction limits.
+type GroupEvictor interface {
+	Evictor
+	// EvictGroup evicts the pods one after another, pacing apart, and returns the number of the pods evicted
+	EvictGroup(ctx context.Context, pods []*v1.Pod, opts EvictOptions, pacing time.Duration) (int, error)
+}
+
+// EvictionOrderer is an optional extension of Evictor ordering the pods picked for eviction by a plugin
+// as configured by the eviction order of the policy, e.g. the pods of the older revisions of a Deployment first.
+type EvictionOrderer interface {
+	Evictor
+	// OrderPodsForEviction reorders the pods in place, the pods of different workloads keep their order
+	OrderPodsForEviction(pods []*v1.Pod)
+}
+
+// OrderPodsForEviction reorders the pods picked for eviction when the evictor orders the pods
+func OrderPodsForEviction(evictor Evictor, pods []*v1.Pod) {
+	if orderer, ok := evictor.(EvictionOrderer); ok {
+		orderer.OrderPodsForEviction(pods)
+	}
 }
 
 // Status describes result of an extension point invocation
@@ -86,6 +226,69 @@ type EvictorPlugin interface {
 	PreEvictionFilter(pod *v1.Pod) bool
 }
 
+// ReportingEvictorPlugin is an optional extension of EvictorPlugin letting the Filter
+// extension point pass pods to a plugin for reporting purposes only. Such pods are never evicted.
+type ReportingEvictorPlugin interface {
+	EvictorPlugin
+	// ReportOnly checks whether the pod is passed to the plugin for reporting purposes only
+	ReportOnly(pluginName string, pod *v1.Pod) bool
+}
+
+// PluginFilterEvictorPlugin is an optional extension of EvictorPlugin filtering pods per plugin.
+// The evictions performed by each plugin are reported back so the filtering can take them into account.
+type PluginFilterEvictorPlugin interface {
+	EvictorPlugin
+	// FilterForPlugin checks if the pod can be evicted by the given plugin
+	FilterForPlugin(pluginName string, pod *v1.Pod) bool
+	// PodEvicted notifies the plugin the pod was evicted by the given plugin
+	PodEvicted(pluginName string, pod *v1.Pod)
+}
+
+// PreEvictionHookEvictorPlugin is an optional extension of EvictorPlugin invoked right before a pod
+// enabled in the PreEvictionFilter extension point is evicted, once the eviction limits are checked.
+// The pod is not evicted when the hook fails. The hooks are not invoked in the dry run mode.
+type PreEvictionHookEvictorPlugin interface {
+	EvictorPlugin
+	// PreEviction prepares the pod for the eviction, e.g. requests a checkpoint of the pod state
+	PreEviction(ctx context.Context, pod *v1.Pod) error
+}
+
+// PreEvictionRevertEvictorPlugin is an optional extension of PreEvictionHookEvictorPlugin invoked when a pod
+// the pre-eviction hook prepared is not evicted after all, e.g. the eviction is refused by a PodDisruptionBudget.
+type PreEvictionRevertEvictorPlugin interface {
+	PreEvictionHookEvictorPlugin
+	// RevertPreEviction reverts the preparation of the pod, the pod is passed as it was before the preparation
+	RevertPreEviction(ctx context.Context, pod *v1.Pod) error
+}
+
+// SoftEvictorPlugin is an optional extension of EvictorPlugin requesting the disruption of a pod enabled in the
+// PreEvictionFilter extension point instead of evicting it through the Eviction API, e.g. from an external controller.
+// The pod counts as evicted once the disruption is requested.
+type SoftEvictorPlugin interface {
+	EvictorPlugin
+	// SoftEvict requests the disruption of the pod with the client the pod would be evicted with, i.e. the cached
+	// client in the dry run mode. It returns false when the pod is to be evicted through the Eviction API.
+	SoftEvict(ctx context.Context, client clientset.Interface, pod *v1.Pod) (bool, error)
+}
+
+// ExplainingEvictorPlugin is an optional extension of EvictorPlugin explaining why it filters out a pod.
+// The explanations are asked for only for the pods the skips are explained for.
+type ExplainingEvictorPlugin interface {
+	EvictorPlugin
+	// FilterReasons lists the reasons Filter rejects the pod, none when the pod passes
+	FilterReasons(pod *v1.Pod) []string
+	// PreEvictionFilterReasons lists the reasons PreEvictionFilter rejects the pod, none when the pod passes
+	PreEvictionFilterReasons(pod *v1.Pod) []string
+}
+
+// CycleEvictorPlugin is an optional extension of EvictorPlugin notified of every descheduling cycle before
+// the plugins of the profile run, e.g. to drop the state cached in the previous cycle.
+type CycleEvictorPlugin interface {
+	EvictorPlugin
+	// StartCycle passes the context of the descheduling cycle, done once the cycle ends
+	StartCycle(ctx context.Context)
+}
+
 type ExtensionPoint string
 
 const (
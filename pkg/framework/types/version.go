@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PluginAPIVersion is the semantic version of the plugin API, i.e. of the Go module of this package, released with
+// the pkg/framework/types/vMAJOR.MINOR.PATCH tags. The version tells the out-of-tree plugins built into a
+// descheduler which API it provides. The major version is bumped when a change breaks the code implementing or
+// calling an interface, e.g. a method added to any interface, the Handle and the Evictor included, or a changed
+// signature, the minor version when the API grows without breaking any implementation, e.g. a new optional plugin
+// interface. A new major version changes the path of the module as well, e.g.
+// sigs.k8s.io/descheduler/pkg/framework/types/v2.
+const PluginAPIVersion = "v1.0.0"
+
+// CompatiblePluginAPI checks a plugin built against the required version of the plugin API, e.g. v1.0.0,
+// runs with this version: the major versions are the same and the minor version is not older.
+func CompatiblePluginAPI(required string) error {
+	requiredMajor, requiredMinor, err := parsePluginAPIVersion(required)
+	if err != nil {
+		return err
+	}
+	major, minor, _ := parsePluginAPIVersion(PluginAPIVersion)
+	if requiredMajor != major || requiredMinor > minor {
+		return fmt.Errorf("plugin API %s required, %s provided", required, PluginAPIVersion)
+	}
+	return nil
+}
+
+// parsePluginAPIVersion returns the major and minor versions of a vMAJOR.MINOR.PATCH version
+func parsePluginAPIVersion(version string) (int, int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if !strings.HasPrefix(version, "v") || len(parts) != 3 {
+		return 0, 0, fmt.Errorf("invalid plugin API version %q, expected vMAJOR.MINOR.PATCH", version)
+	}
+	var numbers [3]int
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return 0, 0, fmt.Errorf("invalid plugin API version %q, expected vMAJOR.MINOR.PATCH", version)
+		}
+		numbers[i] = number
+	}
+	return numbers[0], numbers[1], nil
+}
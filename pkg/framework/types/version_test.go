@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompatiblePluginAPI(t *testing.T) {
+	tests := []struct {
+		required   string
+		compatible bool
+	}{
+		{required: "v1.0.0", compatible: true},
+		{required: "v1.0.7", compatible: true},
+		{required: "v1.1.0", compatible: false},
+		{required: "v0.9.0", compatible: false},
+		{required: "v2.0.0", compatible: false},
+		{required: "1.0.0", compatible: false},
+		{required: "v1.0", compatible: false},
+		{required: "v1.x.0", compatible: false},
+	}
+	for _, tc := range tests {
+		err := CompatiblePluginAPI(tc.required)
+		if (err == nil) != tc.compatible {
+			t.Errorf("Expected %s to be compatible with %s to be %v, got %v", tc.required, PluginAPIVersion, tc.compatible, err)
+		}
+	}
+}
+
+// methods lists the signatures of the methods of an interface
+func methods(iface reflect.Type) []string {
+	var signatures []string
+	for i := 0; i < iface.NumMethod(); i++ {
+		method := iface.Method(i)
+		signatures = append(signatures, method.Name+" "+method.Type.String())
+	}
+	return signatures
+}
+
+// TestPluginAPI pins the plugin API. A failure means the change affects the out-of-tree plugins:
+// bump the major PluginAPIVersion for a breaking change, the minor version for a compatible one,
+// and update the expected methods.
+func TestPluginAPI(t *testing.T) {
+	expected := map[string][]string{
+		"Handle": {
+			"ClientSet func() kubernetes.Interface",
+			"EvictableCapacity func(*v1.Node) (*types.NodeEvictableCapacity, error)",
+			"Evictor func() types.Evictor",
+			"GetPodsAssignedToNodeFunc func() types.GetPodsAssignedToNodeFunc",
+			"MetricsCollector func() types.MetricsCollector",
+			"PrometheusClient func() api.Client",
+			"Rand func() *rand.Rand",
+			"RecentEvictions func() types.RecentEvictions",
+			"RegisterMetric func(metrics.Registerable) error",
+			"SharedInformerFactory func() informers.SharedInformerFactory",
+		},
+		"MetricsCollector": {
+			"AllNodesUsage func() (map[string]map[v1.ResourceName]*resource.Quantity, error)",
+			"HasSynced func() bool",
+			"MetricsClient func() versioned.Interface",
+			"NodeSample func(string) (types.NodeSample, bool)",
+			"NodeUsage func(*v1.Node) (map[v1.ResourceName]*resource.Quantity, error)",
+			"SimulatedNodesIgnored func() bool",
+		},
+		"RecentEvictions": {
+			"EvictedSince func(time.Time) []types.RecentEviction",
+			"Has func(types.UID) bool",
+			"List func() []types.RecentEviction",
+			"ReplacedEviction func(*v1.Pod) (types.RecentEviction, bool)",
+		},
+		"Evictor": {
+			"Evict func(context.Context, *v1.Pod, types.EvictOptions) error",
+			"Filter func(*v1.Pod) bool",
+			"PreEvictionFilter func(*v1.Pod) bool",
+		},
+		"GroupEvictor": {
+			"Evict func(context.Context, *v1.Pod, types.EvictOptions) error",
+			"EvictGroup func(context.Context, []*v1.Pod, types.EvictOptions, time.Duration) (int, error)",
+			"Filter func(*v1.Pod) bool",
+			"PreEvictionFilter func(*v1.Pod) bool",
+		},
+		"EvictionOrderer": {
+			"Evict func(context.Context, *v1.Pod, types.EvictOptions) error",
+			"Filter func(*v1.Pod) bool",
+			"OrderPodsForEviction func([]*v1.Pod)",
+			"PreEvictionFilter func(*v1.Pod) bool",
+		},
+		"Plugin": {
+			"Name func() string",
+		},
+		"DeschedulePlugin": {
+			"Deschedule func(context.Context, []*v1.Node) *types.Status",
+			"Name func() string",
+		},
+		"BalancePlugin": {
+			"Balance func(context.Context, []*v1.Node) *types.Status",
+			"Name func() string",
+		},
+		"EvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"Name func() string",
+			"PreEvictionFilter func(*v1.Pod) bool",
+		},
+		"ReportingEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"Name func() string",
+			"PreEvictionFilter func(*v1.Pod) bool",
+			"ReportOnly func(string, *v1.Pod) bool",
+		},
+		"PluginFilterEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"FilterForPlugin func(string, *v1.Pod) bool",
+			"Name func() string",
+			"PodEvicted func(string, *v1.Pod)",
+			"PreEvictionFilter func(*v1.Pod) bool",
+		},
+		"PreEvictionHookEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"Name func() string",
+			"PreEviction func(context.Context, *v1.Pod) error",
+			"PreEvictionFilter func(*v1.Pod) bool",
+		},
+		"PreEvictionRevertEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"Name func() string",
+			"PreEviction func(context.Context, *v1.Pod) error",
+			"PreEvictionFilter func(*v1.Pod) bool",
+			"RevertPreEviction func(context.Context, *v1.Pod) error",
+		},
+		"SoftEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"Name func() string",
+			"PreEvictionFilter func(*v1.Pod) bool",
+			"SoftEvict func(context.Context, kubernetes.Interface, *v1.Pod) (bool, error)",
+		},
+		"ExplainingEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"FilterReasons func(*v1.Pod) []string",
+			"Name func() string",
+			"PreEvictionFilter func(*v1.Pod) bool",
+			"PreEvictionFilterReasons func(*v1.Pod) []string",
+		},
+		"CycleEvictorPlugin": {
+			"Filter func(*v1.Pod) bool",
+			"Name func() string",
+			"PreEvictionFilter func(*v1.Pod) bool",
+			"StartCycle func(context.Context)",
+		},
+	}
+	interfaces := map[string]reflect.Type{
+		"Handle":                         reflect.TypeOf((*Handle)(nil)).Elem(),
+		"MetricsCollector":               reflect.TypeOf((*MetricsCollector)(nil)).Elem(),
+		"RecentEvictions":                reflect.TypeOf((*RecentEvictions)(nil)).Elem(),
+		"Evictor":                        reflect.TypeOf((*Evictor)(nil)).Elem(),
+		"GroupEvictor":                   reflect.TypeOf((*GroupEvictor)(nil)).Elem(),
+		"EvictionOrderer":                reflect.TypeOf((*EvictionOrderer)(nil)).Elem(),
+		"Plugin":                         reflect.TypeOf((*Plugin)(nil)).Elem(),
+		"DeschedulePlugin":               reflect.TypeOf((*DeschedulePlugin)(nil)).Elem(),
+		"BalancePlugin":                  reflect.TypeOf((*BalancePlugin)(nil)).Elem(),
+		"EvictorPlugin":                  reflect.TypeOf((*EvictorPlugin)(nil)).Elem(),
+		"ReportingEvictorPlugin":         reflect.TypeOf((*ReportingEvictorPlugin)(nil)).Elem(),
+		"PluginFilterEvictorPlugin":      reflect.TypeOf((*PluginFilterEvictorPlugin)(nil)).Elem(),
+		"PreEvictionHookEvictorPlugin":   reflect.TypeOf((*PreEvictionHookEvictorPlugin)(nil)).Elem(),
+		"PreEvictionRevertEvictorPlugin": reflect.TypeOf((*PreEvictionRevertEvictorPlugin)(nil)).Elem(),
+		"SoftEvictorPlugin":              reflect.TypeOf((*SoftEvictorPlugin)(nil)).Elem(),
+		"ExplainingEvictorPlugin":        reflect.TypeOf((*ExplainingEvictorPlugin)(nil)).Elem(),
+		"CycleEvictorPlugin":             reflect.TypeOf((*CycleEvictorPlugin)(nil)).Elem(),
+	}
+	for name, iface := range interfaces {
+		if diff := cmp.Diff(expected[name], methods(iface)); diff != "" {
+			t.Errorf("Plugin API %s changed, bump PluginAPIVersion (-expected +got):\n%s", name, diff)
+		}
+	}
+
+	// Every interface of the package is part of the plugin API
+	declared, err := exportedInterfaces(".")
+	if err != nil {
+		t.Fatalf("Unable to parse the package: %v", err)
+	}
+	for _, name := range declared {
+		if _, ok := interfaces[name]; !ok {
+			t.Errorf("Interface %s not pinned, add it to the plugin API", name)
+		}
+	}
+}
+
+// exportedInterfaces lists the exported interfaces declared in the non-test files of the package
+func exportedInterfaces(dir string) ([]string, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					if _, ok := typeSpec.Type.(*ast.InterfaceType); ok && typeSpec.Name.IsExported() {
+						names = append(names, typeSpec.Name.Name)
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+// NamePatterns matches names against exact names, glob patterns (e.g. kube-*)
+// and regular expressions enclosed in slashes (e.g. /^team-[0-9]+$/)
+type NamePatterns struct {
+	names   sets.Set[string]
+	globs   []string
+	regexps []*regexp.Regexp
+}
+
+// NewNamePatterns compiles the patterns
+func NewNamePatterns(patterns ...string) (*NamePatterns, error) {
+	p := &NamePatterns{names: sets.New[string]()}
+	for _, pattern := range patterns {
+		switch {
+		case len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/"):
+			re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression %q: %v", pattern, err)
+			}
+			p.regexps = append(p.regexps, re)
+		case strings.ContainsAny(pattern, "*?["):
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid glob pattern %q: %v", pattern, err)
+			}
+			p.globs = append(p.globs, pattern)
+		default:
+			p.names.Insert(pattern)
+		}
+	}
+	return p, nil
+}
+
+// Len returns the number of the patterns
+func (p *NamePatterns) Len() int {
+	if p == nil {
+		return 0
+	}
+	return p.names.Len() + len(p.globs) + len(p.regexps)
+}
+
+// Has returns true when the name matches any of the patterns
+func (p *NamePatterns) Has(name string) bool {
+	if p == nil {
+		return false
+	}
+	if p.names.Has(name) {
+		return true
+	}
+	for _, glob := range p.globs {
+		if matched, _ := path.Match(glob, name); matched {
+			return true
+		}
+	}
+	for _, re := range p.regexps {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewNamespacesPatterns compiles the included and excluded namespaces patterns
+func NewNamespacesPatterns(namespaces *api.Namespaces) (*NamePatterns, *NamePatterns, error) {
+	if namespaces == nil {
+		return nil, nil, nil
+	}
+	included, err := NewNamePatterns(namespaces.Include...)
+	if err != nil {
+		return nil, nil, err
+	}
+	excluded, err := NewNamePatterns(namespaces.Exclude...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return included, excluded, nil
+}
+
+// ValidateNamespaces checks at most one of include/exclude namespaces is set
+// and all the namespaces patterns compile
+func ValidateNamespaces(namespaces *api.Namespaces) error {
+	if namespaces == nil {
+		return nil
+	}
+	if len(namespaces.Include) > 0 && len(namespaces.Exclude) > 0 {
+		return fmt.Errorf("only one of Include/Exclude namespaces can be set")
+	}
+	_, _, err := NewNamespacesPatterns(namespaces)
+	return err
+}
+
+// OwnerKinds matches owner references against kinds. A kind qualified with the API group
+// (e.g. Rollout.argoproj.io) matches only owners of the group, an unqualified kind matches
+// owners of any group. Regular expressions are matched against both forms.
+type OwnerKinds struct {
+	kinds      *NamePatterns
+	groupKinds *NamePatterns
+	regexps    *NamePatterns
+}
+
+// NewOwnerKinds compiles the owner kinds patterns
+func NewOwnerKinds(kinds ...string) (*OwnerKinds, error) {
+	var plain, qualified, regexps []string
+	for _, kind := range kinds {
+		switch {
+		case len(kind) > 1 && strings.HasPrefix(kind, "/") && strings.HasSuffix(kind, "/"):
+			regexps = append(regexps, kind)
+		case strings.Contains(kind, "."):
+			qualified = append(qualified, kind)
+		default:
+			plain = append(plain, kind)
+		}
+	}
+	o := &OwnerKinds{}
+	var err error
+	if o.kinds, err = NewNamePatterns(plain...); err != nil {
+		return nil, err
+	}
+	if o.groupKinds, err = NewNamePatterns(qualified...); err != nil {
+		return nil, err
+	}
+	if o.regexps, err = NewNamePatterns(regexps...); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Has returns true when the owner matches any of the kinds
+func (o *OwnerKinds) Has(owner metav1.OwnerReference) bool {
+	if o == nil {
+		return false
+	}
+	if o.kinds.Has(owner.Kind) || o.regexps.Has(owner.Kind) {
+		return true
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return false
+	}
+	groupKind := schema.GroupKind{Group: gv.Group, Kind: owner.Kind}.String()
+	return o.groupKinds.Has(groupKind) || o.regexps.Has(groupKind)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/descheduler/pkg/api"
+)
+
+func TestNamePatterns(t *testing.T) {
+	tests := []struct {
+		name      string
+		patterns  []string
+		matched   []string
+		unmatched []string
+	}{
+		{
+			name:      "exact names",
+			patterns:  []string{"kube-system", "default"},
+			matched:   []string{"kube-system", "default"},
+			unmatched: []string{"kube-public", "defaults"},
+		},
+		{
+			name:      "glob patterns",
+			patterns:  []string{"kube-*", "team-?"},
+			matched:   []string{"kube-system", "kube-public", "team-a"},
+			unmatched: []string{"default", "team-ab"},
+		},
+		{
+			name:      "regular expressions",
+			patterns:  []string{"/^team-[0-9]+$/"},
+			matched:   []string{"team-1", "team-42"},
+			unmatched: []string{"team-a", "my-team-1"},
+		},
+		{
+			name:      "no patterns",
+			unmatched: []string{"default"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			patterns, err := NewNamePatterns(tc.patterns...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if patterns.Len() != len(tc.patterns) {
+				t.Errorf("Expected %d patterns, got %d", len(tc.patterns), patterns.Len())
+			}
+			for _, name := range tc.matched {
+				if !patterns.Has(name) {
+					t.Errorf("Expected %q to match %v", name, tc.patterns)
+				}
+			}
+			for _, name := range tc.unmatched {
+				if patterns.Has(name) {
+					t.Errorf("Expected %q not to match %v", name, tc.patterns)
+				}
+			}
+		})
+	}
+}
+
+func TestNamePatternsInvalid(t *testing.T) {
+	for _, pattern := range []string{"/team-[/", "team-["} {
+		if _, err := NewNamePatterns(pattern); err == nil {
+			t.Errorf("Expected an error compiling %q", pattern)
+		}
+	}
+}
+
+func TestValidateNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces *api.Namespaces
+		expectErr  bool
+	}{
+		{
+			name: "nil namespaces",
+		},
+		{
+			name:       "include patterns",
+			namespaces: &api.Namespaces{Include: []string{"kube-*", "/^team-.*$/"}},
+		},
+		{
+			name:       "include and exclude",
+			namespaces: &api.Namespaces{Include: []string{"default"}, Exclude: []string{"kube-*"}},
+			expectErr:  true,
+		},
+		{
+			name:       "invalid regular expression",
+			namespaces: &api.Namespaces{Exclude: []string{"/(/"}},
+			expectErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateNamespaces(tc.namespaces)
+			if (err != nil) != tc.expectErr {
+				t.Errorf("Expected error %v, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestOwnerKinds(t *testing.T) {
+	argoRollout := metav1.OwnerReference{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout"}
+	otherRollout := metav1.OwnerReference{APIVersion: "example.com/v1", Kind: "Rollout"}
+	replicaSet := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet"}
+	node := metav1.OwnerReference{APIVersion: "v1", Kind: "Node"}
+
+	tests := []struct {
+		name      string
+		kinds     []string
+		matched   []metav1.OwnerReference
+		unmatched []metav1.OwnerReference
+	}{
+		{
+			name:      "unqualified kind matches any group",
+			kinds:     []string{"Rollout"},
+			matched:   []metav1.OwnerReference{argoRollout, otherRollout},
+			unmatched: []metav1.OwnerReference{replicaSet},
+		},
+		{
+			name:      "qualified kind matches its group only",
+			kinds:     []string{"Rollout.argoproj.io"},
+			matched:   []metav1.OwnerReference{argoRollout},
+			unmatched: []metav1.OwnerReference{otherRollout, replicaSet},
+		},
+		{
+			name:      "glob pattern of the group",
+			kinds:     []string{"*.apps"},
+			matched:   []metav1.OwnerReference{replicaSet},
+			unmatched: []metav1.OwnerReference{argoRollout, node},
+		},
+		{
+			name:      "core group kind",
+			kinds:     []string{"Node"},
+			matched:   []metav1.OwnerReference{node},
+			unmatched: []metav1.OwnerReference{replicaSet},
+		},
+		{
+			name:      "regular expression matches the qualified kind",
+			kinds:     []string{"/^Rollout\\.argoproj\\.io$/"},
+			matched:   []metav1.OwnerReference{argoRollout},
+			unmatched: []metav1.OwnerReference{otherRollout},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kinds, err := NewOwnerKinds(tc.kinds...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, owner := range tc.matched {
+				if !kinds.Has(owner) {
+					t.Errorf("Expected %s/%s to match %v", owner.APIVersion, owner.Kind, tc.kinds)
+				}
+			}
+			for _, owner := range tc.unmatched {
+				if kinds.Has(owner) {
+					t.Errorf("Expected %s/%s not to match %v", owner.APIVersion, owner.Kind, tc.kinds)
+				}
+			}
+		})
+	}
+}
@@ -122,16 +122,21 @@ func IsPodWithPVC(pod *v1.Pod) bool {
 
 // IsPodCoveredByPDB returns true if the pod is covered by at least one PodDisruptionBudget.
 func IsPodCoveredByPDB(pod *v1.Pod, lister policyv1.PodDisruptionBudgetLister) (bool, error) {
-	// We can't use the GetPodPodDisruptionBudgets expansion method here because it treats no pdb as an error,
-	// but we want to return false.
-
-	list, err := lister.PodDisruptionBudgets(pod.Namespace).List(labels.Everything())
+	pdbList, err := GetPodPDBs(pod, lister)
 	if err != nil {
 		return false, err
 	}
+	return len(pdbList) > 0, nil
+}
+
+// GetPodPDBs returns the PodDisruptionBudgets covering the pod.
+func GetPodPDBs(pod *v1.Pod, lister policyv1.PodDisruptionBudgetLister) ([]*policy.PodDisruptionBudget, error) {
+	// We can't use the GetPodPodDisruptionBudgets expansion method here because it treats no pdb as an error,
+	// but we want to return an empty list.
 
-	if len(list) == 0 {
-		return false, nil
+	list, err := lister.PodDisruptionBudgets(pod.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
 	}
 
 	podLabels := labels.Set(pod.Labels)
@@ -149,7 +154,17 @@ func IsPodCoveredByPDB(pod *v1.Pod, lister policyv1.PodDisruptionBudgetLister) (
 		pdbList = append(pdbList, pdb)
 	}
 
-	return len(pdbList) > 0, nil
+	return pdbList, nil
+}
+
+// IsPodReady returns true if the pod has the Ready condition set to true.
+func IsPodReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
 }
 
 // GetPodSource returns the source of the pod based on the annotation.
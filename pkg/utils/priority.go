@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	clientset "k8s.io/client-go/kubernetes"
+	schedulinglisters "k8s.io/client-go/listers/scheduling/v1"
 	"sigs.k8s.io/descheduler/pkg/api"
 )
 
@@ -43,3 +45,19 @@ func GetPriorityValueFromPriorityThreshold(ctx context.Context, client clientset
 	}
 	return
 }
+
+// GetPriorityValueFromPriorityClassSelector gets the lowest priority of the priority classes matching the selector.
+// It will return SystemCriticalPriority when no priority class matches.
+func GetPriorityValueFromPriorityClassSelector(lister schedulinglisters.PriorityClassLister, selector labels.Selector) (int32, error) {
+	priorityClasses, err := lister.List(selector)
+	if err != nil {
+		return 0, fmt.Errorf("unable to list priority classes: %v", err)
+	}
+	priority := SystemCriticalPriority
+	for _, priorityClass := range priorityClasses {
+		if priorityClass.Value < priority {
+			priority = priorityClass.Value
+		}
+	}
+	return priority, nil
+}
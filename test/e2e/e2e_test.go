@@ -19,6 +19,7 @@ package e2e
 import (
 	"bufio"
 	"context"
+	"flag"
 	"fmt"
 	"math"
 	"os"
@@ -74,6 +75,7 @@ func initFeatureGates() featuregate.FeatureGate {
 	featureGates := featuregate.NewFeatureGate()
 	featureGates.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		features.EvictionsInBackground: {Default: false, PreRelease: featuregate.Alpha},
+		features.SimulatedNodes:        {Default: false, PreRelease: featuregate.Alpha},
 	})
 	return featureGates
 }
@@ -204,8 +206,13 @@ func printPodLogs(ctx context.Context, t *testing.T, kubeClient clientset.Interf
 }
 
 func TestMain(m *testing.M) {
+	flag.Parse()
+	if err := applyFlags(); err != nil {
+		klog.Errorf("Invalid flags: %v", err)
+		os.Exit(1)
+	}
 	if os.Getenv("DESCHEDULER_IMAGE") == "" {
-		klog.Errorf("DESCHEDULER_IMAGE env is not set")
+		klog.Errorf("DESCHEDULER_IMAGE env is not set, use --descheduler-image")
 		os.Exit(1)
 	}
 
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+var (
+	kubeconfigFlag       = flag.String("kubeconfig", os.Getenv("KUBECONFIG"), "Path to the kubeconfig of the cluster under test. Defaults to the KUBECONFIG env.")
+	deschedulerImageFlag = flag.String("descheduler-image", os.Getenv("DESCHEDULER_IMAGE"), "Descheduler image deployed into the cluster under test. Defaults to the DESCHEDULER_IMAGE env.")
+	suitesFlag           = flag.String("suites", "", fmt.Sprintf("Comma separated list of suites to run, all suites are run when empty. One or more of: %s.", strings.Join(suiteNames(), ", ")))
+)
+
+// suites groups the e2e tests by the descheduler functionality they validate.
+// Every suite lists the names of the top level tests it consists of.
+var suites = map[string][]string{
+	"defaultevictor": {
+		"TestNamespaceConstraintsInclude",
+		"TestNamespaceConstraintsExclude",
+		"TestEvictSystemCriticalPriority",
+		"TestEvictSystemCriticalPriorityClass",
+		"TestEvictDaemonSetPod",
+		"TestThresholdPriority",
+		"TestThresholdPriorityClass",
+		"TestPodLabelSelector",
+		"TestEvictAnnotation",
+	},
+	"evictioninbackground": {"TestLiveMigrationInBackground"},
+	"failedpods":           {"TestFailedPods"},
+	"leaderelection":       {"TestLeaderElection"},
+	"lownodeutilization":   {"TestLowNodeUtilization", "TestLowNodeUtilizationKubernetesMetrics"},
+	"podlifetime":          {"TestPodLifeTimeOldestEvicted"},
+	"removeduplicates":     {"TestRemoveDuplicates"},
+	"server":               {"TestDeschedulingInterval", "TestClientConnectionConfiguration"},
+	"toomanyrestarts":      {"TestTooManyRestarts"},
+	"topologyspreading":    {"TestTopologySpreadConstraint"},
+}
+
+func suiteNames() []string {
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// suitesRunPattern translates a comma separated list of suites into a -test.run pattern
+func suitesRunPattern(list string) (string, error) {
+	var tests []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		suiteTests, ok := suites[name]
+		if !ok {
+			return "", fmt.Errorf("unknown suite %q, expected one of: %s", name, strings.Join(suiteNames(), ", "))
+		}
+		tests = append(tests, suiteTests...)
+	}
+	if len(tests) == 0 {
+		return "", nil
+	}
+	return "^(" + strings.Join(tests, "|") + ")$", nil
+}
+
+// applyFlags propagates the command line flags to the env variables consumed
+// by the tests and restricts the tests run to the selected suites.
+func applyFlags() error {
+	if err := os.Setenv("KUBECONFIG", *kubeconfigFlag); err != nil {
+		return err
+	}
+	if err := os.Setenv("DESCHEDULER_IMAGE", *deschedulerImageFlag); err != nil {
+		return err
+	}
+
+	pattern, err := suitesRunPattern(*suitesFlag)
+	if err != nil || pattern == "" {
+		return err
+	}
+	if run := flag.Lookup("test.run"); run != nil && run.Value.String() != "" {
+		return fmt.Errorf("--suites and --test.run are mutually exclusive")
+	}
+	return flag.Set("test.run", pattern)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package integration provides a harness running full descheduler policies
+// against synthetic clusters backed by a fake clientset. It allows to regression
+// test policy changes at a configurable scale without a real multi-node cluster.
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	utilptr "k8s.io/utils/ptr"
+
+	"sigs.k8s.io/descheduler/cmd/descheduler/app/options"
+	"sigs.k8s.io/descheduler/pkg/descheduler"
+	"sigs.k8s.io/descheduler/pkg/features"
+	"sigs.k8s.io/descheduler/pkg/framework/pluginregistry"
+	"sigs.k8s.io/descheduler/test"
+)
+
+// NodeGroup describes a set of identical synthetic nodes
+type NodeGroup struct {
+	// Prefix of the node names, nodes are named <Prefix>-<index>
+	Prefix string
+	// Count of nodes in the group
+	Count int
+	// MilliCPU, Memory and Pods set the allocatable resources of every node
+	MilliCPU int64
+	Memory   int64
+	Pods     int64
+	Labels   map[string]string
+	Taints   []v1.Taint
+}
+
+// Placement returns the name of the node a replica with the given index runs on
+type Placement func(replica int, nodes []*v1.Node) string
+
+// SpreadPlacement distributes replicas over all the nodes in a round robin fashion
+func SpreadPlacement(replica int, nodes []*v1.Node) string {
+	return nodes[replica%len(nodes)].Name
+}
+
+// PackedPlacement distributes replicas in a round robin fashion over the first n nodes only
+func PackedPlacement(n int) Placement {
+	return func(replica int, nodes []*v1.Node) string {
+		if n > len(nodes) {
+			n = len(nodes)
+		}
+		return nodes[replica%n].Name
+	}
+}
+
+// Workload describes a set of synthetic pods owned by a single replica set
+type Workload struct {
+	Name      string
+	Namespace string
+	Replicas  int
+	// MilliCPU and Memory set the requests of every replica
+	MilliCPU int64
+	Memory   int64
+	Labels   map[string]string
+	// Placement of the replicas, SpreadPlacement when not set
+	Placement Placement
+	// Apply allows to customize every replica, e.g. to set restart counts or the start time
+	Apply func(replica int, pod *v1.Pod)
+}
+
+// Cluster describes a synthetic cluster
+type Cluster struct {
+	NodeGroups []NodeGroup
+	Workloads  []Workload
+}
+
+// Nodes generates the nodes of the cluster
+func (c Cluster) Nodes() []*v1.Node {
+	var nodes []*v1.Node
+	for _, group := range c.NodeGroups {
+		for i := 0; i < group.Count; i++ {
+			nodes = append(nodes, test.BuildTestNode(fmt.Sprintf("%s-%d", group.Prefix, i), group.MilliCPU, group.Memory, group.Pods, func(node *v1.Node) {
+				for key, value := range group.Labels {
+					node.Labels[key] = value
+				}
+				node.Spec.Taints = group.Taints
+			}))
+		}
+	}
+	return nodes
+}
+
+// Objects generates all the objects of the cluster
+func (c Cluster) Objects() []runtime.Object {
+	nodes := c.Nodes()
+	var objects []runtime.Object
+	for _, node := range nodes {
+		objects = append(objects, node)
+	}
+	for _, workload := range c.Workloads {
+		namespace := workload.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		placement := workload.Placement
+		if placement == nil {
+			placement = SpreadPlacement
+		}
+		ownerRef := metav1.OwnerReference{
+			APIVersion: "apps/v1",
+			Kind:       "ReplicaSet",
+			Name:       workload.Name,
+			UID:        types.UID(namespace + "-" + workload.Name),
+			Controller: utilptr.To(true),
+		}
+		for i := 0; i < workload.Replicas; i++ {
+			objects = append(objects, test.BuildTestPod(fmt.Sprintf("%s-%d", workload.Name, i), workload.MilliCPU, workload.Memory, placement(i, nodes), func(pod *v1.Pod) {
+				pod.Namespace = namespace
+				pod.Labels = map[string]string{}
+				for key, value := range workload.Labels {
+					pod.Labels[key] = value
+				}
+				pod.OwnerReferences = []metav1.OwnerReference{ownerRef}
+				pod.Status.Phase = v1.PodRunning
+				if workload.Apply != nil {
+					workload.Apply(i, pod)
+				}
+			}))
+		}
+	}
+	return objects
+}
+
+// Result summarizes the evictions performed in a single descheduling cycle
+type Result struct {
+	// Evicted lists the evicted pods as <namespace>/<name> in the order of eviction
+	Evicted []string
+	// EvictedByNode counts the evicted pods per node
+	EvictedByNode map[string]uint
+	// EvictedByWorkload counts the evicted pods per <namespace>/<workload>
+	EvictedByWorkload map[string]uint
+}
+
+// Run runs a single descheduling cycle of the policy against the cluster.
+// The policy is given in the versioned form, i.e. as it would be read from the policy config file.
+func Run(ctx context.Context, cluster Cluster, policyYAML []byte) (*Result, error) {
+	dir, err := os.MkdirTemp("", "descheduler-integration")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	policyFile := filepath.Join(dir, "policy.yaml")
+	if err := os.WriteFile(policyFile, policyYAML, 0o600); err != nil {
+		return nil, err
+	}
+
+	objects := cluster.Objects()
+	client := fakeclientset.NewSimpleClientset(objects...)
+	result := &Result{
+		EvictedByNode:     map[string]uint{},
+		EvictedByWorkload: map[string]uint{},
+	}
+	var mu sync.Mutex
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction, ok := action.(core.CreateAction).GetObject().(*policy.Eviction)
+		if !ok {
+			return false, nil, fmt.Errorf("unable to convert action object into *policy.Eviction")
+		}
+		obj, err := client.Tracker().Get(action.GetResource(), eviction.Namespace, eviction.Name)
+		if err != nil {
+			return true, nil, err
+		}
+		// The evicted pods are kept in the tracker. Deleting them would flood
+		// the bounded fake watch channels at larger scales.
+		pod := obj.(*v1.Pod)
+		mu.Lock()
+		defer mu.Unlock()
+		result.Evicted = append(result.Evicted, eviction.Namespace+"/"+eviction.Name)
+		result.EvictedByNode[pod.Spec.NodeName]++
+		if ownerRef := metav1.GetControllerOf(pod); ownerRef != nil {
+			result.EvictedByWorkload[pod.Namespace+"/"+ownerRef.Name]++
+		}
+		return true, nil, nil
+	})
+
+	rs, err := options.NewDeschedulerServer()
+	if err != nil {
+		return nil, err
+	}
+	rs.Client = client
+	rs.EventClient = fakeclientset.NewSimpleClientset()
+	rs.DefaultFeatureGates = features.DefaultMutableFeatureGate
+
+	if pluginregistry.PluginRegistry == nil {
+		descheduler.SetupPlugins()
+	}
+	deschedulerPolicy, err := descheduler.LoadPolicyConfig(policyFile, client, pluginregistry.PluginRegistry)
+	if err != nil {
+		return nil, err
+	}
+	if err := descheduler.RunDeschedulerStrategies(ctx, rs, deschedulerPolicy, "v1"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
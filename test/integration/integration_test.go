@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// hotspotCluster creates a cluster where the first hot nodes are utilized at 75% cpu
+// by a single workload while the remaining nodes are empty.
+func hotspotCluster(nodes, hot int) Cluster {
+	return Cluster{
+		NodeGroups: []NodeGroup{
+			{Prefix: "node", Count: nodes, MilliCPU: 4000, Memory: 16 * 1024 * 1024 * 1024, Pods: 110},
+		},
+		Workloads: []Workload{
+			{Name: "web", Replicas: hot * 10, MilliCPU: 300, Memory: 1024, Placement: PackedPlacement(hot)},
+		},
+	}
+}
+
+const lowNodeUtilizationPolicy = `
+apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+%s
+profiles:
+  - name: ProfileName
+    pluginConfig:
+    - name: "LowNodeUtilization"
+      args:
+        thresholds:
+          "cpu": 20
+        targetThresholds:
+          "cpu": %d
+    plugins:
+      balance:
+        enabled:
+          - "LowNodeUtilization"
+`
+
+func TestLowNodeUtilizationArgsMatrix(t *testing.T) {
+	tests := []struct {
+		description       string
+		nodes, hot        int
+		targetThreshold   int
+		limits            string
+		expectedEvictions int
+		expectedPerNode   uint
+	}{
+		{
+			description:       "hot nodes drained below the target threshold",
+			nodes:             20,
+			hot:               4,
+			targetThreshold:   50,
+			expectedEvictions: 16,
+			expectedPerNode:   4,
+		},
+		{
+			description:       "higher target threshold evicts less",
+			nodes:             20,
+			hot:               4,
+			targetThreshold:   70,
+			expectedEvictions: 4,
+			expectedPerNode:   1,
+		},
+		{
+			description:       "nodes below the target threshold are not drained",
+			nodes:             20,
+			hot:               4,
+			targetThreshold:   80,
+			expectedEvictions: 0,
+		},
+		{
+			description:       "evictions limited per node",
+			nodes:             20,
+			hot:               4,
+			targetThreshold:   50,
+			limits:            "maxNoOfPodsToEvictPerNode: 2",
+			expectedEvictions: 8,
+			expectedPerNode:   2,
+		},
+		{
+			description:       "large cluster",
+			nodes:             500,
+			hot:               50,
+			targetThreshold:   50,
+			expectedEvictions: 200,
+			expectedPerNode:   4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			result, err := Run(ctx, hotspotCluster(tc.nodes, tc.hot), []byte(fmt.Sprintf(lowNodeUtilizationPolicy, tc.limits, tc.targetThreshold)))
+			if err != nil {
+				t.Fatalf("Unable to run the policy: %v", err)
+			}
+			if len(result.Evicted) != tc.expectedEvictions {
+				t.Errorf("Expected %v evictions, got %v", tc.expectedEvictions, len(result.Evicted))
+			}
+			for node, evicted := range result.EvictedByNode {
+				if evicted != tc.expectedPerNode {
+					t.Errorf("Expected %v evictions from node %v, got %v", tc.expectedPerNode, node, evicted)
+				}
+			}
+		})
+	}
+}
+
+const removeDuplicatesPolicy = `
+apiVersion: "descheduler/v1alpha2"
+kind: "DeschedulerPolicy"
+profiles:
+  - name: ProfileName
+    pluginConfig:
+    - name: "RemoveDuplicates"
+      args:
+        namespaces:
+          exclude: [%s]
+    plugins:
+      balance:
+        enabled:
+          - "RemoveDuplicates"
+`
+
+func TestRemoveDuplicatesArgsMatrix(t *testing.T) {
+	cluster := Cluster{
+		NodeGroups: []NodeGroup{
+			{Prefix: "node", Count: 10, MilliCPU: 4000, Memory: 16 * 1024 * 1024 * 1024, Pods: 110},
+		},
+		Workloads: []Workload{
+			{Name: "web", Namespace: "dev", Replicas: 10, MilliCPU: 100, Placement: PackedPlacement(2)},
+			{Name: "web", Namespace: "prod", Replicas: 10, MilliCPU: 100, Placement: PackedPlacement(5)},
+			{Name: "db", Namespace: "prod", Replicas: 10, MilliCPU: 100},
+		},
+	}
+
+	tests := []struct {
+		description string
+		excluded    string
+		expected    map[string]uint
+	}{
+		{
+			description: "duplicates evicted in all namespaces",
+			expected:    map[string]uint{"dev/web": 8, "prod/web": 5},
+		},
+		{
+			description: "excluded namespaces untouched",
+			excluded:    `"dev"`,
+			expected:    map[string]uint{"prod/web": 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			result, err := Run(ctx, cluster, []byte(fmt.Sprintf(removeDuplicatesPolicy, tc.excluded)))
+			if err != nil {
+				t.Fatalf("Unable to run the policy: %v", err)
+			}
+			if len(result.EvictedByWorkload) != len(tc.expected) {
+				t.Errorf("Expected evictions %v, got %v", tc.expected, result.EvictedByWorkload)
+			}
+			for workload, evicted := range tc.expected {
+				if result.EvictedByWorkload[workload] != evicted {
+					t.Errorf("Expected %v evictions of %v, got %v", evicted, workload, result.EvictedByWorkload[workload])
+				}
+			}
+		})
+	}
+}